@@ -19,12 +19,17 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"path/filepath"
+	"slices"
 
 	"github.com/linuxsuren/api-testing/pkg/generator"
 	"github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/linuxsuren/api-testing/pkg/util/home"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 func createConvertCommand() (c *cobra.Command) {
@@ -42,9 +47,11 @@ func createConvertCommand() (c *cobra.Command) {
 	flags.StringVarP(&opt.pattern, "pattern", "p", "test-suite-*.yaml",
 		"The file pattern which try to execute the test cases. Brace expansion is supported, such as: test-suite-{1,2}.yaml")
 	flags.StringVarP(&opt.converter, "converter", "", "",
-		fmt.Sprintf("The converter format, supported: %s", util.Keys(converters)))
-	flags.StringVarP(&opt.source, "source", "", "", "The source format, supported: postman")
+		fmt.Sprintf("The converter format, supported: %s, and the template plugins", slices.Sorted(maps.Keys(converters))))
+	flags.StringVarP(&opt.source, "source", "", "",
+		fmt.Sprintf("The source format, supported: %s", slices.Sorted(maps.Keys(generator.GetTestSuiteImporters()))))
 	flags.StringVarP(&opt.target, "target", "t", "", "The target file path")
+	opt.templatePluginOption.addFlags(flags)
 
 	_ = c.MarkFlagRequired("pattern")
 	_ = c.MarkFlagRequired("converter")
@@ -52,21 +59,52 @@ func createConvertCommand() (c *cobra.Command) {
 }
 
 type convertOption struct {
+	templatePluginOption
 	pattern   string
 	converter string
 	source    string
 	target    string
 }
 
+// templatePluginOption has the flags of the template plugins
+type templatePluginOption struct {
+	configDir    string
+	templateDirs []string
+}
+
+func (o *templatePluginOption) addFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(&o.configDir, "config-dir", "", home.GetUserConfigDir(), "The config directory, the template plugins in <config-dir>/templates are loaded")
+	flags.StringArrayVarP(&o.templateDirs, "template-dir", "", nil, "The directories of the code generator and converter templates")
+}
+
+// loadTemplatePlugins registers the template plugins, the broken ones are reported without stopping the command
+func loadTemplatePlugins(c *cobra.Command, configDir string, templateDirs []string) {
+	dirs := append([]string{filepath.Join(os.ExpandEnv(configDir), "templates")}, templateDirs...)
+	if _, err := generator.LoadTemplatePlugins(dirs...); err != nil {
+		c.PrintErrln(err)
+	}
+}
+
+// converterExtensions are the file extensions of the converter outputs
+var converterExtensions = map[string]string{
+	"jmeter":  ".jmx",
+	"raw":     ".yaml",
+	"postman": ".json",
+	"openapi": ".yaml",
+	"k6":      ".js",
+}
+
 func (o *convertOption) preRunE(c *cobra.Command, args []string) (err error) {
-	switch o.source {
-	case "postman":
+	loadTemplatePlugins(c, o.configDir, o.templateDirs)
+
+	switch {
+	case o.source == "":
+		o.target = util.EmptyThenDefault(o.target, "sample"+util.EmptyThenDefault(converterExtensions[o.converter], ".txt"))
+	case generator.GetTestSuiteImporter(o.source) != nil:
 		o.target = util.EmptyThenDefault(o.target, "sample.yaml")
 		o.converter = "raw"
-	case "":
-		o.target = util.EmptyThenDefault(o.target, "sample.jmx")
 	default:
-		err = errors.New("only postman supported")
+		err = fmt.Errorf("not supported source: %s", o.source)
 	}
 
 	return
@@ -82,7 +120,7 @@ func (o *convertOption) runE(c *cobra.Command, args []string) (err error) {
 	if o.source == "" {
 		suite, err = getSuiteFromFile(o.pattern)
 	} else {
-		suite, err = generator.NewPostmanImporter().ConvertFromFile(o.pattern)
+		suite, err = generator.GetTestSuiteImporter(o.source).ConvertFromFile(o.pattern)
 	}
 
 	if err != nil {
@@ -53,6 +53,43 @@ func TestConvert(t *testing.T) {
 		}
 	})
 
+	t.Run("convert to k6", func(t *testing.T) {
+		tmpFile := path.Join(os.TempDir(), time.Now().String())
+		defer os.RemoveAll(tmpFile)
+
+		c.SetArgs([]string{"convert", "-p=testdata/simple-suite.yaml", "--converter=k6", "--target", tmpFile})
+		err := c.Execute()
+		assert.NoError(t, err)
+
+		var data []byte
+		data, err = os.ReadFile(tmpFile)
+		if assert.NoError(t, err) {
+			assert.Contains(t, string(data), "k6/http")
+		}
+	})
+
+	t.Run("convert with a template plugin", func(t *testing.T) {
+		tmpFile := path.Join(t.TempDir(), "suite.csv")
+		c.SetArgs([]string{"convert", "-p=testdata/simple-suite.yaml", "--converter=csv", "--target", tmpFile,
+			"--config-dir", t.TempDir(), "--template-dir", "../pkg/generator/testdata/templates"})
+		err := c.Execute()
+		assert.NoError(t, err)
+
+		var data []byte
+		data, err = os.ReadFile(tmpFile)
+		if assert.NoError(t, err) {
+			assert.Contains(t, string(data), "name,method,api")
+		}
+	})
+
+	t.Run("sorted formats in the flags", func(t *testing.T) {
+		convertCmd, _, err := c.Find([]string{"convert"})
+		if assert.NoError(t, err) {
+			assert.Contains(t, convertCmd.Flags().Lookup("source").Usage, "[bruno curl har insomnia native openapi postman swagger]")
+			assert.Contains(t, convertCmd.Flags().Lookup("converter").Usage, "[jmeter k6 openapi postman raw]")
+		}
+	})
+
 	t.Run("no testSuite", func(t *testing.T) {
 		c.SetArgs([]string{"convert", "-p=testdata/fake.yaml", "--converter=jmeter"})
 
@@ -95,4 +132,17 @@ func TestConvert(t *testing.T) {
 		err := c.Execute()
 		assert.NoError(t, err)
 	})
+
+	t.Run("convert from bruno", func(t *testing.T) {
+		tmpFile := path.Join(os.TempDir(), time.Now().String())
+		defer os.RemoveAll(tmpFile)
+
+		c.SetArgs([]string{"convert", "--source=bruno", "--target", tmpFile, "-p=../pkg/generator/testdata/bruno"})
+		err := c.Execute()
+		assert.NoError(t, err)
+
+		data, err := os.ReadFile(tmpFile)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "name: list orders")
+	})
 }
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/apispec"
+	"github.com/linuxsuren/api-testing/pkg/runner"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/spf13/cobra"
+)
+
+func createCoverageCmd() (c *cobra.Command) {
+	opt := &coverageOption{}
+	c = &cobra.Command{
+		Use:   "coverage",
+		Short: "Compare the test suites against the Swagger or OpenAPI document without sending any requests",
+		Example: `atest coverage -p test-suite.yaml --spec openapi.yaml
+atest coverage -p 'test-suite-*.yaml' --report md --report-file coverage.md`,
+		RunE: opt.runE,
+	}
+
+	flags := c.Flags()
+	flags.StringVarP(&opt.pattern, "pattern", "p", "test-suite-*.yaml",
+		"The file pattern of the test suites. Brace expansion is supported, such as: test-suite-{1,2}.yaml")
+	flags.StringVarP(&opt.spec, "spec", "", "", "The URL of the Swagger 2.0 or OpenAPI 3.x document, it could be a local file. Take the spec.url of the suites if it is empty")
+	flags.StringVarP(&opt.report, "report", "", "std", "The format of the coverage report. Supported: std, markdown, md, html, json")
+	flags.StringVarP(&opt.reportFile, "report-file", "", "", "The file path of the report, print it if it is empty")
+	return
+}
+
+type coverageOption struct {
+	pattern    string
+	spec       string
+	report     string
+	reportFile string
+}
+
+func (o *coverageOption) runE(c *cobra.Command, args []string) (err error) {
+	loader := testing.NewFileLoader()
+	if err = loader.Put(o.pattern); err != nil {
+		return
+	}
+
+	specURL := o.spec
+	var records []apispec.CoverageRecord
+	for loader.HasMore() {
+		var data []byte
+		if data, err = loader.Load(); err != nil {
+			return
+		}
+
+		var suite *testing.TestSuite
+		if suite, err = testing.Parse(data); err != nil {
+			return
+		}
+		if specURL == "" {
+			// a relative local document is resolved from the directory of the suite
+			specURL = suite.Spec.URL
+			if specURL != "" && !strings.Contains(specURL, "://") && !filepath.IsAbs(specURL) {
+				specURL = filepath.Join(loader.GetContext(), specURL)
+			}
+		}
+		records = append(records, runner.GetSuiteCoverageRecords(suite)...)
+	}
+
+	if specURL == "" {
+		err = errors.New("the API specification is required, please set the flag --spec or the spec.url of the suites")
+		return
+	}
+
+	var apiSpec apispec.APISpec
+	if apiSpec, err = apispec.ParseURLToAPISpec(specURL); err != nil {
+		return
+	}
+
+	var writer io.Writer = c.OutOrStdout()
+	if o.reportFile != "" {
+		var file *os.File
+		if file, err = os.Create(o.reportFile); err != nil {
+			return
+		}
+		defer file.Close()
+		writer = file
+	}
+	err = runner.WriteCoverageReport(writer, apispec.NewCoverageReport(apiSpec, records), o.report)
+	return
+}
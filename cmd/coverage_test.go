@@ -0,0 +1,63 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/cmd"
+	"github.com/linuxsuren/api-testing/pkg/server"
+	fakeruntime "github.com/linuxsuren/go-fake-runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoverageCmd(t *testing.T) {
+	suiteFile := "testdata/coverage-suite.yaml"
+
+	t.Run("spec from the suite", func(t *testing.T) {
+		c := cmd.NewRootCmd(&fakeruntime.FakeExecer{ExpectOS: "linux"}, server.NewFakeHTTPServer())
+		buf := new(bytes.Buffer)
+		c.SetOut(buf)
+		c.SetArgs([]string{"coverage", "-p", suiteFile})
+		assert.NoError(t, c.Execute())
+		assert.Contains(t, buf.String(), "API Coverage: operations: 2/4, parameters: 1/4, status codes: 1/5")
+		assert.Contains(t, buf.String(), "POST /users requests: 1, missing status codes: 201, undocumented status codes: 400")
+	})
+
+	t.Run("markdown report file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "coverage.md")
+		c := cmd.NewRootCmd(&fakeruntime.FakeExecer{ExpectOS: "linux"}, server.NewFakeHTTPServer())
+		c.SetOut(new(bytes.Buffer))
+		c.SetArgs([]string{"coverage", "-p", suiteFile, "--spec", "../pkg/apispec/testdata/openapi.yaml",
+			"--report", "md", "--report-file", output})
+		assert.NoError(t, c.Execute())
+
+		data, err := os.ReadFile(output)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "| GET /users | 1 | header:X-Trace | 200 | default |  |")
+	})
+
+	t.Run("without spec", func(t *testing.T) {
+		c := cmd.NewRootCmd(&fakeruntime.FakeExecer{ExpectOS: "linux"}, server.NewFakeHTTPServer())
+		c.SetOut(new(bytes.Buffer))
+		c.SetArgs([]string{"coverage", "-p", "testdata/simple-suite.yaml"})
+		assert.Error(t, c.Execute())
+	})
+}
@@ -64,6 +64,10 @@ func (o *funcPrinterOption) runE(cmd *cobra.Command, args []string) (err error)
 		if err = errors.Join(err, tpl.Validate(), tpl.ConflictWith(render.FuncMap())); err != nil {
 			return
 		}
+
+		if err = render.SetUserDefinedTemplates(tpl); err != nil {
+			return
+		}
 	}
 
 	if len(args) > 0 {
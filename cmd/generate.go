@@ -0,0 +1,116 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/linuxsuren/api-testing/pkg/generator"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/spf13/cobra"
+)
+
+type generateOption struct {
+	templatePluginOption
+	fromOpenAPI string
+	name        string
+	api         string
+	output      string
+	converter   string
+}
+
+func createGenerateCmd() (c *cobra.Command) {
+	opt := &generateOption{}
+	c = &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a test suite from the API specification",
+		Long: `Generate a test suite from the Swagger 2.0 or OpenAPI 3.x document.
+There is a test case for each operation which has the sample request body, parameters,
+authorization headers, the expected status code and the schema of the response.`,
+		Example: "atest generate --from-openapi openapi.yaml -o test-suite.yaml",
+		Args:    cobra.NoArgs,
+		PreRunE: opt.preRunE,
+		RunE:    opt.runE,
+	}
+
+	flags := c.Flags()
+	flags.StringVarP(&opt.fromOpenAPI, "from-openapi", "", "", "The URL of the Swagger 2.0 or OpenAPI 3.x document, it could be a local file")
+	flags.StringVarP(&opt.name, "name", "", "", "The name of the test suite, the title of the document will be used if it is empty")
+	flags.StringVarP(&opt.api, "api", "", "", "The base API of the test suite, the first server of the document will be used if it is empty")
+	flags.StringVarP(&opt.output, "output", "o", "", "The file to write the test suite, print it if it is empty")
+	flags.StringVarP(&opt.converter, "converter", "", "", "Convert the test suite with a converter, such as k6, or a template plugin")
+	opt.templatePluginOption.addFlags(flags)
+
+	_ = c.MarkFlagRequired("from-openapi")
+	return
+}
+
+func (o *generateOption) preRunE(cmd *cobra.Command, args []string) (err error) {
+	loadTemplatePlugins(cmd, o.configDir, o.templateDirs)
+	if o.converter != "" && generator.GetTestSuiteConverter(o.converter) == nil {
+		err = fmt.Errorf("not supported converter: %s", o.converter)
+	}
+	return
+}
+
+func (o *generateOption) runE(cmd *cobra.Command, args []string) (err error) {
+	var suite *testing.TestSuite
+	if suite, err = generator.NewOpenAPIImporter().ConvertFromURL(o.fromOpenAPI); err != nil {
+		return
+	}
+
+	if o.name != "" {
+		suite.Name = o.name
+	}
+	if o.api != "" {
+		suite.API = o.api
+	}
+
+	if o.converter != "" {
+		err = o.convert(cmd, suite)
+		return
+	}
+
+	if o.output != "" {
+		if err = testing.SaveTestSuiteToFile(suite, o.output); err == nil {
+			cmd.Printf("the test suite %q was generated with %d test cases\n", suite.Name, len(suite.Items))
+		}
+		return
+	}
+
+	var data []byte
+	if data, err = testing.ToYAML(suite); err == nil {
+		cmd.Print(testing.GetHeader() + string(data))
+	}
+	return
+}
+
+// convert writes the output of the converter into the output file, or prints it
+func (o *generateOption) convert(cmd *cobra.Command, suite *testing.TestSuite) (err error) {
+	var output string
+	if output, err = generator.GetTestSuiteConverter(o.converter).Convert(suite); err != nil {
+		return
+	}
+
+	if o.output != "" {
+		err = os.WriteFile(o.output, []byte(output), 0644)
+	} else {
+		cmd.Print(output)
+	}
+	return
+}
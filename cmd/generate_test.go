@@ -0,0 +1,85 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/cmd"
+	"github.com/linuxsuren/api-testing/pkg/server"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	fakeruntime "github.com/linuxsuren/go-fake-runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateCmd(t *testing.T) {
+	specFile := "../pkg/apispec/testdata/openapi.yaml"
+
+	t.Run("print the suite", func(t *testing.T) {
+		c := cmd.NewRootCmd(&fakeruntime.FakeExecer{ExpectOS: "linux"}, server.NewFakeHTTPServer())
+		buf := new(bytes.Buffer)
+		c.SetOut(buf)
+		c.SetArgs([]string{"generate", "--from-openapi", specFile, "--name", "users-api"})
+		assert.NoError(t, c.Execute())
+
+		suite, err := atest.Parse(buf.Bytes())
+		assert.NoError(t, err)
+		assert.Equal(t, "users-api", suite.Name)
+		assert.Equal(t, specFile, suite.Spec.URL)
+		assert.Len(t, suite.Items, 4)
+	})
+
+	t.Run("write to a file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "suite.yaml")
+		c := cmd.NewRootCmd(&fakeruntime.FakeExecer{ExpectOS: "linux"}, server.NewFakeHTTPServer())
+		c.SetOut(new(bytes.Buffer))
+		c.SetArgs([]string{"generate", "--from-openapi", specFile, "--api", "http://localhost:9090", "-o", output})
+		assert.NoError(t, c.Execute())
+
+		suite, err := atest.ParseTestSuiteFromFile(output)
+		assert.NoError(t, err)
+		assert.Equal(t, "http://localhost:9090", suite.API)
+	})
+
+	t.Run("convert with a template plugin", func(t *testing.T) {
+		c := cmd.NewRootCmd(&fakeruntime.FakeExecer{ExpectOS: "linux"}, server.NewFakeHTTPServer())
+		buf := new(bytes.Buffer)
+		c.SetOut(buf)
+		c.SetErr(new(bytes.Buffer))
+		c.SetArgs([]string{"generate", "--from-openapi", specFile, "--converter", "csv",
+			"--config-dir", t.TempDir(), "--template-dir", "../pkg/generator/testdata/templates"})
+		assert.NoError(t, c.Execute())
+		assert.True(t, strings.HasPrefix(buf.String(), "name,method,api\n"))
+	})
+
+	t.Run("unknown converter", func(t *testing.T) {
+		c := cmd.NewRootCmd(&fakeruntime.FakeExecer{ExpectOS: "linux"}, server.NewFakeHTTPServer())
+		c.SetOut(new(bytes.Buffer))
+		c.SetArgs([]string{"generate", "--from-openapi", specFile, "--converter", "fake", "--config-dir", t.TempDir()})
+		assert.ErrorContains(t, c.Execute(), "not supported converter: fake")
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		c := cmd.NewRootCmd(&fakeruntime.FakeExecer{ExpectOS: "linux"}, server.NewFakeHTTPServer())
+		c.SetOut(new(bytes.Buffer))
+		c.SetArgs([]string{"generate", "--from-openapi", "fake.yaml"})
+		assert.Error(t, c.Execute())
+	})
+}
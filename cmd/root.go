@@ -40,7 +40,7 @@ func NewRootCmd(execer fakeruntime.Execer, httpServer server.HTTPServer) (c *cob
 		createServerCmd(execer, httpServer), createJSONSchemaCmd(),
 		createServiceCommand(execer), createFunctionCmd(), createConvertCommand(),
 		createMockCmd(), createExtensionCommand(downloader.NewStoreDownloader()),
-		createComposeRun())
+		createComposeRun(), createSecretCmd(), createGenerateCmd(), createCoverageCmd())
 	return
 }
 
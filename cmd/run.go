@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -34,6 +35,7 @@ import (
 
 	"github.com/linuxsuren/api-testing/pkg/apispec"
 	"github.com/linuxsuren/api-testing/pkg/logging"
+	"github.com/linuxsuren/api-testing/pkg/render"
 	"github.com/linuxsuren/api-testing/pkg/runner"
 	"github.com/linuxsuren/api-testing/pkg/runner/monitor"
 	"github.com/linuxsuren/api-testing/pkg/testing"
@@ -66,10 +68,17 @@ type runOption struct {
 	reportTemplate     string
 	reportDest         string
 	swaggerURL         string
+	coverageFile       string
+	coverageInReport   bool
+	coverage           *bytes.Buffer
 	level              string
 	caseItems          []string
 	githubReportOption *runner.GithubPRCommentOption
 	monitorDocker      string
+	seed               int64
+	locale             string
+	configDir          string
+	updateSnapshots    bool
 
 	// for internal use
 	loader testing.Loader
@@ -134,11 +143,17 @@ func (o *runOption) addFlags(flags *pflag.FlagSet) {
 	flags.BoolVarP(&o.reportIgnore, "report-ignore", "", false, "Indicate if ignore the report output")
 	flags.StringVarP(&o.reportTemplate, "report-template", "", "", "The template used to render the report")
 	flags.StringVarP(&o.reportDest, "report-dest", "", "", "The server url where you want to send the report")
-	flags.StringVarP(&o.swaggerURL, "swagger-url", "", "", "The URL of swagger")
+	flags.StringVarP(&o.swaggerURL, "swagger-url", "", "", "The URL of the Swagger 2.0 or OpenAPI 3.x document, it could be a local file")
+	flags.StringVarP(&o.coverageFile, "coverage-file", "", "", "The file path of the API coverage in JSON, it works with the json report and --swagger-url")
+	flags.BoolVarP(&o.coverageInReport, "coverage-in-report", "", false, "Put the API coverage into the json report, the report becomes an object of the results and coverage")
 	flags.Int64VarP(&o.thread, "thread", "", 1, "Threads of the execution")
 	flags.Int32VarP(&o.qps, "qps", "", 5, "QPS")
 	flags.IntVarP(&o.burst, "burst", "", 5, "burst")
 	flags.StringVarP(&o.monitorDocker, "monitor-docker", "", "", "The docker container name to monitor")
+	flags.Int64VarP(&o.seed, "seed", "", 0, "The seed of the random data, replay a run with the seed in its report. It is random if it is zero")
+	flags.StringVarP(&o.locale, "locale", "", render.DefaultFakerLocale, "The locale of the fake data, such as: en_US, zh_CN, de_DE")
+	flags.StringVarP(&o.configDir, "config-dir", "", home.GetUserConfigDir(), "The config directory, the global user-defined functions are loaded from <config-dir>/functions.yaml")
+	flags.BoolVarP(&o.updateSnapshots, "update-snapshots", "", false, "Indicate if overwrite the snapshots with the current responses")
 }
 
 func (o *runOption) preRunE(cmd *cobra.Command, args []string) (err error) {
@@ -147,6 +162,7 @@ func (o *runOption) preRunE(cmd *cobra.Command, args []string) (err error) {
 		ctx = context.Background()
 	}
 	o.context = context.WithValue(ctx, caseFilter, o.caseFilter)
+	o.context = context.WithValue(o.context, runner.NewContextKeyBuilder().UpdateSnapshots(), o.updateSnapshots)
 	writer := cmd.OutOrStdout()
 
 	if o.reportFile != "" && !strings.HasPrefix(o.reportFile, "http://") && !strings.HasPrefix(o.reportFile, "https://") {
@@ -200,14 +216,41 @@ func (o *runOption) preRunE(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	if err == nil {
-		var swaggerAPI apispec.SwaggerAPI
+		var apiSpec apispec.APISpec
 		if o.swaggerURL != "" {
-			if swaggerAPI.Swagger, err = apispec.ParseURLToSwagger(o.swaggerURL); err == nil {
-				o.reportWriter.WithAPICoverage(&swaggerAPI)
+			if apiSpec, err = apispec.ParseURLToAPISpec(o.swaggerURL); err == nil {
+				o.reportWriter.WithAPICoverage(apiSpec)
+			}
+		}
+	}
+
+	if err == nil && (o.coverageFile != "" || o.coverageInReport) {
+		if setter, ok := o.reportWriter.(runner.CoverageWriterSetter); !ok {
+			flag := "--coverage-file"
+			if o.coverageFile == "" {
+				flag = "--coverage-in-report"
+			}
+			err = fmt.Errorf("%s only works with the json report", flag)
+		} else {
+			if o.coverageFile != "" {
+				o.coverage = new(bytes.Buffer)
+				setter.WithCoverageWriter(o.coverage)
+			}
+			if o.coverageInReport {
+				setter.WithCoverageInReport()
 			}
 		}
 	}
 
+	if err == nil {
+		err = render.SetFakerLocale(util.EmptyThenDefault(o.locale, render.DefaultFakerLocale))
+	}
+
+	// the same global user-defined functions as the server
+	if err == nil && o.configDir != "" {
+		err = render.LoadUserDefinedTemplates(filepath.Join(os.ExpandEnv(o.configDir), render.UserDefinedFile))
+	}
+
 	if err == nil {
 		err = o.startMonitor()
 	}
@@ -265,6 +308,24 @@ func (o *runOption) runE(cmd *cobra.Command, args []string) (err error) {
 		return
 	}
 
+	// reset the random source, so that the same seed generates the same data
+	replay := o.seed != 0
+	if !replay {
+		o.seed = util.Seed()
+	}
+	util.SetSeed(o.seed)
+	if o.thread > 1 {
+		// the order of the random data is not stable with multiple threads
+		if replay {
+			cmd.PrintErrln("the random data cannot be replayed with more than one thread")
+		}
+	} else {
+		o.reportWriter.WithSeed(o.seed)
+		if o.report == "json" {
+			cmd.PrintErrf("Seed: %d\n", o.seed)
+		}
+	}
+
 	cmd.Println("found suites:", o.loader.GetCount())
 	for o.loader.HasMore() {
 		if err = o.runSuiteWithDuration(o.loader); err != nil {
@@ -283,6 +344,10 @@ func (o *runOption) runE(cmd *cobra.Command, args []string) (err error) {
 		o.reportWriter.WithResourceUsage(o.reporter.GetResourceUsage())
 		outputErr := o.reportWriter.Output(results)
 		println(cmd, outputErr, "failed to Output all reports", outputErr)
+		if o.coverage != nil && o.coverage.Len() > 0 {
+			coverageErr := os.WriteFile(o.coverageFile, o.coverage.Bytes(), 0644)
+			println(cmd, coverageErr, "failed to write the API coverage", coverageErr)
+		}
 	}
 	println(cmd, reportErr, "failed to export all reports", reportErr)
 	return
@@ -359,6 +424,17 @@ func (o *runOption) runSuite(loader testing.Loader, dataContext map[string]inter
 		return
 	}
 
+	// the hooks of the suite run once around all its test cases
+	if err = runner.RunSuiteJob(testSuite.Before, testSuite, dataContext); err != nil {
+		err = fmt.Errorf("failed to run the before job of suite %s: %w", testSuite.Name, err)
+		return
+	}
+	defer func() {
+		if afterErr := runner.RunSuiteJob(testSuite.After, testSuite, dataContext); afterErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to run the after job of suite %s: %w", testSuite.Name, afterErr))
+		}
+	}()
+
 	var errs []error
 	suiteRunner := runner.GetTestSuiteRunner(testSuite)
 	suiteRunner.WithTestReporter(o.reporter)
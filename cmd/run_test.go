@@ -23,10 +23,12 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/h2non/gock"
+	"github.com/linuxsuren/api-testing/pkg/render"
 	atest "github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
 	"github.com/spf13/cobra"
@@ -102,6 +104,7 @@ func TestRunCommand(t *testing.T) {
 		args    []string
 		prepare func()
 		hasErr  bool
+		output  string
 	}{{
 		name: "status code is not match",
 		args: []string{"-p", simpleSuite},
@@ -170,6 +173,20 @@ func TestRunCommand(t *testing.T) {
 		},
 		args:   []string{"-p", simpleSuite, "--request-ignore-error"},
 		hasErr: true,
+	}, {
+		name:    "coverage in the json report",
+		prepare: fooPrepare,
+		args:    []string{"-p", simpleSuite, "--report", "json", "--swagger-url", "../pkg/apispec/testdata/openapi.yaml", "--coverage-in-report"},
+		output:  `"coverage":{`,
+	}, {
+		name:   "coverage in the markdown report",
+		args:   []string{"-p", simpleSuite, "--report", "md", "--coverage-in-report"},
+		hasErr: true,
+	}, {
+		name:    "with a seed",
+		prepare: fooPrepare,
+		args:    []string{"-p", simpleSuite, "--seed", "42"},
+		output:  "Seed: 42",
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -185,10 +202,66 @@ func TestRunCommand(t *testing.T) {
 
 			err := root.Execute()
 			assert.Equal(t, tt.hasErr, err != nil, err)
+			assert.Contains(t, buf.String(), tt.output)
 		})
 	}
 }
 
+func TestReplayWithSeed(t *testing.T) {
+	run := func(args ...string) (output, data string) {
+		defer gock.Off()
+		gock.New(urlFoo).Get("/bar").
+			AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+				data = req.Header.Get("X-Data")
+				return true, nil
+			}).
+			Reply(http.StatusOK).JSON("{}")
+
+		buf := new(bytes.Buffer)
+		root := &cobra.Command{Use: "root"}
+		root.SetOut(buf)
+		root.AddCommand(createRunCommand())
+		root.SetArgs(append([]string{"run", "-p", "testdata/random-suite.yaml"}, args...))
+		assert.NoError(t, root.Execute())
+		output = buf.String()
+		return
+	}
+
+	output, first := run()
+	seed := regexp.MustCompile(`Seed: (\d+)`).FindStringSubmatch(output)
+	if assert.Len(t, seed, 2) && assert.NotEmpty(t, first) {
+		_, second := run("--seed", seed[1])
+		assert.Equal(t, first, second)
+	}
+}
+
+func TestRunWithUserDefinedFunctions(t *testing.T) {
+	defer gock.Off()
+	defer func() {
+		_ = render.SetUserDefinedTemplates(nil)
+	}()
+	gock.New(urlFoo).Get("/bar").Reply(http.StatusOK).JSON("{}")
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(path.Join(dir, render.UserDefinedFile), []byte(`items:
+- name: barPath
+  render: http://foo/bar`), 0644))
+	suiteFile := path.Join(dir, "suite.yaml")
+	assert.NoError(t, os.WriteFile(suiteFile, []byte(`name: functions
+api: http://foo
+items:
+- name: bar
+  request:
+    api: '{{ barPath }}'`), 0644))
+
+	root := &cobra.Command{Use: "root"}
+	root.SetOut(new(bytes.Buffer))
+	root.AddCommand(createRunCommand())
+	root.SetArgs([]string{"run", "-p", suiteFile, "--config-dir", dir})
+	assert.NoError(t, root.Execute())
+	assert.True(t, gock.IsDone())
+}
+
 func TestPreRunE(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -257,6 +330,33 @@ func TestPreRunE(t *testing.T) {
 			assert.NotNil(t, err)
 			assert.Nil(t, ro.reportWriter)
 		},
+	}, {
+		name: "coverage file with the json report",
+		opt: &runOption{
+			report:       "json",
+			coverageFile: "coverage.json",
+		},
+		verify: func(t *testing.T, ro *runOption, err error) {
+			assert.Nil(t, err)
+			assert.NotNil(t, ro.coverage)
+		},
+	}, {
+		name: "coverage file without the json report",
+		opt: &runOption{
+			report:       "md",
+			coverageFile: "coverage.json",
+		},
+		verify: func(t *testing.T, ro *runOption, err error) {
+			assert.ErrorContains(t, err, "only works with the json report")
+		},
+	}, {
+		name: "invalid locale",
+		opt: &runOption{
+			locale: "fake",
+		},
+		verify: func(t *testing.T, ro *runOption, err error) {
+			assert.ErrorContains(t, err, "not supported locale")
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,179 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/secret"
+	"github.com/linuxsuren/api-testing/pkg/server"
+	"github.com/linuxsuren/api-testing/pkg/testing/local"
+	"github.com/linuxsuren/api-testing/pkg/testing/remote"
+	"github.com/linuxsuren/api-testing/pkg/util/home"
+	"github.com/spf13/cobra"
+)
+
+// envNewSecretPassphrase is the environment variable of the new passphrase when rotating the key
+const envNewSecretPassphrase = "ATEST_SECRET_NEW_PASSPHRASE"
+
+type secretOption struct {
+	configDir    string
+	secretServer string
+	show         bool
+}
+
+func createSecretCmd() (c *cobra.Command) {
+	opt := &secretOption{}
+	c = &cobra.Command{
+		Use:   "secret",
+		Short: "Manage the secrets",
+	}
+
+	flags := c.PersistentFlags()
+	flags.StringVarP(&opt.configDir, "config-dir", "", home.GetUserConfigDir(), "The config directory")
+	flags.StringVarP(&opt.secretServer, "secret-server", "", "", "The secret server URL, the local secrets will be used if it is empty")
+
+	listCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the secrets, the values are masked by default",
+		Args:    cobra.NoArgs,
+		RunE:    opt.runList,
+	}
+	listCmd.Flags().BoolVarP(&opt.show, "show", "", false, "Show the values of the secrets")
+
+	c.AddCommand(listCmd, &cobra.Command{
+		Use:   "set NAME [VALUE]",
+		Short: "Create or update a secret, the value is read from stdin if it is not given",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE:  opt.runSet,
+	}, &cobra.Command{
+		Use:     "delete NAME",
+		Aliases: []string{"rm"},
+		Short:   "Delete a secret",
+		Args:    cobra.ExactArgs(1),
+		RunE:    opt.runDelete,
+	}, &cobra.Command{
+		Use:   "rotate",
+		Short: "Encrypt the local secrets with a new key",
+		Long: `Encrypt the local secrets with a new key.
+A new key file will be generated, the previous one is kept as secret.key.old.
+The new passphrase comes from the environment variable ` + envNewSecretPassphrase + ` if ` + local.EnvSecretPassphrase + ` was set.`,
+		Args: cobra.NoArgs,
+		RunE: opt.runRotate,
+	}, &cobra.Command{
+		Use:   "reencrypt",
+		Short: "Encrypt the local secrets again with the current key, including the plain text ones",
+		Args:  cobra.NoArgs,
+		RunE:  opt.runReencrypt,
+	})
+	return
+}
+
+func (o *secretOption) getSecretServer() (secretServer remote.SecretServiceServer, err error) {
+	if o.secretServer != "" {
+		secretServer, err = remote.NewGRPCSecretFrom(o.secretServer)
+	} else {
+		secretServer = local.NewLocalSecretService(os.ExpandEnv(o.configDir))
+	}
+	return
+}
+
+func (o *secretOption) runList(cmd *cobra.Command, args []string) (err error) {
+	var secretServer remote.SecretServiceServer
+	if secretServer, err = o.getSecretServer(); err != nil {
+		return
+	}
+
+	var secrets *server.Secrets
+	if secrets, err = secretServer.GetSecrets(cmd.Context(), &server.Empty{}); err == nil {
+		for _, item := range secrets.Data {
+			value := secret.MaskText
+			if o.show {
+				value = item.Value
+			}
+			cmd.Printf("%s: %s\n", item.Name, value)
+		}
+	}
+	return
+}
+
+func (o *secretOption) runSet(cmd *cobra.Command, args []string) (err error) {
+	var value string
+	if len(args) > 1 {
+		value = args[1]
+	} else {
+		var data []byte
+		if data, err = io.ReadAll(cmd.InOrStdin()); err != nil {
+			return
+		}
+		value = strings.TrimRight(string(data), "\r\n")
+	}
+
+	var secretServer remote.SecretServiceServer
+	if secretServer, err = o.getSecretServer(); err == nil {
+		_, err = secretServer.UpdateSecret(cmd.Context(), &server.Secret{
+			Name:  args[0],
+			Value: value,
+		})
+	}
+	return
+}
+
+func (o *secretOption) runDelete(cmd *cobra.Command, args []string) (err error) {
+	var secretServer remote.SecretServiceServer
+	if secretServer, err = o.getSecretServer(); err == nil {
+		_, err = secretServer.DeleteSecret(cmd.Context(), &server.Secret{
+			Name: args[0],
+		})
+	}
+	return
+}
+
+func (o *secretOption) runRotate(cmd *cobra.Command, args []string) (err error) {
+	var rotator local.KeyRotator
+	if rotator, err = o.getKeyRotator(); err == nil {
+		if err = rotator.RotateKey(os.Getenv(envNewSecretPassphrase)); err == nil {
+			cmd.Println("the secrets were encrypted with the new key")
+		}
+	}
+	return
+}
+
+func (o *secretOption) runReencrypt(cmd *cobra.Command, args []string) (err error) {
+	var rotator local.KeyRotator
+	if rotator, err = o.getKeyRotator(); err == nil {
+		err = rotator.Reencrypt()
+	}
+	return
+}
+
+func (o *secretOption) getKeyRotator() (rotator local.KeyRotator, err error) {
+	var secretServer remote.SecretServiceServer
+	if secretServer, err = o.getSecretServer(); err != nil {
+		return
+	}
+
+	var ok bool
+	if rotator, ok = secretServer.(local.KeyRotator); !ok {
+		err = errors.New("only the local secrets support key rotation")
+	}
+	return
+}
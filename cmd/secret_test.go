@@ -0,0 +1,69 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/cmd"
+	"github.com/linuxsuren/api-testing/pkg/server"
+	fakeruntime "github.com/linuxsuren/go-fake-runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretCmd(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("ATEST_SECRET_PASSPHRASE", "")
+
+	execute := func(stdin string, args ...string) (string, error) {
+		buf := new(bytes.Buffer)
+		c := cmd.NewRootCmd(&fakeruntime.FakeExecer{ExpectOS: "linux"}, server.NewFakeHTTPServer())
+		c.SetOut(buf)
+		c.SetIn(strings.NewReader(stdin))
+		c.SetArgs(append([]string{"secret", "--config-dir", dir}, args...))
+		err := c.Execute()
+		return buf.String(), err
+	}
+
+	_, err := execute("", "set", "token", "value-from-args")
+	assert.NoError(t, err)
+	_, err = execute("value-from-stdin\n", "set", "password")
+	assert.NoError(t, err)
+
+	output, err := execute("", "list")
+	assert.NoError(t, err)
+	assert.Equal(t, "password: ******\ntoken: ******\n", output)
+
+	output, err = execute("", "rotate")
+	assert.NoError(t, err)
+	assert.Equal(t, "the secrets were encrypted with the new key\n", output)
+
+	_, err = execute("", "reencrypt")
+	assert.NoError(t, err)
+
+	_, err = execute("", "delete", "token")
+	assert.NoError(t, err)
+
+	output, err = execute("", "list", "--show")
+	assert.NoError(t, err)
+	assert.Equal(t, "password: value-from-stdin\n", output)
+
+	_, err = execute("", "rotate", "--secret-server", "localhost:0")
+	assert.Error(t, err)
+}
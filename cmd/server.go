@@ -28,6 +28,7 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"path/filepath"
 	"runtime/debug"
 	"strings"
 	"syscall"
@@ -42,6 +43,7 @@ import (
 	pprof "net/http/pprof"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/linuxsuren/api-testing/pkg/audit"
 	"github.com/linuxsuren/api-testing/pkg/downloader"
 	"github.com/linuxsuren/api-testing/pkg/logging"
 	"github.com/linuxsuren/api-testing/pkg/mock"
@@ -50,6 +52,7 @@ import (
 	"github.com/linuxsuren/api-testing/pkg/server"
 	"github.com/linuxsuren/api-testing/pkg/service"
 	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/testing/git"
 	"github.com/linuxsuren/api-testing/pkg/testing/local"
 	"github.com/linuxsuren/api-testing/pkg/testing/remote"
 	"github.com/linuxsuren/api-testing/pkg/util"
@@ -100,6 +103,11 @@ func createServerCmd(execer fakeruntime.Execer, httpServer server.HTTPServer) (c
 	flags.StringVarP(&opt.oauthServer, "oauth-server", "", "", "The oAuth server address, required if it is a private server")
 	flags.BoolVarP(&opt.oauthSkipTls, "oauth-skip-tls", "", false, "Skip TLS verify when connect to oauth server")
 	flags.StringArrayVarP(&opt.oauthGroup, "oauth-group", "", []string{}, "Alow specific groups, all groups is ok if it is empty")
+	flags.BoolVarP(&opt.rbac, "rbac", "", false, "Enable the role-based access control, it requires the oauth auth mode")
+	flags.StringArrayVarP(&opt.rbacAdmin, "rbac-admin", "", []string{}, "The users who have the admin role of all stores and suites")
+	flags.StringArrayVarP(&opt.audit, "audit", "", nil, "The audit log sinks, supported: file, file://<path>, stdout, grpc://<address>. The file sink writes into <config-dir>/audit.log. Audit is disabled without any sinks")
+	flags.IntVarP(&opt.auditMaxSize, "audit-max-size", "", 100, "The maximum size in megabytes of the audit log file before it gets rotated")
+	flags.IntVarP(&opt.auditMaxBackups, "audit-max-backups", "", 5, "The maximum number of the rotated audit log files to retain")
 	flags.StringVarP(&opt.clientID, "client-id", "", os.Getenv("OAUTH_CLIENT_ID"), "ClientID is the application's ID")
 	flags.StringVarP(&opt.clientSecret, "client-secret", "", os.Getenv("OAUTH_CLIENT_SECRET"), "ClientSecret is the application's secret")
 	flags.BoolVarP(&opt.dryRun, "dry-run", "", false, "Do not really start a gRPC server")
@@ -107,6 +115,7 @@ func createServerCmd(execer fakeruntime.Execer, httpServer server.HTTPServer) (c
 	flags.StringVarP(&opt.mockPrefix, "mock-prefix", "", "/mock", "The mock server API prefix")
 	flags.StringVarP(&opt.extensionRegistry, "extension-registry", "", "docker.io", "The extension registry URL")
 	flags.DurationVarP(&opt.downloadTimeout, "download-timeout", "", time.Minute, "The timeout of extension download")
+	flags.StringArrayVarP(&opt.templateDirs, "template-dir", "", nil, "The directories of the code generator and converter templates, <config-dir>/templates is always loaded")
 
 	// gc related flags
 	flags.IntVarP(&opt.gcPercent, "gc-percent", "", 100, "The GC percent of Go")
@@ -139,6 +148,7 @@ type serverOption struct {
 	skyWalking        string
 	extensionRegistry string
 	downloadTimeout   time.Duration
+	templateDirs      []string
 
 	auth          string
 	oauthProvider string
@@ -149,6 +159,12 @@ type serverOption struct {
 	oauthServer  string
 	oauthSkipTls bool
 	oauthGroup   []string
+	rbac         bool
+	rbacAdmin    []string
+
+	audit           []string
+	auditMaxSize    int
+	auditMaxBackups int
 
 	mockConfig []string
 	mockPrefix string
@@ -158,13 +174,26 @@ type serverOption struct {
 	grpcMaxRecvMsgSize int
 
 	// inner fields, not as command flags
-	provider oauth.OAuthProvider
+	provider      oauth.OAuthProvider
+	auditor       audit.Auditor
+	policyManager atestoauth.PolicyManager
 }
 
 func (o *serverOption) preRunE(cmd *cobra.Command, args []string) (err error) {
 	o.execer.WithContext(cmd.Context())
+	o.configDir = os.ExpandEnv(o.configDir)
 	var grpcOpts []grpc.ServerOption
 
+	var auditOpts []grpc.ServerOption
+	if !o.dryRun {
+		if o.auditor, err = o.newAuditor(); err != nil {
+			return
+		}
+		if o.auditor != nil {
+			auditOpts = server.NewAuditInterceptors(o.auditor)
+		}
+	}
+
 	if o.auth == "oauth" {
 		if o.provider = oauth.GetOAuthProvider(o.oauthProvider); o.provider == nil {
 			err = fmt.Errorf("not support: %q", o.oauthProvider)
@@ -188,7 +217,22 @@ func (o *serverOption) preRunE(cmd *cobra.Command, args []string) (err error) {
 			return
 		}
 
-		grpcOpts = append(grpcOpts, atestoauth.NewAuthInterceptor(o.oauthGroup))
+		grpcOpts = append(grpcOpts, atestoauth.NewAuthInterceptor(o.oauthGroup),
+			atestoauth.NewAuthStreamInterceptor(o.oauthGroup))
+		// the audit comes after the authentication to know the user, and before the RBAC to record the denied calls
+		grpcOpts = append(grpcOpts, auditOpts...)
+
+		if o.rbac {
+			o.policyManager = atestoauth.NewPolicyManager(o.configDir, o.rbacAdmin...).
+				WithStoreOwnerGetter(server.NewStoreOwnerGetter(o.configDir))
+			grpcOpts = append(grpcOpts, atestoauth.NewRBACInterceptors(o.policyManager,
+				server.MethodPermissions, server.NewResourceResolver(o.policyManager))...)
+		}
+	} else if o.rbac {
+		err = errors.New("--rbac requires the oauth auth mode")
+		return
+	} else {
+		grpcOpts = append(grpcOpts, auditOpts...)
 	}
 
 	if o.tls {
@@ -210,7 +254,6 @@ func (o *serverOption) preRunE(cmd *cobra.Command, args []string) (err error) {
 		o.gRPCServer = grpc.NewServer(grpcOpts...)
 	}
 
-	o.configDir = os.ExpandEnv(o.configDir)
 	err = o.execer.MkdirAll(o.configDir, 0755)
 	return
 }
@@ -249,6 +292,12 @@ func (o *serverOption) runE(cmd *cobra.Command, args []string) (err error) {
 		}
 	}
 
+	loadTemplatePlugins(cmd, o.configDir, o.templateDirs)
+
+	if loadErr := template.LoadUserDefinedTemplates(filepath.Join(o.configDir, template.UserDefinedFile)); loadErr != nil {
+		cmd.PrintErrln(loadErr)
+	}
+
 	var secretServer remote.SecretServiceServer
 	if o.secretServer != "" {
 		if secretServer, err = remote.NewGRPCSecretFrom(o.secretServer); err != nil {
@@ -264,9 +313,18 @@ func (o *serverOption) runE(cmd *cobra.Command, args []string) (err error) {
 	extDownloader.WithTimeout(o.downloadTimeout)
 	storeExtMgr := server.NewStoreExtManager(o.execer)
 	storeExtMgr.WithDownloader(extDownloader)
-	remoteServer := server.NewRemoteServer(loader, remote.NewGRPCloaderFromStore(), secretServer, storeExtMgr, o.configDir, o.grpcMaxRecvMsgSize)
+	storeWriterFactory := testing.NewStoreWriterFactory(remote.NewGRPCloaderFromStore(),
+		git.NewGitStoreFactory(o.execer, filepath.Join(o.configDir, "git-stores")))
+	remoteServer := server.NewRemoteServer(loader, storeWriterFactory, secretServer, storeExtMgr, o.configDir, o.grpcMaxRecvMsgSize)
+	if setter, ok := remoteServer.(server.PolicyManagerSetter); ok && o.policyManager != nil {
+		setter.WithPolicyManager(o.policyManager)
+	}
+	if setter, ok := remoteServer.(server.AuditorSetter); ok && o.auditor != nil {
+		setter.WithAuditor(o.auditor)
+		defer o.auditor.Close()
+	}
 	if stores, storeErr := remoteServer.GetStores(ctx, nil); storeErr == nil {
-		if runPluginErr := startPlugins(storeExtMgr, stores); runPluginErr != nil {
+		if runPluginErr := startPlugins(storeExtMgr, storeWriterFactory, stores); runPluginErr != nil {
 			cmd.PrintErrf("error occurred during starting plugins, error: %v\n", runPluginErr)
 		}
 	} else {
@@ -514,9 +572,9 @@ func postRequestProxy(proxy string) func(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-func startPlugins(storeExtMgr server.ExtManager, stores *server.Stores) (err error) {
+func startPlugins(storeExtMgr server.ExtManager, storeWriterFactory testing.StoreWriterFactory, stores *server.Stores) (err error) {
 	for _, store := range stores.Data {
-		if store.Disabled || store.Kind == nil {
+		if store.Disabled || store.Kind == nil || testing.IsBuiltinStoreKind(storeWriterFactory, store.Kind.Name) {
 			continue
 		}
 
@@ -566,6 +624,39 @@ func frontEndHandlerWithLocation(consolePath string) func(w http.ResponseWriter,
 	}
 }
 
+// newAuditor creates the auditor from the --audit flag, returns nil if there is no sink
+func (o *serverOption) newAuditor() (auditor audit.Auditor, err error) {
+	var sinks []audit.Sink
+	for _, item := range o.audit {
+		var sink audit.Sink
+		switch {
+		case item == "":
+			continue
+		case item == "file":
+			sink, err = audit.NewFileSink(filepath.Join(o.configDir, "audit.log"),
+				int64(o.auditMaxSize)*1024*1024, o.auditMaxBackups)
+		case strings.HasPrefix(item, "file://"):
+			sink, err = audit.NewFileSink(strings.TrimPrefix(item, "file://"),
+				int64(o.auditMaxSize)*1024*1024, o.auditMaxBackups)
+		case item == "stdout":
+			sink = audit.NewStdoutSink()
+		case strings.HasPrefix(item, "grpc://"):
+			sink, err = remote.NewGRPCAuditSinkFrom(strings.TrimPrefix(item, "grpc://"))
+		default:
+			err = fmt.Errorf("not support audit sink: %q", item)
+		}
+		if err != nil {
+			return
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if len(sinks) > 0 {
+		auditor = audit.NewAuditor(sinks...)
+	}
+	return
+}
+
 func debugHandler(mux *runtime.ServeMux, remoteServer server.RunnerServer) {
 	mux.HandlePath(http.MethodGet, "/debug/pprof/{sub}", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 		sub := pathParams["sub"]
@@ -32,7 +32,9 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/h2non/gock"
+	"github.com/linuxsuren/api-testing/pkg/generator"
 	"github.com/linuxsuren/api-testing/pkg/server"
+	atesting "github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
 	fakeruntime "github.com/linuxsuren/go-fake-runtime"
 	"github.com/spf13/cobra"
@@ -274,6 +276,14 @@ func TestOAuth(t *testing.T) {
 		name:   "oauth is ok",
 		args:   []string{"server", "--auth=oauth", "--client-id=fake", "--client-secret=fake", "--http-port=0"},
 		hasErr: false,
+	}, {
+		name:   "rbac without oauth",
+		args:   []string{"server", "--rbac", "--http-port=0"},
+		hasErr: true,
+	}, {
+		name:   "rbac is ok",
+		args:   []string{"server", "--auth=oauth", "--client-id=fake", "--client-secret=fake", "--http-port=0", "--port=0", "--rbac", "--rbac-admin=admin"},
+		hasErr: false,
 	}}
 	for i, tt := range tests {
 		buf := new(bytes.Buffer)
@@ -312,6 +322,33 @@ func TestStartPlugins(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("template plugins", func(t *testing.T) {
+		pluginDir := filepath.Join(dir, "plugins", "http-file")
+		assert.NoError(t, os.MkdirAll(pluginDir, 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(pluginDir, "manifest.yaml"), []byte("kind: generator\ntemplate: http.tpl"), 0644))
+		assert.NoError(t, os.WriteFile(filepath.Join(pluginDir, "http.tpl"), []byte("{{ .Request.Method }} {{ .Request.API }}"), 0644))
+
+		rootCmd := &cobra.Command{
+			Use: "atest",
+		}
+		rootCmd.SetOut(io.Discard)
+		rootCmd.AddCommand(createServerCmd(
+			&fakeruntime.FakeExecer{ExpectOS: "linux", ExpectLookPathError: errors.New("not-found")},
+			server.NewFakeHTTPServer(),
+		))
+
+		rootCmd.SetArgs([]string{"server", "--config-dir", dir, "--template-dir", filepath.Join(dir, "plugins"),
+			"--dry-run", "--port=0", "--http-port=0"})
+		err = rootCmd.Execute()
+		assert.NoError(t, err)
+
+		if instance := generator.GetCodeGenerator("http-file"); assert.NotNil(t, instance) {
+			result, err := instance.Generate(nil, &atesting.TestCase{Request: atesting.Request{API: "http://foo"}})
+			assert.NoError(t, err)
+			assert.Equal(t, "GET http://foo", result)
+		}
+	})
+
 	t.Run("normal", func(t *testing.T) {
 		httpServer := server.NewDefaultHTTPServer()
 		rootCmd := &cobra.Command{
@@ -393,3 +430,45 @@ func (w *fakeResponseWriter) GetBody() *bytes.Buffer {
 
 //go:embed testdata/stores.yaml
 var sampleStores string
+
+func TestNewAuditor(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("disabled by default", func(t *testing.T) {
+		c := createServerCmd(fakeruntime.NewDefaultExecer(), server.NewFakeHTTPServer())
+		audit, err := c.Flags().GetStringArray("audit")
+		assert.NoError(t, err)
+		assert.Empty(t, audit)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		opt := &serverOption{audit: []string{""}}
+		auditor, err := opt.newAuditor()
+		assert.NoError(t, err)
+		assert.Nil(t, auditor)
+	})
+
+	t.Run("default file", func(t *testing.T) {
+		opt := &serverOption{audit: []string{"file", "stdout"}, configDir: dir, auditMaxSize: 1}
+		auditor, err := opt.newAuditor()
+		assert.NoError(t, err)
+		assert.NotNil(t, auditor)
+		defer auditor.Close()
+		assert.FileExists(t, filepath.Join(dir, "audit.log"))
+	})
+
+	t.Run("file with path", func(t *testing.T) {
+		opt := &serverOption{audit: []string{"file://" + filepath.Join(dir, "logs", "audit.log")}}
+		auditor, err := opt.newAuditor()
+		assert.NoError(t, err)
+		assert.NotNil(t, auditor)
+		defer auditor.Close()
+		assert.FileExists(t, filepath.Join(dir, "logs", "audit.log"))
+	})
+
+	t.Run("unknown sink", func(t *testing.T) {
+		opt := &serverOption{audit: []string{"fake"}}
+		_, err := opt.newAuditor()
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apispec
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/util/home"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// ContractValidator validates the HTTP responses against the operations of an API specification
+type ContractValidator interface {
+	// Validate returns the contract violations of a response, the body is nil if it is not a text content
+	Validate(method, requestURL string, statusCode int, header http.Header, body []byte) error
+}
+
+type contractValidator struct {
+	operations []operationMatcher
+}
+
+type operationMatcher struct {
+	operation Operation
+	patterns  []*regexp.Regexp
+	params    int
+}
+
+// NewContractValidator creates a validator from the API specification
+func NewContractValidator(apiSpec APISpec) ContractValidator {
+	return newContractValidator(apiSpec)
+}
+
+func newContractValidator(apiSpec APISpec) (validator *contractValidator) {
+	validator = &contractValidator{}
+	for _, operation := range apiSpec.GetOperations() {
+		matcher := operationMatcher{
+			operation: operation,
+			params:    strings.Count(operation.Path, "{"),
+		}
+		for _, prefix := range basePaths(operation.Servers) {
+			matcher.patterns = append(matcher.patterns, pathPattern(prefix+operation.Path))
+		}
+		validator.operations = append(validator.operations, matcher)
+	}
+	return
+}
+
+type cachedContractValidator struct {
+	validator ContractValidator
+	// version is the modification time and size of a local document
+	version string
+	// expire is the time to fetch a remote document again
+	expire time.Time
+}
+
+var (
+	contractValidators     = map[string]cachedContractValidator{}
+	contractValidatorsLock sync.Mutex
+	// contractValidatorTTL is the period of caching a remote document
+	contractValidatorTTL = time.Minute
+)
+
+// GetContractValidator returns the cached validator of the API specification URL.
+// It's created again once the local document is changed, or the remote one is expired
+func GetContractValidator(specURL string) (validator ContractValidator, err error) {
+	version := getDocumentVersion(specURL)
+
+	contractValidatorsLock.Lock()
+	defer contractValidatorsLock.Unlock()
+
+	if cached, ok := contractValidators[specURL]; ok && cached.version == version &&
+		(cached.expire.IsZero() || time.Now().Before(cached.expire)) {
+		validator = cached.validator
+		return
+	}
+
+	var apiSpec APISpec
+	if apiSpec, err = ParseURLToAPISpec(specURL); err == nil {
+		validator = NewContractValidator(apiSpec)
+		cached := cachedContractValidator{validator: validator, version: version}
+		if isRemoteDocument(specURL) {
+			cached.expire = time.Now().Add(contractValidatorTTL)
+		}
+		contractValidators[specURL] = cached
+	}
+	return
+}
+
+// getDocumentVersion returns the modification time and size of a local document, it's empty for a remote one
+func getDocumentVersion(specURL string) (version string) {
+	if isRemoteDocument(specURL) {
+		return
+	}
+
+	path := specURL
+	if strings.HasPrefix(specURL, "atest://") {
+		path = filepath.Join(home.GetUserDataDir(), strings.TrimPrefix(specURL, "atest://"))
+	}
+	if info, err := os.Stat(path); err == nil {
+		version = fmt.Sprintf("%d-%d", info.ModTime().UnixNano(), info.Size())
+	}
+	return
+}
+
+func isRemoteDocument(specURL string) bool {
+	return strings.HasPrefix(specURL, "http://") || strings.HasPrefix(specURL, "https://")
+}
+
+// Validate checks the status code, headers, content type and body schema of the response
+func (v *contractValidator) Validate(method, requestURL string, statusCode int, header http.Header, body []byte) (err error) {
+	var operation *Operation
+	if operation, err = v.findOperation(method, requestURL); err != nil {
+		return
+	}
+	if len(operation.Responses) == 0 {
+		return
+	}
+
+	response := findResponse(operation.Responses, statusCode)
+	if response == nil {
+		err = fmt.Errorf("contract violation of %s %s: status code %d is not documented", operation.Method, operation.Path, statusCode)
+		return
+	}
+
+	var violations []error
+	for _, item := range response.Headers {
+		if item.Required && header.Get(item.Name) == "" {
+			violations = append(violations, fmt.Errorf("required header %q is missing", item.Name))
+		}
+	}
+
+	if contentType := header.Get("Content-Type"); len(response.Content) > 0 && contentType != "" {
+		if schema, found := findContentSchema(response.Content, contentType); !found {
+			violations = append(violations, fmt.Errorf("content type %q is not documented", contentType))
+		} else if schema != nil && len(body) > 0 && strings.Contains(contentType, "json") {
+			violations = append(violations, validateSchema(schema, body))
+		}
+	}
+
+	if violation := errors.Join(violations...); violation != nil {
+		err = fmt.Errorf("contract violation of %s %s with status code %d: %v", operation.Method, operation.Path, statusCode, violation)
+	}
+	return
+}
+
+// findOperation finds the operation by the method and path, the literal paths come before the templated ones
+func (v *contractValidator) findOperation(method, requestURL string) (operation *Operation, err error) {
+	path := requestPath(requestURL)
+	if parsedURL, parseErr := url.Parse(requestURL); parseErr == nil {
+		path = parsedURL.Path
+	}
+
+	var matched *operationMatcher
+	for i, item := range v.operations {
+		if !strings.EqualFold(item.operation.Method, method) || (matched != nil && matched.params <= item.params) {
+			continue
+		}
+		for _, pattern := range item.patterns {
+			if pattern.MatchString(path) {
+				matched = &v.operations[i]
+				break
+			}
+		}
+	}
+
+	if matched == nil {
+		err = fmt.Errorf("contract violation: no operation is documented for %s %s", strings.ToUpper(method), path)
+	} else {
+		operation = &matched.operation
+	}
+	return
+}
+
+// findResponse finds the response of the status code, then the range like 2XX, then the default one
+func findResponse(responses []Response, statusCode int) *Response {
+	code := strconv.Itoa(statusCode)
+	for _, candidate := range []string{code, code[:1] + "XX", "default"} {
+		for i := range responses {
+			if strings.EqualFold(responses[i].StatusCode, candidate) {
+				return &responses[i]
+			}
+		}
+	}
+	return nil
+}
+
+// findContentSchema finds the schema of the content type, the wildcards like application/* are supported
+func findContentSchema(content map[string]*Schema, contentType string) (schema *Schema, found bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = strings.TrimSpace(strings.Split(contentType, ";")[0])
+	}
+
+	for _, candidate := range []string{mediaType, strings.Split(mediaType, "/")[0] + "/*", "*/*"} {
+		for documented, item := range content {
+			if documentedType, _, parseErr := mime.ParseMediaType(documented); parseErr == nil && documentedType == candidate {
+				return item, true
+			}
+		}
+	}
+	return
+}
+
+func validateSchema(schema *Schema, body []byte) (err error) {
+	var jsonSchema string
+	if jsonSchema, err = schema.ToJSONSchema(); err != nil {
+		return
+	}
+
+	var result *gojsonschema.Result
+	if result, err = gojsonschema.Validate(gojsonschema.NewStringLoader(jsonSchema), gojsonschema.NewBytesLoader(body)); err == nil && !result.Valid() {
+		err = fmt.Errorf("body does not match the schema: %v", result.Errors())
+	}
+	return
+}
+
+// basePaths returns the paths of the servers, the operation path could be requested with or without them
+func basePaths(servers []string) (paths []string) {
+	for _, server := range servers {
+		if serverURL, err := url.Parse(server); err == nil && serverURL.Path != "" && serverURL.Path != "/" {
+			paths = append(paths, strings.TrimSuffix(serverURL.Path, "/"))
+		}
+	}
+	paths = append(paths, "")
+	return
+}
+
+var pathParamRegex = regexp.MustCompile(`\\\{[^/]+?\\\}`)
+
+// pathPattern converts a path like /users/{name} to a regular expression
+func pathPattern(path string) *regexp.Regexp {
+	pattern := pathParamRegex.ReplaceAllString(regexp.QuoteMeta(path), "[^/]+")
+	return regexp.MustCompile("^" + pattern + "/?$")
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apispec_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/apispec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContractValidator(t *testing.T) {
+	validator, err := apispec.GetContractValidator("testdata/openapi.yaml")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	jsonHeader := http.Header{"Content-Type": []string{"application/json; charset=utf-8"}}
+
+	tests := []struct {
+		name       string
+		method     string
+		url        string
+		statusCode int
+		header     http.Header
+		body       string
+		errMsg     string
+	}{{
+		name:       "valid body with the base path",
+		method:     http.MethodGet,
+		url:        "http://localhost:8080/api/v1/users?page=1",
+		statusCode: http.StatusOK,
+		header:     jsonHeader,
+		body:       `[{"name":"linuxsuren","email":null}]`,
+	}, {
+		name:       "valid without the base path",
+		method:     http.MethodDelete,
+		url:        "/users/linuxsuren",
+		statusCode: http.StatusNoContent,
+		header:     http.Header{},
+	}, {
+		name:       "the default response",
+		method:     http.MethodGet,
+		url:        "/users",
+		statusCode: http.StatusInternalServerError,
+		header:     http.Header{"Content-Type": []string{"text/plain"}},
+		body:       "error",
+	}, {
+		name:       "body does not match the schema",
+		method:     http.MethodGet,
+		url:        "/api/v1/users",
+		statusCode: http.StatusOK,
+		header:     jsonHeader,
+		body:       `[{"email":"user@example.com"}]`,
+		errMsg:     "body does not match the schema",
+	}, {
+		name:       "undocumented content type",
+		method:     http.MethodGet,
+		url:        "/users",
+		statusCode: http.StatusOK,
+		header:     http.Header{"Content-Type": []string{"text/html"}},
+		body:       "<html/>",
+		errMsg:     `content type "text/html" is not documented`,
+	}, {
+		name:       "undocumented status code",
+		method:     http.MethodDelete,
+		url:        "https://users.example.com/users/linuxsuren",
+		statusCode: http.StatusOK,
+		errMsg:     "status code 200 is not documented",
+	}, {
+		name:       "undocumented operation",
+		method:     http.MethodPatch,
+		url:        "/users/linuxsuren",
+		statusCode: http.StatusOK,
+		errMsg:     "no operation is documented for PATCH /users/linuxsuren",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			if tt.body != "" {
+				body = []byte(tt.body)
+			}
+			err := validator.Validate(tt.method, tt.url, tt.statusCode, tt.header, body)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errMsg)
+			}
+		})
+	}
+
+	t.Run("required header", func(t *testing.T) {
+		headerValidator := apispec.NewContractValidator(&apispec.OpenAPI{})
+		assert.Error(t, headerValidator.Validate(http.MethodGet, "/", http.StatusOK, nil, nil))
+
+		apiSpec, err := apispec.ParseAPISpec([]byte(`{"openapi": "3.0.0", "paths": {"/items": {"get": {"responses": {"200": {
+  "description": "ok", "headers": {"X-Total": {"required": true, "schema": {"type": "integer"}}}}}}}}}`))
+		assert.NoError(t, err)
+		headerValidator = apispec.NewContractValidator(apiSpec)
+		assert.ErrorContains(t, headerValidator.Validate(http.MethodGet, "/items", http.StatusOK, http.Header{}, nil),
+			`required header "X-Total" is missing`)
+		assert.NoError(t, headerValidator.Validate(http.MethodGet, "/items/", http.StatusOK, http.Header{"X-Total": []string{"1"}}, nil))
+	})
+
+	_, err = apispec.GetContractValidator("testdata/fake.yaml")
+	assert.Error(t, err)
+}
+
+func TestGetContractValidatorCache(t *testing.T) {
+	data, err := os.ReadFile("testdata/openapi.yaml")
+	assert.NoError(t, err)
+	specFile := filepath.Join(t.TempDir(), "openapi.yaml")
+	assert.NoError(t, os.WriteFile(specFile, data, 0644))
+
+	first, err := apispec.GetContractValidator(specFile)
+	assert.NoError(t, err)
+	second, err := apispec.GetContractValidator(specFile)
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+
+	// the validator is created again once the document is changed
+	assert.NoError(t, os.WriteFile(specFile, append(data, '\n'), 0644))
+	third, err := apispec.GetContractValidator(specFile)
+	assert.NoError(t, err)
+	assert.NotSame(t, first, third)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apispec
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// CoverageRecord is an exercised request of the API
+type CoverageRecord struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	// Parameters are the query, header and cookie parameters of the request, only the name and location are used
+	Parameters []Parameter `json:"-"`
+	// StatusCode is the observed or expected status code, zero means it is unknown
+	StatusCode int `json:"statusCode,omitempty"`
+}
+
+// CoverageReport is the coverage of the operations of an API specification
+type CoverageReport struct {
+	Operations []OperationCoverage `json:"operations"`
+	// Undocumented are the requests which do not match any operation, such as "GET /unknown"
+	Undocumented []string `json:"undocumented,omitempty"`
+}
+
+// OperationCoverage is the coverage of an operation
+type OperationCoverage struct {
+	ID         string              `json:"id,omitempty"`
+	Method     string              `json:"method"`
+	Path       string              `json:"path"`
+	Requests   int                 `json:"requests"`
+	Parameters []ParameterCoverage `json:"parameters,omitempty"`
+	Responses  []ResponseCoverage  `json:"responses,omitempty"`
+	// UndocumentedStatusCodes are the observed status codes which are not documented
+	UndocumentedStatusCodes []int `json:"undocumentedStatusCodes,omitempty"`
+}
+
+// ParameterCoverage indicates if a documented parameter is exercised
+type ParameterCoverage struct {
+	Name     string `json:"name"`
+	In       string `json:"in"`
+	Required bool   `json:"required,omitempty"`
+	Covered  bool   `json:"covered"`
+}
+
+// ResponseCoverage indicates if a documented status code is observed
+type ResponseCoverage struct {
+	StatusCode string `json:"statusCode"`
+	Covered    bool   `json:"covered"`
+}
+
+// NewCoverageReport creates the coverage report of the records against the API specification
+func NewCoverageReport(apiSpec APISpec, records []CoverageRecord) (report *CoverageReport) {
+	report = &CoverageReport{}
+	validator := newContractValidator(apiSpec)
+	indexes := map[*Operation]int{}
+	for i := range validator.operations {
+		operation := &validator.operations[i].operation
+		indexes[operation] = i
+
+		item := OperationCoverage{
+			ID:     operation.ID,
+			Method: operation.Method,
+			Path:   operation.Path,
+		}
+		for _, param := range operation.Parameters {
+			item.Parameters = append(item.Parameters, ParameterCoverage{
+				Name:     param.Name,
+				In:       param.In,
+				Required: param.Required,
+			})
+		}
+		for _, response := range operation.Responses {
+			item.Responses = append(item.Responses, ResponseCoverage{StatusCode: response.StatusCode})
+		}
+		report.Operations = append(report.Operations, item)
+	}
+
+	for _, record := range records {
+		operation, err := validator.findOperation(record.Method, record.URL)
+		if err != nil {
+			if undocumented := strings.ToUpper(record.Method) + " " + requestPath(record.URL); !slices.Contains(report.Undocumented, undocumented) {
+				report.Undocumented = append(report.Undocumented, undocumented)
+			}
+			continue
+		}
+		report.Operations[indexes[operation]].cover(operation, record)
+	}
+	sort.Strings(report.Undocumented)
+	return
+}
+
+func (o *OperationCoverage) cover(operation *Operation, record CoverageRecord) {
+	o.Requests++
+	for i, param := range o.Parameters {
+		// the path parameters are always given when the path matches
+		if param.In == "path" || slices.ContainsFunc(record.Parameters, func(item Parameter) bool {
+			return item.In == param.In && (item.Name == param.Name ||
+				(param.In == "header" && strings.EqualFold(item.Name, param.Name)))
+		}) {
+			o.Parameters[i].Covered = true
+		}
+	}
+
+	if record.StatusCode == 0 {
+		return
+	}
+	if response := findResponse(operation.Responses, record.StatusCode); response != nil {
+		for i := range o.Responses {
+			if o.Responses[i].StatusCode == response.StatusCode {
+				o.Responses[i].Covered = true
+			}
+		}
+	} else if !slices.Contains(o.UndocumentedStatusCodes, record.StatusCode) {
+		o.UndocumentedStatusCodes = append(o.UndocumentedStatusCodes, record.StatusCode)
+		slices.Sort(o.UndocumentedStatusCodes)
+	}
+}
+
+// Covered returns true if the operation is requested
+func (o OperationCoverage) Covered() bool {
+	return o.Requests > 0
+}
+
+// MissingParameters returns the parameters which are never exercised, such as "query:page"
+func (o OperationCoverage) MissingParameters() (names []string) {
+	for _, param := range o.Parameters {
+		if !param.Covered {
+			names = append(names, param.In+":"+param.Name)
+		}
+	}
+	return
+}
+
+// MissingStatusCodes returns the documented status codes which are never observed
+func (o OperationCoverage) MissingStatusCodes() (codes []string) {
+	for _, response := range o.Responses {
+		if !response.Covered {
+			codes = append(codes, response.StatusCode)
+		}
+	}
+	return
+}
+
+// ObservedStatusCodes returns the documented status codes which are observed
+func (o OperationCoverage) ObservedStatusCodes() (codes []string) {
+	for _, response := range o.Responses {
+		if response.Covered {
+			codes = append(codes, response.StatusCode)
+		}
+	}
+	return
+}
+
+// OperationCount returns the count of the covered and all operations
+func (r *CoverageReport) OperationCount() (covered, total int) {
+	for _, operation := range r.Operations {
+		if operation.Covered() {
+			covered++
+		}
+	}
+	total = len(r.Operations)
+	return
+}
+
+// ParameterCount returns the count of the exercised and all documented parameters
+func (r *CoverageReport) ParameterCount() (covered, total int) {
+	for _, operation := range r.Operations {
+		total += len(operation.Parameters)
+		covered += len(operation.Parameters) - len(operation.MissingParameters())
+	}
+	return
+}
+
+// StatusCodeCount returns the count of the observed and all documented status codes
+func (r *CoverageReport) StatusCodeCount() (covered, total int) {
+	for _, operation := range r.Operations {
+		total += len(operation.Responses)
+		covered += len(operation.ObservedStatusCodes())
+	}
+	return
+}
+
+// Summary returns the coverage in one line, such as "operations: 2/3, parameters: 1/4, status codes: 2/5"
+func (r *CoverageReport) Summary() string {
+	operations, totalOperations := r.OperationCount()
+	parameters, totalParameters := r.ParameterCount()
+	statusCodes, totalStatusCodes := r.StatusCodeCount()
+	return fmt.Sprintf("operations: %d/%d, parameters: %d/%d, status codes: %d/%d",
+		operations, totalOperations, parameters, totalParameters, statusCodes, totalStatusCodes)
+}
+
+func requestPath(requestURL string) string {
+	if index := strings.Index(requestURL, "://"); index >= 0 {
+		requestURL = requestURL[index+3:]
+		if index = strings.Index(requestURL, "/"); index >= 0 {
+			requestURL = requestURL[index:]
+		} else {
+			requestURL = "/"
+		}
+	}
+	if index := strings.IndexAny(requestURL, "?#"); index >= 0 {
+		requestURL = requestURL[:index]
+	}
+	return requestURL
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apispec_test
+
+import (
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/apispec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoverageReport(t *testing.T) {
+	apiSpec, err := apispec.ParseURLToAPISpec("testdata/openapi.yaml")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	report := apispec.NewCoverageReport(apiSpec, []apispec.CoverageRecord{{
+		Method:     "GET",
+		URL:        "http://localhost:8080/api/v1/users?page=1",
+		Parameters: []apispec.Parameter{{Name: "page", In: "query"}, {Name: "x-trace", In: "header"}},
+		StatusCode: 200,
+	}, {
+		Method:     "GET",
+		URL:        "/api/v1/users",
+		StatusCode: 500,
+	}, {
+		Method:     "post",
+		URL:        "/users",
+		StatusCode: 400,
+	}, {
+		Method: "GET",
+		URL:    "https://users.example.com/users/linuxsuren",
+	}, {
+		Method: "PUT",
+		URL:    "http://localhost/api/v1/users?page=1",
+	}})
+
+	if !assert.Len(t, report.Operations, 4) {
+		return
+	}
+
+	list := report.Operations[0]
+	assert.Equal(t, "listUsers", list.ID)
+	assert.Equal(t, 2, list.Requests)
+	assert.Empty(t, list.MissingParameters())
+	assert.Equal(t, []string{"200", "default"}, list.ObservedStatusCodes())
+	assert.Empty(t, list.MissingStatusCodes())
+
+	create := report.Operations[1]
+	assert.Equal(t, []string{"201"}, create.MissingStatusCodes())
+	assert.Equal(t, []int{400}, create.UndocumentedStatusCodes)
+
+	get := report.Operations[2]
+	assert.True(t, get.Covered())
+	assert.Empty(t, get.MissingParameters())
+	assert.Equal(t, []string{"200"}, get.MissingStatusCodes())
+
+	remove := report.Operations[3]
+	assert.False(t, remove.Covered())
+	assert.Equal(t, []string{"path:name"}, remove.MissingParameters())
+
+	assert.Equal(t, []string{"PUT /api/v1/users"}, report.Undocumented)
+	assert.Equal(t, "operations: 3/4, parameters: 3/4, status codes: 2/5", report.Summary())
+}
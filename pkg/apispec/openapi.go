@@ -0,0 +1,555 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apispec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/linuxsuren/api-testing/pkg/util/home"
+)
+
+// APISpec is the abstraction of the API specifications, such as Swagger 2.0 and OpenAPI 3.x
+type APISpec interface {
+	APICoverage
+	// GetTitle returns the title of the document
+	GetTitle() string
+	// GetServers returns the base URLs of the APIs
+	GetServers() []string
+	// GetOperations returns all the operations which are sorted by the path and method
+	GetOperations() []Operation
+}
+
+// Operation represents an API of the specification
+type Operation struct {
+	ID          string
+	Summary     string
+	Path        string
+	Method      string
+	Servers     []string
+	Parameters  []Parameter
+	RequestBody *RequestBody
+	Responses   []Response
+	Security    []SecurityScheme
+}
+
+// Parameter is a parameter of an operation, the location could be query, header, path or cookie
+type Parameter struct {
+	Name     string
+	In       string
+	Required bool
+	Schema   *Schema
+	Example  any
+}
+
+// RequestBody is the request body of an operation
+type RequestBody struct {
+	ContentType string
+	Required    bool
+	Schema      *Schema
+	Example     any
+}
+
+// Response is a documented response of an operation, the status code could be "default" or a range like "2XX"
+type Response struct {
+	StatusCode  string
+	Description string
+	// ContentType is the preferred one of the content, and the Schema belongs to it
+	ContentType string
+	Schema      *Schema
+	// Content has the schemas of all the documented content types, the schema could be nil
+	Content map[string]*Schema
+	Headers []Parameter
+}
+
+// SecurityScheme is a security scheme which is required by an operation.
+// The type is one of http, apiKey, oauth2 and openIdConnect, the Swagger 2.0 basic type is taken as http.
+type SecurityScheme struct {
+	Name string
+	Type string
+	// Scheme is the HTTP authorization scheme, such as basic and bearer
+	Scheme string
+	// In is the location of the API key, it could be query, header or cookie
+	In string
+	// ParamName is the name of the API key parameter
+	ParamName string
+}
+
+// GenerateSample generates a sample value of the parameter
+func (p Parameter) GenerateSample() any {
+	if p.Example != nil {
+		return p.Example
+	}
+	return GenerateSample(p.Schema)
+}
+
+// GenerateSample generates a sample body, the JSON content types are marshaled as JSON
+func (b *RequestBody) GenerateSample() (body string, err error) {
+	sample := b.Example
+	if sample == nil {
+		sample = GenerateSample(b.Schema)
+	}
+
+	switch val := sample.(type) {
+	case nil:
+	case string:
+		body = val
+	default:
+		var data []byte
+		if data, err = json.Marshal(val); err == nil {
+			body = string(data)
+		}
+	}
+	return
+}
+
+// The HTTP methods in the order of the API specifications
+var specMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// OpenAPI represents an OpenAPI 3.x document
+type OpenAPI struct {
+	Version    string
+	Title      string
+	ApiMap     map[string][]string
+	servers    []string
+	operations []Operation
+}
+
+// GetTitle returns the title of the document
+func (o *OpenAPI) GetTitle() string {
+	return o.Title
+}
+
+// GetServers returns the URLs of the servers
+func (o *OpenAPI) GetServers() []string {
+	return o.servers
+}
+
+// GetOperations returns all the operations
+func (o *OpenAPI) GetOperations() []Operation {
+	return o.operations
+}
+
+// HaveAPI checks if the document has the API
+func (o *OpenAPI) HaveAPI(path, method string) bool {
+	return haveAPI(o.ApiMap, path, method)
+}
+
+// APICount returns the count of APIs
+func (o *OpenAPI) APICount() int {
+	return apiCount(o.ApiMap)
+}
+
+// specDocument has the fields of both Swagger 2.0 and OpenAPI 3.x which are needed
+type specDocument struct {
+	Swagger  string                    `json:"swagger"`
+	OpenAPI  string                    `json:"openapi"`
+	Info     specInfo                  `json:"info"`
+	Servers  []specServer              `json:"servers"`
+	Host     string                    `json:"host"`
+	BasePath string                    `json:"basePath"`
+	Schemes  []string                  `json:"schemes"`
+	Consumes []string                  `json:"consumes"`
+	Produces []string                  `json:"produces"`
+	Paths    map[string]map[string]any `json:"paths"`
+	Security []map[string][]string     `json:"security"`
+	// SecurityDefinitions is for Swagger 2.0
+	SecurityDefinitions map[string]specSecurityScheme `json:"securityDefinitions"`
+	Components          struct {
+		SecuritySchemes map[string]specSecurityScheme `json:"securitySchemes"`
+	} `json:"components"`
+}
+
+type specSecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme"`
+	In     string `json:"in"`
+	Name   string `json:"name"`
+}
+
+// specResponse has the content in OpenAPI 3.x, or the schema in Swagger 2.0
+type specResponse struct {
+	Description string                   `json:"description"`
+	Content     map[string]specMediaType `json:"content"`
+	Schema      *Schema                  `json:"schema"`
+	Headers     map[string]specParameter `json:"headers"`
+}
+
+type specInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type specServer struct {
+	URL       string `json:"url"`
+	Variables map[string]struct {
+		Default string `json:"default"`
+	} `json:"variables"`
+}
+
+type specOperation struct {
+	OperationID string          `json:"operationId"`
+	Summary     string          `json:"summary"`
+	Consumes    []string        `json:"consumes"`
+	Produces    []string        `json:"produces"`
+	Parameters  []specParameter `json:"parameters"`
+	RequestBody *struct {
+		Required bool                     `json:"required"`
+		Content  map[string]specMediaType `json:"content"`
+	} `json:"requestBody"`
+	Servers   []specServer            `json:"servers"`
+	Responses map[string]specResponse `json:"responses"`
+	// Security is a pointer because an empty list means no security is required
+	Security *[]map[string][]string `json:"security"`
+}
+
+// specParameter has the schema in OpenAPI 3.x, or the type and format in Swagger 2.0
+type specParameter struct {
+	Name     string     `json:"name"`
+	In       string     `json:"in"`
+	Required bool       `json:"required"`
+	Schema   *Schema    `json:"schema"`
+	Type     SchemaType `json:"type"`
+	Format   string     `json:"format"`
+	Items    *Schema    `json:"items"`
+	Enum     []any      `json:"enum"`
+	Default  any        `json:"default"`
+	Example  any        `json:"example"`
+}
+
+type specMediaType struct {
+	Schema  *Schema `json:"schema"`
+	Example any     `json:"example"`
+}
+
+// ParseAPISpec parses a Swagger 2.0 or OpenAPI 3.x document in YAML or JSON
+func ParseAPISpec(data []byte) (apiSpec APISpec, err error) {
+	var jsonData []byte
+	if jsonData, err = yaml.YAMLToJSON(data); err != nil {
+		err = fmt.Errorf("failed to parse the API specification: %v", err)
+		return
+	}
+
+	var doc *specDocument
+	if doc, err = parseSpecDocument(jsonData); err != nil {
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(doc.OpenAPI, "3."):
+		apiSpec = newOpenAPI(doc)
+	case doc.OpenAPI != "":
+		err = fmt.Errorf("unsupported OpenAPI version %q, only 3.x is supported", doc.OpenAPI)
+	default:
+		// take it as Swagger 2.0 as before
+		var swagger *SwaggerAPI
+		if swagger, err = parseSwaggerAPI(jsonData); err == nil {
+			apiSpec = swagger
+		}
+	}
+	return
+}
+
+// ParseURLToAPISpec parses the API specification from a URL or the data directory with the prefix atest://
+func ParseURLToAPISpec(specURL string) (apiSpec APISpec, err error) {
+	var data []byte
+	if strings.HasPrefix(specURL, "atest://") {
+		data, err = os.ReadFile(filepath.Join(home.GetUserDataDir(), strings.TrimPrefix(specURL, "atest://")))
+	} else if strings.HasPrefix(specURL, "http://") || strings.HasPrefix(specURL, "https://") {
+		var resp *http.Response
+		if resp, err = http.Get(specURL); err == nil {
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				err = fmt.Errorf("failed to get the API specification from %q, status code: %d", specURL, resp.StatusCode)
+			} else {
+				data, err = io.ReadAll(resp.Body)
+			}
+		}
+	} else {
+		data, err = os.ReadFile(specURL)
+	}
+
+	if err == nil {
+		apiSpec, err = ParseAPISpec(data)
+	}
+	return
+}
+
+// parseSpecDocument decodes the document after resolving the references
+func parseSpecDocument(jsonData []byte) (doc *specDocument, err error) {
+	var raw any
+	if err = json.Unmarshal(jsonData, &raw); err != nil {
+		return
+	}
+
+	var resolved []byte
+	if resolved, err = json.Marshal(resolveRefs(raw)); err == nil {
+		doc = &specDocument{}
+		err = json.Unmarshal(resolved, doc)
+	}
+	return
+}
+
+func newOpenAPI(doc *specDocument) *OpenAPI {
+	api := &OpenAPI{
+		Version: doc.OpenAPI,
+		Title:   doc.Info.Title,
+		servers: serverURLs(doc.Servers),
+	}
+	api.operations = buildOperations(doc, api.servers)
+	api.ApiMap = operationsToAPIMap(api.operations)
+	return api
+}
+
+// buildOperations collects the operations of all paths, the parameters of a path are inherited by its operations
+func buildOperations(doc *specDocument, servers []string) (operations []Operation) {
+	for _, path := range sortedKeys(doc.Paths) {
+		pathItem := doc.Paths[path]
+
+		var pathParameters []specParameter
+		decodeValue(pathItem["parameters"], &pathParameters)
+		var pathServers []specServer
+		decodeValue(pathItem["servers"], &pathServers)
+
+		for _, method := range specMethods {
+			rawOperation, ok := pathItem[method]
+			if !ok {
+				continue
+			}
+
+			operation := &specOperation{}
+			decodeValue(rawOperation, operation)
+
+			item := Operation{
+				ID:      operation.OperationID,
+				Summary: operation.Summary,
+				Path:    path,
+				Method:  strings.ToUpper(method),
+				Servers: servers,
+			}
+			if len(operation.Servers) > 0 {
+				item.Servers = serverURLs(operation.Servers)
+			} else if len(pathServers) > 0 {
+				item.Servers = serverURLs(pathServers)
+			}
+
+			for _, param := range mergeParameters(pathParameters, operation.Parameters) {
+				if param.In == "body" {
+					// the request body of Swagger 2.0
+					item.RequestBody = &RequestBody{
+						ContentType: firstContentType(operation.Consumes, doc.Consumes),
+						Required:    param.Required,
+						Schema:      param.Schema,
+						Example:     param.Example,
+					}
+					continue
+				}
+				if param.In == "formData" {
+					continue
+				}
+				item.Parameters = append(item.Parameters, param.toParameter())
+			}
+
+			if body := operation.RequestBody; body != nil && len(body.Content) > 0 {
+				contentType := preferredContentType(sortedKeys(body.Content))
+				item.RequestBody = &RequestBody{
+					ContentType: contentType,
+					Required:    body.Required,
+					Schema:      body.Content[contentType].Schema,
+					Example:     body.Content[contentType].Example,
+				}
+			}
+
+			item.Responses = buildResponses(operation, doc)
+			item.Security = buildSecurity(operation, doc)
+			operations = append(operations, item)
+		}
+	}
+	return
+}
+
+// buildResponses returns the documented responses which are sorted by the status code
+func buildResponses(operation *specOperation, doc *specDocument) (responses []Response) {
+	for _, code := range sortedKeys(operation.Responses) {
+		specResp := operation.Responses[code]
+		response := Response{
+			StatusCode:  code,
+			Description: specResp.Description,
+		}
+
+		if len(specResp.Content) > 0 {
+			response.ContentType = preferredContentType(sortedKeys(specResp.Content))
+			response.Schema = specResp.Content[response.ContentType].Schema
+			response.Content = make(map[string]*Schema, len(specResp.Content))
+			for contentType, mediaType := range specResp.Content {
+				response.Content[contentType] = mediaType.Schema
+			}
+		} else if specResp.Schema != nil {
+			response.ContentType = firstContentType(operation.Produces, doc.Produces)
+			response.Schema = specResp.Schema
+			response.Content = map[string]*Schema{}
+			for _, contentType := range firstNonEmpty(operation.Produces, doc.Produces, []string{response.ContentType}) {
+				response.Content[contentType] = specResp.Schema
+			}
+		}
+
+		for _, name := range sortedKeys(specResp.Headers) {
+			header := specResp.Headers[name]
+			header.Name = name
+			header.In = "header"
+			response.Headers = append(response.Headers, header.toParameter())
+		}
+		responses = append(responses, response)
+	}
+	return
+}
+
+// buildSecurity returns the schemes of the first security requirement, the operation level one overrides the global one
+func buildSecurity(operation *specOperation, doc *specDocument) (schemes []SecurityScheme) {
+	requirements := doc.Security
+	if operation.Security != nil {
+		requirements = *operation.Security
+	}
+	if len(requirements) == 0 {
+		return
+	}
+
+	definitions := doc.Components.SecuritySchemes
+	if len(definitions) == 0 {
+		definitions = doc.SecurityDefinitions
+	}
+	for _, name := range sortedKeys(requirements[0]) {
+		definition, ok := definitions[name]
+		if !ok {
+			continue
+		}
+
+		scheme := SecurityScheme{
+			Name:      name,
+			Type:      definition.Type,
+			Scheme:    strings.ToLower(definition.Scheme),
+			In:        definition.In,
+			ParamName: definition.Name,
+		}
+		if scheme.Type == "basic" {
+			scheme.Type = "http"
+			scheme.Scheme = "basic"
+		}
+		schemes = append(schemes, scheme)
+	}
+	return
+}
+
+// mergeParameters overrides the path level parameters with the operation level ones
+func mergeParameters(pathParameters, operationParameters []specParameter) (parameters []specParameter) {
+	for _, param := range pathParameters {
+		if !slices.ContainsFunc(operationParameters, func(item specParameter) bool {
+			return item.Name == param.Name && item.In == param.In
+		}) {
+			parameters = append(parameters, param)
+		}
+	}
+	parameters = append(parameters, operationParameters...)
+	return
+}
+
+func (p specParameter) toParameter() Parameter {
+	schema := p.Schema
+	if schema == nil {
+		schema = &Schema{
+			Type:    p.Type,
+			Format:  p.Format,
+			Items:   p.Items,
+			Enum:    p.Enum,
+			Default: p.Default,
+		}
+	}
+	return Parameter{
+		Name:     p.Name,
+		In:       p.In,
+		Required: p.Required,
+		Schema:   schema,
+		Example:  p.Example,
+	}
+}
+
+// serverURLs returns the URLs of the servers, the variables are replaced with the default values
+func serverURLs(servers []specServer) (urls []string) {
+	for _, server := range servers {
+		serverURL := server.URL
+		for name, variable := range server.Variables {
+			serverURL = strings.ReplaceAll(serverURL, "{"+name+"}", variable.Default)
+		}
+		urls = append(urls, serverURL)
+	}
+	return
+}
+
+// preferredContentType returns the JSON content type if it exists
+func preferredContentType(contentTypes []string) string {
+	for _, contentType := range contentTypes {
+		if strings.Contains(contentType, "json") {
+			return contentType
+		}
+	}
+	if len(contentTypes) > 0 {
+		return contentTypes[0]
+	}
+	return util.JSON
+}
+
+func firstNonEmpty(candidates ...[]string) []string {
+	for _, items := range candidates {
+		if len(items) > 0 {
+			return items
+		}
+	}
+	return nil
+}
+
+func firstContentType(candidates ...[]string) string {
+	if contentTypes := firstNonEmpty(candidates...); len(contentTypes) > 0 {
+		return preferredContentType(contentTypes)
+	}
+	return util.JSON
+}
+
+func operationsToAPIMap(operations []Operation) (apiMap map[string][]string) {
+	apiMap = make(map[string][]string)
+	for _, operation := range operations {
+		apiMap[operation.Path] = append(apiMap[operation.Path], strings.ToLower(operation.Method))
+	}
+	return
+}
+
+// decodeValue decodes a generic value into a struct, the errors are ignored
+func decodeValue(value any, target any) {
+	if value == nil {
+		return
+	}
+	if data, err := json.Marshal(value); err == nil {
+		_ = json.Unmarshal(data, target)
+	}
+}
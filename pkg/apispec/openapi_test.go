@@ -0,0 +1,169 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apispec_test
+
+import (
+	"net/http"
+	"testing"
+
+	_ "embed"
+
+	"github.com/h2non/gock"
+	"github.com/linuxsuren/api-testing/pkg/apispec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOpenAPI3(t *testing.T) {
+	apiSpec, err := apispec.ParseAPISpec([]byte(testdataOpenAPIYAML))
+	assert.NoError(t, err)
+	assert.IsType(t, &apispec.OpenAPI{}, apiSpec)
+
+	assert.Equal(t, []string{"http://localhost:8080/api/v1"}, apiSpec.GetServers())
+	assert.Equal(t, 4, apiSpec.APICount())
+	assert.True(t, apiSpec.HaveAPI("http://localhost:8080/api/v1/users", http.MethodPost))
+	assert.True(t, apiSpec.HaveAPI("/users/linuxsuren", http.MethodDelete))
+	assert.False(t, apiSpec.HaveAPI("/users/linuxsuren", http.MethodPut))
+
+	operations := apiSpec.GetOperations()
+	if !assert.Len(t, operations, 4) {
+		return
+	}
+
+	listUsers := operations[0]
+	assert.Equal(t, "listUsers", listUsers.ID)
+	assert.Equal(t, http.MethodGet, listUsers.Method)
+	if assert.Len(t, listUsers.Parameters, 2) {
+		assert.Equal(t, "page", listUsers.Parameters[0].Name)
+		assert.Equal(t, "query", listUsers.Parameters[0].In)
+		assert.Equal(t, float64(1), listUsers.Parameters[0].GenerateSample())
+		assert.Equal(t, "3fa85f64-5717-4562-b3fc-2c963f66afa6", listUsers.Parameters[1].GenerateSample())
+	}
+	if assert.Len(t, listUsers.Responses, 2) {
+		assert.Equal(t, "200", listUsers.Responses[0].StatusCode)
+		assert.Equal(t, "application/json", listUsers.Responses[0].ContentType)
+		assert.True(t, listUsers.Responses[0].Schema.Type.Is("array"))
+		assert.Equal(t, "default", listUsers.Responses[1].StatusCode)
+		assert.Nil(t, listUsers.Responses[1].Schema)
+	}
+	assert.Equal(t, []apispec.SecurityScheme{{Name: "bearerAuth", Type: "http", Scheme: "bearer"}}, listUsers.Security)
+
+	createUser := operations[1]
+	assert.Equal(t, "createUser", createUser.ID)
+	if assert.NotNil(t, createUser.RequestBody) {
+		assert.Equal(t, "application/json", createUser.RequestBody.ContentType)
+		assert.True(t, createUser.RequestBody.Required)
+
+		body, err := createUser.RequestBody.GenerateSample()
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"linuxsuren","email":"user@example.com","roles":["admin"],"manager":{}}`, body)
+	}
+
+	getUser := operations[2]
+	assert.Equal(t, "/users/{name}", getUser.Path)
+	assert.Equal(t, []string{"https://users.example.com"}, getUser.Servers)
+	if assert.Len(t, getUser.Parameters, 1) {
+		assert.Equal(t, "path", getUser.Parameters[0].In)
+		assert.True(t, getUser.Parameters[0].Required)
+	}
+	assert.Equal(t, []apispec.SecurityScheme{{Name: "apiKey", Type: "apiKey", In: "header", ParamName: "X-API-Key"}}, getUser.Security)
+	assert.Equal(t, http.MethodDelete, operations[3].Method)
+	assert.Empty(t, operations[3].Security)
+}
+
+func TestParseOpenAPI31(t *testing.T) {
+	apiSpec, err := apispec.ParseAPISpec([]byte(testdataOpenAPI31JSON))
+	assert.NoError(t, err)
+	assert.Empty(t, apiSpec.GetServers())
+
+	operations := apiSpec.GetOperations()
+	if assert.Len(t, operations, 1) {
+		assert.Empty(t, operations[0].ID)
+		body, err := operations[0].RequestBody.GenerateSample()
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id":"3fa85f64-5717-4562-b3fc-2c963f66afa6","count":101}`, body)
+	}
+}
+
+func TestParseSwaggerAsAPISpec(t *testing.T) {
+	apiSpec, err := apispec.ParseAPISpec([]byte(testdataSwaggerJSON))
+	assert.NoError(t, err)
+	assert.IsType(t, &apispec.SwaggerAPI{}, apiSpec)
+	assert.Equal(t, 5, apiSpec.APICount())
+	assert.Empty(t, apiSpec.GetServers())
+	assert.Equal(t, "sample", apiSpec.GetTitle())
+
+	operations := apiSpec.GetOperations()
+	if assert.Len(t, operations, 5) {
+		assert.Equal(t, "getUsers", operations[0].ID)
+		assert.Equal(t, "createUser", operations[1].ID)
+	}
+
+	_, err = apispec.ParseAPISpec([]byte(`{"openapi": "4.0.0"}`))
+	assert.Error(t, err)
+
+	_, err = apispec.ParseAPISpec([]byte(`[invalid`))
+	assert.Error(t, err)
+}
+
+func TestParseURLToAPISpec(t *testing.T) {
+	defer gock.Off()
+	gock.New(urlFoo).Get("/openapi.yaml").Reply(http.StatusOK).BodyString(testdataOpenAPIYAML)
+	gock.New(urlFoo).Get("/missing.yaml").Reply(http.StatusNotFound)
+
+	apiSpec, err := apispec.ParseURLToAPISpec(urlFoo + "/openapi.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, apiSpec.APICount())
+
+	_, err = apispec.ParseURLToAPISpec(urlFoo + "/missing.yaml")
+	assert.Error(t, err)
+
+	apiSpec, err = apispec.ParseURLToAPISpec("testdata/openapi31.json")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, apiSpec.APICount())
+}
+
+func TestGenerateSample(t *testing.T) {
+	assert.Nil(t, apispec.GenerateSample(nil))
+	assert.Equal(t, "a", apispec.GenerateSample(&apispec.Schema{Enum: []any{"a", "b"}}))
+	assert.Equal(t, true, apispec.GenerateSample(&apispec.Schema{Type: apispec.SchemaType{"boolean"}}))
+	assert.Equal(t, "2006-01-02", apispec.GenerateSample(&apispec.Schema{Type: apispec.SchemaType{"string"}, Format: "date"}))
+	assert.Equal(t, []any{101}, apispec.GenerateSample(&apispec.Schema{
+		Type:  apispec.SchemaType{"array"},
+		Items: &apispec.Schema{Type: apispec.SchemaType{"integer"}},
+	}))
+	assert.Equal(t, "random", apispec.GenerateSample(&apispec.Schema{
+		OneOf: []*apispec.Schema{{Type: apispec.SchemaType{"string"}}, {Type: apispec.SchemaType{"integer"}}},
+	}))
+}
+
+func TestToJSONSchema(t *testing.T) {
+	schema := &apispec.Schema{
+		Type: apispec.SchemaType{"object"},
+		Properties: map[string]*apispec.Schema{
+			"email": {Type: apispec.SchemaType{"string"}, Nullable: true},
+		},
+	}
+	jsonSchema, err := schema.ToJSONSchema()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":["object"],"properties":{"email":{"type":["string","null"]}}}`, jsonSchema)
+}
+
+//go:embed testdata/openapi.yaml
+var testdataOpenAPIYAML string
+
+//go:embed testdata/openapi31.json
+var testdataOpenAPI31JSON string
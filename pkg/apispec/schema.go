@@ -0,0 +1,262 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apispec
+
+import (
+	"encoding/json"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Schema is the subset of the JSON schema which is used by Swagger 2.0 and OpenAPI 3.x
+type Schema struct {
+	Type       SchemaType         `json:"type,omitempty"`
+	Format     string             `json:"format,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	Enum       []any              `json:"enum,omitempty"`
+	Example    any                `json:"example,omitempty"`
+	Default    any                `json:"default,omitempty"`
+	Nullable   bool               `json:"nullable,omitempty"`
+	AllOf      []*Schema          `json:"allOf,omitempty"`
+	OneOf      []*Schema          `json:"oneOf,omitempty"`
+	AnyOf      []*Schema          `json:"anyOf,omitempty"`
+}
+
+// SchemaType is the type of a schema, OpenAPI 3.1 allows multiple types like ["string", "null"]
+type SchemaType []string
+
+// UnmarshalJSON accepts both a string and an array of strings
+func (t *SchemaType) UnmarshalJSON(data []byte) (err error) {
+	var single string
+	if err = json.Unmarshal(data, &single); err == nil {
+		*t = SchemaType{single}
+		return
+	}
+
+	var multiple []string
+	if err = json.Unmarshal(data, &multiple); err == nil {
+		*t = multiple
+	}
+	return
+}
+
+// Is checks if the schema type is the given one
+func (t SchemaType) Is(name string) bool {
+	return slices.Contains(t, name)
+}
+
+// ToJSONSchema returns the schema as a JSON schema document, the nullable of OpenAPI 3.0 is converted to the null type
+func (s *Schema) ToJSONSchema() (jsonSchema string, err error) {
+	var data []byte
+	if data, err = json.Marshal(s); err != nil {
+		return
+	}
+
+	var raw map[string]any
+	if err = json.Unmarshal(data, &raw); err == nil {
+		if data, err = json.Marshal(toJSONSchemaNode(raw)); err == nil {
+			jsonSchema = string(data)
+		}
+	}
+	return
+}
+
+func toJSONSchemaNode(node any) any {
+	switch val := node.(type) {
+	case map[string]any:
+		if nullable, _ := val["nullable"].(bool); nullable {
+			delete(val, "nullable")
+			if types, ok := val["type"].([]any); ok && !slices.Contains(types, any("null")) {
+				val["type"] = append(types, "null")
+			}
+		}
+		for key, item := range val {
+			val[key] = toJSONSchemaNode(item)
+		}
+	case []any:
+		for i, item := range val {
+			val[i] = toJSONSchemaNode(item)
+		}
+	}
+	return node
+}
+
+// maxSampleDepth limits the nested levels of a generated sample
+const maxSampleDepth = 8
+
+// GenerateSample generates a sample value of the schema, the example, default or the first enum value comes first
+func GenerateSample(schema *Schema) any {
+	return generateSample(schema, 0)
+}
+
+func generateSample(schema *Schema, depth int) any {
+	if schema == nil || depth > maxSampleDepth {
+		return nil
+	}
+
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	case len(schema.AllOf) > 0:
+		return mergeSamples(schema.AllOf, depth)
+	case len(schema.OneOf) > 0:
+		return generateSample(schema.OneOf[0], depth+1)
+	case len(schema.AnyOf) > 0:
+		return generateSample(schema.AnyOf[0], depth+1)
+	case schema.Type.Is("object") || len(schema.Properties) > 0:
+		sample := map[string]any{}
+		for name, property := range schema.Properties {
+			sample[name] = generateSample(property, depth+1)
+		}
+		return sample
+	case schema.Type.Is("array"):
+		return []any{generateSample(schema.Items, depth+1)}
+	case schema.Type.Is("integer"), schema.Type.Is("number"),
+		slices.Contains([]string{"int32", "int64", "float", "double"}, schema.Format):
+		return 101
+	case schema.Type.Is("boolean"), schema.Format == "boolean":
+		return true
+	default:
+		return sampleOfStringFormat(schema.Format)
+	}
+}
+
+// mergeSamples merges the object samples of the schemas, the last non-object one wins
+func mergeSamples(schemas []*Schema, depth int) (sample any) {
+	merged := map[string]any{}
+	for _, item := range schemas {
+		value := generateSample(item, depth+1)
+		if object, ok := value.(map[string]any); ok {
+			for key, val := range object {
+				merged[key] = val
+			}
+		} else if value != nil {
+			sample = value
+		}
+	}
+	if sample == nil {
+		sample = merged
+	}
+	return
+}
+
+func sampleOfStringFormat(format string) string {
+	switch format {
+	case "date-time":
+		return "2006-01-02T15:04:05Z"
+	case "date":
+		return "2006-01-02"
+	case "email":
+		return "user@example.com"
+	case "uuid":
+		return "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+	case "uri", "url":
+		return "https://example.com"
+	case "ipv4":
+		return "127.0.0.1"
+	default:
+		return "random"
+	}
+}
+
+// refResolver replaces the local references like "#/components/schemas/User" with the referenced objects,
+// the circular references are replaced with the schemas of empty objects
+type refResolver struct {
+	root  any
+	cache map[string]any
+}
+
+func resolveRefs(doc any) any {
+	resolver := &refResolver{
+		root:  doc,
+		cache: map[string]any{},
+	}
+	return resolver.resolve(doc, nil)
+}
+
+func (r *refResolver) resolve(node any, stack []string) any {
+	switch val := node.(type) {
+	case map[string]any:
+		if ref, ok := val["$ref"].(string); ok && strings.HasPrefix(ref, "#/") {
+			if slices.Contains(stack, ref) {
+				return map[string]any{"type": "object"}
+			}
+			if cached, ok := r.cache[ref]; ok {
+				return cached
+			}
+
+			target, found := lookupPointer(r.root, ref)
+			if !found {
+				return val
+			}
+			resolved := r.resolve(target, append(stack[:len(stack):len(stack)], ref))
+			r.cache[ref] = resolved
+			return resolved
+		}
+
+		result := make(map[string]any, len(val))
+		for key, item := range val {
+			result[key] = r.resolve(item, stack)
+		}
+		return result
+	case []any:
+		result := make([]any, len(val))
+		for i, item := range val {
+			result[i] = r.resolve(item, stack)
+		}
+		return result
+	}
+	return node
+}
+
+// lookupPointer finds the object of a JSON pointer like "#/definitions/User"
+func lookupPointer(root any, ref string) (target any, found bool) {
+	target = root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		if unescaped, err := url.PathUnescape(token); err == nil {
+			token = unescaped
+		}
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		object, ok := target.(map[string]any)
+		if !ok {
+			return
+		}
+		if target, ok = object[token]; !ok {
+			return
+		}
+	}
+	found = true
+	return
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys[T any](data map[string]T) (keys []string) {
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return
+}
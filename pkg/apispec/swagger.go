@@ -17,6 +17,9 @@ limitations under the License.
 package apispec
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/go-openapi/spec"
 	"github.com/linuxsuren/api-testing/pkg/util/home"
 	"io"
@@ -46,6 +49,9 @@ func NewSwaggerAPI(swagger *spec.Swagger) *SwaggerAPI {
 
 func buildAPIMap(swagger *spec.Swagger) map[string][]string {
 	apiMap := make(map[string][]string)
+	if swagger == nil || swagger.Paths == nil {
+		return apiMap
+	}
 	for path, pathItem := range swagger.Paths.Paths {
 		var methods []string
 		if pathItem.Get != nil {
@@ -77,10 +83,14 @@ func buildAPIMap(swagger *spec.Swagger) map[string][]string {
 // HaveAPI check if the swagger has the API.
 // If the path is /api/v1/names/linuxsuren, then will match /api/v1/names/{name}
 func (s *SwaggerAPI) HaveAPI(path, method string) (exist bool) {
+	return haveAPI(s.ApiMap, path, method)
+}
+
+func haveAPI(apiMap map[string][]string, path, method string) (exist bool) {
 	method = strings.ToLower(method)
-	for p := range s.ApiMap {
+	for p := range apiMap {
 		if matchAPI(path, p) {
-			if methods, ok := s.ApiMap[p]; ok {
+			if methods, ok := apiMap[p]; ok {
 				for _, m := range methods {
 					if m == method {
 						exist = true
@@ -113,12 +123,62 @@ func swaggerAPIConvert(text string) (result string) {
 
 // APICount return the count of APIs
 func (s *SwaggerAPI) APICount() (count int) {
-	for _, methods := range s.ApiMap {
+	return apiCount(s.ApiMap)
+}
+
+func apiCount(apiMap map[string][]string) (count int) {
+	for _, methods := range apiMap {
 		count += len(methods)
 	}
 	return
 }
 
+// GetTitle returns the title of the document
+func (s *SwaggerAPI) GetTitle() (title string) {
+	if s.Swagger.Info != nil {
+		title = s.Swagger.Info.Title
+	}
+	return
+}
+
+// GetServers returns the URLs which consist of the schemes, host and base path
+func (s *SwaggerAPI) GetServers() (servers []string) {
+	if s.Swagger.Host == "" {
+		if s.Swagger.BasePath != "" {
+			servers = append(servers, s.Swagger.BasePath)
+		}
+		return
+	}
+
+	schemes := s.Swagger.Schemes
+	if len(schemes) == 0 {
+		schemes = []string{"http"}
+	}
+	for _, scheme := range schemes {
+		servers = append(servers, fmt.Sprintf("%s://%s%s", scheme, s.Swagger.Host, s.Swagger.BasePath))
+	}
+	return
+}
+
+// GetOperations returns all the operations, the body parameter is taken as the request body
+func (s *SwaggerAPI) GetOperations() (operations []Operation) {
+	if data, err := json.Marshal(s.Swagger); err == nil {
+		var doc *specDocument
+		if doc, err = parseSpecDocument(data); err == nil {
+			operations = buildOperations(doc, s.GetServers())
+		}
+	}
+	return
+}
+
+func parseSwaggerAPI(data []byte) (swaggerAPI *SwaggerAPI, err error) {
+	var swagger *spec.Swagger
+	if swagger, err = ParseToSwagger(data); err == nil {
+		swaggerAPI = NewSwaggerAPI(swagger)
+	}
+	return
+}
+
 func ParseToSwagger(data []byte) (swagger *spec.Swagger, err error) {
 	swagger = &spec.Swagger{}
 	err = swagger.UnmarshalJSON(data)
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records who changed or ran what
+package audit
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/logging"
+)
+
+var (
+	auditLogger = logging.DefaultLogger(logging.LogLevelInfo).WithName("audit")
+)
+
+// The kinds of the audited resources
+const (
+	KindSuite       = "suite"
+	KindTestCase    = "testcase"
+	KindStore       = "store"
+	KindSecret      = "secret"
+	KindHistory     = "history"
+	KindRoleBinding = "roleBinding"
+	KindMock        = "mock"
+	KindFunction    = "function"
+)
+
+// Entry represents an audit record of a mutating call or a run
+type Entry struct {
+	Time     time.Time `json:"time"`
+	User     string    `json:"user,omitempty"`
+	Method   string    `json:"method"`
+	Kind     string    `json:"kind,omitempty"`
+	Store    string    `json:"store,omitempty"`
+	Suite    string    `json:"suite,omitempty"`
+	TestCase string    `json:"testcase,omitempty"`
+	Name     string    `json:"name,omitempty"`
+	Summary  string    `json:"summary,omitempty"`
+	Success  bool      `json:"success"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// Filter is the condition of the audit entries query, the empty fields are ignored
+type Filter struct {
+	User   string
+	Method string
+	Kind   string
+	Store  string
+	Suite  string
+	Since  time.Time
+	Until  time.Time
+	Limit  int
+}
+
+// Match checks if the entry matches the filter
+func (f Filter) Match(entry Entry) bool {
+	switch {
+	case f.User != "" && f.User != entry.User,
+		f.Method != "" && !strings.Contains(strings.ToLower(entry.Method), strings.ToLower(f.Method)),
+		f.Kind != "" && f.Kind != entry.Kind,
+		f.Store != "" && f.Store != entry.Store,
+		f.Suite != "" && f.Suite != entry.Suite,
+		!f.Since.IsZero() && entry.Time.Before(f.Since),
+		!f.Until.IsZero() && entry.Time.After(f.Until):
+		return false
+	}
+	return true
+}
+
+// Sink is the destination of the audit entries
+type Sink interface {
+	Write(entry Entry) error
+	Close()
+}
+
+// Queryable represents a sink which is able to query the audit entries
+type Queryable interface {
+	Query(filter Filter) ([]Entry, error)
+}
+
+// Auditor records the audit entries into all the sinks
+type Auditor interface {
+	Record(entry Entry)
+	// Query returns the entries from the first queryable sink, the latest one comes first
+	Query(filter Filter) ([]Entry, error)
+	Close()
+}
+
+type multiSinkAuditor struct {
+	sinks []Sink
+	now   func() time.Time
+}
+
+// NewAuditor creates an auditor which writes entries into all the sinks
+func NewAuditor(sinks ...Sink) Auditor {
+	return &multiSinkAuditor{
+		sinks: sinks,
+		now:   time.Now,
+	}
+}
+
+// Record writes the entry into all the sinks, the errors will be logged only
+func (a *multiSinkAuditor) Record(entry Entry) {
+	if entry.Time.IsZero() {
+		entry.Time = a.now()
+	}
+	for _, sink := range a.sinks {
+		if err := sink.Write(entry); err != nil {
+			auditLogger.Error(err, "failed to write audit entry", "method", entry.Method)
+		}
+	}
+}
+
+// ErrNoQueryableSink indicates there is no sink supports query
+var ErrNoQueryableSink = errors.New("no queryable audit sink found")
+
+func (a *multiSinkAuditor) Query(filter Filter) (entries []Entry, err error) {
+	for _, sink := range a.sinks {
+		if queryable, ok := sink.(Queryable); ok {
+			return queryable.Query(filter)
+		}
+	}
+	err = ErrNoQueryableSink
+	return
+}
+
+func (a *multiSinkAuditor) Close() {
+	for _, sink := range a.sinks {
+		sink.Close()
+	}
+}
+
+// filterEntries returns the matched entries in reverse order
+func filterEntries(entries []Entry, filter Filter) (result []Entry) {
+	result = make([]Entry, 0)
+	for _, entry := range slices.Backward(entries) {
+		if !filter.Match(entry) {
+			continue
+		}
+		result = append(result, entry)
+		if filter.Limit > 0 && len(result) >= filter.Limit {
+			break
+		}
+	}
+	return
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	now := time.Now()
+	entry := Entry{
+		Time:   now,
+		User:   "admin",
+		Method: "/server.Runner/UpdateTestSuite",
+		Kind:   KindSuite,
+		Store:  "local",
+		Suite:  "demo",
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		expect bool
+	}{
+		{name: "empty", filter: Filter{}, expect: true},
+		{name: "all matched", filter: Filter{User: "admin", Method: "updatetestsuite", Kind: KindSuite,
+			Store: "local", Suite: "demo", Since: now.Add(-time.Minute), Until: now.Add(time.Minute)}, expect: true},
+		{name: "different user", filter: Filter{User: "guest"}, expect: false},
+		{name: "different method", filter: Filter{Method: "Delete"}, expect: false},
+		{name: "different kind", filter: Filter{Kind: KindStore}, expect: false},
+		{name: "different store", filter: Filter{Store: "git"}, expect: false},
+		{name: "different suite", filter: Filter{Suite: "fake"}, expect: false},
+		{name: "too early", filter: Filter{Since: now.Add(time.Minute)}, expect: false},
+		{name: "too late", filter: Filter{Until: now.Add(-time.Minute)}, expect: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, tt.filter.Match(entry))
+		})
+	}
+}
+
+func TestAuditor(t *testing.T) {
+	t.Run("no queryable sink", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		auditor := NewAuditor(NewJSONSink(buf))
+		defer auditor.Close()
+
+		auditor.Record(Entry{Method: "/server.Runner/Run", Success: true})
+		var entry Entry
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		assert.Equal(t, "/server.Runner/Run", entry.Method)
+		assert.False(t, entry.Time.IsZero())
+
+		_, err := auditor.Query(Filter{})
+		assert.ErrorIs(t, err, ErrNoQueryableSink)
+	})
+
+	t.Run("query from file", func(t *testing.T) {
+		sink, err := NewFileSink(filepath.Join(t.TempDir(), "audit.log"), 0, 0)
+		assert.NoError(t, err)
+
+		auditor := NewAuditor(NewStdoutSink(), sink)
+		defer auditor.Close()
+		auditor.Record(Entry{Method: "/server.Runner/CreateTestSuite", Suite: "a"})
+		auditor.Record(Entry{Method: "/server.Runner/DeleteTestSuite", Suite: "a"})
+		auditor.Record(Entry{Method: "/server.Runner/DeleteTestSuite", Suite: "b"})
+
+		entries, err := auditor.Query(Filter{Suite: "a"})
+		assert.NoError(t, err)
+		if assert.Len(t, entries, 2) {
+			assert.Equal(t, "/server.Runner/DeleteTestSuite", entries[0].Method)
+			assert.Equal(t, "/server.Runner/CreateTestSuite", entries[1].Method)
+		}
+
+		entries, err = auditor.Query(Filter{Limit: 1})
+		assert.NoError(t, err)
+		if assert.Len(t, entries, 1) {
+			assert.Equal(t, "b", entries[0].Suite)
+		}
+	})
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "logs", "audit.log")
+	sink, err := NewFileSink(logFile, 200, 2)
+	assert.NoError(t, err)
+	defer sink.Close()
+
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, sink.Write(Entry{Method: "/server.Runner/RunTestCase", Summary: "rotation"}))
+	}
+	assert.FileExists(t, logFile+".1")
+	assert.FileExists(t, logFile+".2")
+	assert.NoFileExists(t, logFile+".3")
+
+	info, err := os.Stat(logFile)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, info.Size(), int64(200))
+
+	entries, err := sink.(Queryable).Query(Filter{})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, entries)
+	assert.Less(t, len(entries), 10)
+}
+
+func TestDiff(t *testing.T) {
+	type spec struct {
+		Kind string `json:"kind,omitempty"`
+		URL  string `json:"url,omitempty"`
+	}
+	type suite struct {
+		Name  string            `json:"name"`
+		API   string            `json:"api,omitempty"`
+		Param map[string]string `json:"param,omitempty"`
+		Spec  spec              `json:"spec"`
+	}
+
+	tests := []struct {
+		name   string
+		old    interface{}
+		new    interface{}
+		expect string
+	}{{
+		name:   "same",
+		old:    suite{Name: "a"},
+		new:    suite{Name: "a"},
+		expect: "",
+	}, {
+		name: "changed, added and removed",
+		old:  suite{Name: "a", API: "http://foo", Spec: spec{URL: "swagger.json"}},
+		new: suite{Name: "b", API: "http://foo", Param: map[string]string{"key": "value"},
+			Spec: spec{Kind: "swagger"}},
+		expect: "changed: name; added: param.key, spec.kind; removed: spec.url",
+	}, {
+		name:   "nil old object",
+		old:    nil,
+		new:    suite{Name: "a"},
+		expect: "added: name",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, Diff(tt.old, tt.new))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package audit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// Diff returns a summary of the changed fields between two objects,
+// the objects are compared by their JSON representation. The values are not included.
+// For example: "changed: api, param.name; added: proxy; removed: spec.url"
+func Diff(oldObj, newObj interface{}) string {
+	fields := map[string][]string{}
+	for _, change := range util.DiffFields(oldObj, newObj) {
+		fields[change.Type] = append(fields[change.Type], change.Path)
+	}
+
+	var parts []string
+	for _, changeType := range []string{util.ChangeChanged, util.ChangeAdded, util.ChangeRemoved} {
+		if len(fields[changeType]) > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %s", changeType, strings.Join(fields[changeType], ", ")))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+type jsonSink struct {
+	writer io.Writer
+	lock   sync.Mutex
+}
+
+// NewJSONSink creates a sink which writes the entries as JSON lines
+func NewJSONSink(writer io.Writer) Sink {
+	return &jsonSink{writer: writer}
+}
+
+// NewStdoutSink creates a sink which writes the entries into stdout
+func NewStdoutSink() Sink {
+	return NewJSONSink(os.Stdout)
+}
+
+func (s *jsonSink) Write(entry Entry) (err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	err = json.NewEncoder(s.writer).Encode(entry)
+	return
+}
+
+func (s *jsonSink) Close() {}
+
+type fileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+	lock       sync.Mutex
+}
+
+// NewFileSink creates a sink which writes the entries into a file.
+// The file will be rotated once it's larger than maxSize (bytes),
+// the rotated files are named as path.1, path.2 and so on.
+// There is no rotation if maxSize is not positive.
+func NewFileSink(path string, maxSize int64, maxBackups int) (sink Sink, err error) {
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+
+	s := &fileSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err = s.open(); err == nil {
+		sink = s
+	}
+	return
+}
+
+func (s *fileSink) open() (err error) {
+	if s.file, err = os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600); err != nil {
+		return
+	}
+
+	var info os.FileInfo
+	if info, err = s.file.Stat(); err == nil {
+		s.size = info.Size()
+	}
+	return
+}
+
+func (s *fileSink) Write(entry Entry) (err error) {
+	var data []byte
+	if data, err = json.Marshal(entry); err != nil {
+		return
+	}
+	data = append(data, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(data)) > s.maxSize {
+		if err = s.rotate(); err != nil {
+			return
+		}
+	}
+
+	var n int
+	n, err = s.file.Write(data)
+	s.size += int64(n)
+	return
+}
+
+func (s *fileSink) rotate() (err error) {
+	_ = s.file.Close()
+
+	if s.maxBackups <= 0 {
+		_ = os.Remove(s.path)
+	} else {
+		_ = os.Remove(s.backupName(s.maxBackups))
+		for i := s.maxBackups - 1; i >= 1; i-- {
+			if err = os.Rename(s.backupName(i), s.backupName(i+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return
+			}
+		}
+		if err = os.Rename(s.path, s.backupName(1)); err != nil {
+			return
+		}
+	}
+	err = s.open()
+	return
+}
+
+func (s *fileSink) backupName(index int) string {
+	return fmt.Sprintf("%s.%d", s.path, index)
+}
+
+// Query reads the entries from the backup files and the current file
+func (s *fileSink) Query(filter Filter) (result []Entry, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var entries []Entry
+	for i := s.maxBackups; i >= 0; i-- {
+		name := s.path
+		if i > 0 {
+			name = s.backupName(i)
+		}
+
+		var items []Entry
+		if items, err = readEntries(name); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				err = nil
+				continue
+			}
+			return
+		}
+		entries = append(entries, items...)
+	}
+	result = filterEntries(entries, filter)
+	return
+}
+
+func (s *fileSink) Close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.file != nil {
+		_ = s.file.Close()
+		s.file = nil
+	}
+}
+
+func readEntries(name string) (entries []Entry, err error) {
+	var file *os.File
+	if file, err = os.Open(name); err != nil {
+		return
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var entry Entry
+		if json.Unmarshal(scanner.Bytes(), &entry) == nil {
+			entries = append(entries, entry)
+		}
+	}
+	err = scanner.Err()
+	return
+}
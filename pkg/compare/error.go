@@ -66,7 +66,9 @@ func (e *noEqualErr) Error() string {
 		switch v.(type) {
 		case *noEqualErr:
 			if v.(*noEqualErr).NeqErrs != nil {
-				q = append(q, &task{append(q[0].path, v.(*noEqualErr).Path...), v.(*noEqualErr).NeqErrs.errs})
+				// copy the path, the sibling tasks must not share the same underlying array
+				path := append(append([]string{}, q[0].path...), v.(*noEqualErr).Path...)
+				q = append(q, &task{path, v.(*noEqualErr).NeqErrs.errs})
 			} else {
 				msg = append(msg,
 					fmt.Sprintf("compare: field %s: %s",
@@ -0,0 +1,310 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compare
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// The placeholders of the expected string values
+const (
+	// PlaceholderAny matches any value
+	PlaceholderAny = "$any"
+	// PlaceholderRegex matches the value with a regular expression, such as: $regex:^\d+$
+	PlaceholderRegex = "$regex:"
+	// PlaceholderType matches the JSON type of the value, such as: $type:number
+	PlaceholderType = "$type:"
+)
+
+// Options are the options of the structural comparison. The paths are separated by dots,
+// a "*" matches one key or index, and a "**" matches any number of them. For instance: items.*.id
+type Options struct {
+	// Ignore are the paths which are not compared
+	Ignore []string
+	// Unordered are the paths of the arrays which are compared without the order
+	Unordered []string
+	// Tolerance is the max difference of the numbers
+	Tolerance float64
+	// Subset allows the extra fields of the objects and the extra items of the arrays
+	Subset bool
+}
+
+// Match compares the actual JSON against the expected one with the options,
+// all the differences are reported path by path
+func Match(field string, expect, actual gjson.Result, opt Options) error {
+	m := &matcher{opt: opt}
+	return m.element(field, nil, expect, actual)
+}
+
+// Redact replaces the values of the paths with the placeholder $any, the data is the decoded JSON
+func Redact(data interface{}, paths []string) interface{} {
+	if len(paths) == 0 {
+		return data
+	}
+	patterns := make([][]string, len(paths))
+	for i, path := range paths {
+		patterns[i] = strings.Split(path, ".")
+	}
+	return redact(data, nil, patterns)
+}
+
+func redact(data interface{}, path []string, patterns [][]string) interface{} {
+	for _, pattern := range patterns {
+		if len(path) > 0 && matchPath(pattern, path) {
+			return PlaceholderAny
+		}
+	}
+
+	switch val := data.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			val[k] = redact(item, append(path[:len(path):len(path)], k), patterns)
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = redact(item, append(path[:len(path):len(path)], strconv.Itoa(i)), patterns)
+		}
+	}
+	return data
+}
+
+type matcher struct {
+	opt Options
+}
+
+func (m *matcher) element(field string, path []string, expect, actual gjson.Result) error {
+	if expect.Type == gjson.String {
+		if matched, err := m.placeholder(expect.Str, actual); matched {
+			return newNoEqualErrIfNotNil(field, err)
+		}
+	}
+
+	if expect.Type != actual.Type {
+		return newNoEqualErr(field, fmt.Errorf("expect type %s but got %v", expect.Type.String(), actual.Type.String()))
+	}
+
+	switch {
+	case expect.IsObject():
+		return m.object(field, path, expect, actual)
+	case expect.IsArray():
+		return m.array(field, path, expect.Array(), actual.Array())
+	case expect.Type == gjson.Number && m.opt.Tolerance > 0:
+		if math.Abs(expect.Num-actual.Num) > m.opt.Tolerance {
+			return newNoEqualErr(field, fmt.Errorf("expect %v (±%v) but got %v", expect.Num, m.opt.Tolerance, actual.Num))
+		}
+	case !reflect.DeepEqual(expect.Value(), actual.Value()):
+		return newNoEqualErr(field, fmt.Errorf("expect %v but got %v", expect.Value(), actual.Value()))
+	}
+	return nil
+}
+
+// placeholder checks the actual value if the expected one is a placeholder
+func (m *matcher) placeholder(expect string, actual gjson.Result) (matched bool, err error) {
+	switch {
+	case expect == PlaceholderAny:
+		matched = true
+	case strings.HasPrefix(expect, PlaceholderRegex):
+		matched = true
+		var reg *regexp.Regexp
+		if reg, err = regexp.Compile(strings.TrimPrefix(expect, PlaceholderRegex)); err == nil && !reg.MatchString(actual.String()) {
+			err = fmt.Errorf("expect to match %s but got %v", reg.String(), actual.Value())
+		}
+	case strings.HasPrefix(expect, PlaceholderType):
+		matched = true
+		if expectType, actualType := strings.TrimPrefix(expect, PlaceholderType), typeOf(actual); expectType != actualType {
+			err = fmt.Errorf("expect type %s but got %s", expectType, actualType)
+		}
+	}
+	return
+}
+
+func (m *matcher) object(field string, path []string, expect, actual gjson.Result) error {
+	var errs error
+	expectMap, actualMap := expect.Map(), actual.Map()
+	for _, k := range sortedKeys(expectMap) {
+		current := append(path[:len(path):len(path)], k)
+		if m.ignored(current) {
+			continue
+		}
+
+		av, ok := actualMap[k]
+		if !ok {
+			errs = JoinErr(errs, newNoEqualErr(field, fmt.Errorf("field %s is not exist", k)))
+			continue
+		}
+		if err := m.element(k, current, expectMap[k], av); err != nil {
+			errs = JoinErr(errs, newNoEqualErr(field, err))
+		}
+	}
+
+	if !m.opt.Subset {
+		for _, k := range sortedKeys(actualMap) {
+			if _, ok := expectMap[k]; !ok && !m.ignored(append(path[:len(path):len(path)], k)) {
+				errs = JoinErr(errs, newNoEqualErr(field, fmt.Errorf("field %s is not expected", k)))
+			}
+		}
+	}
+	return errs
+}
+
+func (m *matcher) array(field string, path []string, expect, actual []gjson.Result) error {
+	if l1, l2 := len(expect), len(actual); l1 != l2 && !(m.opt.Subset && l1 < l2) {
+		return newNoEqualErr(field, fmt.Errorf("length is not equal, expect %v items but got %v", l1, l2))
+	}
+
+	var errs error
+	if m.unordered(path) {
+		// find the candidates of each expected item, then match them as a bipartite graph,
+		// so an item which matches several ones does not take the only match of another one
+		candidates := make([][]int, len(expect))
+		for i := range expect {
+			current := append(path[:len(path):len(path)], strconv.Itoa(i))
+			if m.ignored(current) {
+				continue
+			}
+			for j := range actual {
+				if m.element(strconv.Itoa(i), current, expect[i], actual[j]) == nil {
+					candidates[i] = append(candidates[i], j)
+				}
+			}
+		}
+
+		owners := make([]int, len(actual))
+		for j := range owners {
+			owners[j] = -1
+		}
+		for i := range expect {
+			if m.ignored(append(path[:len(path):len(path)], strconv.Itoa(i))) {
+				continue
+			}
+			if !augment(i, candidates, owners, make([]bool, len(actual))) {
+				errs = JoinErr(errs, newNoEqualErr(field,
+					newNoEqualErr(strconv.Itoa(i), fmt.Errorf("no matched item for %s", expect[i].Raw))))
+			}
+		}
+		return errs
+	}
+
+	for i := range expect {
+		current := append(path[:len(path):len(path)], strconv.Itoa(i))
+		if m.ignored(current) {
+			continue
+		}
+		if err := m.element(strconv.Itoa(i), current, expect[i], actual[i]); err != nil {
+			errs = JoinErr(errs, newNoEqualErr(field, err))
+		}
+	}
+	return errs
+}
+
+// augment finds an actual item for the expected item i, the matched expected items move to their other candidates if necessary
+func augment(i int, candidates [][]int, owners []int, visited []bool) bool {
+	for _, j := range candidates[i] {
+		if visited[j] {
+			continue
+		}
+		visited[j] = true
+		if owners[j] < 0 || augment(owners[j], candidates, owners, visited) {
+			owners[j] = i
+			return true
+		}
+	}
+	return false
+}
+
+func (m *matcher) ignored(path []string) bool {
+	for _, pattern := range m.opt.Ignore {
+		if matchPath(strings.Split(pattern, "."), path) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *matcher) unordered(path []string) bool {
+	for _, pattern := range m.opt.Unordered {
+		var segments []string
+		if pattern != "" && pattern != "$" {
+			segments = strings.Split(pattern, ".")
+		}
+		if matchPath(segments, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPath checks if the path matches the pattern, a "*" matches one segment, and a "**" matches any number of them
+func matchPath(pattern, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+
+	switch pattern[0] {
+	case "**":
+		for i := 0; i <= len(path); i++ {
+			if matchPath(pattern[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(path) > 0 && matchPath(pattern[1:], path[1:])
+	default:
+		return len(path) > 0 && pattern[0] == path[0] && matchPath(pattern[1:], path[1:])
+	}
+}
+
+func typeOf(result gjson.Result) string {
+	switch {
+	case result.IsObject():
+		return "object"
+	case result.IsArray():
+		return "array"
+	case result.Type == gjson.True || result.Type == gjson.False:
+		return "boolean"
+	case result.Type == gjson.Number:
+		return "number"
+	case result.Type == gjson.String:
+		return "string"
+	default:
+		return "null"
+	}
+}
+
+func sortedKeys(data map[string]gjson.Result) (keys []string) {
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return
+}
+
+func newNoEqualErrIfNotNil(field string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return newNoEqualErr(field, err)
+}
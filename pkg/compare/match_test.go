@@ -0,0 +1,178 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+)
+
+func TestMatch(t *testing.T) {
+	actual := `{
+		"id": "4f6a1c2e-8b1d-4c5e-9f3a-2b7c8d9e0f1a",
+		"createdAt": "2025-01-01T00:00:00Z",
+		"price": 9.995,
+		"tags": ["b", "a", "c"],
+		"items": [{"id": 1, "updatedAt": 100}, {"id": 2, "updatedAt": 200}],
+		"owner": {"name": "rick", "age": 18}
+	}`
+
+	tests := []struct {
+		name   string
+		expect string
+		opt    Options
+		errs   []string
+	}{{
+		name:   "exactly",
+		expect: actual,
+	}, {
+		name: "placeholders",
+		expect: `{
+			"id": "$regex:^[0-9a-f-]{36}$",
+			"createdAt": "$any",
+			"price": "$type:number",
+			"tags": "$type:array",
+			"items": "$any",
+			"owner": {"name": "$regex:^r", "age": "$regex:^\\d+$"}
+		}`,
+	}, {
+		name: "ignore, unordered and tolerance",
+		expect: `{
+			"id": "fake",
+			"createdAt": "fake",
+			"price": 10,
+			"tags": ["a", "b", "c"],
+			"items": [{"id": 2, "updatedAt": 0}, {"id": 1, "updatedAt": 0}],
+			"owner": {"name": "rick", "age": 18}
+		}`,
+		opt: Options{
+			Ignore:    []string{"id", "createdAt", "items.*.updatedAt"},
+			Unordered: []string{"tags", "items"},
+			Tolerance: 0.01,
+		},
+	}, {
+		name:   "subset",
+		expect: `{"tags": ["b"], "owner": {"name": "rick"}}`,
+		opt:    Options{Subset: true},
+	}, {
+		name:   "subset of an unordered array",
+		expect: `{"tags": ["c", "a"]}`,
+		opt:    Options{Subset: true, Unordered: []string{"**"}},
+	}, {
+		name: "mismatched",
+		expect: `{
+			"id": "$regex:^\\d+$",
+			"price": 10,
+			"tags": ["a", "b", "c"],
+			"items": [{"id": 3}, {"id": 2, "updatedAt": 200}],
+			"owner": {"name": 1, "age": "$type:string", "email": "$any"}
+		}`,
+		opt: Options{Unordered: []string{"items"}},
+		errs: []string{
+			"compare: field body.id: expect to match ^\\d+$ but got 4f6a1c2e-8b1d-4c5e-9f3a-2b7c8d9e0f1a",
+			"compare: field body.price: expect 10 but got 9.995",
+			"compare: field body.tags.0: expect a but got b",
+			"compare: field body.items.0: no matched item for {\"id\": 3}",
+			"compare: field body.owner.name: expect type Number but got String",
+			"compare: field body.owner.age: expect type string but got number",
+			"compare: field body.owner: field email is not exist",
+			"compare: field body: field createdAt is not expected",
+		},
+	}, {
+		name:   "different length",
+		expect: `{"tags": ["a"]}`,
+		opt:    Options{Ignore: []string{"id", "createdAt", "price", "items", "owner"}},
+		errs:   []string{"compare: field body.tags: length is not equal, expect 1 items but got 3"},
+	}, {
+		name:   "root array",
+		expect: `[2, 1]`,
+		opt:    Options{Unordered: []string{"$"}},
+	}, {
+		name:   "unordered with placeholders",
+		expect: `{"tags": ["$any", "b", "$regex:^[ab]$"]}`,
+		opt:    Options{Ignore: []string{"id", "createdAt", "price", "items", "owner"}, Unordered: []string{"tags"}},
+	}, {
+		name:   "unordered without enough matches",
+		expect: `{"tags": ["$regex:^[ab]$", "b", "a"]}`,
+		opt:    Options{Ignore: []string{"id", "createdAt", "price", "items", "owner"}, Unordered: []string{"tags"}},
+		errs:   []string{"compare: field body.tags.2: no matched item for \"a\""},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := actual
+			if tt.name == "root array" {
+				target = `[1, 2]`
+			}
+
+			err := Match("body", gjson.Parse(tt.expect), gjson.Parse(target), tt.opt)
+			if len(tt.errs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				for _, msg := range tt.errs {
+					assert.Contains(t, err.Error(), msg)
+				}
+			}
+		})
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	assert.True(t, matchPath([]string{"a", "*", "c"}, []string{"a", "0", "c"}))
+	assert.True(t, matchPath([]string{"**", "c"}, []string{"a", "0", "c"}))
+	assert.True(t, matchPath([]string{"**"}, nil))
+	assert.False(t, matchPath([]string{"a", "*"}, []string{"a"}))
+	assert.False(t, matchPath([]string{"a"}, []string{"b"}))
+}
+
+func TestMatchNestedErrors(t *testing.T) {
+	expect := `{"a": {"b": {"c": 1, "d": 1}, "e": {"f": 1, "g": 1}}, "h": [{"i": 1}, {"j": 1}]}`
+	actual := `{"a": {"b": {"c": 2, "d": 2}, "e": {"f": 2, "g": 2}}, "h": [{"i": 2}, {"j": 2}]}`
+
+	err := Match("body", gjson.Parse(expect), gjson.Parse(actual), Options{})
+	if assert.Error(t, err) {
+		for _, msg := range []string{
+			"compare: field body.a.b.c: expect 1 but got 2",
+			"compare: field body.a.b.d: expect 1 but got 2",
+			"compare: field body.a.e.f: expect 1 but got 2",
+			"compare: field body.a.e.g: expect 1 but got 2",
+			"compare: field body.h.0.i: expect 1 but got 2",
+			"compare: field body.h.1.j: expect 1 but got 2",
+		} {
+			assert.Contains(t, err.Error(), msg)
+		}
+	}
+}
+
+func TestRedact(t *testing.T) {
+	data := map[string]interface{}{
+		"id":    "fake",
+		"items": []interface{}{map[string]interface{}{"id": 1, "etag": "a"}},
+		"name":  "rick",
+	}
+	assert.Equal(t, map[string]interface{}{
+		"id":    PlaceholderAny,
+		"items": []interface{}{map[string]interface{}{"id": 1, "etag": PlaceholderAny}},
+		"name":  "rick",
+	}, Redact(data, []string{"id", "items.*.etag"}))
+
+	assert.Equal(t, "text", Redact("text", []string{"**"}))
+	assert.Equal(t, "text", Redact("text", nil))
+}
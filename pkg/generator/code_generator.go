@@ -72,6 +72,24 @@ func GetTestSuiteConverters() (result map[string]TestSuiteConverter) {
 	return
 }
 
+// generateCode generates the tests of a test case, or all the test cases in the suite mode if the test case is nil.
+// The language specific functions convert the model to the expressions
+func generateCode(testSuite *testing.TestSuite, testCase *testing.TestCase, templateName, templateText string,
+	funcs template.FuncMap) (result string, err error) {
+	var tpl *template.Template
+	if tpl, err = template.New(templateName).Funcs(template.FuncMap{
+		"snakeCase":  snakeCase,
+		"camelCase":  camelCase,
+		"pascalCase": pascalCase,
+	}).Funcs(funcs).Parse(templateText); err == nil {
+		buf := new(bytes.Buffer)
+		if err = tpl.Execute(buf, newCodeSuite(testSuite, testCase)); err == nil {
+			result = buf.String()
+		}
+	}
+	return
+}
+
 func generate(testsuite *testing.TestSuite, testcase *testing.TestCase, templateName, templateText string) (result string, err error) {
 	if testcase != nil && testcase.Request.Method == "" {
 		testcase.Request.Method = http.MethodGet
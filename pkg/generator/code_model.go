@@ -0,0 +1,394 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// codeSuite is the language neutral model of the generated tests, the suite mode has
+// all the test cases, and the outputs of the earlier cases are passed on as variables
+type codeSuite struct {
+	Name   string
+	Params []codePair
+	Cases  []codeCase
+}
+
+type codeCase struct {
+	Name string
+	// Identifier is unique in the suite, it consists of the lower case words and underscores
+	Identifier string
+	Method     string
+	URL        codeString
+	Query      []codeKeyValue
+	Header     []codeKeyValue
+	Cookie     []codeKeyValue
+	Form       []codeKeyValue
+	Body       codeString
+	Assertions codeAssertions
+}
+
+type codeAssertions struct {
+	StatusCode int
+	Header     []codePair
+	Fields     []codeFieldAssertion
+	// Unsupported are the verify expressions which could not be translated
+	Unsupported []string
+}
+
+// codeFieldAssertion compares a field of the response JSON with a literal
+type codeFieldAssertion struct {
+	// Expression is the original one for the messages
+	Expression string
+	Path       []any
+	Length     bool
+	Operator   string
+	// Value is a JSON literal
+	Value string
+}
+
+type codePair struct {
+	Key   string
+	Value string
+}
+
+type codeKeyValue struct {
+	Key   string
+	Value codeString
+}
+
+// codeString is a text which might refer to the suite parameters or the outputs of the earlier cases
+type codeString []codeSegment
+
+type codeSegment struct {
+	Text string
+	Ref  *codeReference
+}
+
+// codeReference refers to a suite parameter if Case is empty, otherwise a field of the case output
+type codeReference struct {
+	Param string
+	Case  string
+	Path  []any
+}
+
+// IsEmpty returns true if there is no text
+func (s codeString) IsEmpty() bool {
+	for _, segment := range s {
+		if segment.Ref != nil || segment.Text != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// HasReference returns true if any segment is a reference
+func (s codeString) HasReference() bool {
+	for _, segment := range s {
+		if segment.Ref != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// merge joins the adjacent texts
+func (s codeString) merge() (result codeString) {
+	for _, segment := range s {
+		if last := len(result) - 1; last >= 0 && segment.Ref == nil && result[last].Ref == nil {
+			result[last].Text += segment.Text
+		} else {
+			result = append(result, segment)
+		}
+	}
+	return
+}
+
+// Literal returns the text if there is no reference
+func (s codeString) Literal() (text string) {
+	for _, segment := range s {
+		text += segment.Text
+	}
+	return
+}
+
+// newCodeSuite creates the model of a test case, or all the test cases if the test case is nil
+func newCodeSuite(testSuite *testing.TestSuite, testCase *testing.TestCase) (suite codeSuite) {
+	if testSuite == nil {
+		testSuite = &testing.TestSuite{}
+	}
+
+	builder := &codeBuilder{
+		ctx:   map[string]interface{}{testing.ContextKeyGlobalParam: testSuite.Param},
+		cases: map[string]bool{},
+	}
+	for _, item := range testSuite.Items {
+		builder.cases[item.Name] = true
+	}
+
+	suite.Name = util.EmptyThenDefault(testSuite.Name, "api-testing")
+	for _, key := range slices.Sorted(maps.Keys(testSuite.Param)) {
+		suite.Params = append(suite.Params, codePair{Key: key, Value: testSuite.Param[key]})
+	}
+
+	baseAPI := builder.render(testSuite.API)
+	if testCase != nil {
+		suite.Cases = append(suite.Cases, builder.newCodeCase(*testCase, baseAPI))
+	} else {
+		for _, item := range testSuite.Items {
+			suite.Cases = append(suite.Cases, builder.newCodeCase(item, baseAPI))
+		}
+	}
+
+	names := map[string]int{}
+	for i := range suite.Cases {
+		suite.Cases[i].Identifier = strings.ReplaceAll(uniqueName(names, snakeCase(suite.Cases[i].Name)), "-", "_")
+	}
+	return
+}
+
+// HasQuery returns true if any case has the query parameters
+func (s codeSuite) HasQuery() bool {
+	return slices.ContainsFunc(s.Cases, func(c codeCase) bool { return len(c.Query) > 0 })
+}
+
+// HasForm returns true if any case has the form data
+func (s codeSuite) HasForm() bool {
+	return slices.ContainsFunc(s.Cases, func(c codeCase) bool { return len(c.Form) > 0 })
+}
+
+var wordRegex = regexp.MustCompile(`[a-zA-Z0-9]+`)
+
+// snakeCase returns the lower case words which are joined with underscores, such as get_user
+func snakeCase(text string) string {
+	words := wordRegex.FindAllString(text, -1)
+	if len(words) == 0 {
+		return "request"
+	}
+	result := strings.ToLower(strings.Join(words, "_"))
+	if result[0] >= '0' && result[0] <= '9' {
+		result = "test_" + result
+	}
+	return result
+}
+
+// camelCase returns the words in camel case, such as getUser
+func camelCase(text string) string {
+	result := pascalCase(text)
+	return strings.ToLower(result[:1]) + result[1:]
+}
+
+// pascalCase returns the words in pascal case, such as GetUser
+func pascalCase(text string) (result string) {
+	for _, word := range strings.Split(snakeCase(text), "_") {
+		if word != "" {
+			result += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return
+}
+
+type codeBuilder struct {
+	ctx   map[string]interface{}
+	cases map[string]bool
+}
+
+func (b *codeBuilder) newCodeCase(testCase testing.TestCase, baseAPI string) (result codeCase) {
+	request := testCase.Request
+	result = codeCase{
+		Name:   util.EmptyThenDefault(testCase.Name, "request"),
+		Method: strings.ToUpper(util.EmptyThenDefault(request.Method, http.MethodGet)),
+		URL:    b.parse(request.API),
+		Body:   b.parse(request.Body.String()),
+	}
+	if strings.HasPrefix(request.API, "/") {
+		result.URL = append(codeString{{Text: strings.TrimSuffix(baseAPI, "/")}}, result.URL...).merge()
+	}
+
+	for _, key := range request.Query.Keys() {
+		result.Query = append(result.Query, codeKeyValue{Key: key, Value: b.parse(fmt.Sprintf("%v", request.Query[key]))})
+	}
+	result.Header = b.parseMap(request.Header)
+	result.Cookie = b.parseMap(request.Cookie)
+	result.Form = b.parseMap(request.Form)
+	result.Assertions = newCodeAssertions(testCase.Expect)
+	return
+}
+
+func (b *codeBuilder) parseMap(data map[string]string) (pairs []codeKeyValue) {
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		pairs = append(pairs, codeKeyValue{Key: key, Value: b.parse(data[key])})
+	}
+	return
+}
+
+// render renders the text, it keeps the original one if failed
+func (b *codeBuilder) render(text string) string {
+	if result, err := render.Render("code", text, b.ctx); err == nil {
+		return result
+	}
+	return text
+}
+
+var templateActionRegex = regexp.MustCompile(`\{\{-?\s*(.*?)\s*-?\}\}`)
+
+// parse splits the text into the literals and references, the other template actions are rendered
+func (b *codeBuilder) parse(text string) (result codeString) {
+	last := 0
+	for _, index := range templateActionRegex.FindAllStringSubmatchIndex(text, -1) {
+		if index[0] > last {
+			result = append(result, codeSegment{Text: text[last:index[0]]})
+		}
+		if ref := b.parseReference(text[index[2]:index[3]]); ref != nil {
+			result = append(result, codeSegment{Ref: ref})
+		} else {
+			result = append(result, codeSegment{Text: b.render(text[index[0]:index[1]])})
+		}
+		last = index[1]
+	}
+	if last < len(text) {
+		result = append(result, codeSegment{Text: text[last:]})
+	}
+	return result.merge()
+}
+
+var (
+	// the type conversions are ignored, such as: int64 .user.id
+	referenceConversionRegex = regexp.MustCompile(`^(?:int|int64|float64|toString)\s+(.+)$`)
+	fieldReferenceExprRegex  = regexp.MustCompile(`^\.([a-zA-Z_]\w*)((?:\.\w+)*)$`)
+	indexReferenceRegex      = regexp.MustCompile(`^\(?index\s+\.([a-zA-Z_]\w*)((?:\.\w+)*)\s+(\d+|"[^"]*")\)?((?:\.\w+)*)$`)
+)
+
+// parseReference parses the expressions like .param.name, .user.id and (index .users 0).id
+func (b *codeBuilder) parseReference(expression string) (ref *codeReference) {
+	if match := referenceConversionRegex.FindStringSubmatch(expression); match != nil {
+		expression = strings.TrimSpace(match[1])
+	}
+
+	var root string
+	var path []any
+	if match := fieldReferenceExprRegex.FindStringSubmatch(expression); match != nil {
+		root, path = match[1], parsePath(match[2])
+	} else if match = indexReferenceRegex.FindStringSubmatch(expression); match != nil {
+		root, path = match[1], parsePath(match[2])
+		if unquoted, err := strconv.Unquote(match[3]); err == nil {
+			path = append(path, unquoted)
+		} else {
+			index, _ := strconv.Atoi(match[3])
+			path = append(path, index)
+		}
+		path = append(path, parsePath(match[4])...)
+	} else {
+		return
+	}
+
+	switch {
+	case root == testing.ContextKeyGlobalParam && len(path) == 1:
+		ref = &codeReference{Param: fmt.Sprintf("%v", path[0])}
+	case b.cases[root]:
+		ref = &codeReference{Case: root, Path: path}
+	}
+	return
+}
+
+// parsePath parses the path like .items.0.name, the numbers are the array indexes
+func parsePath(text string) (path []any) {
+	for _, item := range strings.Split(text, ".") {
+		if item == "" {
+			continue
+		}
+		if index, err := strconv.Atoi(item); err == nil {
+			path = append(path, index)
+		} else {
+			path = append(path, item)
+		}
+	}
+	return
+}
+
+var dataPathItemRegex = regexp.MustCompile(`\.([a-zA-Z_]\w*)|\[(\d+)\]`)
+
+func newCodeAssertions(expect testing.Response) (assertions codeAssertions) {
+	assertions.StatusCode = expect.StatusCode
+	if assertions.StatusCode == 0 {
+		assertions.StatusCode = http.StatusOK
+	}
+	for _, key := range slices.Sorted(maps.Keys(expect.Header)) {
+		assertions.Header = append(assertions.Header, codePair{Key: key, Value: expect.Header[key]})
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(expect.BodyFieldsExpect)) {
+		data, err := json.Marshal(expect.BodyFieldsExpect[key])
+		if err != nil {
+			continue
+		}
+		assertions.Fields = append(assertions.Fields, codeFieldAssertion{
+			Expression: fmt.Sprintf("%s == %s", key, data),
+			Path:       parsePath(key),
+			Operator:   "==",
+			Value:      string(data),
+		})
+	}
+
+	for _, verify := range expect.Verify {
+		assertion, ok := parseSimpleAssertion(verify)
+		if !ok {
+			assertions.Unsupported = append(assertions.Unsupported, verify)
+			continue
+		}
+
+		field := codeFieldAssertion{
+			Expression: verify,
+			Length:     assertion.Length,
+			Operator:   assertion.Operator,
+			Value:      assertion.Value,
+		}
+		for _, match := range dataPathItemRegex.FindAllStringSubmatch(assertion.Path, -1) {
+			if match[1] != "" {
+				field.Path = append(field.Path, match[1])
+			} else {
+				index, _ := strconv.Atoi(match[2])
+				field.Path = append(field.Path, index)
+			}
+		}
+		assertions.Fields = append(assertions.Fields, field)
+	}
+	return
+}
+
+// pathArguments returns the path as the arguments of a function call, the numbers are the indexes
+func pathArguments(path []any) (result string) {
+	for _, item := range path {
+		if index, ok := item.(int); ok {
+			result += fmt.Sprintf(", %d", index)
+		} else {
+			result += ", " + jsString(fmt.Sprint(item))
+		}
+	}
+	return
+}
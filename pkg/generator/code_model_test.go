@@ -0,0 +1,122 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	_ "embed"
+	"testing"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCodeSuite(t *testing.T) {
+	t.Run("suite mode", func(t *testing.T) {
+		suite := newCodeSuite(createChainedSuiteForTest(), nil)
+		assert.Equal(t, "orders", suite.Name)
+		assert.Equal(t, []codePair{{Key: "id", Value: "1"}}, suite.Params)
+		assert.Len(t, suite.Cases, 4)
+		assert.True(t, suite.HasQuery())
+		assert.True(t, suite.HasForm())
+
+		getOrder := suite.Cases[0]
+		assert.Equal(t, "get_order", getOrder.Identifier)
+		assert.Equal(t, codeString{
+			{Text: "http://localhost:8080/orders/"},
+			{Ref: &codeReference{Param: "id"}},
+		}, getOrder.URL)
+		assert.Equal(t, 200, getOrder.Assertions.StatusCode)
+		assert.Equal(t, []codeFieldAssertion{
+			{Expression: `items.0.sku == "a"`, Path: []any{"items", 0, "sku"}, Operator: "==", Value: `"a"`},
+			{Expression: "data.id == 1", Path: []any{"id"}, Operator: "==", Value: "1"},
+			{Expression: `data.name contains "book"`, Path: []any{"name"}, Operator: "contains", Value: `"book"`},
+			{Expression: "len(data.items) > 0", Path: []any{"items"}, Length: true, Operator: ">", Value: "0"},
+		}, getOrder.Assertions.Fields)
+		assert.Equal(t, []string{"all(data.items, .sku != '')"}, getOrder.Assertions.Unsupported)
+
+		getItem := suite.Cases[3]
+		assert.Equal(t, "get_item", getItem.Identifier)
+		assert.Equal(t, codeString{
+			{Text: "http://localhost:8080/items/"},
+			{Ref: &codeReference{Case: "get_order", Path: []any{"items", 0, "sku"}}},
+		}, getItem.URL)
+		assert.Equal(t, []codeKeyValue{
+			{Key: "X-Order", Value: codeString{{Ref: &codeReference{Case: "get_order", Path: []any{"id"}}}}},
+			{Key: "X-Rand", Value: codeString{{Text: "ABC"}}},
+		}, getItem.Header)
+	})
+
+	t.Run("single test case", func(t *testing.T) {
+		testSuite := createChainedSuiteForTest()
+		suite := newCodeSuite(testSuite, &testSuite.Items[1])
+		if assert.Len(t, suite.Cases, 1) {
+			assert.Equal(t, "create order", suite.Cases[0].Name)
+			assert.Equal(t, 201, suite.Cases[0].Assertions.StatusCode)
+		}
+	})
+}
+
+func TestGenerateSuite(t *testing.T) {
+	for name, expected := range map[string]string{
+		"golang":     expectedGoSuiteCode,
+		"java":       expectedJavaSuiteCode,
+		"python":     expectedPythonSuiteCode,
+		"JavaScript": expectedJavaScriptSuiteCode,
+	} {
+		t.Run(name, func(t *testing.T) {
+			result, err := GetCodeGenerator(name).Generate(createChainedSuiteForTest(), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result, result)
+		})
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	assert.Equal(t, "get_user_by_id", snakeCase("Get user-by ID"))
+	assert.Equal(t, "test_1st_request", snakeCase("1st request"))
+	assert.Equal(t, "request", snakeCase("!!"))
+	assert.Equal(t, "getUserById", camelCase("get user by id"))
+	assert.Equal(t, "GetUserById", pascalCase("get user by id"))
+}
+
+// createChainedSuiteForTest returns a suite whose last test case refers to the output of the first one
+func createChainedSuiteForTest() *atest.TestSuite {
+	suite := createOrdersSuiteForTest()
+	suite.Items[0].Name = "get_order"
+	suite.Items[0].Expect.BodyFieldsExpect = map[string]interface{}{"items.0.sku": "a"}
+	suite.Items = append(suite.Items, atest.TestCase{
+		Name: "get item",
+		Request: atest.Request{
+			API:    "/items/{{ (index .get_order.items 0).sku }}",
+			Header: map[string]string{"X-Order": "{{ int64 .get_order.id }}", "X-Rand": `{{ upper "abc" }}`},
+		},
+		Expect: atest.Response{Verify: []string{"data.sku != nil"}},
+	})
+	return suite
+}
+
+//go:embed testdata/expected_go_suite_code.txt
+var expectedGoSuiteCode string
+
+//go:embed testdata/expected_java_suite_code.txt
+var expectedJavaSuiteCode string
+
+//go:embed testdata/expected_python_suite_code.txt
+var expectedPythonSuiteCode string
+
+//go:embed testdata/expected_javascript_suite_code.txt
+var expectedJavaScriptSuiteCode string
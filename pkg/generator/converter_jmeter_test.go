@@ -30,7 +30,9 @@ func TestJmeterConvert(t *testing.T) {
 		assert.NotNil(t, jmeterConvert)
 
 		converters := GetTestSuiteConverters()
-		assert.Equal(t, 2, len(converters))
+		for _, name := range []string{"jmeter", "raw", "postman", "openapi", "k6"} {
+			assert.Contains(t, converters, name)
+		}
 	})
 
 	converter := &jmeterConverter{}
@@ -0,0 +1,201 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+type k6Converter struct {
+}
+
+func init() {
+	RegisterTestSuiteConverter("k6", &k6Converter{})
+}
+
+type k6Script struct {
+	Name     string
+	BaseURL  string
+	Requests []k6Request
+}
+
+// k6Request holds the JavaScript expressions of a request
+type k6Request struct {
+	Name   string
+	Method string
+	URL    string
+	Body   string
+	Params string
+	Checks []string
+}
+
+// Convert converts the test suite to a k6 load testing script, the expectations become the checks.
+// The virtual users, duration and base URL could be changed by the environment variables VUS, DURATION and BASE_URL
+func (c *k6Converter) Convert(original *testing.TestSuite) (result string, err error) {
+	// the test suite is rendered below, work on a copy of it
+	var testSuite *testing.TestSuite
+	if testSuite, err = copyTestSuite(original); err != nil {
+		return
+	}
+
+	ctx := make(map[string]interface{})
+	if err = testSuite.Render(ctx); err != nil {
+		return
+	}
+
+	script := k6Script{
+		Name:    testSuite.Name,
+		BaseURL: jsString(testSuite.API),
+	}
+	for _, testCase := range testSuite.Items {
+		if renderErr := testCase.Request.Render(ctx, ""); renderErr != nil {
+			genLogger.Info("Error rendering request", "error", renderErr)
+		}
+		script.Requests = append(script.Requests, toK6Request(testCase, testSuite.API))
+	}
+
+	var tpl *template.Template
+	if tpl, err = template.New("k6").Parse(k6Template); err == nil {
+		buf := new(bytes.Buffer)
+		if err = tpl.Execute(buf, script); err == nil {
+			result = buf.String()
+		}
+	}
+	return
+}
+
+func toK6Request(testCase testing.TestCase, baseURL string) (request k6Request) {
+	api := testCase.Request.API
+	if len(testCase.Request.Query) > 0 {
+		query := url.Values{}
+		for _, key := range testCase.Request.Query.Keys() {
+			query.Set(key, fmt.Sprintf("%v", testCase.Request.Query[key]))
+		}
+		api += "?" + query.Encode()
+	}
+
+	request = k6Request{
+		Name:   jsString(testCase.Name),
+		Method: jsString(util.EmptyThenDefault(testCase.Request.Method, http.MethodGet)),
+		Body:   "null",
+	}
+	switch {
+	case strings.HasPrefix(api, "/"):
+		request.URL = "`${BASE_URL}" + strings.NewReplacer("`", "\\`", "${", "\\${").Replace(api) + "`"
+	case baseURL != "" && strings.HasPrefix(api, baseURL):
+		request.URL = "`${BASE_URL}" + strings.NewReplacer("`", "\\`", "${", "\\${").Replace(strings.TrimPrefix(api, baseURL)) + "`"
+	default:
+		request.URL = jsString(api)
+	}
+
+	if len(testCase.Request.Form) > 0 {
+		request.Body = jsValue(testCase.Request.Form)
+	} else if !testCase.Request.Body.IsEmpty() {
+		request.Body = jsString(testCase.Request.Body.String())
+	}
+
+	params := map[string]any{}
+	if len(testCase.Request.Header) > 0 {
+		headers := maps.Clone(testCase.Request.Header)
+		if headers[util.ContentType] == util.MultiPartFormData {
+			// k6 sets the boundary of the multipart form
+			delete(headers, util.ContentType)
+		}
+		params["headers"] = headers
+	}
+	if len(testCase.Request.Cookie) > 0 {
+		params["cookies"] = testCase.Request.Cookie
+	}
+	request.Params = jsValue(params)
+	request.Checks = k6Checks(testCase.Expect)
+	return
+}
+
+// k6Operators are the JavaScript operators of the verify ones
+var k6Operators = map[string]string{
+	"==": "===", "!=": "!==", ">": ">", "<": "<", ">=": ">=", "<=": "<=",
+}
+
+// k6Checks returns the checks of the expectations, the unsupported verify expressions are kept as comments
+func k6Checks(expect testing.Response) (checks []string) {
+	statusCode := expect.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	checks = append(checks, fmt.Sprintf("%s: (r) => r.status === %d,", jsString(fmt.Sprintf("status is %d", statusCode)), statusCode))
+
+	for _, key := range slices.Sorted(maps.Keys(expect.Header)) {
+		checks = append(checks, fmt.Sprintf("%s: (r) => r.headers[%s] === %s,",
+			jsString("header "+key), jsString(http.CanonicalHeaderKey(key)), jsString(expect.Header[key])))
+	}
+
+	for _, verify := range expect.Verify {
+		assertion, ok := parseSimpleAssertion(verify)
+		if !ok {
+			// a line break ends the comment, the rest of the expression would break the script
+			checks = append(checks, "// unsupported: "+jsLineBreaks.Replace(verify))
+			continue
+		}
+
+		field := "r.json()" + assertion.Path
+		if assertion.Length {
+			field += ".length"
+		}
+		var expression string
+		if assertion.Operator == "contains" {
+			expression = fmt.Sprintf("%s.includes(%s)", field, assertion.Value)
+		} else {
+			expression = fmt.Sprintf("%s %s %s", field, k6Operators[assertion.Operator], assertion.Value)
+		}
+		checks = append(checks, fmt.Sprintf("%s: (r) => %s,", jsString(verify), expression))
+	}
+	return
+}
+
+// jsLineBreaks replaces the line terminators of JavaScript
+var jsLineBreaks = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ", "\u2028", " ", "\u2029", " ")
+
+// jsString returns the JavaScript string literal
+func jsString(text string) string {
+	return jsValue(text)
+}
+
+// jsValue returns the JavaScript literal of a value, JSON is a subset of JavaScript
+func jsValue(value any) string {
+	buf := new(bytes.Buffer)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "null"
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+//go:embed data/k6.tpl
+var k6Template string
@@ -0,0 +1,60 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"testing"
+
+	_ "embed"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestK6Convert(t *testing.T) {
+	converter := GetTestSuiteConverter("k6")
+	if !assert.NotNil(t, converter) {
+		return
+	}
+
+	suite := createOrdersSuiteForTest()
+	// the rendering trims the suffix of the API
+	suite.API += "/"
+	output, err := converter.Convert(suite)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedK6, output, output)
+	// the test suite of the caller is not changed
+	assert.Equal(t, "http://localhost:8080/", suite.API)
+
+	t.Run("absolute URL", func(t *testing.T) {
+		suite := createOrdersSuiteForTest()
+		suite.Items[0].Request.API = "http://foo/orders"
+		output, err := converter.Convert(suite)
+		assert.NoError(t, err)
+		assert.Contains(t, output, `http.request("GET", "http://foo/orders?expand=items", null,`)
+	})
+
+	t.Run("multi-line verify", func(t *testing.T) {
+		suite := createOrdersSuiteForTest()
+		suite.Items[0].Expect.Verify = []string{"all(data.items,\n  {.id > 0})"}
+		output, err := converter.Convert(suite)
+		assert.NoError(t, err)
+		assert.Contains(t, output, "// unsupported: all(data.items,   {.id > 0})\n")
+	})
+}
+
+//go:embed testdata/expected_k6.js
+var expectedK6 string
@@ -0,0 +1,317 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/json"
+	"maps"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"gopkg.in/yaml.v3"
+)
+
+type openAPIConverter struct {
+}
+
+func init() {
+	RegisterTestSuiteConverter("openapi", &openAPIConverter{})
+}
+
+type openAPIDocument struct {
+	OpenAPI string                                  `yaml:"openapi"`
+	Info    openAPIInfo                             `yaml:"info"`
+	Servers []openAPIServer                         `yaml:"servers,omitempty"`
+	Paths   map[string]map[string]*openAPIOperation `yaml:"paths"`
+}
+
+type openAPIInfo struct {
+	Title   string `yaml:"title"`
+	Version string `yaml:"version"`
+}
+
+type openAPIServer struct {
+	URL string `yaml:"url"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `yaml:"operationId"`
+	Summary     string                      `yaml:"summary"`
+	Parameters  []openAPIParameter          `yaml:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `yaml:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `yaml:"responses"`
+}
+
+type openAPIParameter struct {
+	Name     string         `yaml:"name"`
+	In       string         `yaml:"in"`
+	Required bool           `yaml:"required,omitempty"`
+	Schema   map[string]any `yaml:"schema"`
+	Example  any            `yaml:"example,omitempty"`
+}
+
+type openAPIRequestBody struct {
+	Content map[string]openAPIMediaType `yaml:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `yaml:"description"`
+	Content     map[string]openAPIMediaType `yaml:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema  map[string]any `yaml:"schema"`
+	Example any            `yaml:"example,omitempty"`
+}
+
+// the path segments which are the references of the suite parameters, such as /users/{{ .param.id }}
+var pathParamRegex = regexp.MustCompile(`/\{\{\s*(?:\.param\.([a-zA-Z_]\w*)|index\s+\.param\s+"([^"]+)")\s*\}\}`)
+
+// Convert infers an OpenAPI 3 document from the requests and the expected responses,
+// the test cases of the same operation are merged into one
+func (c *openAPIConverter) Convert(original *testing.TestSuite) (result string, err error) {
+	// the test suite is rendered and changed below, work on a copy of it
+	var testSuite *testing.TestSuite
+	if testSuite, err = copyTestSuite(original); err != nil {
+		return
+	}
+
+	document := openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title:   util.EmptyThenDefault(testSuite.Name, "api-testing"),
+			Version: "1.0.0",
+		},
+		Paths: map[string]map[string]*openAPIOperation{},
+	}
+
+	// keep the path parameters as the OpenAPI templates before rendering
+	pathParams := make([][]string, len(testSuite.Items))
+	for i := range testSuite.Items {
+		request := &testSuite.Items[i].Request
+		request.API = pathParamRegex.ReplaceAllStringFunc(request.API, func(reference string) string {
+			match := pathParamRegex.FindStringSubmatch(reference)
+			pathParams[i] = append(pathParams[i], match[1]+match[2])
+			return "/{" + match[1] + match[2] + "}"
+		})
+	}
+
+	ctx := make(map[string]interface{})
+	if err = testSuite.Render(ctx); err != nil {
+		return
+	}
+	if testSuite.API != "" {
+		document.Servers = []openAPIServer{{URL: testSuite.API}}
+	}
+
+	names := map[string]int{}
+	for i, testCase := range testSuite.Items {
+		if renderErr := testCase.Request.Render(ctx, ""); renderErr != nil {
+			genLogger.Info("Error rendering request", "error", renderErr)
+		}
+
+		path := openAPIPath(testCase.Request.API, testSuite.API)
+		method := strings.ToLower(util.EmptyThenDefault(testCase.Request.Method, http.MethodGet))
+		if document.Paths[path] == nil {
+			document.Paths[path] = map[string]*openAPIOperation{}
+		}
+
+		operation := document.Paths[path][method]
+		if operation == nil {
+			operation = &openAPIOperation{
+				OperationID: uniqueName(names, operationID(testCase.Name)),
+				Summary:     testCase.Name,
+				Parameters:  openAPIParameters(testCase.Request, pathParams[i], testSuite.Param),
+				RequestBody: openAPIRequestBodyOf(testCase.Request),
+				Responses:   map[string]*openAPIResponse{},
+			}
+			document.Paths[path][method] = operation
+		}
+
+		statusCode := testCase.Expect.StatusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+		if _, ok := operation.Responses[strconv.Itoa(statusCode)]; !ok {
+			operation.Responses[strconv.Itoa(statusCode)] = openAPIResponseOf(statusCode, testCase.Expect)
+		}
+	}
+
+	var data []byte
+	if data, err = yaml.Marshal(document); err == nil {
+		result = string(data)
+	}
+	return
+}
+
+// openAPIPath returns the path which is relative to the server
+func openAPIPath(api, server string) (path string) {
+	if server != "" && strings.HasPrefix(api, server) {
+		path = strings.TrimPrefix(api, server)
+	} else if requestURL, err := url.Parse(api); err == nil && requestURL.Host != "" {
+		path = requestURL.Path
+	} else {
+		path = api
+	}
+	path = strings.Split(path, "?")[0]
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	// the braces of the path parameters are escaped by the URL parser
+	return strings.NewReplacer("%7B", "{", "%7D", "}").Replace(path)
+}
+
+var nonWordRegex = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+func operationID(name string) string {
+	return util.EmptyThenDefault(strings.Trim(nonWordRegex.ReplaceAllString(name, "-"), "-"), "operation")
+}
+
+func openAPIParameters(request testing.Request, pathParams []string, suiteParams map[string]string) (parameters []openAPIParameter) {
+	for _, name := range pathParams {
+		parameters = append(parameters, openAPIParameter{
+			Name: name, In: "path", Required: true,
+			Schema:  map[string]any{"type": "string"},
+			Example: util.EmptyThenDefault(suiteParams[name], ""),
+		})
+	}
+	for _, key := range request.Query.Keys() {
+		value := request.Query[key]
+		parameters = append(parameters, openAPIParameter{Name: key, In: "query", Schema: inferSchema(value), Example: value})
+	}
+	for _, key := range slices.Sorted(maps.Keys(request.Header)) {
+		if strings.EqualFold(key, util.ContentType) || strings.EqualFold(key, util.Authorization) {
+			continue
+		}
+		parameters = append(parameters, openAPIParameter{
+			Name: key, In: "header",
+			Schema:  map[string]any{"type": "string"},
+			Example: request.Header[key],
+		})
+	}
+	for _, key := range slices.Sorted(maps.Keys(request.Cookie)) {
+		parameters = append(parameters, openAPIParameter{
+			Name: key, In: "cookie",
+			Schema:  map[string]any{"type": "string"},
+			Example: request.Cookie[key],
+		})
+	}
+	return
+}
+
+func openAPIRequestBodyOf(request testing.Request) *openAPIRequestBody {
+	contentType := request.Header[util.ContentType]
+	if len(request.Form) > 0 {
+		properties := map[string]any{}
+		example := map[string]any{}
+		for key, value := range request.Form {
+			properties[key] = map[string]any{"type": "string"}
+			example[key] = value
+		}
+		return &openAPIRequestBody{Content: map[string]openAPIMediaType{
+			util.EmptyThenDefault(contentType, util.Form): {
+				Schema:  map[string]any{"type": "object", "properties": properties},
+				Example: example,
+			},
+		}}
+	}
+
+	if request.Body.IsEmpty() {
+		return nil
+	}
+	return &openAPIRequestBody{Content: map[string]openAPIMediaType{
+		mediaTypeOf(contentType, request.Body.String()): mediaTypeOfBody(request.Body.String()),
+	}}
+}
+
+func openAPIResponseOf(statusCode int, expect testing.Response) (response *openAPIResponse) {
+	response = &openAPIResponse{Description: util.EmptyThenDefault(http.StatusText(statusCode), "response")}
+	contentType := expect.Header[util.ContentType]
+
+	switch {
+	case expect.Schema != "":
+		schema := map[string]any{}
+		if err := json.Unmarshal([]byte(expect.Schema), &schema); err == nil {
+			response.Content = map[string]openAPIMediaType{
+				util.EmptyThenDefault(contentType, util.JSON): {Schema: schema},
+			}
+		}
+	case expect.Body != "":
+		response.Content = map[string]openAPIMediaType{
+			mediaTypeOf(contentType, expect.Body): mediaTypeOfBody(expect.Body),
+		}
+	}
+	return
+}
+
+// mediaTypeOf returns the content type, it is JSON or plain text if absent
+func mediaTypeOf(contentType, body string) string {
+	if contentType != "" {
+		return strings.TrimSpace(strings.Split(contentType, ";")[0])
+	}
+	if json.Valid([]byte(body)) {
+		return util.JSON
+	}
+	return util.Plain
+}
+
+func mediaTypeOfBody(body string) openAPIMediaType {
+	var data any
+	if err := json.Unmarshal([]byte(body), &data); err == nil {
+		return openAPIMediaType{Schema: inferSchema(data), Example: data}
+	}
+	return openAPIMediaType{Schema: map[string]any{"type": "string"}, Example: body}
+}
+
+// inferSchema returns the JSON schema of a sample value
+func inferSchema(value any) (schema map[string]any) {
+	switch val := value.(type) {
+	case map[string]any:
+		properties := map[string]any{}
+		for key, item := range val {
+			properties[key] = inferSchema(item)
+		}
+		schema = map[string]any{"type": "object", "properties": properties}
+	case []any:
+		schema = map[string]any{"type": "array", "items": map[string]any{}}
+		if len(val) > 0 {
+			schema["items"] = inferSchema(val[0])
+		}
+	case float64:
+		if val == math.Trunc(val) {
+			schema = map[string]any{"type": "integer"}
+		} else {
+			schema = map[string]any{"type": "number"}
+		}
+	case int, int64:
+		schema = map[string]any{"type": "integer"}
+	case bool:
+		schema = map[string]any{"type": "boolean"}
+	case nil:
+		schema = map[string]any{"nullable": true}
+	default:
+		schema = map[string]any{"type": "string"}
+	}
+	return
+}
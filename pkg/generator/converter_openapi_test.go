@@ -0,0 +1,54 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"testing"
+
+	_ "embed"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAPIConvert(t *testing.T) {
+	converter := GetTestSuiteConverter("openapi")
+	if !assert.NotNil(t, converter) {
+		return
+	}
+
+	suite := createOrdersSuiteForTest()
+	output, err := converter.Convert(suite)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedOpenAPI, output, output)
+	// the test suite of the caller is not changed
+	assert.Equal(t, createOrdersSuiteForTest(), suite)
+
+	t.Run("openAPIPath", func(t *testing.T) {
+		assert.Equal(t, "/users", openAPIPath("http://foo/api/users?a=b", "http://foo/api"))
+		assert.Equal(t, "/users/{id}", openAPIPath("http://bar/users/%7Bid%7D", "http://foo"))
+		assert.Equal(t, "/users", openAPIPath("users", ""))
+	})
+
+	t.Run("inferSchema", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"type": "boolean"}, inferSchema(true))
+		assert.Equal(t, map[string]any{"nullable": true}, inferSchema(nil))
+		assert.Equal(t, map[string]any{"type": "array", "items": map[string]any{}}, inferSchema([]any{}))
+	})
+}
+
+//go:embed testdata/expected_openapi.yaml
+var expectedOpenAPI string
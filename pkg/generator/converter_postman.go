@@ -0,0 +1,237 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+const postmanSchema = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+type postmanConverter struct {
+}
+
+func init() {
+	RegisterTestSuiteConverter("postman", &postmanConverter{})
+}
+
+type postmanExport struct {
+	Info     postmanExportInfo   `json:"info"`
+	Item     []postmanExportItem `json:"item"`
+	Variable []PostmanVariable   `json:"variable,omitempty"`
+}
+
+type postmanExportInfo struct {
+	Name   string `json:"name"`
+	Schema string `json:"schema"`
+}
+
+type postmanExportItem struct {
+	Name    string               `json:"name"`
+	Event   []PostmanEvent       `json:"event,omitempty"`
+	Request postmanExportRequest `json:"request"`
+}
+
+type postmanExportRequest struct {
+	Method string             `json:"method"`
+	Header []Pair             `json:"header,omitempty"`
+	Body   *postmanExportBody `json:"body,omitempty"`
+	URL    postmanExportURL   `json:"url"`
+}
+
+type postmanExportURL struct {
+	Raw   string `json:"raw"`
+	Query []Pair `json:"query,omitempty"`
+}
+
+type postmanExportBody struct {
+	Mode       string      `json:"mode"`
+	Raw        string      `json:"raw,omitempty"`
+	URLEncoded []Pair      `json:"urlencoded,omitempty"`
+	FormData   []Pair      `json:"formdata,omitempty"`
+	Options    interface{} `json:"options,omitempty"`
+}
+
+// Convert converts the test suite to a Postman collection v2.1, the suite parameters
+// become the collection variables and the verify expressions become the test scripts
+func (c *postmanConverter) Convert(original *testing.TestSuite) (result string, err error) {
+	// the test suite is rendered below, work on a copy of it
+	var testSuite *testing.TestSuite
+	if testSuite, err = copyTestSuite(original); err != nil {
+		return
+	}
+
+	if err = testSuite.Render(make(map[string]interface{})); err != nil {
+		return
+	}
+
+	collection := postmanExport{
+		Info: postmanExportInfo{
+			Name:   testSuite.Name,
+			Schema: postmanSchema,
+		},
+		Item: []postmanExportItem{},
+	}
+	for _, key := range slices.Sorted(maps.Keys(testSuite.Param)) {
+		collection.Variable = append(collection.Variable, PostmanVariable{Key: key, Value: testSuite.Param[key]})
+	}
+
+	baseURL := ""
+	if testSuite.API != "" {
+		baseURL = uniqueVariable(testSuite.Param, "baseUrl")
+		collection.Variable = append(collection.Variable, PostmanVariable{Key: baseURL, Value: testSuite.API})
+	}
+
+	for _, testCase := range testSuite.Items {
+		collection.Item = append(collection.Item, toPostmanItem(testCase, baseURL))
+	}
+
+	var data []byte
+	if data, err = json.MarshalIndent(collection, "", "  "); err == nil {
+		result = string(data)
+	}
+	return
+}
+
+func uniqueVariable(params map[string]string, name string) string {
+	for i := 1; ; i++ {
+		if _, ok := params[name]; !ok {
+			return name
+		}
+		name = fmt.Sprintf("%s%d", strings.TrimRight(name, "0123456789"), i)
+	}
+}
+
+func toPostmanItem(testCase testing.TestCase, baseURL string) (item postmanExportItem) {
+	request := testCase.Request
+	api := postmanVariables(request.API)
+	if baseURL != "" && strings.HasPrefix(api, "/") {
+		api = "{{" + baseURL + "}}" + api
+	}
+
+	item = postmanExportItem{
+		Name: testCase.Name,
+		Request: postmanExportRequest{
+			Method: util.EmptyThenDefault(request.Method, "GET"),
+			Header: toPostmanPairs(request.Header),
+			URL:    postmanExportURL{Raw: api},
+		},
+	}
+
+	if len(request.Query) > 0 {
+		var query []string
+		for _, key := range request.Query.Keys() {
+			value := postmanVariables(fmt.Sprintf("%v", request.Query[key]))
+			item.Request.URL.Query = append(item.Request.URL.Query, Pair{Key: key, Value: value})
+			query = append(query, key+"="+value)
+		}
+		item.Request.URL.Raw = api + "?" + strings.Join(query, "&")
+	}
+	if len(request.Cookie) > 0 {
+		var cookies []string
+		for _, key := range slices.Sorted(maps.Keys(request.Cookie)) {
+			cookies = append(cookies, key+"="+postmanVariables(request.Cookie[key]))
+		}
+		item.Request.Header = append(item.Request.Header, Pair{Key: "Cookie", Value: strings.Join(cookies, "; ")})
+	}
+
+	switch {
+	case len(request.Form) > 0 && request.Header[util.ContentType] == util.MultiPartFormData:
+		item.Request.Body = &postmanExportBody{Mode: "formdata", FormData: toPostmanPairs(request.Form)}
+	case len(request.Form) > 0:
+		item.Request.Body = &postmanExportBody{Mode: "urlencoded", URLEncoded: toPostmanPairs(request.Form)}
+	case !request.Body.IsEmpty():
+		item.Request.Body = &postmanExportBody{Mode: "raw", Raw: postmanVariables(request.Body.String())}
+		if strings.HasPrefix(request.Header[util.ContentType], util.JSON) {
+			item.Request.Body.Options = map[string]interface{}{"raw": map[string]string{"language": "json"}}
+		}
+	}
+
+	if exec := postmanTestScript(testCase.Expect); len(exec) > 0 {
+		event := PostmanEvent{Listen: "test"}
+		event.Script.Exec = exec
+		item.Event = []PostmanEvent{event}
+	}
+	return
+}
+
+func toPostmanPairs(data map[string]string) (pairs []Pair) {
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		pairs = append(pairs, Pair{Key: key, Value: postmanVariables(data[key])})
+	}
+	return
+}
+
+var paramReferenceRegex = regexp.MustCompile(`\{\{\s*(?:\.param\.([a-zA-Z_]\w*)|index\s+\.param\s+"([^"]+)")\s*\}\}`)
+
+// postmanVariables converts the references of the suite parameters to the Postman variables
+func postmanVariables(text string) string {
+	return paramReferenceRegex.ReplaceAllStringFunc(text, func(reference string) string {
+		match := paramReferenceRegex.FindStringSubmatch(reference)
+		return "{{" + match[1] + match[2] + "}}"
+	})
+}
+
+// postmanAssertions are the chai assertions of the verify operators
+var postmanAssertions = map[string]string{
+	"==": "eql", "!=": "not.eql",
+	">": "be.above", "<": "be.below", ">=": "be.at.least", "<=": "be.at.most",
+	"contains": "include",
+}
+
+// postmanTestScript returns the test script of the expectations, the unsupported verify expressions are kept as comments
+func postmanTestScript(expect testing.Response) (exec []string) {
+	if expect.StatusCode > 0 {
+		exec = append(exec,
+			fmt.Sprintf(`pm.test("status code is %d", function () {`, expect.StatusCode),
+			fmt.Sprintf("    pm.response.to.have.status(%d);", expect.StatusCode),
+			"});")
+	}
+	if len(expect.Header) > 0 {
+		exec = append(exec, `pm.test("headers", function () {`)
+		for _, key := range slices.Sorted(maps.Keys(expect.Header)) {
+			exec = append(exec, fmt.Sprintf("    pm.response.to.have.header(%q, %q);", key, expect.Header[key]))
+		}
+		exec = append(exec, "});")
+	}
+	if len(expect.Verify) > 0 {
+		exec = append(exec, `pm.test("verify", function () {`, "    var jsonData = pm.response.json();")
+		for _, verify := range expect.Verify {
+			assertion, ok := parseSimpleAssertion(verify)
+			if !ok {
+				exec = append(exec, "    // unsupported: "+verify)
+				continue
+			}
+
+			field := "jsonData" + assertion.Path
+			if assertion.Length {
+				field += ".length"
+			}
+			exec = append(exec, fmt.Sprintf("    pm.expect(%s).to.%s(%s);", field, postmanAssertions[assertion.Operator], assertion.Value))
+		}
+		exec = append(exec, "});")
+	}
+	return
+}
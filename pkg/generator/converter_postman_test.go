@@ -0,0 +1,104 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"testing"
+
+	_ "embed"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostmanConvert(t *testing.T) {
+	converter := GetTestSuiteConverter("postman")
+	if !assert.NotNil(t, converter) {
+		return
+	}
+
+	suite := createOrdersSuiteForTest()
+	// the rendering trims the suffix of the API
+	suite.API += "/"
+	output, err := converter.Convert(suite)
+	assert.NoError(t, err)
+	assert.JSONEq(t, expectedPostman, output, output)
+	// the test suite of the caller is not changed
+	assert.Equal(t, "http://localhost:8080/", suite.API)
+
+	t.Run("round trip", func(t *testing.T) {
+		suite, err := NewPostmanImporter().Convert([]byte(output))
+		if !assert.NoError(t, err) || !assert.Len(t, suite.Items, 3) {
+			return
+		}
+		assert.Equal(t, "http://localhost:8080", suite.Param["baseUrl"])
+		assert.Equal(t, "{{ .param.baseUrl }}/orders/{{ .param.id }}", suite.Items[0].Request.API)
+		assert.Equal(t, []string{"data.id == 1", `data.name contains "book"`, "len(data.items) > 0"}, suite.Items[0].Expect.Verify)
+	})
+
+	t.Run("unique variable", func(t *testing.T) {
+		assert.Equal(t, "baseUrl1", uniqueVariable(map[string]string{"baseUrl": ""}, "baseUrl"))
+	})
+}
+
+// createOrdersSuiteForTest returns a test suite which covers the features of the converters
+func createOrdersSuiteForTest() *atest.TestSuite {
+	return &atest.TestSuite{
+		Name:  "orders",
+		API:   "http://localhost:8080",
+		Param: map[string]string{"id": "1"},
+		Items: []atest.TestCase{{
+			Name: "get order",
+			Request: atest.Request{
+				API:    "/orders/{{ .param.id }}",
+				Query:  atest.SortedKeysStringMap{"expand": "items"},
+				Header: map[string]string{"X-Request-Id": "abc", util.Authorization: "Bearer token"},
+				Cookie: map[string]string{"session": "xyz"},
+			},
+			Expect: atest.Response{
+				StatusCode: 200,
+				Header:     map[string]string{util.ContentType: util.JSON},
+				Body:       `{"id": 1, "name": "book", "price": 9.9, "items": [{"sku": "a"}]}`,
+				Verify:     []string{"data.id == 1", `data.name contains "book"`, "len(data.items) > 0", "all(data.items, .sku != '')"},
+			},
+		}, {
+			Name: "create order",
+			Request: atest.Request{
+				API:    "/orders",
+				Method: "POST",
+				Header: map[string]string{util.ContentType: util.JSON},
+				Body:   atest.NewRequestBody(`{"name": "book", "count": 2}`),
+			},
+			Expect: atest.Response{
+				StatusCode: 201,
+				Schema:     `{"type": "object", "properties": {"id": {"type": "integer"}}}`,
+			},
+		}, {
+			Name: "login",
+			Request: atest.Request{
+				API:    "/login",
+				Method: "POST",
+				Header: map[string]string{util.ContentType: util.Form},
+				Form:   map[string]string{"username": "admin"},
+			},
+		}},
+	}
+}
+
+//go:embed testdata/expected_postman.json
+var expectedPostman string
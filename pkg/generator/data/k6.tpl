@@ -0,0 +1,23 @@
+import http from 'k6/http';
+import { check, group } from 'k6';
+
+export const options = {
+    vus: __ENV.VUS ? parseInt(__ENV.VUS) : 1,
+    duration: __ENV.DURATION || '30s',
+};
+
+const BASE_URL = __ENV.BASE_URL || {{ .BaseURL }};
+
+// generated from the test suite: {{ .Name }}
+export default function () {
+{{- range .Requests }}
+    group({{ .Name }}, function () {
+        const res = http.request({{ .Method }}, {{ .URL }}, {{ .Body }}, {{ .Params }});
+        check(res, {
+        {{- range .Checks }}
+            {{ . }}
+        {{- end }}
+        });
+    });
+{{- end }}
+}
@@ -13,51 +13,171 @@ limitations under the License.
 package main
 
 import (
-	{{- if gt (len .Request.Body.String) 0}}
-	"bytes"
-	{{- end}}
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	{{- if or .HasQuery .HasForm }}
+	"net/url"
+	{{- end }}
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
 )
 
-func main() {
-	{{- if gt (len .Request.Form) 0 }}
-	data := url.Values{}
-	{{- range $key, $val := .Request.Form}}
-	data.Set("{{$key}}", "{{$val}}")
-	{{- end}}
-	body := strings.NewReader(data.Encode())
-	{{- else}}
-	body := bytes.NewBufferString(`{{.Request.Body.String | safeString}}`)
-	{{- end }}
+var params = map[string]string{
+	{{- range .Params }}
+	{{ goQuote .Key }}: {{ goQuote .Value }},
+	{{- end }}{{ if .Params }}
+{{ end }}}
 
-	req, err := http.NewRequest("{{.Request.Method}}", "{{.Request.API}}", body)
-	if err != nil {
-		panic(err)
-	}
+// outputs holds the response data of the test cases, the later ones could refer to them
+var outputs = map[string]any{}
+
+func Test{{ pascalCase .Name }}(t *testing.T) {
+	{{- range .Cases }}
+	t.Run({{ goQuote .Name }}, func(t *testing.T) {
+		api := {{ goString .URL }}
+		{{- if gt (len .Query) 0 }}
+		query := url.Values{}
+		{{- range .Query }}
+		query.Set({{ goQuote .Key }}, {{ goString .Value }})
+		{{- end }}
+		api += "?" + query.Encode()
+		{{- end }}
+
+		{{- if gt (len .Form) 0 }}
+		form := url.Values{}
+		{{- range .Form }}
+		form.Set({{ goQuote .Key }}, {{ goString .Value }})
+		{{- end }}
+		body := strings.NewReader(form.Encode())
+		{{- else if not .Body.IsEmpty }}
+		body := strings.NewReader({{ goString .Body }})
+		{{- else }}
+		var body io.Reader
+		{{- end }}
 
-	{{- range $key, $val := .Request.Header}}
-	req.Header.Set("{{$key}}", "{{$val}}")
- 	{{- end}}
+		req, err := http.NewRequest({{ goQuote .Method }}, api, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		{{- range .Header }}
+		req.Header.Set({{ goQuote .Key }}, {{ goString .Value }})
+		{{- end }}
+		{{- range .Cookie }}
+		req.AddCookie(&http.Cookie{Name: {{ goQuote .Key }}, Value: {{ goString .Value }}})
+		{{- end }}
 
-	{{- if gt (len .Request.Cookie) 0 }}
-	{{- range $key, $val := .Request.Cookie}}
-	req.AddCookie(&http.Cookie{
-		Name:  "{{$key}}",
-		Value: "{{$val}}",
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != {{ .Assertions.StatusCode }} {
+			t.Fatalf("expected status code {{ .Assertions.StatusCode }}, got %d: %s", resp.StatusCode, data)
+		}
+		{{- range .Assertions.Header }}
+		if got := resp.Header.Get({{ goQuote .Key }}); got != {{ goQuote .Value }} {
+			t.Errorf("expected header %s is %q, got %q", {{ goQuote .Key }}, {{ goQuote .Value }}, got)
+		}
+		{{- end }}
+
+		var output any
+		if err := json.Unmarshal(data, &output); err != nil {
+			output = string(data)
+		}
+		outputs[{{ goQuote .Name }}] = output
+		{{- range .Assertions.Fields }}
+		{{ goAssert . }}
+		{{- end }}
+		{{- range .Assertions.Unsupported }}
+		// unsupported: {{ . }}
+		{{- end }}
 	})
-	{{- end}}
-	{{- end}}
+	{{- end }}
+}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		panic(err)
+// lookup returns the field of the JSON data, the numbers of the path are the array indexes
+func lookup(data any, path ...any) any {
+	for _, key := range path {
+		switch val := data.(type) {
+		case map[string]any:
+			data = val[fmt.Sprint(key)]
+		case []any:
+			index, ok := key.(int)
+			if !ok || index < 0 || index >= len(val) {
+				return nil
+			}
+			data = val[index]
+		default:
+			return nil
+		}
 	}
+	return data
+}
 
-	if resp.StatusCode != http.StatusOK {
-		panic("status code is not 200")
+func length(data any) any {
+	switch val := data.(type) {
+	case []any:
+		return float64(len(val))
+	case map[string]any:
+		return float64(len(val))
+	case string:
+		return float64(len(val))
 	}
+	return nil
+}
+
+func text(data any) string {
+	switch val := data.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case map[string]any, []any:
+		raw, _ := json.Marshal(val)
+		return string(raw)
+	}
+	return fmt.Sprint(data)
+}
 
-	data, err := io.ReadAll(resp.Body)
-	println(string(data))
+// assertField compares the actual value with the expected JSON literal
+func assertField(t *testing.T, expression string, actual any, operator, expected string) {
+	t.Helper()
+	var want any
+	if err := json.Unmarshal([]byte(expected), &want); err != nil {
+		t.Fatalf("invalid expected value of %q: %v", expression, err)
+	}
+
+	var ok bool
+	switch operator {
+	case "==":
+		ok = reflect.DeepEqual(actual, want)
+	case "!=":
+		ok = !reflect.DeepEqual(actual, want)
+	case "contains":
+		if items, isArray := actual.([]any); isArray {
+			for _, item := range items {
+				ok = ok || reflect.DeepEqual(item, want)
+			}
+		} else {
+			ok = strings.Contains(text(actual), text(want))
+		}
+	default:
+		got, isNumber := actual.(float64)
+		number, _ := want.(float64)
+		ok = isNumber && map[string]bool{">": got > number, "<": got < number, ">=": got >= number, "<=": got <= number}[operator]
+	}
+	if !ok {
+		t.Errorf("failed to verify %q, got %v", expression, actual)
+	}
 }
@@ -10,60 +10,150 @@ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 See the License for the specific language governing permissions and
 limitations under the License.
 */
-import java.io.BufferedReader;
-import java.io.InputStreamReader;
-import java.net.HttpURLConnection;
-import java.net.URL;
+import static org.junit.jupiter.api.Assertions.assertEquals;
+import static org.junit.jupiter.api.Assertions.assertNotEquals;
+import static org.junit.jupiter.api.Assertions.assertTrue;
+
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.JsonNode;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import com.fasterxml.jackson.databind.node.NullNode;
+import com.fasterxml.jackson.databind.node.TextNode;
+import java.net.URI;
 import java.net.URLEncoder;
+import java.net.http.HttpClient;
+import java.net.http.HttpRequest;
+import java.net.http.HttpResponse;
+import java.nio.charset.StandardCharsets;
+import java.util.ArrayList;
+import java.util.HashMap;
+import java.util.LinkedHashMap;
+import java.util.List;
+import java.util.Map;
+import java.util.stream.Collectors;
+import org.junit.jupiter.api.DisplayName;
+import org.junit.jupiter.api.MethodOrderer;
+import org.junit.jupiter.api.Order;
+import org.junit.jupiter.api.Test;
+import org.junit.jupiter.api.TestMethodOrder;
+
+@TestMethodOrder(MethodOrderer.OrderAnnotation.class)
+public class {{ pascalCase .Name }}Test {
+    private static final HttpClient CLIENT = HttpClient.newHttpClient();
+    private static final ObjectMapper MAPPER = new ObjectMapper();
+    private static final Map<String, String> params = new HashMap<>();
+    // outputs holds the response data of the test cases, the later ones could refer to them
+    private static final Map<String, JsonNode> outputs = new HashMap<>();
+    {{- if .Params }}
 
-public class Main {
-    public static void main(String[] args) throws Exception {
-        {{- if gt (len .Request.Form) 0 }}
-        StringBuilder postData = new StringBuilder();
-        {{- range $key, $val := .Request.Form}}
-        postData.append(URLEncoder.encode("{{$key}}", "UTF-8"));
-        postData.append("=");
-        postData.append(URLEncoder.encode("{{$val}}", "UTF-8"));
-        postData.append("&");
-        {{- end}}
-        byte[] postDataBytes = postData.toString().getBytes("UTF-8");
-        {{- else}}
-        String body = """
-        {{.Request.Body.String}}""";
-        byte[] postDataBytes = body.getBytes("UTF-8");
+    static {
+        {{- range .Params }}
+        params.put({{ javaQuote .Key }}, {{ javaQuote .Value }});
         {{- end }}
+    }
+    {{- end }}
+{{- range $index, $case := .Cases }}
 
-        URL url = new URL("{{.Request.API}}");
-        HttpURLConnection conn = (HttpURLConnection) url.openConnection();
-        conn.setRequestMethod("{{.Request.Method}}");
+    @Test
+    @Order({{ $index }})
+    @DisplayName({{ javaQuote $case.Name }})
+    void test{{ pascalCase $case.Identifier }}() throws Exception {
+        String url = {{ javaString $case.URL }};
+        {{- if gt (len $case.Query) 0 }}
+        Map<String, String> query = new LinkedHashMap<>();
+        {{- range $case.Query }}
+        query.put({{ javaQuote .Key }}, {{ javaString .Value }});
+        {{- end }}
+        url += "?" + encode(query);
+        {{- end }}
+        {{- if gt (len $case.Form) 0 }}
+        Map<String, String> form = new LinkedHashMap<>();
+        {{- range $case.Form }}
+        form.put({{ javaQuote .Key }}, {{ javaString .Value }});
+        {{- end }}
+        HttpRequest.BodyPublisher body = HttpRequest.BodyPublishers.ofString(encode(form));
+        {{- else if not $case.Body.IsEmpty }}
+        HttpRequest.BodyPublisher body = HttpRequest.BodyPublishers.ofString({{ javaString $case.Body }});
+        {{- else }}
+        HttpRequest.BodyPublisher body = HttpRequest.BodyPublishers.noBody();
+        {{- end }}
 
-        {{- range $key, $val := .Request.Header}}
-        conn.setRequestProperty("{{$key}}", "{{$val}}");
-        {{- end}}
+        HttpRequest.Builder builder = HttpRequest.newBuilder(URI.create(url)).method({{ javaQuote $case.Method }}, body);
+        {{- range $case.Header }}
+        builder.header({{ javaQuote .Key }}, {{ javaString .Value }});
+        {{- end }}
+        {{- if gt (len $case.Cookie) 0 }}
+        List<String> cookies = new ArrayList<>();
+        {{- range $case.Cookie }}
+        cookies.add({{ javaQuote (printf "%s=" .Key) }} + {{ javaString .Value }});
+        {{- end }}
+        builder.header("Cookie", String.join("; ", cookies));
+        {{- end }}
 
-        {{- if gt (len .Request.Cookie) 0 }}
-        {{- range $key, $val := .Request.Cookie}}
-        conn.setRequestProperty("Cookie", "{{$key}}={{$val}}");
-        {{- end}}
-        {{- end}}
+        HttpResponse<String> resp = CLIENT.send(builder.build(), HttpResponse.BodyHandlers.ofString());
+        assertEquals({{ $case.Assertions.StatusCode }}, resp.statusCode(), resp.body());
+        {{- range $case.Assertions.Header }}
+        assertEquals({{ javaQuote .Value }}, resp.headers().firstValue({{ javaQuote .Key }}).orElse(null));
+        {{- end }}
 
-        conn.setDoOutput(true);
-        {{- if ne .Request.Method "GET" }}
-        conn.getOutputStream().write(postDataBytes);
-        {{- end}}
+        JsonNode output = parse(resp.body());
+        outputs.put({{ javaQuote $case.Name }}, output);
+        {{- range $case.Assertions.Fields }}
+        {{ javaAssert . }}
+        {{- end }}
+        {{- range $case.Assertions.Unsupported }}
+        // unsupported: {{ . }}
+        {{- end }}
+    }
+{{- end }}
 
-        BufferedReader reader = new BufferedReader(new InputStreamReader(conn.getInputStream(), "UTF-8"));
-        StringBuilder response = new StringBuilder();
-        String line;
-        while ((line = reader.readLine()) != null) {
-            response.append(line);
+    // lookup returns the field of the JSON data, the numbers of the path are the array indexes
+    private static JsonNode lookup(JsonNode data, Object... path) {
+        JsonNode node = data == null ? NullNode.getInstance() : data;
+        for (Object key : path) {
+            node = key instanceof Integer ? node.path((Integer) key) : node.path(key.toString());
         }
-        reader.close();
+        return node.isMissingNode() ? NullNode.getInstance() : node;
+    }
 
-        System.out.println(response);
+    private static JsonNode parse(String body) {
+        try {
+            return MAPPER.readTree(body);
+        } catch (JsonProcessingException e) {
+            return TextNode.valueOf(body);
+        }
+    }
 
-        if (conn.getResponseCode() != HttpURLConnection.HTTP_OK) {
-            throw new RuntimeException("status code is not 200");
+    private static JsonNode json(String value) throws JsonProcessingException {
+        return MAPPER.readTree(value);
+    }
+
+    private static String text(JsonNode node) {
+        if (node.isNull()) {
+            return "";
         }
+        return node.isValueNode() ? node.asText() : node.toString();
+    }
+
+    private static int size(JsonNode node) {
+        return node.isTextual() ? node.asText().length() : node.size();
+    }
+
+    private static boolean contains(JsonNode node, JsonNode expected) {
+        if (node.isTextual()) {
+            return node.asText().contains(expected.asText());
+        }
+        for (JsonNode item : node) {
+            if (item.equals(expected)) {
+                return true;
+            }
+        }
+        return false;
+    }
+
+    private static String encode(Map<String, String> values) {
+        return values.entrySet().stream()
+            .map(e -> URLEncoder.encode(e.getKey(), StandardCharsets.UTF_8) + "=" + URLEncoder.encode(e.getValue(), StandardCharsets.UTF_8))
+            .collect(Collectors.joining("&"));
     }
 }
@@ -11,66 +11,99 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-async function makeRequest() {
-    {{- if gt (len .Request.Header) 0 }}
-    const headers = new Headers();
-    {{- range $key, $val := .Request.Header}}
-    headers.append("{{$key}}", "{{$val}}");
-    {{- end}}
-    {{- end}}
+const params = {
+    {{- range .Params }}
+    {{ jsQuote .Key }}: {{ jsQuote .Value }},
+    {{- end }}{{ if .Params }}
+{{ end }}};
 
-    {{- if gt (len .Request.Cookie) 0 }}
-    const cookieHeader = [
-        {{- range $key, $val := .Request.Cookie}}
-        "{{$key}}={{$val}}",
-        {{- end}}
-    ].join("; ");
-    headers.append("Cookie", cookieHeader);
-    {{- end}}
+// outputs holds the response data of the test cases, the later ones could refer to them
+const outputs = {};
 
-    const requestOptions = {
-        method: "{{.Request.Method}}",
-        {{- if gt (len .Request.Header) 0 }}
-        headers: headers,
-        {{- end}}
-        redirect: "follow"
-    };
+// lookup returns the field of the JSON data, the numbers of the path are the array indexes
+function lookup(data, ...path) {
+    for (const key of path) {
+        if (data === null || data === undefined) {
+            return null;
+        }
+        data = data[key];
+    }
+    return data === undefined ? null : data;
+}
 
-    {{- if ne .Request.Body.String "" }}
-    console.log('the body is ignored because this is a GET request')
-    /*
-    {{- else if gt (len .Request.Form) 0 }}
-    console.log('the body is ignored because this is a GET request')
-    /*
-    {{- end}}
-    {{- if gt (len .Request.Form) 0 }}
-    const formData = new URLSearchParams();
-    {{- range $key, $val := .Request.Form}}
-    formData.append("{{$key}}", "{{$val}}");
-    {{- end}}
-    let body = formData;
-    requestOptions.body = body;
-    {{- else if ne .Request.Body.String ""}}
-    let body = `{{.Request.Body.String | safeString}}`;
-    requestOptions.body = body;
-    {{- end}}
-    {{- if ne .Request.Body.String "" }}
-    */
-    {{- else if gt (len .Request.Form) 0 }}
-    */
-    {{- end}}
+function length(value) {
+    if (value !== null && typeof value === "object" && !Array.isArray(value)) {
+        return Object.keys(value).length;
+    }
+    return value.length;
+}
 
-    try {
-        const response = await fetch("{{.Request.API}}", requestOptions);
-        if (response.ok) { // Check if HTTP status code is 200/OK
-            const text = await response.text();
-            console.log(text);
-        } else {
-            throw new Error('Network response was not ok. Status code: ' + response.status);
-        }
-    } catch (error) {
-        console.error('Fetch error:', error);
+function text(value) {
+    if (value === null || value === undefined) {
+        return "";
     }
+    return typeof value === "object" ? JSON.stringify(value) : String(value);
 }
 
-makeRequest();
+describe({{ jsQuote .Name }}, () => {
+    {{- range $index, $case := .Cases }}
+    {{- if $index }}
+{{ end }}
+    test({{ jsQuote $case.Name }}, async () => {
+        let url = {{ jsString $case.URL }};
+        {{- if gt (len $case.Query) 0 }}
+        const query = new URLSearchParams();
+        {{- range $case.Query }}
+        query.append({{ jsQuote .Key }}, {{ jsString .Value }});
+        {{- end }}
+        url += "?" + query;
+        {{- end }}
+        const headers = new Headers();
+        {{- range $case.Header }}
+        headers.append({{ jsQuote .Key }}, {{ jsString .Value }});
+        {{- end }}
+        {{- if gt (len $case.Cookie) 0 }}
+        headers.append("Cookie", [
+            {{- range $case.Cookie }}
+            {{ jsQuote (printf "%s=" .Key) }} + {{ jsString .Value }},
+            {{- end }}
+        ].join("; "));
+        {{- end }}
+        {{- if gt (len $case.Form) 0 }}
+        const body = new URLSearchParams();
+        {{- range $case.Form }}
+        body.append({{ jsQuote .Key }}, {{ jsString .Value }});
+        {{- end }}
+        {{- else if not $case.Body.IsEmpty }}
+        const body = {{ jsString $case.Body }};
+        {{- end }}
+
+        const resp = await fetch(url, {
+            method: {{ jsQuote $case.Method }},
+            headers: headers,
+            {{- if or (gt (len $case.Form) 0) (not $case.Body.IsEmpty) }}
+            body: body,
+            {{- end }}
+        });
+        const data = await resp.text();
+        expect(resp.status).toBe({{ $case.Assertions.StatusCode }});
+        {{- range $case.Assertions.Header }}
+        expect(resp.headers.get({{ jsQuote .Key }})).toBe({{ jsQuote .Value }});
+        {{- end }}
+
+        let output = data;
+        try {
+            output = JSON.parse(data);
+        } catch (e) {
+            // keep the text when the response is not JSON
+        }
+        outputs[{{ jsQuote $case.Name }}] = output;
+        {{- range $case.Assertions.Fields }}
+        {{ jsAssert . }}
+        {{- end }}
+        {{- range $case.Assertions.Unsupported }}
+        // unsupported: {{ . }}
+        {{- end }}
+    });
+    {{- end }}
+});
@@ -1,60 +1,102 @@
-'''
-Copyright 2024-2025 API Testing Authors.
+"""
+Copyright 2024 API Testing Authors.
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
 You may obtain a copy of the License at
-	http://www.apache.org/licenses/LICENSE-2.0
+    http://www.apache.org/licenses/LICENSE-2.0
 Unless required by applicable law or agreed to in writing, software
 distributed under the License is distributed on an "AS IS" BASIS,
 WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 See the License for the specific language governing permissions and
 limitations under the License.
-'''
-import io
+"""
+import json
+
 import requests
-from urllib.parse import urlencode
-
-def main():
-    {{- if gt (len .Request.Form) 0 }}
-    data = {}
-    {{- range $key, $val := .Request.Form}}
-    data["{{$key}}"] = "{{$val}}"
-    encoded_data = urlencode(data)
-    {{- end}}
-    body = io.BytesIO(encoded_data.encode("utf-8"))
-    {{- else}}
-    body = io.BytesIO(b"""{{.Request.Body.String}}""")
-    {{- end}}
-    {{- if gt (len .Request.Header) 0 }}
-    {{- range $key, $val := .Request.Header}}
-    headers = {"{{$key}}": "{{$val}}"}
-    {{- end}}
-    {{- else }}
-    headers = {}
-    {{- end }}
-    {{- if gt (len .Request.Cookie) 0 }}
-    {{- range $key, $val := .Request.Cookie}}
-    cookies = {"{{$key}}": "{{$val}}"}
-    {{- end}}
-    {{- end}}
-    {{- if gt (len .Request.Cookie) 0 }}
-    try:
-        req = requests.Request("{{.Request.Method}}", "{{.Request.API}}", headers=headers, cookies=cookies, data=body)
-    except requests.RequestException as e:
-        raise e
-    {{- else}}
-    try:
-        req = requests.Request("{{.Request.Method}}", "{{.Request.API}}", headers=headers, data=body)
-    except requests.RequestException as e:
-        raise e
-    {{- end}}
 
-    resp = requests.Session().send(req.prepare(), verify=False)
-    if resp.status_code != 200:
-        raise Exception("status code is not 200")
+params = {
+    {{- range .Params }}
+    {{ pyQuote .Key }}: {{ pyQuote .Value }},
+    {{- end }}{{ if .Params }}
+{{ end }}}
+
+# outputs holds the response data of the test cases, the later ones could refer to them
+outputs = {}
+
+
+def lookup(data, *path):
+    """returns the field of the JSON data, the numbers of the path are the list indexes"""
+    for key in path:
+        try:
+            data = data[key]
+        except (KeyError, IndexError, TypeError):
+            return None
+    return data
 
-    data = resp.content
-    print(data.decode("utf-8"))
 
-if __name__ == "__main__":
-    main()
+def text(value):
+    if value is None:
+        return ""
+    if isinstance(value, bool):
+        return "true" if value else "false"
+    if isinstance(value, float) and value.is_integer():
+        return str(int(value))
+    if isinstance(value, (dict, list)):
+        return json.dumps(value)
+    return str(value)
+{{- range .Cases }}
+
+
+def test_{{ .Identifier }}():
+    {{ pyQuote .Name }}
+    resp = requests.request(
+        {{ pyQuote .Method }},
+        {{ pyString .URL }},
+        {{- if gt (len .Query) 0 }}
+        params={
+            {{- range .Query }}
+            {{ pyQuote .Key }}: {{ pyString .Value }},
+            {{- end }}
+        },
+        {{- end }}
+        {{- if gt (len .Header) 0 }}
+        headers={
+            {{- range .Header }}
+            {{ pyQuote .Key }}: {{ pyString .Value }},
+            {{- end }}
+        },
+        {{- end }}
+        {{- if gt (len .Cookie) 0 }}
+        cookies={
+            {{- range .Cookie }}
+            {{ pyQuote .Key }}: {{ pyString .Value }},
+            {{- end }}
+        },
+        {{- end }}
+        {{- if gt (len .Form) 0 }}
+        data={
+            {{- range .Form }}
+            {{ pyQuote .Key }}: {{ pyString .Value }},
+            {{- end }}
+        },
+        {{- else if not .Body.IsEmpty }}
+        data={{ pyString .Body }},
+        {{- end }}
+    )
+    assert resp.status_code == {{ .Assertions.StatusCode }}, resp.text
+    {{- range .Assertions.Header }}
+    assert resp.headers.get({{ pyQuote .Key }}) == {{ pyQuote .Value }}
+    {{- end }}
+
+    try:
+        output = resp.json()
+    except ValueError:
+        output = resp.text
+    outputs[{{ pyQuote .Name }}] = output
+    {{- range .Assertions.Fields }}
+    {{ pyAssert . }}
+    {{- end }}
+    {{- range .Assertions.Unsupported }}
+    # unsupported: {{ . }}
+    {{- end }}
+{{- end }}
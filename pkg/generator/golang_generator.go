@@ -1,5 +1,5 @@
 /*
-Copyright 2023 API Testing Authors.
+Copyright 2024-2025 API Testing Authors.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -13,11 +13,12 @@ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 See the License for the specific language governing permissions and
 limitations under the License.
 */
+
 package generator
 
 import (
-	"bytes"
-	"net/http"
+	"fmt"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -33,29 +34,64 @@ func NewGolangGenerator() CodeGenerator {
 	return &golangGenerator{}
 }
 
+// Generate generates a Go test which is based on the standard testing and net/http
 func (g *golangGenerator) Generate(testSuite *testing.TestSuite, testcase *testing.TestCase) (result string, err error) {
-	if testcase.Request.Method == "" {
-		testcase.Request.Method = http.MethodGet
+	return generateCode(testSuite, testcase, "golang template", golangTemplate, template.FuncMap{
+		"goQuote":  goQuote,
+		"goString": goString,
+		"goAssert": goAssert,
+	})
+}
+
+func init() {
+	RegisterCodeGenerator("golang", NewGolangGenerator())
+}
+
+// goQuote returns the Go string literal, the raw string is preferred if there are double quotes
+func goQuote(text string) string {
+	if strings.Contains(text, `"`) && !strings.ContainsAny(text, "`\r") {
+		return "`" + text + "`"
 	}
-	var tpl *template.Template
-	if tpl, err = template.New("golang template").Funcs(template.FuncMap{"safeString": safeString}).Parse(golangTemplate); err == nil {
-		buf := new(bytes.Buffer)
-		if err = tpl.Execute(buf, testcase); err == nil {
-			result = buf.String()
-			// Check if "bytes." is used in the generated code
-			if strings.Contains(result, "bytes.") {
-				// Only insert "bytes" import if it's not already included
-				if !strings.Contains(result, "\"bytes\"") {
-					result = strings.Replace(result, "import (", "import (\n\t\"bytes\"", 1)
-				}
+	return strconv.Quote(text)
+}
+
+func goString(text codeString) string {
+	var parts []string
+	for _, segment := range text {
+		switch {
+		case segment.Ref == nil:
+			if segment.Text != "" {
+				parts = append(parts, goQuote(segment.Text))
 			}
+		case segment.Ref.Case == "":
+			parts = append(parts, fmt.Sprintf("params[%q]", segment.Ref.Param))
+		default:
+			parts = append(parts, fmt.Sprintf("text(lookup(outputs[%q]%s))", segment.Ref.Case, goPath(segment.Ref.Path)))
+		}
+	}
+	if len(parts) == 0 {
+		return `""`
+	}
+	return strings.Join(parts, " + ")
+}
+
+func goPath(path []any) (result string) {
+	for _, item := range path {
+		if index, ok := item.(int); ok {
+			result += fmt.Sprintf(", %d", index)
+		} else {
+			result += fmt.Sprintf(", %q", item)
 		}
 	}
 	return
 }
 
-func init() {
-	RegisterCodeGenerator("golang", NewGolangGenerator())
+func goAssert(field codeFieldAssertion) string {
+	actual := fmt.Sprintf("lookup(output%s)", goPath(field.Path))
+	if field.Length {
+		actual = fmt.Sprintf("length(%s)", actual)
+	}
+	return fmt.Sprintf("assertField(t, %s, %s, %q, %s)", goQuote(field.Expression), actual, field.Operator, goQuote(field.Value))
 }
 
 //go:embed data/main.go.tpl
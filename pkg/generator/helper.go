@@ -16,9 +16,172 @@ limitations under the License.
 package generator
 
 import (
+	"encoding/base64"
+	"fmt"
 	"html/template"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"gopkg.in/yaml.v3"
 )
 
 func safeString(str string) template.HTML {
 	return template.HTML(str)
 }
+
+var identifierRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// paramReference returns the template which refers to a suite parameter
+func paramReference(name string) string {
+	return fmt.Sprintf("{{ %s }}", paramValue(name))
+}
+
+func paramValue(name string) string {
+	if identifierRegex.MatchString(name) {
+		return ".param." + name
+	}
+	return fmt.Sprintf("index .param %q", name)
+}
+
+// variableRegex matches the variables of the other tools, such as {{baseUrl}} and {{ _.baseUrl }}
+var variableRegex = regexp.MustCompile(`\{\{\s*(?:_\.)?([a-zA-Z_][\w.-]*)\s*\}\}`)
+
+// convertVariables converts the variables of the other tools to the suite parameters
+func convertVariables(text string) string {
+	return variableRegex.ReplaceAllStringFunc(text, func(variable string) string {
+		return paramReference(variableRegex.FindStringSubmatch(variable)[1])
+	})
+}
+
+// splitQuery moves the query string of a URL into a map
+func splitQuery(rawURL string) (api string, query testing.SortedKeysStringMap) {
+	api = rawURL
+	index := strings.Index(rawURL, "?")
+	if index < 0 {
+		return
+	}
+
+	values, err := url.ParseQuery(rawURL[index+1:])
+	if err != nil {
+		return
+	}
+	api = rawURL[:index]
+	query = testing.SortedKeysStringMap{}
+	for key := range values {
+		query[key] = values.Get(key)
+	}
+	return
+}
+
+// uniqueName returns the name with a number suffix if it exists
+func uniqueName(names map[string]int, name string) string {
+	names[name]++
+	if count := names[name]; count > 1 {
+		name = fmt.Sprintf("%s-%d", name, count)
+	}
+	return name
+}
+
+// basicAuthorization returns the value of the basic authorization header, the variables are supported
+func basicAuthorization(username, password string) string {
+	if !variableRegex.MatchString(username) && !variableRegex.MatchString(password) {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	}
+	return fmt.Sprintf(`Basic {{ b64enc (printf "%%s:%%s" %s %s) }}`, templateExpression(username), templateExpression(password))
+}
+
+// templateExpression returns the expression of a variable, or the quoted text
+func templateExpression(text string) string {
+	if match := variableRegex.FindStringSubmatch(text); match != nil && match[0] == strings.TrimSpace(text) {
+		return paramValue(match[1])
+	}
+	return fmt.Sprintf("%q", text)
+}
+
+// commonOrigin returns the scheme and host if all the URLs have the same one
+func commonOrigin(urls []string) (origin string) {
+	for i, rawURL := range urls {
+		requestURL, err := url.Parse(rawURL)
+		if err != nil || requestURL.Host == "" {
+			return ""
+		}
+
+		current := requestURL.Scheme + "://" + requestURL.Host
+		if i == 0 {
+			origin = current
+		} else if origin != current {
+			return ""
+		}
+	}
+	return
+}
+
+// trimOrigin returns the path of a URL if it has the origin
+func trimOrigin(rawURL, origin string) string {
+	if origin == "" || !strings.HasPrefix(rawURL, origin) {
+		return rawURL
+	}
+	return util.EmptyThenDefault(strings.TrimPrefix(rawURL, origin), "/")
+}
+
+// simpleAssertion is a verify expression like "data.items[0].name == 'foo'" or "len(data.items) > 0"
+type simpleAssertion struct {
+	// Path is the field path of the response data, such as ".items[0].name"
+	Path     string
+	Length   bool
+	Operator string
+	// Value is a JSON compatible literal
+	Value string
+}
+
+var (
+	simpleAssertionRegex = regexp.MustCompile(`^(len\()?data((?:\.[a-zA-Z_]\w*|\[\d+\])*)(\))?\s*(==|!=|>=|<=|>|<|contains)\s*(.+)$`)
+	fieldReferenceRegex  = regexp.MustCompile(`^(?:\.[a-zA-Z_]\w*|\[\d+\])*$`)
+)
+
+// parseSimpleAssertion parses the verify expression which compares a response field with a literal,
+// it returns false if the expression is too complex to be translated
+func parseSimpleAssertion(expression string) (assertion simpleAssertion, ok bool) {
+	match := simpleAssertionRegex.FindStringSubmatch(strings.TrimSpace(expression))
+	if match == nil || (match[1] == "") != (match[3] == "") || !fieldReferenceRegex.MatchString(match[2]) {
+		return
+	}
+
+	value := strings.TrimSpace(match[5])
+	switch {
+	case value == "nil":
+		value = "null"
+	case value == "true" || value == "false":
+	case strings.HasPrefix(value, `"`):
+		if _, err := strconv.Unquote(value); err != nil {
+			return
+		}
+	default:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return
+		}
+	}
+
+	assertion = simpleAssertion{
+		Path:     match[2],
+		Length:   match[1] != "",
+		Operator: match[4],
+		Value:    value,
+	}
+	ok = true
+	return
+}
+
+// copyTestSuite returns a deep copy of the test suite, the maps and pointers are not shared
+func copyTestSuite(testSuite *testing.TestSuite) (result *testing.TestSuite, err error) {
+	var data []byte
+	if data, err = yaml.Marshal(testSuite); err == nil {
+		result = &testing.TestSuite{}
+		err = yaml.Unmarshal(data, result)
+	}
+	return
+}
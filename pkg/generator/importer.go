@@ -18,8 +18,12 @@ package generator
 
 import (
 	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
 )
 
 type PostmanCollection struct {
@@ -27,8 +31,11 @@ type PostmanCollection struct {
 }
 
 type Postman struct {
-	Info PostmanInfo   `json:"info"`
-	Item []PostmanItem `json:"item"`
+	Info     PostmanInfo       `json:"info"`
+	Item     []PostmanItem     `json:"item"`
+	Variable []PostmanVariable `json:"variable"`
+	Auth     *PostmanAuth      `json:"auth"`
+	Event    []PostmanEvent    `json:"event"`
 }
 
 type PostmanInfo struct {
@@ -36,16 +43,20 @@ type PostmanInfo struct {
 }
 
 type PostmanItem struct {
-	Name    string         `json:"name"`
-	Request PostmanRequest `json:"request"`
-	Item    []PostmanItem  `json:"item"`
+	Name     string            `json:"name"`
+	Request  PostmanRequest    `json:"request"`
+	Item     []PostmanItem     `json:"item"`
+	Variable []PostmanVariable `json:"variable"`
+	Auth     *PostmanAuth      `json:"auth"`
+	Event    []PostmanEvent    `json:"event"`
 }
 
 type PostmanRequest struct {
-	Method string      `json:"method"`
-	URL    PostmanURL  `json:"url"`
-	Header Paris       `json:"header"`
-	Body   PostmanBody `json:"body"`
+	Method string       `json:"method"`
+	URL    PostmanURL   `json:"url"`
+	Header Paris        `json:"header"`
+	Body   PostmanBody  `json:"body"`
+	Auth   *PostmanAuth `json:"auth"`
 }
 
 type PostmanBody struct {
@@ -54,6 +65,11 @@ type PostmanBody struct {
 	FormData   []TypeField `json:"formdata"`
 	URLEncoded []TypeField `json:"urlencoded"`
 	Disabled   bool        `json:"disabled"`
+	Options    struct {
+		Raw struct {
+			Language string `json:"language"`
+		} `json:"raw"`
+	} `json:"options"`
 }
 
 type TypeField struct {
@@ -62,7 +78,7 @@ type TypeField struct {
 	Disabled    bool   `json:"disabled"`
 	Type        string `json:"type"`
 	Description string `json:"description"`
-	Src         string `json:"src"`
+	Src         any    `json:"src"`
 }
 
 type PostmanURL struct {
@@ -71,10 +87,64 @@ type PostmanURL struct {
 	Query Paris    `json:"query"`
 }
 
+// PostmanVariable is a variable of the collection or folder, the value could be any type
+type PostmanVariable struct {
+	Key      string `json:"key"`
+	Value    any    `json:"value"`
+	Disabled bool   `json:"disabled,omitempty"`
+}
+
+// String returns the value as text, it is empty if the value is absent
+func (v PostmanVariable) String() string {
+	if v.Value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", v.Value)
+}
+
+// PostmanAuth is the authorization of the collection, folder or request
+type PostmanAuth struct {
+	Type   string                `json:"type"`
+	Bearer PostmanAuthAttributes `json:"bearer"`
+	Basic  PostmanAuthAttributes `json:"basic"`
+	APIKey PostmanAuthAttributes `json:"apikey"`
+}
+
+// PostmanAuthAttributes is a list of key-value pairs in the collection v2.1, or an object in v2.0
+type PostmanAuthAttributes map[string]string
+
+func (a *PostmanAuthAttributes) UnmarshalJSON(data []byte) (err error) {
+	*a = PostmanAuthAttributes{}
+	var variables []PostmanVariable
+	if err = json.Unmarshal(data, &variables); err == nil {
+		for _, variable := range variables {
+			(*a)[variable.Key] = variable.String()
+		}
+		return
+	}
+
+	object := map[string]any{}
+	if err = json.Unmarshal(data, &object); err == nil {
+		for key, val := range object {
+			(*a)[key] = fmt.Sprintf("%v", val)
+		}
+	}
+	return
+}
+
+// PostmanEvent is a script which runs before or after the request
+type PostmanEvent struct {
+	Listen string `json:"listen"`
+	Script struct {
+		Exec []string `json:"exec"`
+	} `json:"script"`
+}
+
 type Paris []Pair
 type Pair struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Disabled bool   `json:"disabled,omitempty"`
 }
 
 func (p Paris) ToMap() (result map[string]string) {
@@ -84,7 +154,9 @@ func (p Paris) ToMap() (result map[string]string) {
 	}
 	result = make(map[string]string, count)
 	for _, item := range p {
-		result[item.Key] = item.Value
+		if !item.Disabled {
+			result[item.Key] = item.Value
+		}
 	}
 	return
 }
@@ -99,9 +171,35 @@ type Importer interface {
 	ConvertFromURL(dataURL string) (*testing.TestSuite, error)
 }
 
+var importers = map[string]Importer{}
+
+// RegisterTestSuiteImporter registers an importer of the source kind
+func RegisterTestSuiteImporter(kind string, importer Importer) {
+	importers[kind] = importer
+}
+
+// GetTestSuiteImporter returns the importer of the source kind, it is nil if not found
+func GetTestSuiteImporter(kind string) Importer {
+	return importers[kind]
+}
+
+// GetTestSuiteImporters returns all the importers
+func GetTestSuiteImporters() (result map[string]Importer) {
+	// returns an immutable map
+	result = make(map[string]Importer, len(importers))
+	for k, v := range importers {
+		result[k] = v
+	}
+	return
+}
+
 type postmanImporter struct {
 }
 
+func init() {
+	RegisterTestSuiteImporter("postman", NewPostmanImporter())
+}
+
 // NewPostmanImporter returns a new postman importer
 func NewPostmanImporter() Importer {
 	return &postmanImporter{}
@@ -121,9 +219,15 @@ func (p *postmanImporter) Convert(data []byte) (suite *testing.TestSuite, err er
 		postman = &postmanCollection.Collection
 	}
 
-	suite = &testing.TestSuite{}
-	suite.Name = postman.Info.Name
-	err = p.convertItems(postman.Item, "", suite)
+	suite = &testing.TestSuite{
+		Name:  postman.Info.Name,
+		Param: map[string]string{},
+	}
+	setPostmanVariables(suite, postman.Variable)
+	err = p.convertItems(postman.Item, "", postman.Auth, postmanTestScripts(postman.Event), suite)
+	if len(suite.Param) == 0 {
+		suite.Param = nil
+	}
 	return
 }
 
@@ -135,24 +239,151 @@ func (p *postmanImporter) ConvertFromURL(dataURLStr string) (*testing.TestSuite,
 	return convertFromURL(dataURLStr, p)
 }
 
-func (p *postmanImporter) convertItems(items []PostmanItem, prefix string, suite *testing.TestSuite) (err error) {
+// convertItems converts the requests recursively, the auth and test scripts are inherited from the parents
+func (p *postmanImporter) convertItems(items []PostmanItem, prefix string, auth *PostmanAuth,
+	scripts []string, suite *testing.TestSuite) (err error) {
 	for _, item := range items {
 		itemName := prefix + item.Name
+		itemAuth := auth
+		if item.Auth != nil {
+			itemAuth = item.Auth
+		}
+		itemScripts := append(slices.Clone(scripts), postmanTestScripts(item.Event)...)
+
 		if len(item.Item) == 0 {
-			suite.Items = append(suite.Items, testing.TestCase{
-				Name: itemName,
-				Request: testing.Request{
-					Method: item.Request.Method,
-					API:    item.Request.URL.Raw,
-					Body:   testing.NewRequestBody(item.Request.Body.Raw),
-					Header: item.Request.Header.ToMap(),
-				},
-			})
+			if item.Request.Auth != nil {
+				itemAuth = item.Request.Auth
+			}
+
+			testCase := testing.TestCase{
+				Name:    itemName,
+				Request: item.Request.toRequest(itemAuth),
+			}
+			testCase.Expect = convertPostmanScripts(itemScripts)
+			suite.Items = append(suite.Items, testCase)
 		} else {
-			if err = p.convertItems(item.Item, itemName+" ", suite); err != nil {
+			setPostmanVariables(suite, item.Variable)
+			if err = p.convertItems(item.Item, itemName+" ", itemAuth, itemScripts, suite); err != nil {
 				return
 			}
 		}
 	}
 	return
 }
+
+// setPostmanVariables sets the variables as the suite parameters, the existing ones are kept
+func setPostmanVariables(suite *testing.TestSuite, variables []PostmanVariable) {
+	for _, variable := range variables {
+		if _, ok := suite.Param[variable.Key]; ok || variable.Disabled || variable.Key == "" {
+			continue
+		}
+		suite.Param[variable.Key] = variable.String()
+	}
+}
+
+func postmanTestScripts(events []PostmanEvent) (scripts []string) {
+	for _, event := range events {
+		if event.Listen == "test" {
+			scripts = append(scripts, event.Script.Exec...)
+		}
+	}
+	return
+}
+
+func (r PostmanRequest) toRequest(auth *PostmanAuth) (request testing.Request) {
+	request = testing.Request{
+		Method: r.Method,
+		API:    convertVariables(r.URL.Raw),
+		Header: map[string]string{},
+	}
+
+	var query testing.SortedKeysStringMap
+	if len(r.URL.Query) > 0 {
+		request.API = strings.Split(request.API, "?")[0]
+		query = testing.SortedKeysStringMap{}
+		for _, item := range r.URL.Query {
+			if !item.Disabled {
+				query[item.Key] = convertVariables(item.Value)
+			}
+		}
+	} else {
+		request.API, query = splitQuery(request.API)
+	}
+	if len(query) > 0 {
+		request.Query = query
+	}
+
+	for key, val := range r.Header.ToMap() {
+		request.Header[key] = convertVariables(val)
+	}
+
+	switch r.Body.Mode {
+	case "urlencoded":
+		request.Form = postmanForm(r.Body.URLEncoded)
+		request.Header[util.ContentType] = util.Form
+	case "formdata":
+		request.Form = postmanForm(r.Body.FormData)
+		request.Header[util.ContentType] = util.MultiPartFormData
+	default:
+		request.Body = testing.NewRequestBody(convertVariables(r.Body.Raw))
+		if _, ok := request.Header[util.ContentType]; !ok && r.Body.Options.Raw.Language == "json" {
+			request.Header[util.ContentType] = util.JSON
+		}
+	}
+
+	if auth != nil {
+		auth.apply(&request)
+	}
+	if len(request.Header) == 0 {
+		request.Header = nil
+	}
+	return
+}
+
+func postmanForm(fields []TypeField) (form map[string]string) {
+	form = map[string]string{}
+	for _, field := range fields {
+		if field.Disabled {
+			continue
+		}
+		if field.Type == "file" {
+			form[field.Key] = fileSource(field.Src)
+		} else {
+			form[field.Key] = convertVariables(field.Value)
+		}
+	}
+	return
+}
+
+// fileSource returns the file path, it could be a string or an array in the Postman collections
+func fileSource(src any) string {
+	switch val := src.(type) {
+	case string:
+		return val
+	case []any:
+		if len(val) > 0 {
+			return fmt.Sprintf("%v", val[0])
+		}
+	}
+	return ""
+}
+
+// apply sets the authorization into the request headers or query
+func (a *PostmanAuth) apply(request *testing.Request) {
+	switch a.Type {
+	case "bearer":
+		request.Header[util.Authorization] = "Bearer " + convertVariables(a.Bearer["token"])
+	case "basic":
+		request.Header[util.Authorization] = basicAuthorization(a.Basic["username"], a.Basic["password"])
+	case "apikey":
+		key, value := a.APIKey["key"], convertVariables(a.APIKey["value"])
+		if a.APIKey["in"] == "query" {
+			if request.Query == nil {
+				request.Query = testing.SortedKeysStringMap{}
+			}
+			request.Query[key] = value
+		} else {
+			request.Header[key] = value
+		}
+	}
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// BrunoCollection is the JSON export of a Bruno collection
+type BrunoCollection struct {
+	Name         string             `json:"name"`
+	Items        []BrunoItem        `json:"items"`
+	Environments []BrunoEnvironment `json:"environments"`
+}
+
+type BrunoItem struct {
+	Type    string       `json:"type"`
+	Name    string       `json:"name"`
+	Seq     int          `json:"seq"`
+	Request BrunoRequest `json:"request"`
+	Items   []BrunoItem  `json:"items"`
+}
+
+type BrunoRequest struct {
+	URL     string      `json:"url"`
+	Method  string      `json:"method"`
+	Headers []BrunoPair `json:"headers"`
+	Params  []BrunoPair `json:"params"`
+	Body    BrunoBody   `json:"body"`
+	Auth    BrunoAuth   `json:"auth"`
+}
+
+type BrunoBody struct {
+	Mode           string      `json:"mode"`
+	JSON           string      `json:"json"`
+	Text           string      `json:"text"`
+	XML            string      `json:"xml"`
+	FormURLEncoded []BrunoPair `json:"formUrlEncoded"`
+	MultipartForm  []BrunoPair `json:"multipartForm"`
+}
+
+type BrunoAuth struct {
+	Mode   string `json:"mode"`
+	Bearer struct {
+		Token string `json:"token"`
+	} `json:"bearer"`
+	Basic struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	} `json:"basic"`
+}
+
+type BrunoPair struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+}
+
+type BrunoEnvironment struct {
+	Name      string      `json:"name"`
+	Variables []BrunoPair `json:"variables"`
+}
+
+type brunoImporter struct {
+}
+
+// NewBrunoImporter returns an importer of the Bruno collections, it supports
+// the collection directory, a single .bru file and the JSON export
+func NewBrunoImporter() Importer {
+	return &brunoImporter{}
+}
+
+func init() {
+	RegisterTestSuiteImporter("bruno", NewBrunoImporter())
+}
+
+// Convert converts the JSON export or a .bru file to test suite
+func (b *brunoImporter) Convert(data []byte) (suite *testing.TestSuite, err error) {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		collection := &BrunoCollection{}
+		if err = json.Unmarshal(data, collection); err == nil {
+			suite = collection.toTestSuite()
+		}
+		return
+	}
+
+	var item BrunoItem
+	if item, err = parseBruFile(string(data)); err == nil {
+		suite = (&BrunoCollection{Name: "bruno", Items: []BrunoItem{item}}).toTestSuite()
+	}
+	return
+}
+
+// ConvertFromFile converts a collection directory, a .bru file or the JSON export
+func (b *brunoImporter) ConvertFromFile(dataFile string) (suite *testing.TestSuite, err error) {
+	var info os.FileInfo
+	if info, err = os.Stat(dataFile); err != nil {
+		return
+	}
+	if !info.IsDir() {
+		return convertFromFile(dataFile, b)
+	}
+
+	collection := &BrunoCollection{Name: filepath.Base(dataFile)}
+	if data, readErr := os.ReadFile(filepath.Join(dataFile, "bruno.json")); readErr == nil {
+		_ = json.Unmarshal(data, collection)
+	}
+	if collection.Items, err = readBruDir(dataFile); err != nil {
+		return
+	}
+
+	envFiles, _ := filepath.Glob(filepath.Join(dataFile, "environments", "*.bru"))
+	for _, envFile := range envFiles {
+		var data []byte
+		if data, err = os.ReadFile(envFile); err != nil {
+			return
+		}
+		env := BrunoEnvironment{Name: strings.TrimSuffix(filepath.Base(envFile), ".bru")}
+		for _, block := range parseBruBlocks(string(data)) {
+			if block.name == "vars" {
+				env.Variables = block.pairs
+			}
+		}
+		collection.Environments = append(collection.Environments, env)
+	}
+	suite = collection.toTestSuite()
+	return
+}
+
+func (b *brunoImporter) ConvertFromURL(dataURL string) (*testing.TestSuite, error) {
+	return convertFromURL(dataURL, b)
+}
+
+// readBruDir reads the requests of a directory, the sub-directories are the folders
+func readBruDir(dir string) (items []BrunoItem, err error) {
+	var entries []os.DirEntry
+	if entries, err = os.ReadDir(dir); err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		switch {
+		case entry.IsDir() && entry.Name() != "environments" && !strings.HasPrefix(entry.Name(), "."):
+			folder := BrunoItem{Type: "folder", Name: entry.Name()}
+			if folder.Items, err = readBruDir(path); err != nil {
+				return
+			}
+			if len(folder.Items) > 0 {
+				items = append(items, folder)
+			}
+		case !entry.IsDir() && filepath.Ext(entry.Name()) == ".bru" && entry.Name() != "folder.bru" && entry.Name() != "collection.bru":
+			var data []byte
+			if data, err = os.ReadFile(path); err != nil {
+				return
+			}
+			var item BrunoItem
+			if item, err = parseBruFile(string(data)); err != nil {
+				err = errors.Join(errors.New(path), err)
+				return
+			}
+			item.Name = util.EmptyThenDefault(item.Name, strings.TrimSuffix(entry.Name(), ".bru"))
+			items = append(items, item)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Seq < items[j].Seq
+	})
+	return
+}
+
+func (c *BrunoCollection) toTestSuite() (suite *testing.TestSuite) {
+	suite = &testing.TestSuite{
+		Name:  util.EmptyThenDefault(c.Name, "bruno"),
+		Param: map[string]string{},
+	}
+	if len(c.Environments) > 0 {
+		for _, variable := range c.Environments[0].Variables {
+			if variable.Enabled {
+				suite.Param[variable.Name] = variable.Value
+			}
+		}
+	}
+
+	names := map[string]int{}
+	var convertItems func(items []BrunoItem, prefix string)
+	convertItems = func(items []BrunoItem, prefix string) {
+		for _, item := range items {
+			if item.Type == "folder" {
+				convertItems(item.Items, prefix+item.Name+" ")
+				continue
+			}
+
+			testCase := item.Request.toTestCase()
+			testCase.Name = uniqueName(names, prefix+item.Name)
+			suite.Items = append(suite.Items, testCase)
+		}
+	}
+	convertItems(c.Items, "")
+	return
+}
+
+func (r BrunoRequest) toTestCase() (testCase testing.TestCase) {
+	api, query := splitQuery(convertVariables(r.URL))
+	testCase.Request = testing.Request{
+		API:    api,
+		Method: strings.ToUpper(util.EmptyThenDefault(r.Method, "GET")),
+		Query:  testing.SortedKeysStringMap{},
+		Header: map[string]string{},
+		Form:   map[string]string{},
+	}
+
+	// the enabled query parameters are the same as the ones in the URL
+	for key, val := range query {
+		testCase.Request.Query[key] = val
+	}
+	for _, param := range r.Params {
+		if param.Enabled && param.Type != "path" {
+			testCase.Request.Query[param.Name] = convertVariables(param.Value)
+		}
+	}
+	for _, header := range r.Headers {
+		if header.Enabled {
+			testCase.Request.Header[header.Name] = convertVariables(header.Value)
+		}
+	}
+
+	setBody := func(body, contentType string) {
+		testCase.Request.Body = testing.NewRequestBody(convertVariables(body))
+		if _, ok := testCase.Request.Header[util.ContentType]; !ok {
+			testCase.Request.Header[util.ContentType] = contentType
+		}
+	}
+	setForm := func(pairs []BrunoPair, contentType string) {
+		for _, pair := range pairs {
+			if pair.Enabled {
+				testCase.Request.Form[pair.Name] = convertVariables(pair.Value)
+			}
+		}
+		testCase.Request.Header[util.ContentType] = contentType
+	}
+	switch r.Body.Mode {
+	case "json":
+		setBody(r.Body.JSON, util.JSON)
+	case "text":
+		setBody(r.Body.Text, util.Plain)
+	case "xml":
+		setBody(r.Body.XML, "application/xml")
+	case "formUrlEncoded":
+		setForm(r.Body.FormURLEncoded, util.Form)
+	case "multipartForm":
+		setForm(r.Body.MultipartForm, util.MultiPartFormData)
+	}
+
+	switch r.Auth.Mode {
+	case "bearer":
+		testCase.Request.Header[util.Authorization] = "Bearer " + convertVariables(r.Auth.Bearer.Token)
+	case "basic":
+		testCase.Request.Header[util.Authorization] = basicAuthorization(r.Auth.Basic.Username, r.Auth.Basic.Password)
+	}
+	return
+}
+
+// bruBlock is a block of the .bru file, it has either the pairs or the text
+type bruBlock struct {
+	name  string
+	pairs []BrunoPair
+	text  string
+}
+
+var bruBlockStartRegex = regexp.MustCompile(`^([\w:-]+)\s*\{\s*$`)
+
+// parseBruBlocks parses the blocks like "headers {", the block ends with "}" at the beginning of a line
+func parseBruBlocks(content string) (blocks []bruBlock) {
+	var current *bruBlock
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if current == nil {
+			if match := bruBlockStartRegex.FindStringSubmatch(line); match != nil {
+				current = &bruBlock{name: match[1]}
+				lines = nil
+			}
+			continue
+		}
+
+		if strings.TrimRight(line, " \t") == "}" {
+			current.text = strings.TrimSpace(strings.Join(lines, "\n"))
+			for _, item := range lines {
+				item = strings.TrimSpace(item)
+				if key, val, found := strings.Cut(item, ":"); found && key != "" {
+					enabled := !strings.HasPrefix(key, "~")
+					current.pairs = append(current.pairs, BrunoPair{
+						Name:    strings.TrimSpace(strings.TrimPrefix(key, "~")),
+						Value:   strings.TrimSpace(val),
+						Enabled: enabled,
+					})
+				}
+			}
+			blocks = append(blocks, *current)
+			current = nil
+			continue
+		}
+		lines = append(lines, strings.TrimPrefix(line, "  "))
+	}
+	return
+}
+
+var bruMethods = []string{"get", "post", "put", "delete", "patch", "options", "head"}
+
+// parseBruFile parses a request of the Bruno markup language
+func parseBruFile(content string) (item BrunoItem, err error) {
+	item.Type = "http-request"
+	for _, block := range parseBruBlocks(content) {
+		pairs := pairsToMap(block.pairs)
+		switch {
+		case block.name == "meta":
+			item.Name = pairs["name"]
+			item.Seq, _ = strconv.Atoi(pairs["seq"])
+		case slices.Contains(bruMethods, block.name):
+			item.Request.Method = strings.ToUpper(block.name)
+			item.Request.URL = pairs["url"]
+		case block.name == "headers":
+			item.Request.Headers = block.pairs
+		case block.name == "params:query" || block.name == "query":
+			item.Request.Params = block.pairs
+		case block.name == "auth:bearer":
+			item.Request.Auth.Mode = "bearer"
+			item.Request.Auth.Bearer.Token = pairs["token"]
+		case block.name == "auth:basic":
+			item.Request.Auth.Mode = "basic"
+			item.Request.Auth.Basic.Username = pairs["username"]
+			item.Request.Auth.Basic.Password = pairs["password"]
+		case block.name == "body:json":
+			item.Request.Body.Mode = "json"
+			item.Request.Body.JSON = block.text
+		case block.name == "body:text":
+			item.Request.Body.Mode = "text"
+			item.Request.Body.Text = block.text
+		case block.name == "body:xml":
+			item.Request.Body.Mode = "xml"
+			item.Request.Body.XML = block.text
+		case block.name == "body:form-urlencoded":
+			item.Request.Body.Mode = "formUrlEncoded"
+			item.Request.Body.FormURLEncoded = block.pairs
+		case block.name == "body:multipart-form":
+			item.Request.Body.Mode = "multipartForm"
+			item.Request.Body.MultipartForm = block.pairs
+		}
+	}
+
+	if item.Request.Method == "" {
+		err = errors.New("no HTTP request found in the .bru file")
+	}
+	return
+}
+
+func pairsToMap(pairs []BrunoPair) (result map[string]string) {
+	result = make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		if pair.Enabled {
+			result[pair.Name] = pair.Value
+		}
+	}
+	return
+}
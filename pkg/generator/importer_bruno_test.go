@@ -0,0 +1,107 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package generator
+
+import (
+	"net/http"
+	"testing"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrunoImporter(t *testing.T) {
+	importer := NewBrunoImporter()
+
+	t.Run("from directory", func(t *testing.T) {
+		suite, err := importer.ConvertFromFile("testdata/bruno")
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "orders", suite.Name)
+		assert.Equal(t, map[string]string{"baseUrl": "http://localhost:8080"}, suite.Param)
+		if !assert.Len(t, suite.Items, 3) {
+			return
+		}
+		// the folders have no sequence, so they come before the requests
+
+		list := suite.Items[1]
+		assert.Equal(t, "list orders", list.Name)
+		assert.Equal(t, "{{ .param.baseUrl }}/orders", list.Request.API)
+		assert.Equal(t, atest.SortedKeysStringMap{"page": "1"}, list.Request.Query)
+		assert.Equal(t, `Basic {{ b64enc (printf "%s:%s" "admin" .param.password) }}`, list.Request.Header[util.Authorization])
+
+		create := suite.Items[2]
+		assert.Equal(t, "create order", create.Name)
+		assert.Equal(t, http.MethodPost, create.Request.Method)
+		assert.Equal(t, "1", create.Request.Header["X-Request-Id"])
+		assert.Equal(t, "Bearer {{ .param.token }}", create.Request.Header[util.Authorization])
+		assert.Equal(t, util.JSON, create.Request.Header[util.ContentType])
+		assert.JSONEq(t, `{"id":"123","items":[{"name":"book"}]}`, create.Request.Body.String())
+
+		login := suite.Items[0]
+		assert.Equal(t, "users login", login.Name)
+		assert.Equal(t, map[string]string{"username": "admin"}, login.Request.Form)
+		assert.Equal(t, util.Form, login.Request.Header[util.ContentType])
+	})
+
+	t.Run("single bru file", func(t *testing.T) {
+		suite, err := importer.ConvertFromFile("testdata/bruno/create.bru")
+		if assert.NoError(t, err) && assert.Len(t, suite.Items, 1) {
+			assert.Equal(t, "bruno", suite.Name)
+			assert.Equal(t, "create order", suite.Items[0].Name)
+		}
+	})
+
+	t.Run("JSON export", func(t *testing.T) {
+		suite, err := importer.Convert([]byte(`{
+  "name": "orders",
+  "items": [{
+    "type": "folder",
+    "name": "admin",
+    "items": [{
+      "type": "http-request",
+      "name": "update",
+      "request": {
+        "url": "{{baseUrl}}/orders/1",
+        "method": "PUT",
+        "headers": [{"name": "X-Debug", "value": "true", "enabled": false}],
+        "body": {"mode": "text", "text": "hello"}
+      }
+    }]
+  }],
+  "environments": [{"name": "local", "variables": [{"name": "baseUrl", "value": "http://foo", "enabled": true}]}]
+}`))
+		if !assert.NoError(t, err) || !assert.Len(t, suite.Items, 1) {
+			return
+		}
+		assert.Equal(t, map[string]string{"baseUrl": "http://foo"}, suite.Param)
+		assert.Equal(t, "admin update", suite.Items[0].Name)
+		assert.Equal(t, "hello", suite.Items[0].Request.Body.String())
+		assert.Equal(t, map[string]string{util.ContentType: util.Plain}, suite.Items[0].Request.Header)
+	})
+
+	t.Run("no request in bru file", func(t *testing.T) {
+		_, err := importer.Convert([]byte("meta {\n  name: fake\n}\n"))
+		assert.Error(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := importer.ConvertFromFile("testdata/fake")
+		assert.Error(t, err)
+	})
+}
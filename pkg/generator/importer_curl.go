@@ -0,0 +1,407 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+type curlImporter struct {
+}
+
+// NewCurlImporter returns an importer of the cURL command lines, there could be multiple commands in lines
+func NewCurlImporter() Importer {
+	return &curlImporter{}
+}
+
+func init() {
+	RegisterTestSuiteImporter("curl", NewCurlImporter())
+}
+
+// the options which have a value, the others are ignored
+var curlValueOptions = map[string]string{
+	"-X": "-X", "--request": "-X",
+	"-H": "-H", "--header": "-H",
+	"-d": "-d", "--data": "-d", "--data-raw": "-d", "--data-binary": "-d", "--data-ascii": "-d",
+	"-F": "-F", "--form": "-F", "--form-string": "-F",
+	"-b": "-b", "--cookie": "-b",
+	"-u": "-u", "--user": "-u",
+	"-A": "-A", "--user-agent": "-A",
+	"-e": "-e", "--referer": "-e",
+	"--url": "--url", "--data-urlencode": "--data-urlencode",
+	// the values are ignored
+	"-o": "", "--output": "", "-m": "", "--max-time": "", "--connect-timeout": "", "-x": "", "--proxy": "",
+	"-w": "", "--write-out": "", "--retry": "", "--cacert": "", "--cert": "", "-E": "", "--key": "",
+	"-c": "", "--cookie-jar": "", "-T": "", "--upload-file": "", "--resolve": "",
+}
+
+// Convert converts the cURL commands to test suite
+func (c *curlImporter) Convert(data []byte) (suite *testing.TestSuite, err error) {
+	var commands [][]string
+	if commands, err = splitShellCommands(string(data)); err != nil {
+		return
+	}
+
+	var testCases []testing.TestCase
+	var urls []string
+	for _, args := range commands {
+		if len(args) == 0 || args[0] != "curl" {
+			continue
+		}
+
+		var testCase testing.TestCase
+		if testCase, err = parseCurlCommand(args[1:]); err != nil {
+			return
+		}
+		testCases = append(testCases, testCase)
+		urls = append(urls, testCase.Request.API)
+	}
+	if len(testCases) == 0 {
+		err = errors.New("no curl command found")
+		return
+	}
+
+	suite = &testing.TestSuite{
+		Name: "curl",
+		API:  commonOrigin(urls),
+	}
+	names := map[string]int{}
+	for _, testCase := range testCases {
+		testCase.Request.API = trimOrigin(testCase.Request.API, suite.API)
+		testCase.Name = uniqueName(names, testCase.Request.Method+" "+testCase.Request.API)
+		suite.Items = append(suite.Items, testCase)
+	}
+	return
+}
+
+func (c *curlImporter) ConvertFromFile(dataFile string) (*testing.TestSuite, error) {
+	return convertFromFile(dataFile, c)
+}
+
+func (c *curlImporter) ConvertFromURL(dataURL string) (*testing.TestSuite, error) {
+	return convertFromURL(dataURL, c)
+}
+
+func parseCurlCommand(args []string) (testCase testing.TestCase, err error) {
+	request := testing.Request{
+		Query:  testing.SortedKeysStringMap{},
+		Header: map[string]string{},
+		Cookie: map[string]string{},
+		Form:   map[string]string{},
+	}
+
+	var data []string
+	var dataAsQuery bool
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			// curl requests all the URLs in order, only the first one is taken
+			if request.API == "" {
+				request.API = arg
+			}
+			continue
+		}
+
+		option, ok := curlValueOptions[arg]
+		value := ""
+		if ok {
+			if i+1 >= len(args) {
+				err = fmt.Errorf("the value of option %q is missing", arg)
+				return
+			}
+			i++
+			value = args[i]
+		} else if len(arg) > 2 && !strings.HasPrefix(arg, "--") {
+			// the short options with values like -XPOST
+			if option, ok = curlValueOptions[arg[:2]]; ok {
+				value = arg[2:]
+			}
+		}
+
+		switch {
+		case option == "-X":
+			request.Method = strings.ToUpper(value)
+		case option == "-H":
+			if key, val, found := strings.Cut(value, ":"); found {
+				setCurlHeader(&request, strings.TrimSpace(key), strings.TrimSpace(val))
+			}
+		case option == "-d":
+			if strings.HasPrefix(value, "@") && arg != "--data-raw" {
+				if value, err = readCurlDataFile(value[1:], arg == "--data-binary"); err != nil {
+					return
+				}
+			}
+			data = append(data, value)
+		case option == "--data-urlencode":
+			if key, val, found := strings.Cut(value, "="); found {
+				data = append(data, key+"="+url.QueryEscape(val))
+			} else {
+				data = append(data, url.QueryEscape(value))
+			}
+		case option == "-F":
+			if key, val, found := strings.Cut(value, "="); found {
+				request.Form[key] = val
+			}
+			request.Header[util.ContentType] = util.MultiPartFormData
+		case option == "-b":
+			setCookies(&request, value)
+		case option == "-u":
+			username, password, _ := strings.Cut(value, ":")
+			request.Header[util.Authorization] = basicAuthorization(username, password)
+		case option == "-A":
+			request.Header["User-Agent"] = value
+		case option == "-e":
+			request.Header["Referer"] = value
+		case option == "--url":
+			if request.API == "" {
+				request.API = value
+			}
+		case arg == "-G" || arg == "--get":
+			dataAsQuery = true
+		case arg == "-I" || arg == "--head":
+			request.Method = http.MethodHead
+		}
+	}
+
+	if request.API == "" {
+		err = errors.New("the URL is missing in the curl command")
+		return
+	}
+	var query testing.SortedKeysStringMap
+	if request.API, query = splitQuery(request.API); query != nil {
+		request.Query = query
+	}
+
+	if len(data) > 0 {
+		body := strings.Join(data, "&")
+		if dataAsQuery {
+			if values, parseErr := url.ParseQuery(body); parseErr == nil {
+				for key := range values {
+					request.Query[key] = values.Get(key)
+				}
+			}
+		} else {
+			setCurlData(&request, body)
+		}
+	}
+
+	if request.Method == "" {
+		request.Method = http.MethodGet
+		if (len(data) > 0 && !dataAsQuery) || len(request.Form) > 0 {
+			request.Method = http.MethodPost
+		}
+	}
+	testCase.Request = request
+	return
+}
+
+// readCurlDataFile reads the data like @file.json, curl strips the newlines unless it is binary
+func readCurlDataFile(name string, binary bool) (data string, err error) {
+	if name == "-" {
+		err = errors.New("the data from stdin is not supported in the curl command")
+		return
+	}
+
+	var raw []byte
+	if raw, err = os.ReadFile(name); err != nil {
+		err = fmt.Errorf("failed to read the data file %q of the curl command: %w", name, err)
+		return
+	}
+	data = string(raw)
+	if !binary {
+		data = strings.NewReplacer("\r", "", "\n", "").Replace(data)
+	}
+	return
+}
+
+func setCurlHeader(request *testing.Request, key, value string) {
+	switch {
+	case strings.EqualFold(key, "Cookie"):
+		setCookies(request, value)
+	case strings.EqualFold(key, util.ContentType):
+		request.Header[util.ContentType] = value
+	case strings.EqualFold(key, util.ContentLength), strings.EqualFold(key, "Host"):
+	default:
+		request.Header[key] = value
+	}
+}
+
+// setCookies sets the cookies like "a=b; c=d", the cookie file is ignored
+func setCookies(request *testing.Request, value string) {
+	for _, item := range strings.Split(value, ";") {
+		if key, val, found := strings.Cut(strings.TrimSpace(item), "="); found {
+			request.Cookie[key] = val
+		}
+	}
+}
+
+// setCurlData sets the data as the form or body, curl sends the data as a form without the content type
+func setCurlData(request *testing.Request, data string) {
+	contentType, hasContentType := request.Header[util.ContentType]
+	trimmed := strings.TrimSpace(data)
+	isJSON := strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")
+
+	if (!hasContentType && !isJSON) || strings.HasPrefix(contentType, util.Form) {
+		if values, err := url.ParseQuery(data); err == nil {
+			for key := range values {
+				request.Form[key] = values.Get(key)
+			}
+			request.Header[util.ContentType] = util.Form
+			return
+		}
+	}
+
+	request.Body = testing.NewRequestBody(data)
+	if !hasContentType && isJSON {
+		request.Header[util.ContentType] = util.JSON
+	}
+}
+
+// splitShellCommands splits the text into the commands and arguments like a shell,
+// the quotes, escapes, line continuations and ANSI-C quotes like $'a\nb' are supported
+func splitShellCommands(text string) (commands [][]string, err error) {
+	var args []string
+	var current strings.Builder
+	var hasToken bool
+	endToken := func() {
+		if hasToken {
+			args = append(args, current.String())
+			current.Reset()
+			hasToken = false
+		}
+	}
+	endCommand := func() {
+		endToken()
+		if len(args) > 0 {
+			commands = append(commands, args)
+			args = nil
+		}
+	}
+
+	runes := []rune(strings.ReplaceAll(text, "\r\n", "\n"))
+	for i := 0; i < len(runes); i++ {
+		char := runes[i]
+		switch {
+		case char == '\\':
+			if i+1 < len(runes) {
+				i++
+				if runes[i] != '\n' {
+					current.WriteRune(runes[i])
+					hasToken = true
+				}
+			}
+		case char == '\'':
+			end := indexRune(runes, i+1, '\'')
+			if end < 0 {
+				return nil, errors.New("unterminated single quote")
+			}
+			current.WriteString(string(runes[i+1 : end]))
+			hasToken = true
+			i = end
+		case char == '$' && i+1 < len(runes) && runes[i+1] == '\'':
+			if i, err = readANSIQuote(runes, i+2, &current); err != nil {
+				return
+			}
+			hasToken = true
+		case char == '"':
+			if i, err = readDoubleQuote(runes, i+1, &current); err != nil {
+				return
+			}
+			hasToken = true
+		case char == '\n' || char == ';':
+			endCommand()
+		case char == '|' || (char == '&' && i+1 < len(runes) && runes[i+1] == '&'):
+			// the pipes, && and || end the command, the next one is parsed separately
+			if i+1 < len(runes) && runes[i+1] == char {
+				i++
+			}
+			endCommand()
+		case char == ' ' || char == '\t':
+			endToken()
+		case char == '#' && !hasToken:
+			// skip the comment
+			if end := indexRune(runes, i, '\n'); end >= 0 {
+				i = end - 1
+			} else {
+				i = len(runes)
+			}
+		default:
+			current.WriteRune(char)
+			hasToken = true
+		}
+	}
+	endCommand()
+	return
+}
+
+func indexRune(runes []rune, from int, target rune) int {
+	for i := from; i < len(runes); i++ {
+		if runes[i] == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// readDoubleQuote reads until the closing double quote, returns its index
+func readDoubleQuote(runes []rune, from int, builder *strings.Builder) (int, error) {
+	for i := from; i < len(runes); i++ {
+		switch runes[i] {
+		case '"':
+			return i, nil
+		case '\\':
+			if i+1 < len(runes) && strings.ContainsRune("\"\\$`\n", runes[i+1]) {
+				i++
+				if runes[i] != '\n' {
+					builder.WriteRune(runes[i])
+				}
+				continue
+			}
+		}
+		builder.WriteRune(runes[i])
+	}
+	return 0, errors.New("unterminated double quote")
+}
+
+// readANSIQuote reads until the closing single quote, returns its index
+func readANSIQuote(runes []rune, from int, builder *strings.Builder) (int, error) {
+	escapes := map[rune]string{'n': "\n", 't': "\t", 'r': "\r", '\\': "\\", '\'': "'", '"': "\""}
+	for i := from; i < len(runes); i++ {
+		switch runes[i] {
+		case '\'':
+			return i, nil
+		case '\\':
+			if i+1 < len(runes) {
+				if escaped, ok := escapes[runes[i+1]]; ok {
+					builder.WriteString(escaped)
+					i++
+					continue
+				}
+			}
+		}
+		builder.WriteRune(runes[i])
+	}
+	return 0, errors.New("unterminated ANSI-C quote")
+}
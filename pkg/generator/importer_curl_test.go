@@ -0,0 +1,150 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package generator
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurlImporter(t *testing.T) {
+	importer := NewCurlImporter()
+
+	t.Run("from file", func(t *testing.T) {
+		suite, err := importer.ConvertFromFile("testdata/curl.sh")
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "curl", suite.Name)
+		assert.Equal(t, "https://example.com", suite.API)
+		if !assert.Len(t, suite.Items, 5) {
+			return
+		}
+
+		list := suite.Items[0]
+		assert.Equal(t, "GET /api/orders", list.Name)
+		assert.Equal(t, "1", list.Request.Query["page"])
+		assert.Equal(t, map[string]string{"accept": "application/json"}, list.Request.Header)
+		assert.Equal(t, map[string]string{"session": "abc", "theme": "dark"}, list.Request.Cookie)
+
+		update := suite.Items[1]
+		assert.Equal(t, http.MethodPut, update.Request.Method)
+		assert.Equal(t, `{"name":"it's a book"}`, update.Request.Body.String())
+		assert.Equal(t, util.JSON, update.Request.Header[util.ContentType])
+
+		login := suite.Items[2]
+		assert.Equal(t, http.MethodPost, login.Request.Method)
+		assert.Equal(t, map[string]string{"username": "admin", "remember": "true"}, login.Request.Form)
+		assert.Equal(t, util.Form, login.Request.Header[util.ContentType])
+		assert.Equal(t, "Basic YWRtaW46c2VjcmV0", login.Request.Header[util.Authorization])
+
+		search := suite.Items[3]
+		assert.Equal(t, http.MethodGet, search.Request.Method)
+		assert.Equal(t, "hello world", search.Request.Query["q"])
+
+		upload := suite.Items[4]
+		assert.Equal(t, http.MethodPost, upload.Request.Method)
+		assert.Equal(t, map[string]string{"name": "book", "file": "@book.png"}, upload.Request.Form)
+		assert.Equal(t, util.MultiPartFormData, upload.Request.Header[util.ContentType])
+	})
+
+	t.Run("JSON data without content type", func(t *testing.T) {
+		suite, err := importer.Convert([]byte(`curl -XPOST http://foo/api -d '{"a":1}'`))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "/api", suite.Items[0].Request.API)
+			assert.Equal(t, util.JSON, suite.Items[0].Request.Header[util.ContentType])
+		}
+	})
+
+	t.Run("head request", func(t *testing.T) {
+		suite, err := importer.Convert([]byte(`curl -I --url "http://foo/api"`))
+		if assert.NoError(t, err) {
+			assert.Equal(t, http.MethodHead, suite.Items[0].Request.Method)
+		}
+	})
+
+	t.Run("pipes and command lists", func(t *testing.T) {
+		suite, err := importer.Convert([]byte(`curl http://foo/a | jq .name && curl http://foo/b || echo failed
+curl http://foo/c|grep ok`))
+		if assert.NoError(t, err) && assert.Len(t, suite.Items, 3) {
+			assert.Equal(t, "/a", suite.Items[0].Request.API)
+			assert.Equal(t, "/b", suite.Items[1].Request.API)
+			assert.Equal(t, "/c", suite.Items[2].Request.API)
+		}
+
+		suite, err = importer.Convert([]byte(`curl 'http://foo/a?q=a|b&c=d'`))
+		if assert.NoError(t, err) && assert.Len(t, suite.Items, 1) {
+			assert.Equal(t, "a|b", suite.Items[0].Request.Query["q"])
+		}
+	})
+
+	t.Run("the first URL", func(t *testing.T) {
+		suite, err := importer.Convert([]byte(`curl http://foo/first http://foo/second`))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "/first", suite.Items[0].Request.API)
+		}
+	})
+
+	t.Run("data from file", func(t *testing.T) {
+		suite, err := importer.Convert([]byte(`curl -H 'Content-Type: application/json' -d @testdata/curl-data.json http://foo/api`))
+		if assert.NoError(t, err) {
+			assert.Equal(t, `{  "name": "book"}`, suite.Items[0].Request.Body.String())
+		}
+
+		suite, err = importer.Convert([]byte(`curl --data-binary @testdata/curl-data.json http://foo/api`))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "{\n  \"name\": \"book\"\n}\n", suite.Items[0].Request.Body.String())
+			assert.Equal(t, util.JSON, suite.Items[0].Request.Header[util.ContentType])
+		}
+
+		suite, err = importer.Convert([]byte(`curl -H 'Content-Type: text/plain' --data-raw @testdata/curl-data.json http://foo/api`))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "@testdata/curl-data.json", suite.Items[0].Request.Body.String())
+		}
+
+		_, err = importer.Convert([]byte(`curl -d @testdata/fake.json http://foo/api`))
+		assert.ErrorContains(t, err, `failed to read the data file "testdata/fake.json"`)
+
+		_, err = importer.Convert([]byte(`curl --data-binary @- http://foo/api`))
+		assert.Error(t, err)
+	})
+
+	t.Run("no curl command", func(t *testing.T) {
+		_, err := importer.Convert([]byte(`wget http://foo`))
+		assert.Error(t, err)
+	})
+
+	t.Run("no URL", func(t *testing.T) {
+		_, err := importer.Convert([]byte(`curl -X GET`))
+		assert.Error(t, err)
+	})
+
+	t.Run("missing option value", func(t *testing.T) {
+		_, err := importer.Convert([]byte(`curl http://foo -H`))
+		assert.Error(t, err)
+	})
+
+	t.Run("unterminated quote", func(t *testing.T) {
+		_, err := importer.Convert([]byte(`curl 'http://foo`))
+		assert.Error(t, err)
+
+		_, err = importer.Convert([]byte(`curl "http://foo`))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// HAR is the HTTP Archive which is exported by the browsers
+type HAR struct {
+	Log HARLog `json:"log"`
+}
+
+type HARLog struct {
+	Pages   []HARPage  `json:"pages"`
+	Entries []HAREntry `json:"entries"`
+}
+
+type HARPage struct {
+	Title string `json:"title"`
+}
+
+type HAREntry struct {
+	Request HARRequest `json:"request"`
+}
+
+type HARRequest struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	Headers     []HARPair   `json:"headers"`
+	QueryString []HARPair   `json:"queryString"`
+	Cookies     []HARPair   `json:"cookies"`
+	PostData    HARPostData `json:"postData"`
+}
+
+type HARPostData struct {
+	MimeType string    `json:"mimeType"`
+	Text     string    `json:"text"`
+	Params   []HARPair `json:"params"`
+}
+
+type HARPair struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	FileName string `json:"fileName"`
+}
+
+type harImporter struct {
+}
+
+// NewHARImporter returns an importer of the HTTP Archive files
+func NewHARImporter() Importer {
+	return &harImporter{}
+}
+
+func init() {
+	RegisterTestSuiteImporter("har", NewHARImporter())
+}
+
+// Convert converts the HAR data to test suite, the common origin of the requests is taken as the suite API
+func (h *harImporter) Convert(data []byte) (suite *testing.TestSuite, err error) {
+	har := &HAR{}
+	if err = json.Unmarshal(data, har); err != nil {
+		return
+	}
+	if len(har.Log.Entries) == 0 {
+		err = errors.New("no entries found in the HAR file")
+		return
+	}
+
+	suite = &testing.TestSuite{Name: "har"}
+	if len(har.Log.Pages) > 0 && har.Log.Pages[0].Title != "" {
+		suite.Name = har.Log.Pages[0].Title
+	}
+	var urls []string
+	for _, entry := range har.Log.Entries {
+		urls = append(urls, entry.Request.URL)
+	}
+	suite.API = commonOrigin(urls)
+
+	names := map[string]int{}
+	for _, entry := range har.Log.Entries {
+		testCase := entry.Request.toTestCase(suite.API)
+		testCase.Name = uniqueName(names, testCase.Name)
+		suite.Items = append(suite.Items, testCase)
+	}
+	return
+}
+
+func (h *harImporter) ConvertFromFile(dataFile string) (*testing.TestSuite, error) {
+	return convertFromFile(dataFile, h)
+}
+
+func (h *harImporter) ConvertFromURL(dataURL string) (*testing.TestSuite, error) {
+	return convertFromURL(dataURL, h)
+}
+
+func (r HARRequest) toTestCase(origin string) (testCase testing.TestCase) {
+	api := trimOrigin(strings.Split(r.URL, "?")[0], origin)
+	testCase = testing.TestCase{
+		Name: r.Method + " " + api,
+		Request: testing.Request{
+			API:    api,
+			Method: r.Method,
+			Query:  testing.SortedKeysStringMap{},
+			Header: map[string]string{},
+			Cookie: map[string]string{},
+			Form:   map[string]string{},
+		},
+	}
+
+	if len(r.QueryString) > 0 {
+		for _, item := range r.QueryString {
+			testCase.Request.Query[item.Name] = item.Value
+		}
+	} else if _, query := splitQuery(r.URL); query != nil {
+		testCase.Request.Query = query
+	}
+
+	for _, header := range r.Headers {
+		switch {
+		case strings.HasPrefix(header.Name, ":"):
+			// the pseudo headers of HTTP/2
+		case strings.EqualFold(header.Name, "Cookie"), strings.EqualFold(header.Name, util.ContentLength),
+			strings.EqualFold(header.Name, "Host"):
+		case strings.EqualFold(header.Name, util.ContentType):
+			testCase.Request.Header[util.ContentType] = header.Value
+		default:
+			testCase.Request.Header[header.Name] = header.Value
+		}
+	}
+	for _, cookie := range r.Cookies {
+		testCase.Request.Cookie[cookie.Name] = cookie.Value
+	}
+
+	mimeType := strings.TrimSpace(strings.Split(r.PostData.MimeType, ";")[0])
+	switch {
+	case len(r.PostData.Params) > 0 && (mimeType == util.Form || mimeType == util.MultiPartFormData):
+		for _, param := range r.PostData.Params {
+			testCase.Request.Form[param.Name] = util.EmptyThenDefault(param.Value, param.FileName)
+		}
+		testCase.Request.Header[util.ContentType] = mimeType
+	case mimeType == util.Form && r.PostData.Text != "":
+		if values, err := url.ParseQuery(r.PostData.Text); err == nil {
+			for key := range values {
+				testCase.Request.Form[key] = values.Get(key)
+			}
+			testCase.Request.Header[util.ContentType] = mimeType
+		} else {
+			testCase.Request.Body = testing.NewRequestBody(r.PostData.Text)
+		}
+	case r.PostData.Text != "":
+		testCase.Request.Body = testing.NewRequestBody(r.PostData.Text)
+	}
+	return
+}
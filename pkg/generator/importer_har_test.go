@@ -0,0 +1,79 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package generator
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHARImporter(t *testing.T) {
+	importer := NewHARImporter()
+
+	t.Run("from file", func(t *testing.T) {
+		suite, err := importer.ConvertFromFile("testdata/har.json")
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "orders", suite.Name)
+		assert.Equal(t, "https://example.com", suite.API)
+		if !assert.Len(t, suite.Items, 4) {
+			return
+		}
+
+		list := suite.Items[0]
+		assert.Equal(t, "GET /api/orders", list.Name)
+		assert.Equal(t, "/api/orders", list.Request.API)
+		assert.Equal(t, "10", list.Request.Query["size"])
+		assert.Equal(t, map[string]string{"accept": "application/json"}, list.Request.Header)
+		assert.Equal(t, map[string]string{"session": "abc"}, list.Request.Cookie)
+
+		create := suite.Items[1]
+		assert.Equal(t, http.MethodPost, create.Request.Method)
+		assert.Equal(t, `{"id":"123"}`, create.Request.Body.String())
+		assert.Equal(t, map[string]string{util.ContentType: util.JSON}, create.Request.Header)
+
+		login := suite.Items[2]
+		assert.Equal(t, map[string]string{"username": "admin"}, login.Request.Form)
+		assert.Equal(t, util.Form, login.Request.Header[util.ContentType])
+
+		assert.Equal(t, "GET /api/orders-2", suite.Items[3].Name)
+	})
+
+	t.Run("from URL", func(t *testing.T) {
+		defer gock.Off()
+		gock.New(urlFoo).Get("/").Reply(http.StatusOK).Body(bytes.NewBufferString(`{"log":{"entries":[{"request":{"method":"GET","url":"http://foo/api"}}]}}`))
+
+		suite, err := importer.ConvertFromURL(urlFoo)
+		assert.NoError(t, err)
+		assert.Equal(t, "har", suite.Name)
+	})
+
+	t.Run("no entries", func(t *testing.T) {
+		_, err := importer.Convert([]byte(`{"log":{}}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		_, err := importer.Convert([]byte(`fake`))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// InsomniaExport is the v4 export format of Insomnia
+type InsomniaExport struct {
+	Type      string             `json:"_type"`
+	Format    int                `json:"__export_format"`
+	Resources []InsomniaResource `json:"resources"`
+}
+
+// InsomniaResource could be a workspace, request group, request or environment
+type InsomniaResource struct {
+	ID             string                 `json:"_id"`
+	Type           string                 `json:"_type"`
+	ParentID       string                 `json:"parentId"`
+	Name           string                 `json:"name"`
+	URL            string                 `json:"url"`
+	Method         string                 `json:"method"`
+	Body           InsomniaBody           `json:"body"`
+	Headers        []InsomniaPair         `json:"headers"`
+	Parameters     []InsomniaPair         `json:"parameters"`
+	Authentication InsomniaAuthentication `json:"authentication"`
+	Data           map[string]any         `json:"data"`
+}
+
+type InsomniaBody struct {
+	MimeType string         `json:"mimeType"`
+	Text     string         `json:"text"`
+	Params   []InsomniaPair `json:"params"`
+}
+
+type InsomniaPair struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Disabled bool   `json:"disabled"`
+}
+
+type InsomniaAuthentication struct {
+	Type     string `json:"type"`
+	Token    string `json:"token"`
+	Prefix   string `json:"prefix"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Disabled bool   `json:"disabled"`
+}
+
+type insomniaImporter struct {
+}
+
+// NewInsomniaImporter returns an importer of the Insomnia v4 exports
+func NewInsomniaImporter() Importer {
+	return &insomniaImporter{}
+}
+
+func init() {
+	RegisterTestSuiteImporter("insomnia", NewInsomniaImporter())
+}
+
+// Convert converts the Insomnia export to test suite, the variables of the base environment become the suite parameters
+func (i *insomniaImporter) Convert(data []byte) (suite *testing.TestSuite, err error) {
+	export := &InsomniaExport{}
+	if err = json.Unmarshal(data, export); err != nil {
+		return
+	}
+	if export.Type != "export" || export.Format != 4 {
+		err = fmt.Errorf("only the Insomnia export format v4 is supported")
+		return
+	}
+
+	resources := map[string]InsomniaResource{}
+	for _, resource := range export.Resources {
+		resources[resource.ID] = resource
+	}
+
+	suite = &testing.TestSuite{
+		Name:  "insomnia",
+		Param: map[string]string{},
+	}
+	names := map[string]int{}
+	for _, resource := range export.Resources {
+		switch resource.Type {
+		case "workspace":
+			suite.Name = util.EmptyThenDefault(resource.Name, suite.Name)
+		case "environment":
+			// the base environment belongs to the workspace
+			if parent, ok := resources[resource.ParentID]; ok && parent.Type == "workspace" {
+				for key, val := range resource.Data {
+					suite.Param[key] = fmt.Sprintf("%v", val)
+				}
+			}
+		case "request":
+			testCase := resource.toTestCase()
+			testCase.Name = uniqueName(names, groupPrefix(resources, resource.ParentID)+testCase.Name)
+			suite.Items = append(suite.Items, testCase)
+		}
+	}
+	return
+}
+
+func (i *insomniaImporter) ConvertFromFile(dataFile string) (*testing.TestSuite, error) {
+	return convertFromFile(dataFile, i)
+}
+
+func (i *insomniaImporter) ConvertFromURL(dataURL string) (*testing.TestSuite, error) {
+	return convertFromURL(dataURL, i)
+}
+
+// groupPrefix returns the names of the parent request groups
+func groupPrefix(resources map[string]InsomniaResource, parentID string) (prefix string) {
+	for parent, ok := resources[parentID]; ok && parent.Type == "request_group"; parent, ok = resources[parent.ParentID] {
+		prefix = parent.Name + " " + prefix
+	}
+	return
+}
+
+func (r InsomniaResource) toTestCase() (testCase testing.TestCase) {
+	api, query := splitQuery(convertVariables(r.URL))
+	testCase = testing.TestCase{
+		Name: r.Name,
+		Request: testing.Request{
+			API:    api,
+			Method: strings.ToUpper(util.EmptyThenDefault(r.Method, "GET")),
+			Query:  query,
+			Header: map[string]string{},
+			Form:   map[string]string{},
+		},
+	}
+	if testCase.Request.Query == nil {
+		testCase.Request.Query = testing.SortedKeysStringMap{}
+	}
+
+	for _, param := range r.Parameters {
+		if !param.Disabled {
+			testCase.Request.Query[param.Name] = convertVariables(param.Value)
+		}
+	}
+	for _, header := range r.Headers {
+		if !header.Disabled {
+			testCase.Request.Header[header.Name] = convertVariables(header.Value)
+		}
+	}
+
+	switch r.Body.MimeType {
+	case util.Form, util.MultiPartFormData:
+		for _, param := range r.Body.Params {
+			if !param.Disabled {
+				testCase.Request.Form[param.Name] = convertVariables(param.Value)
+			}
+		}
+		testCase.Request.Header[util.ContentType] = r.Body.MimeType
+	case "":
+	default:
+		testCase.Request.Body = testing.NewRequestBody(convertVariables(r.Body.Text))
+		if _, ok := testCase.Request.Header[util.ContentType]; !ok {
+			testCase.Request.Header[util.ContentType] = r.Body.MimeType
+		}
+	}
+
+	auth := r.Authentication
+	if !auth.Disabled {
+		switch auth.Type {
+		case "bearer":
+			testCase.Request.Header[util.Authorization] = fmt.Sprintf("%s %s",
+				util.EmptyThenDefault(auth.Prefix, "Bearer"), convertVariables(auth.Token))
+		case "basic":
+			testCase.Request.Header[util.Authorization] = basicAuthorization(auth.Username, auth.Password)
+		}
+	}
+	return
+}
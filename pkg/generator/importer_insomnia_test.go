@@ -0,0 +1,68 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package generator
+
+import (
+	"net/http"
+	"testing"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsomniaImporter(t *testing.T) {
+	importer := NewInsomniaImporter()
+
+	t.Run("from file", func(t *testing.T) {
+		suite, err := importer.ConvertFromFile("testdata/insomnia.json")
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "orders", suite.Name)
+		assert.Equal(t, map[string]string{"base_url": "http://localhost:8080", "size": "10"}, suite.Param)
+		if !assert.Len(t, suite.Items, 3) {
+			return
+		}
+
+		list := suite.Items[0]
+		assert.Equal(t, "admin list", list.Name)
+		assert.Equal(t, "{{ .param.base_url }}/orders", list.Request.API)
+		assert.Equal(t, atest.SortedKeysStringMap{"page": "1", "size": "{{ .param.size }}"}, list.Request.Query)
+		assert.Equal(t, "Bearer {{ .param.token }}", list.Request.Header[util.Authorization])
+
+		create := suite.Items[1]
+		assert.Equal(t, http.MethodPost, create.Request.Method)
+		assert.Equal(t, "{{ .param.base_url }}/orders", create.Request.API)
+		assert.Equal(t, `{"id": "{{ .param.id }}"}`, create.Request.Body.String())
+		assert.Equal(t, util.JSON, create.Request.Header[util.ContentType])
+		assert.Equal(t, "Basic YWRtaW46c2VjcmV0", create.Request.Header[util.Authorization])
+
+		login := suite.Items[2]
+		assert.Equal(t, map[string]string{"username": "admin"}, login.Request.Form)
+		assert.Equal(t, util.Form, login.Request.Header[util.ContentType])
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := importer.Convert([]byte(`{"_type":"export","__export_format":3}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		_, err := importer.Convert([]byte(`fake`))
+		assert.Error(t, err)
+	})
+}
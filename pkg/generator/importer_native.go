@@ -38,6 +38,10 @@ func NewNativeImporter() Importer {
 	return &nativeImporter{}
 }
 
+func init() {
+	RegisterTestSuiteImporter("native", NewNativeImporter())
+}
+
 func (p *nativeImporter) Convert(data []byte) (suite *testing.TestSuite, err error) {
 	nativeData := nativeData{}
 	if err = json.Unmarshal(data, &nativeData); err == nil {
@@ -0,0 +1,221 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/apispec"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// The parameters of the generated suite which are used by the security schemes
+const (
+	ParamToken    = "token"
+	ParamUsername = "username"
+	ParamPassword = "password"
+)
+
+type openAPIImporter struct {
+}
+
+// NewOpenAPIImporter returns an importer which generates a complete test suite from a Swagger 2.0 or OpenAPI 3.x document
+func NewOpenAPIImporter() Importer {
+	return &openAPIImporter{}
+}
+
+func init() {
+	RegisterTestSuiteImporter("openapi", NewOpenAPIImporter())
+	RegisterTestSuiteImporter("swagger", NewOpenAPIImporter())
+}
+
+// Convert generates the test suite from the document data
+func (o *openAPIImporter) Convert(data []byte) (suite *testing.TestSuite, err error) {
+	var apiSpec apispec.APISpec
+	if apiSpec, err = apispec.ParseAPISpec(data); err == nil {
+		suite, err = GenerateTestSuite(apiSpec)
+	}
+	return
+}
+
+// ConvertFromFile generates the test suite from a local file, the file is taken as the spec of the suite
+func (o *openAPIImporter) ConvertFromFile(dataFile string) (*testing.TestSuite, error) {
+	return o.convertFromSpecURL(dataFile)
+}
+
+// ConvertFromURL generates the test suite from a URL, the URL is taken as the spec of the suite
+func (o *openAPIImporter) ConvertFromURL(dataURL string) (*testing.TestSuite, error) {
+	return o.convertFromSpecURL(dataURL)
+}
+
+func (o *openAPIImporter) convertFromSpecURL(specURL string) (suite *testing.TestSuite, err error) {
+	var apiSpec apispec.APISpec
+	if apiSpec, err = apispec.ParseURLToAPISpec(specURL); err != nil {
+		return
+	}
+
+	if suite, err = GenerateTestSuite(apiSpec); err == nil {
+		suite.Spec.URL = specURL
+	}
+	return
+}
+
+// GenerateTestSuite generates a test suite which has a test case for each operation of the API specification.
+// The test cases have the sample request bodies, parameters, authorization and the expected status code and schema.
+func GenerateTestSuite(apiSpec apispec.APISpec) (suite *testing.TestSuite, err error) {
+	suite = &testing.TestSuite{
+		Name:  util.EmptyThenDefault(apiSpec.GetTitle(), "openapi"),
+		Param: map[string]string{},
+		Spec: testing.APISpec{
+			Kind: "openapi",
+		},
+	}
+	if _, ok := apiSpec.(*apispec.SwaggerAPI); ok {
+		suite.Spec.Kind = "swagger"
+	}
+	if servers := apiSpec.GetServers(); len(servers) > 0 {
+		suite.API = servers[0]
+	}
+
+	names := map[string]int{}
+	for _, operation := range apiSpec.GetOperations() {
+		var testCase testing.TestCase
+		if testCase, err = generateTestCase(operation, suite); err != nil {
+			return
+		}
+
+		testCase.Name = uniqueName(names, testCase.Name)
+		suite.Items = append(suite.Items, testCase)
+	}
+	return
+}
+
+func generateTestCase(operation apispec.Operation, suite *testing.TestSuite) (testCase testing.TestCase, err error) {
+	testCase = testing.TestCase{
+		Name: util.EmptyThenDefault(operation.ID, operation.Method+" "+operation.Path),
+		Request: testing.Request{
+			API:    operation.Path,
+			Method: operation.Method,
+			Query:  testing.SortedKeysStringMap{},
+			Header: map[string]string{},
+			Cookie: map[string]string{},
+		},
+	}
+	if len(operation.Servers) > 0 && operation.Servers[0] != suite.API {
+		testCase.Request.API = strings.TrimSuffix(operation.Servers[0], "/") + operation.Path
+	}
+
+	for _, param := range operation.Parameters {
+		value := fmt.Sprintf("%v", param.GenerateSample())
+		switch param.In {
+		case "path":
+			if _, ok := suite.Param[param.Name]; !ok {
+				suite.Param[param.Name] = value
+			}
+			testCase.Request.API = strings.ReplaceAll(testCase.Request.API, "{"+param.Name+"}", paramReference(param.Name))
+		case "query":
+			testCase.Request.Query[param.Name] = value
+		case "header":
+			testCase.Request.Header[param.Name] = value
+		case "cookie":
+			testCase.Request.Cookie[param.Name] = value
+		}
+	}
+
+	if operation.RequestBody != nil {
+		var body string
+		if body, err = operation.RequestBody.GenerateSample(); err != nil {
+			return
+		}
+		testCase.Request.Body = testing.NewRequestBody(body)
+		testCase.Request.Header[util.ContentType] = operation.RequestBody.ContentType
+	}
+
+	for _, scheme := range operation.Security {
+		setAuthorization(scheme, testCase.Request, suite.Param)
+	}
+
+	if response := successResponse(operation.Responses); response != nil {
+		testCase.Expect.StatusCode = statusCodeOf(response.StatusCode)
+		if response.Schema != nil && strings.Contains(response.ContentType, "json") {
+			if testCase.Expect.Schema, err = response.Schema.ToJSONSchema(); err != nil {
+				return
+			}
+		}
+	} else {
+		testCase.Expect.StatusCode = http.StatusOK
+	}
+	return
+}
+
+// setAuthorization sets the header, query or cookie which refer to the suite parameters
+func setAuthorization(scheme apispec.SecurityScheme, request testing.Request, param map[string]string) {
+	switch scheme.Type {
+	case "http":
+		if scheme.Scheme == "basic" {
+			addParam(param, ParamUsername)
+			addParam(param, ParamPassword)
+			request.Header[util.Authorization] = fmt.Sprintf(`Basic {{ b64enc (printf "%%s:%%s" %s %s) }}`,
+				paramValue(ParamUsername), paramValue(ParamPassword))
+			return
+		}
+		addParam(param, ParamToken)
+		request.Header[util.Authorization] = "Bearer " + paramReference(ParamToken)
+	case "oauth2", "openIdConnect":
+		addParam(param, ParamToken)
+		request.Header[util.Authorization] = "Bearer " + paramReference(ParamToken)
+	case "apiKey":
+		addParam(param, scheme.Name)
+		switch scheme.In {
+		case "query":
+			request.Query[scheme.ParamName] = paramReference(scheme.Name)
+		case "cookie":
+			request.Cookie[scheme.ParamName] = paramReference(scheme.Name)
+		default:
+			request.Header[scheme.ParamName] = paramReference(scheme.Name)
+		}
+	}
+}
+
+// addParam adds an empty parameter which needs to be set by the user
+func addParam(param map[string]string, name string) {
+	if _, ok := param[name]; !ok {
+		param[name] = ""
+	}
+}
+
+// successResponse returns the first documented 2xx response
+func successResponse(responses []apispec.Response) *apispec.Response {
+	for i, response := range responses {
+		if strings.HasPrefix(response.StatusCode, "2") {
+			return &responses[i]
+		}
+	}
+	return nil
+}
+
+// statusCodeOf converts the status code of a response, the range like 2XX is taken as 200
+func statusCodeOf(code string) int {
+	if statusCode, err := strconv.Atoi(code); err == nil {
+		return statusCode
+	}
+	return http.StatusOK
+}
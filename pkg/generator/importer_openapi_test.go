@@ -0,0 +1,87 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"os"
+	"testing"
+
+	_ "embed"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAPIImporter(t *testing.T) {
+	importer := NewOpenAPIImporter()
+
+	t.Run("from file", func(t *testing.T) {
+		suite, err := importer.ConvertFromFile("../apispec/testdata/openapi.yaml")
+		assert.NoError(t, err)
+
+		data, err := atest.ToYAML(suite)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedSuiteFromOpenAPI, string(data))
+	})
+
+	t.Run("from Swagger data", func(t *testing.T) {
+		data, err := os.ReadFile("../apispec/testdata/swagger.json")
+		assert.NoError(t, err)
+
+		suite, err := importer.Convert(data)
+		assert.NoError(t, err)
+		assert.Equal(t, "swagger", suite.Spec.Kind)
+		assert.Empty(t, suite.Spec.URL)
+		if assert.Len(t, suite.Items, 5) {
+			assert.Equal(t, "/api/v1/users", suite.Items[0].Request.API)
+			assert.NotZero(t, suite.Items[0].Expect.StatusCode)
+		}
+	})
+
+	t.Run("basic auth and api key in query", func(t *testing.T) {
+		suite, err := importer.Convert([]byte(`{
+  "openapi": "3.1.0",
+  "security": [{"basic": [], "key": []}],
+  "components": {"securitySchemes": {
+    "basic": {"type": "http", "scheme": "Basic"},
+    "key": {"type": "apiKey", "in": "query", "name": "api-key"}
+  }},
+  "paths": {"/items/{item-id}": {"get": {"parameters": [{"name": "item-id", "in": "path", "schema": {"type": "integer"}}], "responses": {"2XX": {"description": "ok"}}}}}
+}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "openapi", suite.Name)
+		assert.Equal(t, map[string]string{"item-id": "101", "key": "", "username": "", "password": ""}, suite.Param)
+		if assert.Len(t, suite.Items, 1) {
+			request := suite.Items[0].Request
+			assert.Equal(t, `/items/{{ index .param "item-id" }}`, request.API)
+			assert.Equal(t, `Basic {{ b64enc (printf "%s:%s" .param.username .param.password) }}`, request.Header["Authorization"])
+			assert.Equal(t, "{{ .param.key }}", request.Query["api-key"])
+			assert.Equal(t, 200, suite.Items[0].Expect.StatusCode)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := importer.Convert([]byte(`{"openapi": "2.5"}`))
+		assert.Error(t, err)
+
+		_, err = importer.ConvertFromURL("testdata/fake.yaml")
+		assert.Error(t, err)
+	})
+}
+
+//go:embed testdata/expected_suite_from_openapi.yaml
+var expectedSuiteFromOpenAPI string
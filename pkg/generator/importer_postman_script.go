@@ -0,0 +1,152 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+)
+
+var (
+	postmanStatusRegex      = regexp.MustCompile(`pm\.response\.to\.(?:have|be)\.status\(\s*(\d{3})\s*\)`)
+	postmanCodeRegex        = regexp.MustCompile(`pm\.expect\(\s*pm\.response\.code\s*\)\.to\.(?:be\.)?(?:eql|equal|eq)\(\s*(\d{3})\s*\)`)
+	postmanHeaderRegex      = regexp.MustCompile(`pm\.response\.to\.have\.header\(\s*(['"])([^'"]+)['"]\s*,\s*(['"])([^'"]*)['"]\s*\)`)
+	postmanJSONVarRegex     = regexp.MustCompile(`(?:var|let|const)\s+(\w+)\s*=\s*pm\.response\.json\(\)`)
+	postmanExpectRegex      = regexp.MustCompile(`pm\.expect\(\s*([\w.\[\]()'"]+?)\s*\)\.to\.((?:not\.)?(?:be\.|have\.|deep\.)*\w+)(?:\(([^()]*)\))?`)
+	postmanSingleQuoteRegex = regexp.MustCompile(`^'(.*)'$`)
+)
+
+// postmanOperators are the chai assertions which are translated to the expr operators
+var postmanOperators = map[string]string{
+	"eql": "==", "equal": "==", "eq": "==", "equals": "==",
+	"above": ">", "greaterThan": ">", "gt": ">",
+	"below": "<", "lessThan": "<", "lt": "<",
+	"least": ">=", "gte": ">=",
+	"most": "<=", "lte": "<=",
+}
+
+// convertPostmanScripts translates the common assertions of the Postman test scripts,
+// the status code and headers become the expectations, the others become the verify expressions
+func convertPostmanScripts(lines []string) (expect testing.Response) {
+	script := strings.Join(lines, "\n")
+	jsonVars := []string{"pm.response.json()"}
+	for _, match := range postmanJSONVarRegex.FindAllStringSubmatch(script, -1) {
+		jsonVars = append(jsonVars, match[1])
+	}
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if match := postmanStatusRegex.FindStringSubmatch(line); match != nil {
+			expect.StatusCode, _ = strconv.Atoi(match[1])
+		} else if match = postmanCodeRegex.FindStringSubmatch(line); match != nil {
+			expect.StatusCode, _ = strconv.Atoi(match[1])
+		} else if match = postmanHeaderRegex.FindStringSubmatch(line); match != nil {
+			if expect.Header == nil {
+				expect.Header = map[string]string{}
+			}
+			expect.Header[match[2]] = match[4]
+		} else if match = postmanExpectRegex.FindStringSubmatch(line); match != nil {
+			if verify := postmanVerify(jsonVars, match[1], match[2], match[3]); verify != "" {
+				expect.Verify = append(expect.Verify, verify)
+			}
+		}
+	}
+	return
+}
+
+// postmanVerify returns the verify expression of an assertion like pm.expect(jsonData.name).to.eql("foo"),
+// it is empty if the assertion is not supported
+func postmanVerify(jsonVars []string, subject, assertion, arg string) string {
+	field, ok := postmanField(jsonVars, subject)
+	if !ok {
+		return ""
+	}
+
+	not := strings.HasPrefix(assertion, "not.")
+	assertion = strings.TrimPrefix(assertion, "not.")
+	assertion = assertion[strings.LastIndex(assertion, ".")+1:]
+	value := postmanLiteral(strings.TrimSpace(arg))
+
+	var verify string
+	switch {
+	case postmanOperators[assertion] != "" && value != "":
+		verify = fmt.Sprintf("%s %s %s", field, postmanOperators[assertion], value)
+	case (assertion == "include" || assertion == "contain" || assertion == "string") && value != "":
+		if strings.HasPrefix(value, `"`) {
+			verify = fmt.Sprintf("%s contains %s", field, value)
+		} else {
+			verify = fmt.Sprintf("%s in %s", value, field)
+		}
+	case assertion == "exist":
+		verify = fmt.Sprintf("%s != nil", field)
+	case assertion == "undefined":
+		verify = fmt.Sprintf("%s == nil", field)
+	case assertion == "true" || assertion == "false":
+		verify = fmt.Sprintf("%s == %s", field, assertion)
+	case assertion == "null":
+		verify = fmt.Sprintf("%s == nil", field)
+	case assertion == "empty":
+		verify = fmt.Sprintf("len(%s) == 0", field)
+	case assertion == "lengthOf" && value != "":
+		verify = fmt.Sprintf("len(%s) == %s", field, value)
+	default:
+		return ""
+	}
+
+	if not {
+		verify = fmt.Sprintf("!(%s)", verify)
+	}
+	return verify
+}
+
+// postmanField converts the field of the response JSON to the one of the verify data
+func postmanField(jsonVars []string, subject string) (field string, ok bool) {
+	for _, jsonVar := range jsonVars {
+		if subject == jsonVar || strings.HasPrefix(subject, jsonVar+".") || strings.HasPrefix(subject, jsonVar+"[") {
+			field, ok = "data"+strings.TrimPrefix(subject, jsonVar), true
+			break
+		}
+	}
+	if ok && strings.HasSuffix(field, ".length") {
+		field = fmt.Sprintf("len(%s)", strings.TrimSuffix(field, ".length"))
+	}
+	return
+}
+
+// postmanLiteral converts the JavaScript literal to the expr one, it is empty if not a literal
+func postmanLiteral(arg string) string {
+	if match := postmanSingleQuoteRegex.FindStringSubmatch(arg); match != nil {
+		return strconv.Quote(match[1])
+	}
+	if _, err := strconv.ParseFloat(arg, 64); err == nil {
+		return arg
+	}
+	if unquoted, err := strconv.Unquote(arg); err == nil && strings.HasPrefix(arg, `"`) {
+		return strconv.Quote(unquoted)
+	}
+	switch arg {
+	case "true", "false":
+		return arg
+	case "null", "undefined":
+		return "nil"
+	}
+	return ""
+}
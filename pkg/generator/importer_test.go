@@ -24,6 +24,8 @@ import (
 	_ "embed"
 
 	"github.com/h2non/gock"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -88,6 +90,50 @@ func TestPostmanImport(t *testing.T) {
 		assert.Equal(t, expectedSuiteFromPostman, strings.TrimSpace(result), result)
 	})
 
+	t.Run("variables, auth, forms and scripts", func(t *testing.T) {
+		suite, err := importer.ConvertFromFile("testdata/postman-advanced.json")
+		if !assert.NoError(t, err) || !assert.Len(t, suite.Items, 4) {
+			return
+		}
+		assert.Equal(t, map[string]string{
+			"baseUrl": "http://localhost:8080",
+			"size":    "10",
+			"page":    "1",
+		}, suite.Param)
+
+		list := suite.Items[0]
+		assert.Equal(t, "admin list", list.Name)
+		assert.Equal(t, "{{ .param.baseUrl }}/orders", list.Request.API)
+		assert.Equal(t, atest.SortedKeysStringMap{"page": "{{ .param.page }}", "size": "{{ .param.size }}"}, list.Request.Query)
+		assert.Equal(t, `Basic {{ b64enc (printf "%s:%s" "admin" .param.password) }}`, list.Request.Header[util.Authorization])
+		assert.Equal(t, http.StatusOK, list.Expect.StatusCode)
+		assert.Equal(t, map[string]string{util.ContentType: util.JSON}, list.Expect.Header)
+		assert.Equal(t, []string{
+			"data.total == 2",
+			"len(data.items) > 0",
+			`data.items[0].name contains "book"`,
+			"!(data.next != nil)",
+			"data.admin == true",
+		}, list.Expect.Verify)
+
+		create := suite.Items[1]
+		assert.Equal(t, "Bearer {{ .param.token }}", create.Request.Header[util.Authorization])
+		assert.Equal(t, util.JSON, create.Request.Header[util.ContentType])
+		assert.NotContains(t, create.Request.Header, "X-Debug")
+		assert.Equal(t, `{"id": "{{ .param.id }}"}`, create.Request.Body.String())
+		assert.Equal(t, http.StatusCreated, create.Expect.StatusCode)
+
+		login := suite.Items[2]
+		assert.Equal(t, map[string]string{util.ContentType: util.Form}, login.Request.Header)
+		assert.Equal(t, map[string]string{"username": "admin"}, login.Request.Form)
+		assert.Equal(t, http.StatusOK, login.Expect.StatusCode)
+
+		upload := suite.Items[3]
+		assert.Equal(t, util.MultiPartFormData, upload.Request.Header[util.ContentType])
+		assert.Equal(t, map[string]string{"name": "book", "file": "/tmp/book.png"}, upload.Request.Form)
+		assert.Equal(t, "{{ .param.apiKey }}", upload.Request.Query["api_key"])
+	})
+
 	t.Run("nil data", func(t *testing.T) {
 		_, err := importer.Convert(nil)
 		assert.Error(t, err)
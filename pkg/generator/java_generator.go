@@ -16,9 +16,9 @@ limitations under the License.
 package generator
 
 import (
-	"bytes"
 	_ "embed"
-	"net/http"
+	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/linuxsuren/api-testing/pkg/testing"
@@ -31,23 +31,63 @@ func NewJavaGenerator() CodeGenerator {
 	return &javaGenerator{}
 }
 
+// Generate generates the JUnit 5 tests which send the requests via HttpClient
 func (g *javaGenerator) Generate(testSuite *testing.TestSuite, testcase *testing.TestCase) (result string, err error) {
-	if testcase.Request.Method == "" {
-		testcase.Request.Method = http.MethodGet
-	}
-	var tpl *template.Template
-	if tpl, err = template.New("java template").Parse(javaTemplate); err == nil {
-		buf := new(bytes.Buffer)
-		if err = tpl.Execute(buf, testcase); err == nil {
-			result = buf.String()
-		}
-	}
-	return
+	return generateCode(testSuite, testcase, "java template", javaTemplate, template.FuncMap{
+		"javaQuote":  jsString,
+		"javaString": javaString,
+		"javaAssert": javaAssert,
+	})
 }
 
 func init() {
 	RegisterCodeGenerator("java", NewJavaGenerator())
 }
 
+func javaString(text codeString) string {
+	var parts []string
+	for _, segment := range text {
+		switch {
+		case segment.Ref == nil:
+			if segment.Text != "" {
+				parts = append(parts, jsString(segment.Text))
+			}
+		case segment.Ref.Case == "":
+			parts = append(parts, fmt.Sprintf("params.get(%s)", jsString(segment.Ref.Param)))
+		default:
+			parts = append(parts, fmt.Sprintf("text(lookup(outputs.get(%s)%s))", jsString(segment.Ref.Case), pathArguments(segment.Ref.Path)))
+		}
+	}
+	if len(parts) == 0 {
+		return `""`
+	}
+	return strings.Join(parts, " + ")
+}
+
+func javaAssert(field codeFieldAssertion) string {
+	actual := fmt.Sprintf("lookup(output%s)", pathArguments(field.Path))
+	expected := fmt.Sprintf("json(%s)", jsString(field.Value))
+	message := jsString(field.Expression)
+
+	var numeric string
+	if field.Length {
+		numeric = fmt.Sprintf("size(%s)", actual)
+		actual = fmt.Sprintf("MAPPER.valueToTree(%s)", numeric)
+	} else {
+		numeric = actual + ".asDouble()"
+	}
+
+	switch field.Operator {
+	case "==":
+		return fmt.Sprintf("assertEquals(%s, %s, %s);", expected, actual, message)
+	case "!=":
+		return fmt.Sprintf("assertNotEquals(%s, %s, %s);", expected, actual, message)
+	case "contains":
+		return fmt.Sprintf("assertTrue(contains(%s, %s), %s);", actual, expected, message)
+	default:
+		return fmt.Sprintf("assertTrue(%s %s %s, %s);", numeric, field.Operator, field.Value, message)
+	}
+}
+
 //go:embed data/main.java.tpl
 var javaTemplate string
@@ -17,6 +17,9 @@ package generator
 
 import (
 	_ "embed"
+	"fmt"
+	"strings"
+	"text/template"
 
 	"github.com/linuxsuren/api-testing/pkg/testing"
 )
@@ -28,13 +31,56 @@ func NewJavaScriptGenerator() CodeGenerator {
 	return &javascriptGenerator{}
 }
 
+// Generate generates the Jest tests which send the requests via fetch
 func (g *javascriptGenerator) Generate(testSuite *testing.TestSuite, testcase *testing.TestCase) (string, error) {
-	return generate(testSuite, testcase, "javascript template", javascriptTemplate)
+	return generateCode(testSuite, testcase, "javascript template", javascriptTemplate, template.FuncMap{
+		"jsQuote":  jsString,
+		"jsString": jsCodeString,
+		"jsAssert": jsAssert,
+	})
 }
 
 func init() {
 	RegisterCodeGenerator("JavaScript", NewJavaScriptGenerator())
 }
 
+func jsCodeString(text codeString) string {
+	var parts []string
+	for _, segment := range text {
+		switch {
+		case segment.Ref == nil:
+			if segment.Text != "" {
+				parts = append(parts, jsString(segment.Text))
+			}
+		case segment.Ref.Case == "":
+			parts = append(parts, fmt.Sprintf("params[%s]", jsString(segment.Ref.Param)))
+		default:
+			parts = append(parts, fmt.Sprintf("text(lookup(outputs[%s]%s))", jsString(segment.Ref.Case), pathArguments(segment.Ref.Path)))
+		}
+	}
+	if len(parts) == 0 {
+		return `""`
+	}
+	return strings.Join(parts, " + ")
+}
+
+var jestMatchers = map[string]string{
+	"==":       "toEqual",
+	"!=":       "not.toEqual",
+	">":        "toBeGreaterThan",
+	">=":       "toBeGreaterThanOrEqual",
+	"<":        "toBeLessThan",
+	"<=":       "toBeLessThanOrEqual",
+	"contains": "toContain",
+}
+
+func jsAssert(field codeFieldAssertion) string {
+	actual := fmt.Sprintf("lookup(output%s)", pathArguments(field.Path))
+	if field.Length {
+		actual = fmt.Sprintf("length(%s)", actual)
+	}
+	return fmt.Sprintf("expect(%s).%s(%s); // %s", actual, jestMatchers[field.Operator], field.Value, field.Expression)
+}
+
 //go:embed data/main.javascript.tpl
 var javascriptTemplate string
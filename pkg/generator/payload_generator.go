@@ -19,8 +19,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
-	"math/rand"
 	"os"
 	"path/filepath"
 	"strings"
@@ -120,91 +120,11 @@ func generateGRPCPayloadAsJSON(rpc *testing.RPCDesc, service string) (resultJSON
 		return "", err
 	}
 
-	randFuncMap := map[protoreflect.Kind]func(md protoreflect.FieldDescriptor) any{}
-	randFuncMap[protoreflect.Int32Kind] = func(md protoreflect.FieldDescriptor) any {
-		if md.IsList() {
-			return []int{rand.Intn(100), rand.Intn(100), rand.Intn(100)}
-		}
-		return rand.Intn(100)
-	}
-	randFuncMap[protoreflect.Uint32Kind] = func(md protoreflect.FieldDescriptor) any {
-		if md.IsList() {
-			return []int{rand.Intn(100), rand.Intn(100), rand.Intn(100)}
-		}
-		return rand.Intn(100)
-	}
-	randFuncMap[protoreflect.Int64Kind] = func(md protoreflect.FieldDescriptor) any {
-		if md.IsList() {
-			return []int{rand.Intn(100), rand.Intn(100), rand.Intn(100)}
-		}
-		return rand.Intn(100)
-	}
-	randFuncMap[protoreflect.Uint64Kind] = func(md protoreflect.FieldDescriptor) any {
-		if md.IsList() {
-			return []int{rand.Intn(100), rand.Intn(100), rand.Intn(100)}
-		}
-		return rand.Intn(100)
-	}
-	randFuncMap[protoreflect.FloatKind] = func(md protoreflect.FieldDescriptor) any {
-		if md.IsList() {
-			return []float32{rand.Float32(), rand.Float32(), rand.Float32()}
-		}
-		return rand.Float32()
-	}
-	randFuncMap[protoreflect.DoubleKind] = func(md protoreflect.FieldDescriptor) any {
-		if md.IsList() {
-			return []float64{rand.Float64(), rand.Float64(), rand.Float64()}
-		}
-		return rand.Float64()
-	}
-	randFuncMap[protoreflect.BoolKind] = func(md protoreflect.FieldDescriptor) any {
-		if md.IsList() {
-			return []bool{true, false, true}
-		}
-		return true
-	}
-	randFuncMap[protoreflect.StringKind] = func(md protoreflect.FieldDescriptor) any {
-		if md.IsList() {
-			return []string{"xxx", "yyy", "zzz"}
-		}
-		return "xxx"
-	}
-	randFuncMap[protoreflect.EnumKind] = func(md protoreflect.FieldDescriptor) any {
-		enums := md.Enum().Values()
-		return enums.Get(rand.Intn(enums.Len())).Index()
-	}
-	randFuncMap[protoreflect.MessageKind] = func(md protoreflect.FieldDescriptor) any {
-		result := map[string]any{}
-		if md.IsMap() {
-			key := randFuncMap[md.MapKey().Kind()](md.MapKey())
-			if strKey, ok := key.(string); ok {
-				result[strKey] = randFuncMap[md.MapValue().Kind()](md.MapValue())
-			}
-		} else if md.IsList() {
-			child := md.Message().Fields().Get(0)
-			randFunc := randFuncMap[child.Kind()]
-			result[md.JSONName()] = []interface{}{randFunc(child)}
-		} else {
-			for i := 0; i < md.Message().Fields().Len(); i++ {
-				field := md.Message().Fields().Get(i)
-				randFunc := randFuncMap[field.Kind()]
-				if randFunc != nil {
-					result[field.JSONName()] = randFunc(field)
-				}
-			}
-		}
-		return result
-	}
-
-	data := map[string]any{}
-	abc := dp.(protoreflect.MethodDescriptor)
-	for i := 0; i < abc.Input().Fields().Len(); i++ {
-		field := abc.Input().Fields().Get(i)
-		randFunc := randFuncMap[field.Kind()]
-		if randFunc != nil {
-			data[string(field.Name())] = randFunc(field)
-		}
+	method, ok := dp.(protoreflect.MethodDescriptor)
+	if !ok {
+		return "", fmt.Errorf("%s is not a method", service)
 	}
+	data := NewProtoPayloadSynthesizer(nil).Valid(method.Input())
 
 	var result []byte
 	result, err = json.Marshal(data)
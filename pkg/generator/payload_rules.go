@@ -0,0 +1,258 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"reflect"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// the options extensions of buf.validate and protoc-gen-validate
+const (
+	bufValidateField    protoreflect.FullName = "buf.validate.field"
+	bufValidateOneof    protoreflect.FullName = "buf.validate.oneof"
+	pgvValidateRules    protoreflect.FullName = "validate.rules"
+	pgvValidateRequired protoreflect.FullName = "validate.required"
+)
+
+// fieldRules is the validation rules of a field, the rule messages are read by the field names,
+// because buf.validate and protoc-gen-validate share the same names
+type fieldRules struct {
+	required bool
+	// typed is the rules of the field kind, such as StringRules
+	typed protoreflect.Message
+	// repeated is the RepeatedRules or MapRules
+	repeated protoreflect.Message
+	// items is the typed rules of the list items
+	items protoreflect.Message
+}
+
+func readFieldRules(field protoreflect.FieldDescriptor) (rules fieldRules) {
+	if constraints := optionExtension(field.Options(), field.ParentFile(), bufValidateField); constraints != nil {
+		rules = rulesOf(field, constraints)
+		rules.required = ruleBoolean(constraints, "required")
+	} else if constraints = optionExtension(field.Options(), field.ParentFile(), pgvValidateRules); constraints != nil {
+		rules = rulesOf(field, constraints)
+		rules.required = ruleBoolean(ruleMessage(constraints, "message"), "required")
+	}
+	return
+}
+
+func rulesOf(field protoreflect.FieldDescriptor, constraints protoreflect.Message) (rules fieldRules) {
+	switch {
+	case field.IsMap():
+		rules.repeated = ruleMessage(constraints, "map")
+	case field.IsList():
+		rules.repeated = ruleMessage(constraints, "repeated")
+		if items := ruleMessage(rules.repeated, "items"); items != nil {
+			rules.items = ruleMessage(items, kindRuleName(field.Kind()))
+		}
+	default:
+		rules.typed = ruleMessage(constraints, kindRuleName(field.Kind()))
+	}
+	return
+}
+
+// kindRuleName returns the field name of the typed rules in the constraints
+func kindRuleName(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.EnumKind:
+		return "enum"
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return "message"
+	}
+	return kind.String()
+}
+
+func isOneofRequired(oneof protoreflect.OneofDescriptor) bool {
+	if constraints := optionExtension(oneof.Options(), oneof.ParentFile(), bufValidateOneof); constraints != nil {
+		return ruleBoolean(constraints, "required")
+	}
+	extension := findExtension(oneof.ParentFile(), pgvValidateRequired, map[string]bool{})
+	if extension == nil {
+		return false
+	}
+	for _, data := range extensionFields(oneof.Options(), extension.Number()) {
+		if value, n := protowire.ConsumeVarint(data); n > 0 {
+			return value != 0
+		}
+	}
+	return false
+}
+
+// optionExtension returns the message of the extension in the options, or nil if it does not exist.
+// The extension is looked up from the imported files, so the validation packages are not required at build time
+func optionExtension(options proto.Message, file protoreflect.FileDescriptor, name protoreflect.FullName) protoreflect.Message {
+	extension := findExtension(file, name, map[string]bool{})
+	if extension == nil || extension.Message() == nil {
+		return nil
+	}
+
+	values := extensionFields(options, extension.Number())
+	if len(values) == 0 {
+		return nil
+	}
+	message := dynamicpb.NewMessage(extension.Message())
+	for _, data := range values {
+		if err := (proto.UnmarshalOptions{Merge: true}).Unmarshal(data, message); err != nil {
+			return nil
+		}
+	}
+	return message
+}
+
+// extensionFields returns the raw values of a field number in the options
+func extensionFields(options proto.Message, number protoreflect.FieldNumber) (values [][]byte) {
+	if options == nil || reflect.ValueOf(options).IsNil() {
+		return
+	}
+	data, err := proto.Marshal(options)
+	if err != nil {
+		return
+	}
+	for len(data) > 0 {
+		fieldNumber, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return
+		}
+		data = data[n:]
+		size := protowire.ConsumeFieldValue(fieldNumber, wireType, data)
+		if size < 0 {
+			return
+		}
+		if fieldNumber == number {
+			value := data[:size]
+			if wireType == protowire.BytesType {
+				value, _ = protowire.ConsumeBytes(value)
+			}
+			values = append(values, value)
+		}
+		data = data[size:]
+	}
+	return
+}
+
+func findExtension(file protoreflect.FileDescriptor, name protoreflect.FullName, visited map[string]bool) protoreflect.ExtensionDescriptor {
+	if file == nil || visited[file.Path()] {
+		return nil
+	}
+	visited[file.Path()] = true
+	if extension := file.Extensions().ByName(name.Name()); extension != nil && extension.FullName() == name {
+		return extension
+	}
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if extension := findExtension(imports.Get(i).FileDescriptor, name, visited); extension != nil {
+			return extension
+		}
+	}
+	return nil
+}
+
+func ruleField(rules protoreflect.Message, name string) (value protoreflect.Value, ok bool) {
+	if rules == nil {
+		return
+	}
+	field := rules.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if field == nil || !rules.Has(field) {
+		return
+	}
+	return rules.Get(field), true
+}
+
+func ruleMessage(rules protoreflect.Message, name string) protoreflect.Message {
+	if rules == nil {
+		return nil
+	}
+	field := rules.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if field == nil || field.Message() == nil || !rules.Has(field) {
+		return nil
+	}
+	return rules.Get(field).Message()
+}
+
+func ruleBoolean(rules protoreflect.Message, name string) bool {
+	value, ok := ruleField(rules, name)
+	if ok {
+		_, isBool := value.Interface().(bool)
+		return isBool && value.Bool()
+	}
+	return false
+}
+
+func ruleNumber(rules protoreflect.Message, name string) (number float64, ok bool) {
+	var value protoreflect.Value
+	if value, ok = ruleField(rules, name); ok {
+		number, ok = toFloat(value.Interface())
+	}
+	return
+}
+
+func ruleNumbers(rules protoreflect.Message, name string) (numbers []float64) {
+	value, ok := ruleField(rules, name)
+	if !ok {
+		return
+	}
+	if list, isList := value.Interface().(protoreflect.List); isList {
+		for i := 0; i < list.Len(); i++ {
+			if number, isNumber := toFloat(list.Get(i).Interface()); isNumber {
+				numbers = append(numbers, number)
+			}
+		}
+	}
+	return
+}
+
+func ruleStrings(rules protoreflect.Message, name string) (texts []string) {
+	value, ok := ruleField(rules, name)
+	if !ok {
+		return
+	}
+	if list, isList := value.Interface().(protoreflect.List); isList {
+		for i := 0; i < list.Len(); i++ {
+			texts = append(texts, list.Get(i).String())
+		}
+	}
+	return
+}
+
+func toFloat(value any) (number float64, ok bool) {
+	ok = true
+	switch val := value.(type) {
+	case int32:
+		number = float64(val)
+	case int64:
+		number = float64(val)
+	case uint32:
+		number = float64(val)
+	case uint64:
+		number = float64(val)
+	case float32:
+		number = float64(val)
+	case float64:
+		number = val
+	case protoreflect.EnumNumber:
+		number = float64(val)
+	default:
+		ok = false
+	}
+	return
+}
@@ -0,0 +1,782 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// InvalidPayload is a payload which violates one field type or validation rule
+type InvalidPayload struct {
+	// Reason describes the violation, such as "email: not a valid email"
+	Reason  string
+	Payload map[string]any
+}
+
+// ProtoPayloadSynthesizer synthesizes the protojson payloads from the protobuf message descriptors.
+// The field names, enums, oneofs, well-known types and the validation rules of
+// buf.validate and protoc-gen-validate are respected
+type ProtoPayloadSynthesizer interface {
+	// Valid returns a payload which satisfies the validation rules
+	Valid(protoreflect.MessageDescriptor) map[string]any
+	// Invalid returns the payloads which break one field type or validation rule each
+	Invalid(protoreflect.MessageDescriptor) []InvalidPayload
+}
+
+type protoPayloadSynthesizer struct {
+	random *rand.Rand
+}
+
+// NewProtoPayloadSynthesizer returns a synthesizer, the source derives from the shared random source if it is nil
+func NewProtoPayloadSynthesizer(source rand.Source) ProtoPayloadSynthesizer {
+	if source == nil {
+		source = rand.NewSource(util.Int63())
+	}
+	return &protoPayloadSynthesizer{random: rand.New(source)}
+}
+
+// the nested messages are omitted when they are deeper than it, it stops the recursive messages
+const maxPayloadDepth = 3
+
+func (s *protoPayloadSynthesizer) Valid(message protoreflect.MessageDescriptor) map[string]any {
+	return s.message(message, 0)
+}
+
+func (s *protoPayloadSynthesizer) message(message protoreflect.MessageDescriptor, depth int) map[string]any {
+	result := map[string]any{}
+
+	// only one field of a oneof could be set
+	chosen := map[protoreflect.FullName]protoreflect.FieldDescriptor{}
+	for i := 0; i < message.Oneofs().Len(); i++ {
+		if oneof := message.Oneofs().Get(i); !oneof.IsSynthetic() {
+			chosen[oneof.FullName()] = oneof.Fields().Get(s.random.Intn(oneof.Fields().Len()))
+		}
+	}
+
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() && chosen[oneof.FullName()] != field {
+			continue
+		}
+		if value, ok := s.field(field, readFieldRules(field), depth); ok {
+			result[field.JSONName()] = value
+		}
+	}
+	return result
+}
+
+func (s *protoPayloadSynthesizer) field(field protoreflect.FieldDescriptor, rules fieldRules, depth int) (value any, ok bool) {
+	name := string(field.Name())
+	switch {
+	case field.IsMap():
+		entries := map[string]any{}
+		for i := 0; i < s.itemCount(rules.repeated, "min_pairs", "max_pairs", 1); i++ {
+			if item, itemOK := s.value(field.MapValue(), fieldRules{}, name, depth); itemOK {
+				entries[mapKey(field.MapKey(), i)] = item
+			}
+		}
+		value, ok = entries, true
+	case field.IsList():
+		items := []any{}
+		for i := 0; i < s.itemCount(rules.repeated, "min_items", "max_items", 2); i++ {
+			if item, itemOK := s.value(field, fieldRules{typed: rules.items}, name, depth); itemOK {
+				items = append(items, item)
+			}
+		}
+		value, ok = items, true
+	default:
+		value, ok = s.value(field, rules, name, depth)
+	}
+	return
+}
+
+// value returns the value of a singular field, the name is for guessing the semantic
+func (s *protoPayloadSynthesizer) value(field protoreflect.FieldDescriptor, rules fieldRules, name string, depth int) (any, bool) {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if value, ok := s.wellKnown(field.Message(), name, depth); ok {
+			return value, true
+		}
+		if depth >= maxPayloadDepth {
+			return nil, false
+		}
+		return s.message(field.Message(), depth+1), true
+	case protoreflect.EnumKind:
+		return s.enumValue(field.Enum(), rules.typed), true
+	case protoreflect.BoolKind:
+		if value, ok := ruleField(rules.typed, "const"); ok {
+			return value.Bool(), true
+		}
+		return s.random.Intn(2) == 0, true
+	case protoreflect.StringKind:
+		return s.stringValue(name, rules.typed), true
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString([]byte(s.stringValue(name, nil))), true
+	default:
+		return s.numberValue(field.Kind(), name, rules.typed), true
+	}
+}
+
+func (s *protoPayloadSynthesizer) wellKnown(message protoreflect.MessageDescriptor, name string, depth int) (value any, ok bool) {
+	ok = true
+	switch message.FullName() {
+	case "google.protobuf.Timestamp":
+		value = payloadBaseTime.Add(time.Duration(s.random.Intn(365*24)) * time.Hour).Format(time.RFC3339)
+	case "google.protobuf.Duration":
+		value = fmt.Sprintf("%ds", s.random.Intn(3600)+1)
+	case "google.protobuf.Empty":
+		value = map[string]any{}
+	case "google.protobuf.FieldMask":
+		value = "name"
+	case "google.protobuf.Struct":
+		value = map[string]any{"key": "value"}
+	case "google.protobuf.Value":
+		value = "value"
+	case "google.protobuf.ListValue":
+		value = []any{"value"}
+	case "google.protobuf.Any":
+		value = map[string]any{"@type": "type.googleapis.com/google.protobuf.StringValue", "value": s.stringValue(name, nil)}
+	default:
+		// the wrappers are represented as the wrapped values
+		if message.ParentFile().Path() == "google/protobuf/wrappers.proto" {
+			return s.value(message.Fields().ByName("value"), fieldRules{}, name, depth)
+		}
+		ok = false
+	}
+	return
+}
+
+var payloadBaseTime = time.Date(2025, time.January, 1, 8, 0, 0, 0, time.UTC)
+
+func (s *protoPayloadSynthesizer) enumValue(enum protoreflect.EnumDescriptor, rules protoreflect.Message) any {
+	values := enum.Values()
+	if number, ok := ruleNumber(rules, "const"); ok {
+		return enumName(enum, protoreflect.EnumNumber(number))
+	}
+	if in := ruleNumbers(rules, "in"); len(in) > 0 {
+		return enumName(enum, protoreflect.EnumNumber(in[s.random.Intn(len(in))]))
+	}
+
+	notIn := ruleNumbers(rules, "not_in")
+	var candidates []protoreflect.EnumValueDescriptor
+	for i := 0; i < values.Len(); i++ {
+		value := values.Get(i)
+		// the zero value is usually UNSPECIFIED
+		if !containsNumber(notIn, float64(value.Number())) && (value.Number() != 0 || values.Len() == 1) {
+			candidates = append(candidates, value)
+		}
+	}
+	if len(candidates) == 0 {
+		return string(values.Get(0).Name())
+	}
+	return string(candidates[s.random.Intn(len(candidates))].Name())
+}
+
+func enumName(enum protoreflect.EnumDescriptor, number protoreflect.EnumNumber) any {
+	if value := enum.Values().ByNumber(number); value != nil {
+		return string(value.Name())
+	}
+	return int64(number)
+}
+
+func (s *protoPayloadSynthesizer) numberValue(kind protoreflect.Kind, name string, rules protoreflect.Message) any {
+	isFloat := kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind
+	if value, ok := ruleNumber(rules, "const"); ok {
+		return numberOf(value, isFloat)
+	}
+	if in := ruleNumbers(rules, "in"); len(in) > 0 {
+		return numberOf(in[s.random.Intn(len(in))], isFloat)
+	}
+
+	low, high := numberRange(name)
+	if isUnsigned(kind) && low < 0 {
+		low = 0
+	}
+	step := 1.0
+	if isFloat {
+		step = 0.01
+	}
+	lowRule, hasLow := ruleNumber(rules, "gte")
+	if gt, ok := ruleNumber(rules, "gt"); ok {
+		lowRule, hasLow = gt+step, true
+	}
+	highRule, hasHigh := ruleNumber(rules, "lte")
+	if lt, ok := ruleNumber(rules, "lt"); ok {
+		highRule, hasHigh = lt-step, true
+	}
+	if hasLow {
+		low = math.Max(low, lowRule)
+	}
+	if hasHigh {
+		high = math.Min(high, highRule)
+	}
+	if low > high {
+		switch {
+		case hasLow && hasHigh:
+			low, high = lowRule, highRule
+		case hasLow:
+			high = low + 100
+		default:
+			low = high - 100
+		}
+	}
+
+	notIn := ruleNumbers(rules, "not_in")
+	var value float64
+	for i := 0; i < 10; i++ {
+		if isFloat {
+			value = math.Round((low+s.random.Float64()*(high-low))*100) / 100
+			value = math.Min(math.Max(value, low), high)
+		} else {
+			value = math.Ceil(low) + float64(s.random.Int63n(int64(math.Floor(high)-math.Ceil(low))+1))
+		}
+		if !containsNumber(notIn, value) {
+			break
+		}
+	}
+	return numberOf(value, isFloat)
+}
+
+func numberOf(value float64, isFloat bool) any {
+	if isFloat {
+		return value
+	}
+	return int64(value)
+}
+
+func isUnsigned(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return true
+	}
+	return false
+}
+
+// numberRange guesses the range of a number by the field name
+func numberRange(name string) (low, high float64) {
+	words := nameWords(name)
+	switch {
+	case hasWord(words, "age"):
+		return 18, 80
+	case hasWord(words, "year"):
+		return 1990, 2030
+	case hasWord(words, "month"):
+		return 1, 12
+	case hasWord(words, "day"):
+		return 1, 28
+	case hasWord(words, "hour"):
+		return 0, 23
+	case hasWord(words, "minute", "second"):
+		return 0, 59
+	case hasWord(words, "port"):
+		return 1024, 65535
+	case hasWord(words, "size", "limit", "count", "quantity", "qty", "num", "total"):
+		return 1, 20
+	case hasWord(words, "page"):
+		return 1, 10
+	case hasWord(words, "price", "amount", "cost", "balance", "salary"):
+		return 1, 1000
+	case hasWord(words, "percent", "percentage"):
+		return 0, 100
+	case hasWord(words, "ratio", "rate", "probability"):
+		return 0, 1
+	case hasWord(words, "latitude", "lat"):
+		return -90, 90
+	case hasWord(words, "longitude", "lng", "lon"):
+		return -180, 180
+	case hasWord(words, "rating", "score", "stars", "level", "priority"):
+		return 1, 5
+	case hasWord(words, "id"):
+		return 1, 10000
+	}
+	return 1, 100
+}
+
+func (s *protoPayloadSynthesizer) stringValue(name string, rules protoreflect.Message) string {
+	if value, ok := ruleField(rules, "const"); ok {
+		return value.String()
+	}
+	if in := ruleStrings(rules, "in"); len(in) > 0 {
+		return in[s.random.Intn(len(in))]
+	}
+
+	value := s.semanticString(name)
+	if format := stringFormat(rules); format != "" {
+		value = s.formattedString(format)
+	}
+	if prefix, ok := ruleField(rules, "prefix"); ok && !strings.HasPrefix(value, prefix.String()) {
+		value = prefix.String() + value
+	}
+	if suffix, ok := ruleField(rules, "suffix"); ok && !strings.HasSuffix(value, suffix.String()) {
+		value += suffix.String()
+	}
+	if contains, ok := ruleField(rules, "contains"); ok && !strings.Contains(value, contains.String()) {
+		value += contains.String()
+	}
+
+	minLen, maxLen := -1, -1
+	if length, ok := ruleNumber(rules, "len"); ok {
+		minLen, maxLen = int(length), int(length)
+	}
+	if length, ok := ruleNumber(rules, "min_len"); ok {
+		minLen = int(length)
+	}
+	if length, ok := ruleNumber(rules, "max_len"); ok {
+		maxLen = int(length)
+	}
+	value = fitLength(value, minLen, maxLen)
+
+	if pattern, ok := ruleField(rules, "pattern"); ok {
+		if reg, err := regexp.Compile(pattern.String()); err == nil && !reg.MatchString(value) {
+			for _, candidate := range []string{strings.ToLower(value), strings.ToUpper(value), "abc", "ABC", "abc123", "123", "a", "A", "1"} {
+				if candidate = fitLength(candidate, minLen, maxLen); reg.MatchString(candidate) {
+					value = candidate
+					break
+				}
+			}
+		}
+	}
+	return value
+}
+
+// fitLength pads or truncates the text, the negative length means no limit
+func fitLength(text string, minLen, maxLen int) string {
+	runes := []rune(text)
+	if minLen > 0 && len(runes) < minLen {
+		for i := len(runes); i < minLen; i++ {
+			runes = append(runes, 'a'+rune(i%26))
+		}
+	}
+	if maxLen >= 0 && len(runes) > maxLen {
+		runes = runes[:maxLen]
+	}
+	return string(runes)
+}
+
+var (
+	firstNames = []string{"Alice", "Bob", "Carol", "David", "Emma", "Frank", "Grace", "Henry"}
+	lastNames  = []string{"Smith", "Johnson", "Brown", "Garcia", "Miller", "Davis", "Wilson", "Taylor"}
+	cities     = []string{"Berlin", "London", "Paris", "Tokyo", "New York", "Shanghai", "Toronto"}
+	countries  = []string{"Germany", "United Kingdom", "France", "Japan", "United States", "China", "Canada"}
+	companies  = []string{"Acme Corp", "Globex", "Initech", "Umbrella", "Hooli"}
+	colors     = []string{"red", "green", "blue", "black", "white"}
+	sentences  = []string{
+		"The quick brown fox jumps over the lazy dog.",
+		"Lorem ipsum dolor sit amet, consectetur adipiscing elit.",
+		"Everything works as expected.",
+	}
+)
+
+// semanticString guesses a realistic value by the field name
+func (s *protoPayloadSynthesizer) semanticString(name string) string {
+	words := nameWords(name)
+	pick := func(items []string) string {
+		return items[s.random.Intn(len(items))]
+	}
+
+	switch {
+	case hasWord(words, "email", "mail"):
+		return strings.ToLower(pick(firstNames)+"."+pick(lastNames)) + "@example.com"
+	case hasWord(words, "uuid", "guid", "id"):
+		return s.formattedString("uuid")
+	case hasWord(words, "first", "given") && hasWord(words, "name"):
+		return pick(firstNames)
+	case hasWord(words, "last", "family", "surname"):
+		return pick(lastNames)
+	case hasWord(words, "username", "login", "nickname", "nick") || (hasWord(words, "user") && hasWord(words, "name")):
+		return strings.ToLower(pick(firstNames)) + fmt.Sprint(s.random.Intn(100))
+	case hasWord(words, "company", "organization", "org"):
+		return pick(companies)
+	case hasWord(words, "name", "author", "owner"):
+		return pick(firstNames) + " " + pick(lastNames)
+	case hasWord(words, "phone", "mobile", "tel", "telephone"):
+		return fmt.Sprintf("+1-202-555-%04d", s.random.Intn(10000))
+	case hasWord(words, "url", "uri", "website", "link", "homepage", "endpoint"):
+		return "https://example.com/" + strings.Join(words, "-")
+	case hasWord(words, "host", "hostname", "domain"):
+		return "example.com"
+	case hasWord(words, "ip"):
+		return s.formattedString("ipv4")
+	case hasWord(words, "city"):
+		return pick(cities)
+	case hasWord(words, "country"):
+		return pick(countries)
+	case hasWord(words, "street", "address"):
+		return fmt.Sprintf("%d Main Street", s.random.Intn(200)+1)
+	case hasWord(words, "zip", "postal", "postcode"):
+		return fmt.Sprintf("%05d", s.random.Intn(100000))
+	case hasWord(words, "password", "passwd", "pwd", "secret"):
+		return fmt.Sprintf("P@ssw0rd-%d", s.random.Intn(1000))
+	case hasWord(words, "token", "key", "apikey", "hash"):
+		return fmt.Sprintf("%016x%016x", s.random.Uint64(), s.random.Uint64())
+	case hasWord(words, "description", "comment", "content", "text", "body", "message", "note", "summary", "bio"):
+		return pick(sentences)
+	case hasWord(words, "title", "subject"):
+		return "Weekly report"
+	case hasWord(words, "color", "colour"):
+		return pick(colors)
+	case hasWord(words, "currency"):
+		return "USD"
+	case hasWord(words, "language", "lang", "locale"):
+		return "en-US"
+	case hasWord(words, "status", "state"):
+		return "active"
+	case hasWord(words, "version"):
+		return fmt.Sprintf("1.%d.0", s.random.Intn(10))
+	case hasWord(words, "date", "birthday", "dob"):
+		return payloadBaseTime.AddDate(0, 0, s.random.Intn(365)).Format(time.DateOnly)
+	case hasWord(words, "time", "timestamp", "at"):
+		return payloadBaseTime.Add(time.Duration(s.random.Intn(365*24)) * time.Hour).Format(time.RFC3339)
+	case hasWord(words, "path", "file", "filename"):
+		return "/tmp/report.txt"
+	}
+	return fmt.Sprintf("%s-%d", strings.Join(words, "-"), s.random.Intn(1000))
+}
+
+func (s *protoPayloadSynthesizer) formattedString(format string) string {
+	switch format {
+	case "email":
+		return strings.ToLower(firstNames[s.random.Intn(len(firstNames))]+"."+
+			lastNames[s.random.Intn(len(lastNames))]) + "@example.com"
+	case "hostname":
+		return "example.com"
+	case "uri":
+		return "https://example.com/index.html"
+	case "ip", "ipv4", "address":
+		return fmt.Sprintf("192.168.%d.%d", s.random.Intn(255), s.random.Intn(254)+1)
+	case "ipv6":
+		return fmt.Sprintf("2001:db8::%x", s.random.Intn(0xffff)+1)
+	case "uuid":
+		return fmt.Sprintf("%08x-%04x-4%03x-%04x-%012x", s.random.Uint32(), s.random.Intn(0x10000),
+			s.random.Intn(0x1000), 0x8000|s.random.Intn(0x4000), s.random.Int63n(1<<48))
+	}
+	return ""
+}
+
+// the well-known formats of the string rules
+var stringFormats = []string{"email", "hostname", "uri", "ip", "ipv4", "ipv6", "address", "uuid"}
+
+func stringFormat(rules protoreflect.Message) string {
+	for _, format := range stringFormats {
+		if value, ok := ruleField(rules, format); ok && value.Bool() {
+			return format
+		}
+	}
+	return ""
+}
+
+// nameWords splits a field name into the lower case words, such as mobilePhone to mobile and phone
+func nameWords(name string) []string {
+	return strings.Split(snakeCase(camelBoundary.ReplaceAllString(name, "${1}_${2}")), "_")
+}
+
+var camelBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+func hasWord(words []string, candidates ...string) bool {
+	for _, word := range words {
+		for _, candidate := range candidates {
+			if word == candidate {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func mapKey(key protoreflect.FieldDescriptor, index int) string {
+	switch key.Kind() {
+	case protoreflect.StringKind:
+		return fmt.Sprintf("key%d", index+1)
+	case protoreflect.BoolKind:
+		return fmt.Sprint(index%2 == 0)
+	}
+	return fmt.Sprint(index + 1)
+}
+
+func (s *protoPayloadSynthesizer) itemCount(rules protoreflect.Message, minName, maxName string, defaultCount int) int {
+	count := defaultCount
+	if minItems, ok := ruleNumber(rules, minName); ok && count < int(minItems) {
+		count = int(minItems)
+	}
+	if maxItems, ok := ruleNumber(rules, maxName); ok && count > int(maxItems) {
+		count = int(maxItems)
+	}
+	return count
+}
+
+func (s *protoPayloadSynthesizer) Invalid(message protoreflect.MessageDescriptor) (payloads []InvalidPayload) {
+	valid := s.Valid(message)
+	for _, item := range s.violations(message, valid, nil, 0) {
+		payload := copyPayload(valid)
+		parent := payload
+		for _, key := range item.path {
+			parent = parent[key].(map[string]any)
+		}
+		for _, key := range item.remove {
+			delete(parent, key)
+		}
+		if item.key != "" {
+			parent[item.key] = item.value
+		}
+
+		field := strings.Join(append(append([]string{}, item.path...), item.key), ".")
+		if item.key == "" {
+			field = strings.Join(append(append([]string{}, item.path...), strings.Join(item.remove, "|")), ".")
+		}
+		payloads = append(payloads, InvalidPayload{
+			Reason:  field + ": " + item.reason,
+			Payload: payload,
+		})
+	}
+	return
+}
+
+// violation replaces a field of the valid payload, or removes fields
+type violation struct {
+	path   []string
+	key    string
+	value  any
+	remove []string
+	reason string
+}
+
+func (s *protoPayloadSynthesizer) violations(message protoreflect.MessageDescriptor, current map[string]any, path []string, depth int) (result []violation) {
+	add := func(key string, value any, reason string, args ...any) {
+		result = append(result, violation{path: path, key: key, value: value, reason: fmt.Sprintf(reason, args...)})
+	}
+
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		key := field.JSONName()
+		rules := readFieldRules(field)
+		value, exists := current[key]
+
+		if rules.required && exists {
+			result = append(result, violation{path: path, remove: []string{key}, reason: "is required"})
+		}
+		if depth == 0 && exists {
+			add(key, wrongType(field), "wrong type")
+		}
+
+		switch {
+		case field.IsList():
+			items, _ := value.([]any)
+			if minItems, ok := ruleNumber(rules.repeated, "min_items"); ok && minItems > 0 && len(items) > 0 {
+				add(key, items[:int(minItems)-1], "less than %d items", int(minItems))
+			}
+			if maxItems, ok := ruleNumber(rules.repeated, "max_items"); ok && len(items) > 0 {
+				more := make([]any, int(maxItems)+1)
+				for j := range more {
+					more[j] = items[j%len(items)]
+				}
+				add(key, more, "more than %d items", int(maxItems))
+			}
+		case field.IsMap():
+		case field.Kind() == protoreflect.MessageKind:
+			if nested, ok := value.(map[string]any); ok && depth < maxPayloadDepth {
+				result = append(result, s.violations(field.Message(), nested, append(append([]string{}, path...), key), depth+1)...)
+			}
+		default:
+			for _, item := range typedViolations(field, rules.typed) {
+				add(key, item.value, "%s", item.reason)
+			}
+		}
+	}
+
+	for i := 0; i < message.Oneofs().Len(); i++ {
+		oneof := message.Oneofs().Get(i)
+		if oneof.IsSynthetic() || !isOneofRequired(oneof) {
+			continue
+		}
+		var keys []string
+		for j := 0; j < oneof.Fields().Len(); j++ {
+			if key := oneof.Fields().Get(j).JSONName(); current[key] != nil {
+				keys = append(keys, key)
+			}
+		}
+		result = append(result, violation{path: path, remove: keys, reason: fmt.Sprintf("one of %s is required", oneof.Name())})
+	}
+	return
+}
+
+type typedViolation struct {
+	value  any
+	reason string
+}
+
+func typedViolations(field protoreflect.FieldDescriptor, rules protoreflect.Message) (result []typedViolation) {
+	if rules == nil {
+		return
+	}
+	add := func(value any, reason string, args ...any) {
+		result = append(result, typedViolation{value: value, reason: fmt.Sprintf(reason, args...)})
+	}
+
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		if value, ok := ruleField(rules, "const"); ok {
+			add(value.String()+"-invalid", "not equal to %q", value.String())
+		}
+		if in := ruleStrings(rules, "in"); len(in) > 0 {
+			add(strings.Join(in, "-")+"-invalid", "not in %v", in)
+		}
+		if length, ok := ruleNumber(rules, "len"); ok {
+			add(strings.Repeat("x", int(length)+1), "length is not %d", int(length))
+		}
+		if length, ok := ruleNumber(rules, "min_len"); ok && length > 0 {
+			add(strings.Repeat("x", int(length)-1), "shorter than %d", int(length))
+		}
+		if length, ok := ruleNumber(rules, "max_len"); ok {
+			add(strings.Repeat("x", int(length)+1), "longer than %d", int(length))
+		}
+		if format := stringFormat(rules); format != "" {
+			add("not-a-valid-"+format+" !", "not a valid %s", format)
+		}
+		if pattern, ok := ruleField(rules, "pattern"); ok {
+			if reg, err := regexp.Compile(pattern.String()); err == nil {
+				for _, candidate := range []string{"!@#$%", "", "0", "a", "A"} {
+					if !reg.MatchString(candidate) {
+						add(candidate, "does not match %q", pattern.String())
+						break
+					}
+				}
+			}
+		}
+		if prefix, ok := ruleField(rules, "prefix"); ok {
+			add("invalid", "does not start with %q", prefix.String())
+		}
+		if suffix, ok := ruleField(rules, "suffix"); ok {
+			add("invalid", "does not end with %q", suffix.String())
+		}
+	case protoreflect.EnumKind:
+		if ruleBoolean(rules, "defined_only") {
+			add(int64(math.MaxInt16), "not a defined value")
+		}
+		if in := ruleNumbers(rules, "in"); len(in) > 0 {
+			values := field.Enum().Values()
+			for i := 0; i < values.Len(); i++ {
+				if value := values.Get(i); !containsNumber(in, float64(value.Number())) {
+					add(string(value.Name()), "not in %v", in)
+					break
+				}
+			}
+		}
+	case protoreflect.BoolKind:
+		if value, ok := ruleField(rules, "const"); ok {
+			add(!value.Bool(), "not equal to %v", value.Bool())
+		}
+	case protoreflect.BytesKind:
+	default:
+		isFloat := field.Kind() == protoreflect.FloatKind || field.Kind() == protoreflect.DoubleKind
+		step := 1.0
+		if isFloat {
+			step = 0.5
+		}
+		if value, ok := ruleNumber(rules, "const"); ok {
+			add(numberOf(value+step, isFloat), "not equal to %v", value)
+		}
+		if in := ruleNumbers(rules, "in"); len(in) > 0 {
+			maxValue := in[0]
+			for _, item := range in {
+				maxValue = math.Max(maxValue, item)
+			}
+			add(numberOf(maxValue+step, isFloat), "not in %v", in)
+		}
+		if value, ok := ruleNumber(rules, "gt"); ok {
+			add(numberOf(value, isFloat), "not greater than %v", value)
+		}
+		if value, ok := ruleNumber(rules, "gte"); ok {
+			add(numberOf(value-step, isFloat), "less than %v", value)
+		}
+		if value, ok := ruleNumber(rules, "lt"); ok {
+			add(numberOf(value, isFloat), "not less than %v", value)
+		}
+		if value, ok := ruleNumber(rules, "lte"); ok {
+			add(numberOf(value+step, isFloat), "greater than %v", value)
+		}
+	}
+	return
+}
+
+// wrongType returns a value which cannot be decoded as the field
+func wrongType(field protoreflect.FieldDescriptor) any {
+	switch {
+	case field.IsList():
+		return "not-a-list"
+	case field.IsMap():
+		return "not-an-object"
+	}
+
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return 12345
+	case protoreflect.BytesKind:
+		return "!not-base64!"
+	case protoreflect.BoolKind:
+		return "not-a-bool"
+	case protoreflect.EnumKind:
+		return "NOT_A_DEFINED_VALUE"
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if field.Message().FullName() == "google.protobuf.Value" {
+			return map[string]any{"@invalid": true}
+		}
+		return []any{"not-an-object"}
+	}
+	return "not-a-number"
+}
+
+func copyPayload(payload map[string]any) map[string]any {
+	result := make(map[string]any, len(payload))
+	for key, value := range payload {
+		result[key] = copyPayloadValue(value)
+	}
+	return result
+}
+
+func copyPayloadValue(value any) any {
+	switch val := value.(type) {
+	case map[string]any:
+		return copyPayload(val)
+	case []any:
+		items := make([]any, len(val))
+		for i, item := range val {
+			items[i] = copyPayloadValue(item)
+		}
+		return items
+	}
+	return value
+}
+
+func containsNumber(numbers []float64, number float64) bool {
+	for _, item := range numbers {
+		if item == number {
+			return true
+		}
+	}
+	return false
+}
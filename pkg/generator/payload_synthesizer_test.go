@@ -0,0 +1,208 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestProtoPayloadSynthesizer(t *testing.T) {
+	user := findMessageForTest(t, "users.proto", "users.User")
+
+	t.Run("valid", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			payload := NewProtoPayloadSynthesizer(rand.NewSource(seed)).Valid(user)
+			assertDecodable(t, user, payload)
+
+			assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, payload["id"])
+			assert.Regexp(t, `^[a-z]+\.[a-z]+@example\.com$`, payload["email"])
+			assert.GreaterOrEqual(t, len(payload["firstName"].(string)), 2)
+			assert.LessOrEqual(t, len(payload["firstName"].(string)), 5)
+			assert.GreaterOrEqual(t, payload["age"], int64(21))
+			assert.Less(t, payload["age"], int64(30))
+			assert.Contains(t, []any{"ROLE_ADMIN", "ROLE_MEMBER"}, payload["role"])
+			assert.Len(t, payload["tags"], 3)
+			assert.Regexp(t, `^[0-9]{5}$`, payload["address"].(map[string]any)["zipCode"])
+			assert.IsType(t, "", payload["nickname"])
+			assert.Regexp(t, `^[0-9]+s$`, payload["ttl"])
+			_, err := time.Parse(time.RFC3339, payload["createdAt"].(string))
+			assert.NoError(t, err)
+
+			_, hasPhone := payload["phone"]
+			_, hasWebsite := payload["website"]
+			assert.True(t, hasPhone != hasWebsite, "only one field of the oneof is expected")
+			if hasWebsite {
+				assert.True(t, strings.HasPrefix(payload["website"].(string), "https://"))
+			}
+
+			// the recursive message is limited
+			manager := payload["manager"].(map[string]any)
+			manager = manager["manager"].(map[string]any)
+			manager = manager["manager"].(map[string]any)
+			assert.NotContains(t, manager, "manager")
+		}
+	})
+
+	t.Run("same seed, same payload", func(t *testing.T) {
+		first, _ := json.Marshal(NewProtoPayloadSynthesizer(rand.NewSource(1)).Valid(user))
+		second, _ := json.Marshal(NewProtoPayloadSynthesizer(rand.NewSource(1)).Valid(user))
+		assert.Equal(t, string(first), string(second))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		payloads := NewProtoPayloadSynthesizer(rand.NewSource(1)).Invalid(user)
+		reasons := map[string]map[string]any{}
+		for _, payload := range payloads {
+			reasons[payload.Reason] = payload.Payload
+		}
+
+		assert.NotContains(t, reasons["email: is required"], "email")
+		assert.Equal(t, "not-a-valid-email !", reasons["email: not a valid email"]["email"])
+		assert.Equal(t, "not-a-valid-uuid !", reasons["id: not a valid uuid"]["id"])
+		assert.Equal(t, "x", reasons["firstName: shorter than 2"]["firstName"])
+		assert.Equal(t, "xxxxxx", reasons["firstName: longer than 5"]["firstName"])
+		assert.Equal(t, int64(20), reasons["age: less than 21"]["age"])
+		assert.Equal(t, int64(30), reasons["age: not less than 30"]["age"])
+		assert.Equal(t, int64(32767), reasons["role: not a defined value"]["role"])
+		assert.Len(t, reasons["tags: less than 3 items"]["tags"], 2)
+		assert.Len(t, reasons["tags: more than 4 items"]["tags"], 5)
+		assert.Equal(t, "!@#$%", reasons[`address.zipCode: does not match "^[0-9]{5}$"`]["address"].(map[string]any)["zipCode"])
+
+		var oneofRequired map[string]any
+		for reason, payload := range reasons {
+			field, found := strings.CutSuffix(reason, ": one of contact is required")
+			if found && !strings.Contains(field, ".") {
+				oneofRequired = payload
+			}
+		}
+		if assert.NotNil(t, oneofRequired) {
+			assert.NotContains(t, oneofRequired, "phone")
+			assert.NotContains(t, oneofRequired, "website")
+		}
+
+		for reason, payload := range reasons {
+			data, _ := json.Marshal(payload)
+			err := protojson.Unmarshal(data, dynamicpb.NewMessage(user))
+			if strings.HasSuffix(reason, ": wrong type") {
+				assert.Error(t, err, reason)
+			} else {
+				// the other payloads are decodable, but rejected by the validation rules
+				assert.NoError(t, err, reason)
+			}
+		}
+	})
+
+	t.Run("nested message", func(t *testing.T) {
+		request := findMessageForTest(t, "users.proto", "users.CreateUserRequest")
+		reasons := map[string]bool{}
+		for _, payload := range NewProtoPayloadSynthesizer(rand.NewSource(1)).Invalid(request) {
+			reasons[payload.Reason] = true
+		}
+		assert.True(t, reasons["user: is required"])
+		assert.True(t, reasons["user: wrong type"])
+		assert.True(t, reasons["user.email: not a valid email"])
+	})
+
+	t.Run("protoc-gen-validate", func(t *testing.T) {
+		order := findMessageForTest(t, "orders.proto", "orders.Order")
+		synthesizer := NewProtoPayloadSynthesizer(rand.NewSource(1))
+		for i := 0; i < 20; i++ {
+			payload := synthesizer.Valid(order)
+			assertDecodable(t, order, payload)
+			assert.Regexp(t, `^SKU-.{4}$`, payload["sku"])
+			assert.Greater(t, payload["price"], 0.0)
+			assert.LessOrEqual(t, payload["price"], 100.0)
+			assert.Contains(t, []any{"STATUS_PAID", "STATUS_SHIPPED"}, payload["status"])
+		}
+
+		reasons := map[string]map[string]any{}
+		for _, payload := range synthesizer.Invalid(order) {
+			reasons[payload.Reason] = payload.Payload
+		}
+		assert.Equal(t, "xxxxxxxxx", reasons["sku: length is not 8"]["sku"])
+		assert.Equal(t, "invalid", reasons[`sku: does not start with "SKU-"`]["sku"])
+		assert.Equal(t, 0.0, reasons["price: not greater than 0"]["price"])
+		assert.Equal(t, 100.5, reasons["price: greater than 100"]["price"])
+		assert.Equal(t, "STATUS_UNKNOWN", reasons["status: not in [1 2]"]["status"])
+		var found bool
+		for reason := range reasons {
+			found = found || strings.HasSuffix(reason, ": one of payment is required")
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("without rules", func(t *testing.T) {
+		advanced := findMessageForTest(t, "../test.proto", "grpctest.AdvancedType")
+		payload := NewProtoPayloadSynthesizer(nil).Valid(advanced)
+		assertDecodable(t, advanced, payload)
+		assert.Equal(t, "gRPC", payload["Protocol"])
+		assert.Len(t, payload["StringArray"], 2)
+
+		for _, invalid := range NewProtoPayloadSynthesizer(nil).Invalid(advanced) {
+			assert.True(t, strings.HasSuffix(invalid.Reason, ": wrong type"), invalid.Reason)
+		}
+	})
+}
+
+func TestSemanticValues(t *testing.T) {
+	synthesizer := &protoPayloadSynthesizer{random: rand.New(rand.NewSource(1))}
+	assert.Regexp(t, `^\+1-202-555-\d{4}$`, synthesizer.semanticString("mobilePhone"))
+	assert.Regexp(t, `^\d+\.\d+\.\d+\.\d+$`, synthesizer.semanticString("client_ip"))
+	assert.Regexp(t, `^\d{4}-\d{2}-\d{2}$`, synthesizer.semanticString("birthday"))
+	assert.Regexp(t, `^sku-\d+$`, synthesizer.semanticString("sku"))
+	assert.Contains(t, cities, synthesizer.semanticString("city"))
+
+	low, high := numberRange("pageSize")
+	assert.Equal(t, []float64{1, 20}, []float64{low, high})
+	low, high = numberRange("latitude")
+	assert.Equal(t, []float64{-90, 90}, []float64{low, high})
+}
+
+func findMessageForTest(t *testing.T, file, name string) protoreflect.MessageDescriptor {
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			ImportPaths: []string{"testdata/validation"},
+		}),
+	}
+	files, err := compiler.Compile(context.Background(), file)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	descriptor, err := files.AsResolver().FindDescriptorByName(protoreflect.FullName(name))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return descriptor.(protoreflect.MessageDescriptor)
+}
+
+func assertDecodable(t *testing.T, message protoreflect.MessageDescriptor, payload map[string]any) {
+	data, err := json.Marshal(payload)
+	if assert.NoError(t, err) {
+		assert.NoError(t, protojson.Unmarshal(data, dynamicpb.NewMessage(message)), string(data))
+	}
+}
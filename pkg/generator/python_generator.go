@@ -17,6 +17,9 @@ package generator
 
 import (
 	_ "embed"
+	"fmt"
+	"strings"
+	"text/template"
 
 	"github.com/linuxsuren/api-testing/pkg/testing"
 )
@@ -28,13 +31,72 @@ func NewPythonGenerator() CodeGenerator {
 	return &pythonGenerator{}
 }
 
+// Generate generates the pytest tests which send the requests via requests
 func (g *pythonGenerator) Generate(testSuite *testing.TestSuite, testcase *testing.TestCase) (result string, err error) {
-	return generate(testSuite, testcase, "python template", pythonTemplate)
+	return generateCode(testSuite, testcase, "python template", pythonTemplate, template.FuncMap{
+		"pyQuote":  jsString,
+		"pyString": pyString,
+		"pyAssert": pyAssert,
+	})
 }
 
 func init() {
 	RegisterCodeGenerator("python", NewPythonGenerator())
 }
 
+func pyString(text codeString) string {
+	var parts []string
+	for _, segment := range text {
+		switch {
+		case segment.Ref == nil:
+			if segment.Text != "" {
+				parts = append(parts, jsString(segment.Text))
+			}
+		case segment.Ref.Case == "":
+			parts = append(parts, fmt.Sprintf("params[%s]", jsString(segment.Ref.Param)))
+		default:
+			parts = append(parts, fmt.Sprintf("text(lookup(outputs.get(%s)%s))", jsString(segment.Ref.Case), pathArguments(segment.Ref.Path)))
+		}
+	}
+	if len(parts) == 0 {
+		return `""`
+	}
+	return strings.Join(parts, " + ")
+}
+
+// pyValue converts the JSON literal to the Python one
+func pyValue(value string) string {
+	switch value {
+	case "true":
+		return "True"
+	case "false":
+		return "False"
+	case "null":
+		return "None"
+	}
+	return value
+}
+
+func pyAssert(field codeFieldAssertion) string {
+	actual := fmt.Sprintf("lookup(output%s)", pathArguments(field.Path))
+	if field.Length {
+		actual = fmt.Sprintf("len(%s)", actual)
+	}
+
+	value := pyValue(field.Value)
+	var assertion string
+	switch {
+	case field.Operator == "contains":
+		assertion = fmt.Sprintf("%s in %s", value, actual)
+	case value == "None" && field.Operator == "==":
+		assertion = fmt.Sprintf("%s is None", actual)
+	case value == "None" && field.Operator == "!=":
+		assertion = fmt.Sprintf("%s is not None", actual)
+	default:
+		assertion = fmt.Sprintf("%s %s %s", actual, field.Operator, value)
+	}
+	return fmt.Sprintf("assert %s, %s", assertion, jsString(field.Expression))
+}
+
 //go:embed data/main.python.tpl
 var pythonTemplate string
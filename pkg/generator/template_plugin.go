@@ -0,0 +1,139 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// TemplatePluginManifest is the manifest file name of a template plugin
+	TemplatePluginManifest = "manifest.yaml"
+
+	TemplatePluginKindGenerator = "generator"
+	TemplatePluginKindConverter = "converter"
+)
+
+// TemplatePlugin is a code generator or test suite converter which is defined by a text/template file
+type TemplatePlugin struct {
+	// Name is the name of the code generator or converter, the directory name is the default value
+	Name string `yaml:"name"`
+	// Kind could be generator or converter
+	Kind string `yaml:"kind"`
+	// Template is the template file path, it is relative to the manifest file
+	Template string `yaml:"template"`
+}
+
+type templatePlugin struct {
+	name string
+	text string
+}
+
+// NewTemplateCodeGenerator returns a code generator which renders the template,
+// it is executed with the test case, or the test suite if there is no test case
+func NewTemplateCodeGenerator(name, text string) (CodeGenerator, error) {
+	return newTemplatePlugin(name, text)
+}
+
+// NewTemplateConverter returns a test suite converter which renders the template with the test suite
+func NewTemplateConverter(name, text string) (TestSuiteConverter, error) {
+	return newTemplatePlugin(name, text)
+}
+
+func newTemplatePlugin(name, text string) (plugin *templatePlugin, err error) {
+	if _, err = template.New(name).Funcs(template.FuncMap{"safeString": safeString}).Parse(text); err == nil {
+		plugin = &templatePlugin{name: name, text: text}
+	}
+	return
+}
+
+func (p *templatePlugin) Generate(testSuite *testing.TestSuite, testcase *testing.TestCase) (string, error) {
+	return generate(testSuite, testcase, p.name, p.text)
+}
+
+func (p *templatePlugin) Convert(testSuite *testing.TestSuite) (string, error) {
+	return generate(testSuite, nil, p.name, p.text)
+}
+
+// LoadTemplatePlugins registers the template plugins in the sub-directories of the given directories,
+// each sub-directory has a manifest file. The missing directories are ignored, and a broken plugin
+// does not stop loading the others
+func LoadTemplatePlugins(dirs ...string) (plugins []TemplatePlugin, err error) {
+	for _, dir := range dirs {
+		manifests, _ := filepath.Glob(filepath.Join(dir, "*", TemplatePluginManifest))
+		for _, manifest := range manifests {
+			plugin, loadErr := loadTemplatePlugin(manifest)
+			if loadErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to load template plugin %q: %w", manifest, loadErr))
+				continue
+			}
+			plugins = append(plugins, plugin)
+		}
+	}
+	return
+}
+
+func loadTemplatePlugin(manifest string) (plugin TemplatePlugin, err error) {
+	var data []byte
+	if data, err = os.ReadFile(manifest); err != nil {
+		return
+	}
+	if err = yaml.Unmarshal(data, &plugin); err != nil {
+		return
+	}
+
+	dir := filepath.Dir(manifest)
+	if plugin.Name == "" {
+		plugin.Name = filepath.Base(dir)
+	}
+	if plugin.Template == "" {
+		err = errors.New("the template is required")
+		return
+	}
+
+	var text []byte
+	if text, err = os.ReadFile(filepath.Join(dir, plugin.Template)); err != nil {
+		return
+	}
+
+	switch plugin.Kind {
+	case TemplatePluginKindGenerator:
+		var generator CodeGenerator
+		if GetCodeGenerator(plugin.Name) != nil {
+			err = fmt.Errorf("code generator %q already exists", plugin.Name)
+		} else if generator, err = NewTemplateCodeGenerator(plugin.Name, string(text)); err == nil {
+			RegisterCodeGenerator(plugin.Name, generator)
+		}
+	case TemplatePluginKindConverter:
+		var converter TestSuiteConverter
+		if GetTestSuiteConverter(plugin.Name) != nil {
+			err = fmt.Errorf("converter %q already exists", plugin.Name)
+		} else if converter, err = NewTemplateConverter(plugin.Name, string(text)); err == nil {
+			RegisterTestSuiteConverter(plugin.Name, converter)
+		}
+	default:
+		err = fmt.Errorf("not supported kind: %q", plugin.Kind)
+	}
+	return
+}
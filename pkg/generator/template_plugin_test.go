@@ -0,0 +1,81 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"testing"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadTemplatePlugins(t *testing.T) {
+	t.Cleanup(func() {
+		delete(codeGenerators, "ruby")
+		delete(converters, "csv")
+	})
+
+	plugins, err := LoadTemplatePlugins("testdata/templates", "testdata/not-exist")
+	assert.ErrorContains(t, err, `not supported kind: "unknown"`)
+	assert.Equal(t, []TemplatePlugin{
+		{Name: "csv", Kind: TemplatePluginKindConverter, Template: "suite.csv.tpl"},
+		{Name: "ruby", Kind: TemplatePluginKindGenerator, Template: "main.rb.tpl"},
+	}, plugins)
+
+	t.Run("generator", func(t *testing.T) {
+		result, err := GetCodeGenerator("ruby").Generate(nil, &atest.TestCase{
+			Request: atest.Request{
+				API:    "http://localhost/users",
+				Header: map[string]string{"Accept": "application/json"},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `require "net/http"
+
+uri = URI("http://localhost/users")
+request = Net::HTTP::Get.new(uri)
+request["Accept"] = "application/json"
+response = Net::HTTP.start(uri.hostname, uri.port, use_ssl: uri.scheme == "https") { |http| http.request(request) }
+puts response.body
+`, result)
+	})
+
+	t.Run("converter", func(t *testing.T) {
+		result, err := GetTestSuiteConverter("csv").Convert(createOrdersSuiteForTest())
+		assert.NoError(t, err)
+		assert.Equal(t, `name,method,api
+get order,GET,/orders/{{ .param.id }}
+create order,POST,/orders
+login,POST,/login
+`, result)
+	})
+
+	t.Run("duplicated", func(t *testing.T) {
+		plugins, err := LoadTemplatePlugins("testdata/templates")
+		assert.ErrorContains(t, err, `code generator "ruby" already exists`)
+		assert.ErrorContains(t, err, `converter "csv" already exists`)
+		assert.Empty(t, plugins)
+	})
+}
+
+func TestNewTemplateCodeGenerator(t *testing.T) {
+	_, err := NewTemplateCodeGenerator("invalid", "{{ .Name")
+	assert.Error(t, err)
+
+	_, err = NewTemplateConverter("invalid", "{{ unknownFunc }}")
+	assert.Error(t, err)
+}
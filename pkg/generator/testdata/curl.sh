@@ -0,0 +1,11 @@
+# copied from the browser
+curl 'https://example.com/api/orders?page=1' \
+  -H 'accept: application/json' \
+  -H 'cookie: session=abc; theme=dark' \
+  --compressed
+
+curl -X PUT https://example.com/api/orders/123 -H "Content-Type: application/json" \
+  --data-raw $'{"name":"it\'s a book"}'
+curl -u admin:secret -d username=admin -d 'remember=true' https://example.com/api/login
+curl -G https://example.com/api/search --data-urlencode "q=hello world" -sSL
+curl -F name=book -F file=@book.png https://example.com/api/upload
@@ -0,0 +1,36 @@
+import http from 'k6/http';
+import { check, group } from 'k6';
+
+export const options = {
+    vus: __ENV.VUS ? parseInt(__ENV.VUS) : 1,
+    duration: __ENV.DURATION || '30s',
+};
+
+const BASE_URL = __ENV.BASE_URL || "http://localhost:8080";
+
+// generated from the test suite: orders
+export default function () {
+    group("get order", function () {
+        const res = http.request("GET", `${BASE_URL}/orders/1?expand=items`, null, {"cookies":{"session":"xyz"},"headers":{"Authorization":"Bearer token","X-Request-Id":"abc"}});
+        check(res, {
+            "status is 200": (r) => r.status === 200,
+            "header Content-Type": (r) => r.headers["Content-Type"] === "application/json",
+            "data.id == 1": (r) => r.json().id === 1,
+            "data.name contains \"book\"": (r) => r.json().name.includes("book"),
+            "len(data.items) > 0": (r) => r.json().items.length > 0,
+            // unsupported: all(data.items, .sku != '')
+        });
+    });
+    group("create order", function () {
+        const res = http.request("POST", `${BASE_URL}/orders`, "{\"name\": \"book\", \"count\": 2}", {"headers":{"Content-Type":"application/json"}});
+        check(res, {
+            "status is 201": (r) => r.status === 201,
+        });
+    });
+    group("login", function () {
+        const res = http.request("POST", `${BASE_URL}/login`, {"username":"admin"}, {"headers":{"Content-Type":"application/x-www-form-urlencoded"}});
+        check(res, {
+            "status is 200": (r) => r.status === 200,
+        });
+    });
+}
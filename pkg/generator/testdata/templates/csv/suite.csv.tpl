@@ -0,0 +1,4 @@
+name,method,api
+{{- range .Items }}
+{{ .Name }},{{ .Request.Method }},{{ .Request.API }}
+{{- end }}
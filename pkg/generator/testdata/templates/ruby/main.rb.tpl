@@ -0,0 +1,9 @@
+require "net/http"
+
+uri = URI({{ printf "%q" .Request.API }})
+request = Net::HTTP::{{ if eq .Request.Method "POST" }}Post{{ else }}Get{{ end }}.new(uri)
+{{- range $key, $val := .Request.Header }}
+request[{{ printf "%q" $key }}] = {{ printf "%q" $val }}
+{{- end }}
+response = Net::HTTP.start(uri.hostname, uri.port, use_ssl: uri.scheme == "https") { |http| http.request(request) }
+puts response.body
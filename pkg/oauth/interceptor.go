@@ -27,11 +27,20 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// NewAuthInterceptor creates an unary interceptor which only allows the users of the specific groups
 func NewAuthInterceptor(groups []string) grpc.ServerOption {
 	inter := &authInter{
 		groups: groups,
 	}
-	return grpc.UnaryInterceptor(inter.authInterceptor)
+	return grpc.ChainUnaryInterceptor(inter.authInterceptor)
+}
+
+// NewAuthStreamInterceptor creates a stream interceptor which only allows the users of the specific groups
+func NewAuthStreamInterceptor(groups []string) grpc.ServerOption {
+	inter := &authInter{
+		groups: groups,
+	}
+	return grpc.ChainStreamInterceptor(inter.authStreamInterceptor)
 }
 
 type authInter struct {
@@ -41,8 +50,25 @@ type authInter struct {
 func (a *authInter) authInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler) (
 	resp interface{}, err error) {
-	if info.FullMethod == "/server.Runner/GetVersion" {
-		return handler(ctx, req)
+	if err = a.authenticate(ctx, info.FullMethod); err == nil {
+		resp, err = handler(ctx, req)
+	} else if sta, ok := status.FromError(err); ok && sta.Code() == codes.Unauthenticated {
+		resp = sta
+	}
+	return
+}
+
+func (a *authInter) authStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) (err error) {
+	if err = a.authenticate(ss.Context(), info.FullMethod); err == nil {
+		err = handler(srv, ss)
+	}
+	return
+}
+
+func (a *authInter) authenticate(ctx context.Context, fullMethod string) (err error) {
+	if fullMethod == "/server.Runner/GetVersion" {
+		return
 	}
 
 	if user := GetUserFromContext(ctx); user != nil {
@@ -59,22 +85,13 @@ func (a *authInter) authInterceptor(ctx context.Context, req interface{}, info *
 			}
 		}
 
-		if approve {
-			resp, err = handler(ctx, req)
-		} else {
+		if !approve {
 			err = errors.New("invalid group")
 		}
 		return
 	}
 
-	msg := "no auth found"
-	if err != nil {
-		msg = err.Error()
-	}
-
-	sta := status.New(codes.Unauthenticated, msg)
-	resp = sta
-	err = sta.Err()
+	err = status.New(codes.Unauthenticated, "no auth found").Err()
 	return
 }
 
@@ -60,5 +60,22 @@ func TestAuthInterceptor(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("stream", func(t *testing.T) {
+		oauth.SetUser("fake", &oauth.UserInfo{Groups: []string{"dev"}})
+		ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs("auth", "fake"))
+		handler := func(srv interface{}, stream grpc.ServerStream) error {
+			return nil
+		}
+		info := &grpc.StreamServerInfo{FullMethod: "/server.Runner/RunTestSuite"}
+
+		inter := &authInter{groups: []string{"dev"}}
+		assert.NoError(t, inter.authStreamInterceptor(nil, &fakeServerStream{ctx: ctx}, info, handler))
+		assert.Error(t, inter.authStreamInterceptor(nil, &fakeServerStream{ctx: context.TODO()}, info, handler))
+
+		inter = &authInter{groups: []string{"ops"}}
+		assert.Error(t, inter.authStreamInterceptor(nil, &fakeServerStream{ctx: ctx}, info, handler))
+	})
+
 	assert.NotNil(t, NewAuthInterceptor(nil)) // should have a better way to test it
+	assert.NotNil(t, NewAuthStreamInterceptor(nil))
 }
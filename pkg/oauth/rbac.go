@@ -0,0 +1,316 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package oauth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"sync"
+
+	"github.com/linuxsuren/oauth-hub"
+	"gopkg.in/yaml.v3"
+)
+
+// Role represents a set of permissions which can be bound to users or groups
+type Role string
+
+const (
+	RoleViewer Role = "viewer"
+	RoleRunner Role = "runner"
+	RoleEditor Role = "editor"
+	RoleAdmin  Role = "admin"
+)
+
+// Permission represents an action against a store or a test suite
+type Permission string
+
+const (
+	PermissionNone  Permission = ""
+	PermissionRead  Permission = "read"
+	PermissionRun   Permission = "run"
+	PermissionWrite Permission = "write"
+	PermissionAdmin Permission = "admin"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleViewer: {PermissionRead},
+	RoleRunner: {PermissionRead, PermissionRun},
+	RoleEditor: {PermissionRead, PermissionRun, PermissionWrite},
+	RoleAdmin:  {PermissionRead, PermissionRun, PermissionWrite, PermissionAdmin},
+}
+
+// GetRoles returns all the supported roles
+func GetRoles() []Role {
+	return []Role{RoleViewer, RoleRunner, RoleEditor, RoleAdmin}
+}
+
+// IsValid checks if it's a supported role
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Has checks if the role grants the permission
+func (r Role) Has(perm Permission) bool {
+	if perm == PermissionNone {
+		return true
+	}
+	return slices.Contains(rolePermissions[r], perm)
+}
+
+// Resource represents the target of a request
+type Resource struct {
+	Store string
+	Suite string
+}
+
+// RoleBinding binds a role to users and groups in the scope of a store and a suite.
+// An empty or "*" store (or suite) matches all of them.
+type RoleBinding struct {
+	Name   string   `yaml:"name" json:"name"`
+	Role   Role     `yaml:"role" json:"role"`
+	Users  []string `yaml:"users,omitempty" json:"users,omitempty"`
+	Groups []string `yaml:"groups,omitempty" json:"groups,omitempty"`
+	Store  string   `yaml:"store,omitempty" json:"store,omitempty"`
+	Suite  string   `yaml:"suite,omitempty" json:"suite,omitempty"`
+}
+
+// Validate checks if the role binding is valid
+func (b RoleBinding) Validate() (err error) {
+	switch {
+	case b.Name == "":
+		err = errors.New("name is required")
+	case !b.Role.IsValid():
+		err = fmt.Errorf("invalid role: %q", b.Role)
+	case len(b.Users) == 0 && len(b.Groups) == 0:
+		err = errors.New("at least one user or group is required")
+	}
+	return
+}
+
+func (b RoleBinding) matchSubject(user *oauth.UserInfo) bool {
+	if slices.Contains(b.Users, user.Name) {
+		return true
+	}
+	for _, group := range user.Groups {
+		if slices.Contains(b.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b RoleBinding) matchResource(res Resource) bool {
+	return matchScope(b.Store, res.Store) && matchScope(b.Suite, res.Suite)
+}
+
+func matchScope(scope, target string) bool {
+	return scope == "" || scope == "*" || scope == target
+}
+
+// Policy is the persisted data of all role bindings
+type Policy struct {
+	Bindings []RoleBinding `yaml:"bindings"`
+}
+
+// Authorizer decides whether a user is allowed to do something
+type Authorizer interface {
+	Authorize(user *oauth.UserInfo, perm Permission, res Resource) bool
+}
+
+// PolicyManager manages the role bindings
+type PolicyManager interface {
+	Authorizer
+
+	GetRoleBindings() (bindings []RoleBinding, err error)
+	GetRoleBinding(name string) (binding *RoleBinding, err error)
+	CreateRoleBinding(binding RoleBinding) (err error)
+	UpdateRoleBinding(binding RoleBinding) (err error)
+	DeleteRoleBinding(name string) (err error)
+	WithStoreOwnerGetter(getter StoreOwnerGetter) PolicyManager
+}
+
+// StoreOwnerGetter returns the owner of a store, the owner has the admin role of it
+type StoreOwnerGetter func(store string) string
+
+type filePolicyManager struct {
+	configDir   string
+	admins      []string
+	ownerGetter StoreOwnerGetter
+	lock        sync.RWMutex
+}
+
+// NewPolicyManager creates a policy manager which stores the role bindings into the config directory.
+// The admins have the admin role of all stores and suites.
+func NewPolicyManager(configDir string, admins ...string) PolicyManager {
+	return &filePolicyManager{
+		configDir: configDir,
+		admins:    admins,
+	}
+}
+
+// WithStoreOwnerGetter sets the getter of the store owner
+func (m *filePolicyManager) WithStoreOwnerGetter(getter StoreOwnerGetter) PolicyManager {
+	m.ownerGetter = getter
+	return m
+}
+
+// Authorize checks if the user has the permission against the resource
+func (m *filePolicyManager) Authorize(user *oauth.UserInfo, perm Permission, res Resource) bool {
+	if user == nil {
+		return false
+	}
+	if perm == PermissionNone || slices.Contains(m.admins, user.Name) {
+		return true
+	}
+	if m.ownerGetter != nil && res.Store != "" && user.Name != "" && m.ownerGetter(res.Store) == user.Name {
+		return true
+	}
+
+	bindings, err := m.GetRoleBindings()
+	if err != nil {
+		return false
+	}
+	for _, binding := range bindings {
+		if binding.Role.Has(perm) && binding.matchSubject(user) && binding.matchResource(res) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRoleBindings returns all the role bindings
+func (m *filePolicyManager) GetRoleBindings() (bindings []RoleBinding, err error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var policy *Policy
+	if policy, err = m.getPolicy(); err == nil {
+		bindings = policy.Bindings
+	}
+	return
+}
+
+// GetRoleBinding returns the role binding by name, it's nil if not found
+func (m *filePolicyManager) GetRoleBinding(name string) (binding *RoleBinding, err error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	var policy *Policy
+	if policy, err = m.getPolicy(); err == nil {
+		if index := policy.indexOf(name); index != -1 {
+			binding = &policy.Bindings[index]
+		}
+	}
+	return
+}
+
+// CreateRoleBinding creates a role binding
+func (m *filePolicyManager) CreateRoleBinding(binding RoleBinding) (err error) {
+	if err = binding.Validate(); err != nil {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var policy *Policy
+	if policy, err = m.getPolicy(); err == nil {
+		if policy.indexOf(binding.Name) != -1 {
+			err = fmt.Errorf("role binding %s already exists", binding.Name)
+			return
+		}
+		policy.Bindings = append(policy.Bindings, binding)
+		err = m.save(policy)
+	}
+	return
+}
+
+// UpdateRoleBinding updates an existing role binding.
+// The scope could not be changed, because the permission is checked against the existing one.
+func (m *filePolicyManager) UpdateRoleBinding(binding RoleBinding) (err error) {
+	if err = binding.Validate(); err != nil {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var policy *Policy
+	if policy, err = m.getPolicy(); err == nil {
+		index := policy.indexOf(binding.Name)
+		if index == -1 {
+			err = fmt.Errorf("role binding %s is not exists", binding.Name)
+			return
+		}
+		if existing := policy.Bindings[index]; existing.Store != binding.Store || existing.Suite != binding.Suite {
+			err = fmt.Errorf("the scope of role binding %s cannot be changed, please delete and create it again", binding.Name)
+			return
+		}
+		policy.Bindings[index] = binding
+		err = m.save(policy)
+	}
+	return
+}
+
+// DeleteRoleBinding deletes a role binding by name
+func (m *filePolicyManager) DeleteRoleBinding(name string) (err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var policy *Policy
+	if policy, err = m.getPolicy(); err == nil {
+		if index := policy.indexOf(name); index != -1 {
+			policy.Bindings = slices.Delete(policy.Bindings, index, index+1)
+			err = m.save(policy)
+		}
+	}
+	return
+}
+
+func (m *filePolicyManager) getPolicy() (policy *Policy, err error) {
+	policy = &Policy{}
+	var data []byte
+	if data, err = os.ReadFile(m.getPolicyFile()); err == nil {
+		err = yaml.Unmarshal(data, policy)
+	} else if os.IsNotExist(err) {
+		err = nil
+	}
+	return
+}
+
+func (m *filePolicyManager) save(policy *Policy) (err error) {
+	if err = os.MkdirAll(m.configDir, 0755); err == nil {
+		var data []byte
+		if data, err = yaml.Marshal(policy); err == nil {
+			err = os.WriteFile(m.getPolicyFile(), data, 0644)
+		}
+	}
+	return
+}
+
+func (m *filePolicyManager) getPolicyFile() string {
+	return path.Join(m.configDir, "rbac.yaml")
+}
+
+func (p *Policy) indexOf(name string) int {
+	return slices.IndexFunc(p.Bindings, func(binding RoleBinding) bool {
+		return binding.Name == name
+	})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package oauth
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResourceResolver resolves the target resource of a gRPC request
+type ResourceResolver func(ctx context.Context, fullMethod string, req interface{}) Resource
+
+// NewRBACInterceptors creates the unary and stream interceptors which check the required permission of each method.
+// The methods which are not in the permissions map require the admin permission.
+func NewRBACInterceptors(authorizer Authorizer, permissions map[string]Permission, resolver ResourceResolver) []grpc.ServerOption {
+	inter := &rbacInter{
+		authorizer:  authorizer,
+		permissions: permissions,
+		resolver:    resolver,
+	}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(inter.unaryInterceptor),
+		grpc.ChainStreamInterceptor(inter.streamInterceptor),
+	}
+}
+
+type rbacInter struct {
+	authorizer  Authorizer
+	permissions map[string]Permission
+	resolver    ResourceResolver
+}
+
+func (r *rbacInter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (resp interface{}, err error) {
+	if err = r.check(ctx, info.FullMethod, req); err == nil {
+		resp, err = handler(ctx, req)
+	}
+	return
+}
+
+// streamInterceptor checks the permission of each received message,
+// because the target resource is only known from the message.
+func (r *rbacInter) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	return handler(srv, &rbacServerStream{
+		ServerStream: ss,
+		inter:        r,
+		fullMethod:   info.FullMethod,
+	})
+}
+
+func (r *rbacInter) getPermission(fullMethod string) Permission {
+	if perm, ok := r.permissions[fullMethod]; ok {
+		return perm
+	}
+	return PermissionAdmin
+}
+
+func (r *rbacInter) check(ctx context.Context, fullMethod string, req interface{}) (err error) {
+	perm := r.getPermission(fullMethod)
+	if perm == PermissionNone {
+		return
+	}
+
+	user := GetUserFromContext(ctx)
+	if user == nil {
+		err = status.Error(codes.Unauthenticated, "no auth found")
+		return
+	}
+
+	var res Resource
+	if r.resolver != nil {
+		res = r.resolver(ctx, fullMethod, req)
+	}
+	if !r.authorizer.Authorize(user, perm, res) {
+		err = status.Error(codes.PermissionDenied,
+			fmt.Sprintf("user %q does not have the %q permission of store %q suite %q", user.Name, perm, res.Store, res.Suite))
+	}
+	return
+}
+
+type rbacServerStream struct {
+	grpc.ServerStream
+	inter      *rbacInter
+	fullMethod string
+}
+
+// RecvMsg receives a message then checks the permission against it
+func (s *rbacServerStream) RecvMsg(m interface{}) (err error) {
+	if err = s.ServerStream.RecvMsg(m); err == nil {
+		err = s.inter.check(s.Context(), s.fullMethod, m)
+	}
+	return
+}
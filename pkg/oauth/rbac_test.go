@@ -0,0 +1,206 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package oauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linuxsuren/oauth-hub"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRole(t *testing.T) {
+	assert.Equal(t, 4, len(GetRoles()))
+	assert.True(t, RoleViewer.IsValid())
+	assert.False(t, Role("fake").IsValid())
+
+	assert.True(t, RoleViewer.Has(PermissionRead))
+	assert.False(t, RoleViewer.Has(PermissionRun))
+	assert.True(t, RoleRunner.Has(PermissionRun))
+	assert.False(t, RoleRunner.Has(PermissionWrite))
+	assert.True(t, RoleEditor.Has(PermissionWrite))
+	assert.False(t, RoleEditor.Has(PermissionAdmin))
+	assert.True(t, RoleAdmin.Has(PermissionAdmin))
+	assert.True(t, Role("fake").Has(PermissionNone))
+}
+
+func TestPolicyManager(t *testing.T) {
+	manager := NewPolicyManager(t.TempDir(), "root")
+
+	bindings, err := manager.GetRoleBindings()
+	assert.NoError(t, err)
+	assert.Empty(t, bindings)
+
+	assert.Error(t, manager.CreateRoleBinding(RoleBinding{}))
+	assert.Error(t, manager.CreateRoleBinding(RoleBinding{Name: "fake", Role: "fake"}))
+	assert.Error(t, manager.CreateRoleBinding(RoleBinding{Name: "fake", Role: RoleViewer}))
+
+	assert.NoError(t, manager.CreateRoleBinding(RoleBinding{
+		Name:   "viewers",
+		Role:   RoleViewer,
+		Groups: []string{"dev"},
+	}))
+	assert.NoError(t, manager.CreateRoleBinding(RoleBinding{
+		Name:  "suite-editor",
+		Role:  RoleEditor,
+		Users: []string{"alice"},
+		Store: "local",
+		Suite: "orders",
+	}))
+	assert.Error(t, manager.CreateRoleBinding(RoleBinding{
+		Name:  "viewers",
+		Role:  RoleViewer,
+		Users: []string{"bob"},
+	}))
+
+	bindings, err = manager.GetRoleBindings()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(bindings))
+
+	alice := &oauth.UserInfo{Name: "alice"}
+	bob := &oauth.UserInfo{Name: "bob", Groups: []string{"dev"}}
+	orders := Resource{Store: "local", Suite: "orders"}
+	users := Resource{Store: "local", Suite: "users"}
+
+	assert.False(t, manager.Authorize(nil, PermissionRead, orders))
+	assert.True(t, manager.Authorize(&oauth.UserInfo{Name: "root"}, PermissionAdmin, orders))
+	assert.True(t, manager.Authorize(alice, PermissionWrite, orders))
+	assert.False(t, manager.Authorize(alice, PermissionAdmin, orders))
+	assert.False(t, manager.Authorize(alice, PermissionRead, users))
+	assert.True(t, manager.Authorize(bob, PermissionRead, users))
+	assert.False(t, manager.Authorize(bob, PermissionRun, users))
+	assert.True(t, manager.Authorize(bob, PermissionNone, users))
+
+	t.Run("store owner", func(t *testing.T) {
+		manager.WithStoreOwnerGetter(func(store string) string {
+			if store == "orm" {
+				return "bob"
+			}
+			return ""
+		})
+		assert.True(t, manager.Authorize(bob, PermissionAdmin, Resource{Store: "orm"}))
+		assert.False(t, manager.Authorize(bob, PermissionAdmin, Resource{Store: "local"}))
+	})
+
+	assert.Error(t, manager.UpdateRoleBinding(RoleBinding{Name: "fake", Role: RoleAdmin, Users: []string{"bob"}}))
+	assert.NoError(t, manager.UpdateRoleBinding(RoleBinding{Name: "viewers", Role: RoleRunner, Groups: []string{"dev"}}))
+	assert.True(t, manager.Authorize(bob, PermissionRun, users))
+	assert.ErrorContains(t, manager.UpdateRoleBinding(RoleBinding{Name: "suite-editor", Role: RoleEditor, Users: []string{"alice"}}),
+		"cannot be changed")
+
+	binding, err := manager.GetRoleBinding("suite-editor")
+	assert.NoError(t, err)
+	if assert.NotNil(t, binding) {
+		assert.Equal(t, "orders", binding.Suite)
+	}
+	binding, err = manager.GetRoleBinding("fake")
+	assert.NoError(t, err)
+	assert.Nil(t, binding)
+
+	assert.NoError(t, manager.DeleteRoleBinding("viewers"))
+	assert.NoError(t, manager.DeleteRoleBinding("fake"))
+	assert.False(t, manager.Authorize(bob, PermissionRead, users))
+}
+
+func TestRBACInterceptors(t *testing.T) {
+	manager := NewPolicyManager(t.TempDir())
+	assert.NoError(t, manager.CreateRoleBinding(RoleBinding{
+		Name:  "runner",
+		Role:  RoleRunner,
+		Users: []string{"alice"},
+		Suite: "orders",
+	}))
+	oauth.SetUser("alice-token", &oauth.UserInfo{Name: "alice"})
+
+	inter := &rbacInter{
+		authorizer: manager,
+		permissions: map[string]Permission{
+			"/server.Runner/GetVersion":   PermissionNone,
+			"/server.Runner/RunTestCase":  PermissionRun,
+			"/server.Runner/DeleteSuite":  PermissionWrite,
+			"/server.Runner/RunTestSuite": PermissionRun,
+		},
+		resolver: func(ctx context.Context, fullMethod string, req interface{}) Resource {
+			var suite string
+			switch v := req.(type) {
+			case string:
+				suite = v
+			case *string:
+				suite = *v
+			}
+			return Resource{Store: "local", Suite: suite}
+		},
+	}
+	assert.Equal(t, 2, len(NewRBACInterceptors(manager, nil, nil)))
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs("auth", "alice-token"))
+
+	_, err := inter.unaryInterceptor(context.TODO(), "orders", &grpc.UnaryServerInfo{FullMethod: "/server.Runner/GetVersion"}, handler)
+	assert.NoError(t, err)
+
+	_, err = inter.unaryInterceptor(context.TODO(), "orders", &grpc.UnaryServerInfo{FullMethod: "/server.Runner/RunTestCase"}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	resp, err := inter.unaryInterceptor(ctx, "orders", &grpc.UnaryServerInfo{FullMethod: "/server.Runner/RunTestCase"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = inter.unaryInterceptor(ctx, "users", &grpc.UnaryServerInfo{FullMethod: "/server.Runner/RunTestCase"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = inter.unaryInterceptor(ctx, "orders", &grpc.UnaryServerInfo{FullMethod: "/server.Runner/DeleteSuite"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = inter.unaryInterceptor(ctx, "orders", &grpc.UnaryServerInfo{FullMethod: "/server.Runner/Unknown"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	t.Run("stream", func(t *testing.T) {
+		streamHandler := func(srv interface{}, stream grpc.ServerStream) error {
+			var msg string
+			return stream.RecvMsg(&msg)
+		}
+		info := &grpc.StreamServerInfo{FullMethod: "/server.Runner/RunTestSuite"}
+
+		err := inter.streamInterceptor(nil, &fakeServerStream{ctx: ctx, msg: "orders"}, info, streamHandler)
+		assert.NoError(t, err)
+
+		err = inter.streamInterceptor(nil, &fakeServerStream{ctx: ctx, msg: "users"}, info, streamHandler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	msg string
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	*m.(*string) = s.msg
+	return nil
+}
@@ -0,0 +1,561 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"context"
+	_ "embed"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFakerLocale is the locale of the fake data when there is no one specified
+const DefaultFakerLocale = "en_US"
+
+//go:embed data/faker.yaml
+var fakerData []byte
+
+type fakerLocale struct {
+	Name            string   `yaml:"-"`
+	NameFormat      string   `yaml:"nameFormat"`
+	FirstNames      []string `yaml:"firstNames"`
+	LastNames       []string `yaml:"lastNames"`
+	AddressFormat   string   `yaml:"addressFormat"`
+	Streets         []string `yaml:"streets"`
+	Cities          []string `yaml:"cities"`
+	States          []string `yaml:"states"`
+	PostcodeFormat  string   `yaml:"postcodeFormat"`
+	PhoneFormats    []string `yaml:"phoneFormats"`
+	CompanyFormat   string   `yaml:"companyFormat"`
+	CompanyWords    []string `yaml:"companyWords"`
+	CompanySuffixes []string `yaml:"companySuffixes"`
+	IBANCountry     string   `yaml:"ibanCountry"`
+}
+
+var faker = struct {
+	sync.RWMutex
+	locales map[string]*fakerLocale
+	locale  string
+}{
+	locale: DefaultFakerLocale,
+}
+
+func init() {
+	if err := yaml.Unmarshal(fakerData, &faker.locales); err != nil {
+		panic(err)
+	}
+	for name, locale := range faker.locales {
+		locale.Name = name
+	}
+	advancedFuncs = append(advancedFuncs, fakerFuncs...)
+}
+
+// SetFakerLocale sets the default locale of the fake data, such as: en_US, zh_CN, de_DE
+func SetFakerLocale(locale string) (err error) {
+	locale = normalizeLocale(locale)
+	if _, ok := faker.locales[locale]; !ok {
+		err = fmt.Errorf("not supported locale: %q, the supported ones are: %s", locale, strings.Join(GetFakerLocales(), ", "))
+		return
+	}
+
+	faker.Lock()
+	defer faker.Unlock()
+	faker.locale = locale
+	return
+}
+
+// GetFakerLocales returns all the supported locales of the fake data
+func GetFakerLocales() (locales []string) {
+	for locale := range faker.locales {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.TrimSpace(locale), "-", "_")
+}
+
+// getFakerLocale returns the first given locale, or the default one
+func getFakerLocale(locales ...string) (data *fakerLocale, err error) {
+	faker.RLock()
+	locale := faker.locale
+	faker.RUnlock()
+	if len(locales) > 0 && locales[0] != "" {
+		locale = normalizeLocale(locales[0])
+	}
+
+	var ok bool
+	if data, ok = faker.locales[locale]; !ok {
+		err = fmt.Errorf("not supported locale: %q", locale)
+	}
+	return
+}
+
+// fakeFormat replaces the placeholders of the format, a '#' is a random digit, and a '?' is a random upper letter
+func fakeFormat(format string, values map[string]string) string {
+	for key, val := range values {
+		format = strings.ReplaceAll(format, "{"+key+"}", val)
+	}
+
+	builder := strings.Builder{}
+	for _, c := range format {
+		switch c {
+		case '#':
+			builder.WriteByte(numeric[util.Intn(len(numeric))])
+		case '?':
+			builder.WriteByte(alphabetic[26+util.Intn(26)])
+		default:
+			builder.WriteRune(c)
+		}
+	}
+	return builder.String()
+}
+
+func fakeName(locales ...string) (name string, err error) {
+	var data *fakerLocale
+	if data, err = getFakerLocale(locales...); err == nil {
+		name = fakeFormat(data.NameFormat, map[string]string{
+			"first": randItem(data.FirstNames...),
+			"last":  randItem(data.LastNames...),
+		})
+	}
+	return
+}
+
+func fakeFirstName(locales ...string) (name string, err error) {
+	var data *fakerLocale
+	if data, err = getFakerLocale(locales...); err == nil {
+		name = randItem(data.FirstNames...)
+	}
+	return
+}
+
+func fakeLastName(locales ...string) (name string, err error) {
+	var data *fakerLocale
+	if data, err = getFakerLocale(locales...); err == nil {
+		name = randItem(data.LastNames...)
+	}
+	return
+}
+
+func fakeAddress(locales ...string) (address string, err error) {
+	var data *fakerLocale
+	if data, err = getFakerLocale(locales...); err == nil {
+		address = fakeFormat(data.AddressFormat, map[string]string{
+			"number":   strconv.Itoa(util.Intn(999) + 1),
+			"street":   randItem(data.Streets...),
+			"city":     randItem(data.Cities...),
+			"state":    randItem(data.States...),
+			"postcode": data.PostcodeFormat,
+		})
+	}
+	return
+}
+
+func fakeStreet(locales ...string) (street string, err error) {
+	var data *fakerLocale
+	if data, err = getFakerLocale(locales...); err == nil {
+		street = randItem(data.Streets...)
+	}
+	return
+}
+
+func fakeCity(locales ...string) (city string, err error) {
+	var data *fakerLocale
+	if data, err = getFakerLocale(locales...); err == nil {
+		city = randItem(data.Cities...)
+	}
+	return
+}
+
+func fakePostcode(locales ...string) (postcode string, err error) {
+	var data *fakerLocale
+	if data, err = getFakerLocale(locales...); err == nil {
+		postcode = fakeFormat(data.PostcodeFormat, nil)
+	}
+	return
+}
+
+func fakePhone(locales ...string) (phone string, err error) {
+	var data *fakerLocale
+	if data, err = getFakerLocale(locales...); err == nil {
+		phone = fakeFormat(randItem(data.PhoneFormats...), nil)
+	}
+	return
+}
+
+func fakeCompany(locales ...string) (company string, err error) {
+	var data *fakerLocale
+	if data, err = getFakerLocale(locales...); err == nil {
+		company = fakeFormat(data.CompanyFormat, map[string]string{
+			"last":   randItem(data.LastNames...),
+			"city":   randItem(data.Cities...),
+			"word":   randItem(data.CompanyWords...),
+			"suffix": randItem(data.CompanySuffixes...),
+		})
+	}
+	return
+}
+
+// ibanFormats are the formats of the Basic Bank Account Number of the countries
+var ibanFormats = map[string]string{
+	"DE": "##################",
+	"ES": "####################",
+	"FR": "#######################",
+	"GB": "????##############",
+	"NL": "????##########",
+}
+
+// fakeIBAN returns an IBAN with the valid check digits, the country is from the locale by default
+func fakeIBAN(countries ...string) (iban string, err error) {
+	var country string
+	if len(countries) > 0 && countries[0] != "" {
+		country = strings.ToUpper(countries[0])
+	} else {
+		var data *fakerLocale
+		if data, err = getFakerLocale(); err != nil {
+			return
+		}
+		country = data.IBANCountry
+	}
+
+	format, ok := ibanFormats[country]
+	if !ok {
+		err = fmt.Errorf("not supported IBAN country: %q", country)
+		return
+	}
+
+	bban := fakeFormat(format, nil)
+	iban = fmt.Sprintf("%s%02d%s", country, 98-ibanMod97(bban+country+"00"), bban)
+	return
+}
+
+// ibanMod97 returns the remainder of the number which converts the letters to 10-35
+func ibanMod97(text string) (mod int) {
+	for _, c := range text {
+		if c >= 'A' && c <= 'Z' {
+			mod = (mod*100 + int(c-'A') + 10) % 97
+		} else {
+			mod = (mod*10 + int(c-'0')) % 97
+		}
+	}
+	return
+}
+
+var creditCardPrefixes = map[string]struct {
+	prefixes []string
+	length   int
+}{
+	"visa":       {prefixes: []string{"4"}, length: 16},
+	"mastercard": {prefixes: []string{"51", "52", "53", "54", "55"}, length: 16},
+	"amex":       {prefixes: []string{"34", "37"}, length: 15},
+}
+
+// fakeCreditCard returns a Luhn-valid card number, the brand could be visa (default), mastercard or amex
+func fakeCreditCard(brands ...string) (number string, err error) {
+	brand := "visa"
+	if len(brands) > 0 && brands[0] != "" {
+		brand = strings.ToLower(brands[0])
+	}
+
+	card, ok := creditCardPrefixes[brand]
+	if !ok {
+		err = fmt.Errorf("not supported credit card brand: %q", brand)
+		return
+	}
+
+	prefix := randItem(card.prefixes...)
+	number = prefix + fakeFormat(strings.Repeat("#", card.length-len(prefix)-1), nil)
+	number += strconv.Itoa(luhnCheckDigit(number))
+	return
+}
+
+// luhnCheckDigit returns the check digit which makes the number Luhn-valid
+func luhnCheckDigit(number string) int {
+	sum := 0
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if (len(number)-i)%2 == 1 {
+			if digit *= 2; digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return (10 - sum%10) % 10
+}
+
+// fakeNationalID returns a country-specific ID: the SSN of en_US, the resident ID of zh_CN, and the tax ID of de_DE
+func fakeNationalID(locales ...string) (id string, err error) {
+	var data *fakerLocale
+	if data, err = getFakerLocale(locales...); err != nil {
+		return
+	}
+
+	switch data.Name {
+	case "zh_CN":
+		id = fakeChineseResidentID()
+	case "de_DE":
+		id = fakeGermanTaxID()
+	default:
+		// the area number cannot be 000, 666 or 900-999, and the group and serial cannot be zeros
+		id = fmt.Sprintf("%03d-%02d-%04d", randItem(util.Intn(665)+1, util.Intn(233)+667), util.Intn(99)+1, util.Intn(9999)+1)
+	}
+	return
+}
+
+var chineseAreaCodes = []string{"110101", "310104", "320102", "330106", "440106", "510104", "420102", "610103"}
+
+// fakeChineseResidentID returns an 18 digits ID with the ISO 7064 MOD 11-2 check character
+func fakeChineseResidentID() string {
+	birthday := time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, util.Intn(365*55))
+	id := randItem(chineseAreaCodes...) + birthday.Format("20060102") + fakeFormat("###", nil)
+	return id + string(chineseIDCheckChar(id))
+}
+
+func chineseIDCheckChar(id string) byte {
+	weights := []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+	sum := 0
+	for i, weight := range weights {
+		sum += int(id[i]-'0') * weight
+	}
+	return "10X98765432"[sum%11]
+}
+
+// fakeGermanTaxID returns an 11 digits ID, exactly one digit of the first ten appears twice,
+// and the last one is the ISO 7064 MOD 11,10 check digit
+func fakeGermanTaxID() string {
+	digits := util.Perm(10)[:9]
+	if digits[0] == 0 {
+		digits[0], digits[1] = digits[1], digits[0]
+	}
+	position := util.Intn(9) + 1
+	digits = append(digits[:position], append([]int{digits[util.Intn(9)]}, digits[position:]...)...)
+
+	builder := strings.Builder{}
+	for _, digit := range digits {
+		builder.WriteString(strconv.Itoa(digit))
+	}
+	return builder.String() + strconv.Itoa(germanTaxIDCheckDigit(builder.String()))
+}
+
+func germanTaxIDCheckDigit(id string) (check int) {
+	product := 10
+	for _, c := range id {
+		sum := (int(c-'0') + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = (sum * 2) % 11
+	}
+	if check = 11 - product; check == 10 {
+		check = 0
+	}
+	return
+}
+
+// fakeDate returns a date between from and to, the layout is 2006-01-02 by default
+func fakeDate(from, to string, layouts ...string) (date string, err error) {
+	layout := "2006-01-02"
+	if len(layouts) > 0 && layouts[0] != "" {
+		layout = layouts[0]
+	}
+
+	var start, end time.Time
+	if start, err = time.Parse(layout, from); err != nil {
+		return
+	}
+	if end, err = time.Parse(layout, to); err != nil {
+		return
+	}
+	if end.Before(start) {
+		err = fmt.Errorf("the end date %q is before the start date %q", to, from)
+		return
+	}
+
+	offset := time.Duration(0)
+	if span := end.Sub(start); span > 0 {
+		offset = time.Duration(util.Int63() % int64(span+1))
+	}
+	date = start.Add(offset).Format(layout)
+	return
+}
+
+// uuidv7 returns a version 7 UUID, it starts with the Unix timestamp in milliseconds
+func uuidv7() string {
+	var id [16]byte
+	binary.BigEndian.PutUint64(id[:8], uint64(time.Now().UnixMilli())<<16)
+	_, _ = util.RandReader.Read(id[6:])
+	id[6] = (id[6] & 0x0f) | 0x70
+	id[8] = (id[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:])
+}
+
+const crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ulid returns a Universally Unique Lexicographically Sortable Identifier,
+// it has a 48 bits timestamp in milliseconds and 80 random bits
+func ulid() string {
+	var id [16]byte
+	binary.BigEndian.PutUint64(id[:8], uint64(time.Now().UnixMilli())<<16)
+	_, _ = util.RandReader.Read(id[6:])
+
+	// encode the 128 bits as 26 characters, the first one takes 3 bits only
+	high, low := binary.BigEndian.Uint64(id[:8]), binary.BigEndian.Uint64(id[8:])
+	result := make([]byte, 26)
+	for i := 25; i >= 0; i-- {
+		result[i] = crockfordBase32[low&0x1f]
+		low = low>>5 | high<<59
+		high >>= 5
+	}
+	return string(result)
+}
+
+var loremWords = strings.Fields(`lorem ipsum dolor sit amet consectetur adipiscing elit sed do eiusmod tempor
+	incididunt ut labore et dolore magna aliqua enim ad minim veniam quis nostrud exercitation ullamco laboris
+	nisi aliquip ex ea commodo consequat duis aute irure in reprehenderit voluptate velit esse cillum fugiat
+	nulla pariatur excepteur sint occaecat cupidatat non proident sunt culpa qui officia deserunt mollit anim id est laborum`)
+
+func fakeLoremWords(count int) string {
+	words := make([]string, count)
+	for i := range words {
+		words[i] = randItem(loremWords...)
+	}
+	return strings.Join(words, " ")
+}
+
+func fakeLoremSentence(count int) string {
+	sentence := fakeLoremWords(count)
+	if sentence == "" {
+		return sentence
+	}
+	return strings.ToUpper(sentence[:1]) + sentence[1:] + "."
+}
+
+func fakeLoremParagraph(count int) string {
+	sentences := make([]string, count)
+	for i := range sentences {
+		sentences[i] = fakeLoremSentence(util.Intn(8) + 5)
+	}
+	return strings.Join(sentences, " ")
+}
+
+// fakeGenerator writes the template of a function, the fields are the arguments which are separated by commas
+func fakeGenerator(funcName string, quoted bool) func(ctx context.Context, fields string) error {
+	return func(ctx context.Context, fields string) (err error) {
+		funcExp := "{{" + funcName
+		for _, item := range strings.Split(fields, ",") {
+			if item = strings.TrimSpace(item); item == "" {
+				continue
+			}
+			if quoted {
+				item = strconv.Quote(item)
+			}
+			funcExp += " " + item
+		}
+		writeWithContext(ctx, funcExp+"}}")
+		return
+	}
+}
+
+var fakerFuncs = []AdvancedFunc{{
+	FuncName:   "fakeName",
+	Func:       fakeName,
+	GoDogExper: `^随机姓名(.*)$`,
+	Generator:  fakeGenerator("fakeName", true),
+}, {
+	FuncName: "fakeFirstName",
+	Func:     fakeFirstName,
+}, {
+	FuncName: "fakeLastName",
+	Func:     fakeLastName,
+}, {
+	FuncName:   "fakeAddress",
+	Func:       fakeAddress,
+	GoDogExper: `^随机地址(.*)$`,
+	Generator:  fakeGenerator("fakeAddress", true),
+}, {
+	FuncName: "fakeStreet",
+	Func:     fakeStreet,
+}, {
+	FuncName: "fakeCity",
+	Func:     fakeCity,
+}, {
+	FuncName: "fakePostcode",
+	Func:     fakePostcode,
+}, {
+	FuncName:   "fakePhone",
+	Func:       fakePhone,
+	GoDogExper: `^随机电话(.*)$`,
+	Generator:  fakeGenerator("fakePhone", true),
+}, {
+	FuncName:   "fakeCompany",
+	Func:       fakeCompany,
+	GoDogExper: `^随机公司名称(.*)$`,
+	Generator:  fakeGenerator("fakeCompany", true),
+}, {
+	FuncName:   "fakeIBAN",
+	Func:       fakeIBAN,
+	GoDogExper: `^随机IBAN(.*)$`,
+	Generator:  fakeGenerator("fakeIBAN", true),
+}, {
+	FuncName:   "fakeCreditCard",
+	Func:       fakeCreditCard,
+	GoDogExper: `^随机信用卡号(.*)$`,
+	Generator:  fakeGenerator("fakeCreditCard", true),
+}, {
+	FuncName:   "fakeNationalID",
+	Func:       fakeNationalID,
+	GoDogExper: `^随机身份证号(.*)$`,
+	Generator:  fakeGenerator("fakeNationalID", true),
+}, {
+	FuncName:   "fakeDate",
+	Func:       fakeDate,
+	GoDogExper: `^随机日期，范围 (.*)$`,
+	Generator:  fakeGenerator("fakeDate", true),
+}, {
+	FuncName:   "uuidv7",
+	Func:       uuidv7,
+	GoDogExper: `^随机UUIDv7(.*)$`,
+	Generator:  fakeGenerator("uuidv7", true),
+}, {
+	FuncName:   "ulid",
+	Func:       ulid,
+	GoDogExper: `^随机ULID(.*)$`,
+	Generator:  fakeGenerator("ulid", true),
+}, {
+	FuncName:   "loremWords",
+	Func:       fakeLoremWords,
+	GoDogExper: `^随机文本，单词数 (.*)$`,
+	Generator:  fakeGenerator("loremWords", false),
+}, {
+	FuncName: "loremSentence",
+	Func:     fakeLoremSentence,
+}, {
+	FuncName: "loremParagraph",
+	Func:     fakeLoremParagraph,
+}}
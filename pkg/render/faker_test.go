@@ -0,0 +1,241 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakerLocale(t *testing.T) {
+	assert.Equal(t, []string{"de_DE", "en_US", "zh_CN"}, GetFakerLocales())
+	assert.Error(t, SetFakerLocale("fake"))
+
+	defer func() {
+		assert.NoError(t, SetFakerLocale(DefaultFakerLocale))
+	}()
+	assert.NoError(t, SetFakerLocale("zh-CN"))
+	name, err := fakeName()
+	assert.NoError(t, err)
+	assert.Regexp(t, `^\p{Han}+$`, name)
+
+	name, err = fakeName("de_DE")
+	assert.NoError(t, err)
+	assert.Contains(t, name, " ")
+
+	_, err = fakeAddress("fake")
+	assert.Error(t, err)
+}
+
+func TestFakerFuncs(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		verify func(t *testing.T, result string)
+	}{{
+		name: "fakeName",
+		text: `{{fakeName}}`,
+		verify: func(t *testing.T, result string) {
+			assert.Regexp(t, `^[A-Za-z]+ [A-Za-z]+$`, result)
+		},
+	}, {
+		name: "fakeAddress",
+		text: `{{fakeAddress "zh_CN"}}`,
+		verify: func(t *testing.T, result string) {
+			assert.Regexp(t, `^\p{Han}+省\p{Han}+市\p{Han}+路\d+号$`, result)
+		},
+	}, {
+		name: "fakePostcode",
+		text: `{{fakePostcode "de_DE"}}`,
+		verify: func(t *testing.T, result string) {
+			assert.Regexp(t, `^\d{5}$`, result)
+		},
+	}, {
+		name: "fakePhone",
+		text: `{{fakePhone "zh_CN"}}`,
+		verify: func(t *testing.T, result string) {
+			assert.Regexp(t, `^1[3578]\d{9}$`, result)
+		},
+	}, {
+		name: "fakeCompany",
+		text: `{{fakeCompany "de_DE"}}`,
+		verify: func(t *testing.T, result string) {
+			assert.Regexp(t, `(GmbH|AG|KG)$`, result)
+		},
+	}, {
+		name: "fakeIBAN",
+		text: `{{fakeIBAN}} {{fakeIBAN "NL"}} {{fakeIBAN "DE"}}`,
+		verify: func(t *testing.T, result string) {
+			for _, iban := range strings.Fields(result) {
+				assert.Equal(t, 1, ibanMod97(iban[4:]+iban[:4]), iban)
+			}
+			assert.Regexp(t, `^GB\d{2}[A-Z]{4}\d{14} NL\d{2}[A-Z]{4}\d{10} DE\d{20}$`, result)
+		},
+	}, {
+		name: "fakeCreditCard",
+		text: `{{fakeCreditCard}} {{fakeCreditCard "mastercard"}} {{fakeCreditCard "amex"}}`,
+		verify: func(t *testing.T, result string) {
+			cards := strings.Fields(result)
+			for _, card := range cards {
+				assert.Equal(t, int(card[len(card)-1]-'0'), luhnCheckDigit(card[:len(card)-1]), card)
+			}
+			assert.Regexp(t, `^4\d{15}$`, cards[0])
+			assert.Regexp(t, `^5[1-5]\d{14}$`, cards[1])
+			assert.Regexp(t, `^3[47]\d{13}$`, cards[2])
+		},
+	}, {
+		name: "fakeNationalID",
+		text: `{{fakeNationalID}} {{fakeNationalID "zh_CN"}} {{fakeNationalID "de_DE"}}`,
+		verify: func(t *testing.T, result string) {
+			ids := strings.Fields(result)
+			assert.Regexp(t, `^\d{3}-\d{2}-\d{4}$`, ids[0])
+			assert.Regexp(t, `^\d{17}[\dX]$`, ids[1])
+			assert.Regexp(t, `^[1-9]\d{10}$`, ids[2])
+		},
+	}, {
+		name: "fakeDate",
+		text: `{{fakeDate "2020-01-01" "2020-01-31"}}`,
+		verify: func(t *testing.T, result string) {
+			assert.Regexp(t, `^2020-01-[0-3]\d$`, result)
+		},
+	}, {
+		name: "uuidv7",
+		text: `{{uuidv7}}`,
+		verify: func(t *testing.T, result string) {
+			assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, result)
+		},
+	}, {
+		name: "ulid",
+		text: `{{ulid}}`,
+		verify: func(t *testing.T, result string) {
+			assert.Regexp(t, `^[0-7][0-9A-HJKMNP-TV-Z]{25}$`, result)
+		},
+	}, {
+		name: "lorem",
+		text: `{{loremWords 3}}|{{loremSentence 4}}|{{loremParagraph 2}}`,
+		verify: func(t *testing.T, result string) {
+			items := strings.Split(result, "|")
+			assert.Equal(t, 3, len(strings.Fields(items[0])))
+			assert.Regexp(t, `^[A-Z][a-z]*( [a-z]+){3}\.$`, items[1])
+			assert.Equal(t, 2, strings.Count(items[2], "."))
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Render(tt.name, tt.text, nil)
+			assert.NoError(t, err)
+			tt.verify(t, result)
+		})
+	}
+}
+
+func TestFakerWithSeed(t *testing.T) {
+	text := `{{fakeName}} {{fakeAddress}} {{fakeIBAN}} {{fakeCreditCard}} {{fakeNationalID "zh_CN"}}`
+
+	util.SetSeed(1024)
+	first, err := Render("seed", text, nil)
+	assert.NoError(t, err)
+
+	util.SetSeed(1024)
+	second, err := Render("seed", text, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestFakeDate(t *testing.T) {
+	date, err := fakeDate("2020-01-01", "2020-01-01")
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-01-01", date)
+
+	date, err = fakeDate("01/02/2020", "01/03/2020", "01/02/2006")
+	assert.NoError(t, err)
+	assert.Regexp(t, `^01/0[23]/2020$`, date)
+
+	_, err = fakeDate("2020-02-01", "2020-01-01")
+	assert.Error(t, err)
+
+	_, err = fakeDate("invalid", "2020-01-01")
+	assert.Error(t, err)
+
+	_, err = fakeDate("2020-01-01", "invalid")
+	assert.Error(t, err)
+}
+
+func TestCheckDigits(t *testing.T) {
+	// the well-known samples
+	assert.Equal(t, 1, ibanMod97("WEST12345698765432"+"GB82"))
+	assert.Equal(t, 3, luhnCheckDigit("7992739871"))
+	assert.Equal(t, byte('X'), chineseIDCheckChar("11010519491231002"))
+	assert.Equal(t, 9, germanTaxIDCheckDigit("8609574271"))
+
+	id := fakeChineseResidentID()
+	assert.Equal(t, chineseIDCheckChar(id), id[17])
+
+	taxID := fakeGermanTaxID()
+	assert.Equal(t, strconv.Itoa(germanTaxIDCheckDigit(taxID[:10])), taxID[10:])
+	counts := map[rune]int{}
+	for _, c := range taxID[:10] {
+		counts[c]++
+	}
+	assert.Equal(t, 9, len(counts), taxID)
+
+	_, err := fakeIBAN("US")
+	assert.Error(t, err)
+	_, err = fakeCreditCard("fake")
+	assert.Error(t, err)
+}
+
+func TestFakerGenerator(t *testing.T) {
+	tests := []struct {
+		funcName string
+		fields   string
+		expect   string
+	}{{
+		funcName: "fakeName",
+		expect:   `{{fakeName}}`,
+	}, {
+		funcName: "fakeAddress",
+		fields:   " zh_CN",
+		expect:   `{{fakeAddress "zh_CN"}}`,
+	}, {
+		funcName: "fakeDate",
+		fields:   "2020-01-01, 2024-12-31",
+		expect:   `{{fakeDate "2020-01-01" "2024-12-31"}}`,
+	}, {
+		funcName: "loremWords",
+		fields:   "5",
+		expect:   `{{loremWords 5}}`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.funcName, func(t *testing.T) {
+			for _, f := range GetAdvancedFuncs() {
+				if f.FuncName == tt.funcName {
+					buf := new(bytes.Buffer)
+					err := f.Generator(context.WithValue(context.Background(), ContextBufferKey, buf), tt.fields)
+					assert.NoError(t, err)
+					assert.Equal(t, tt.expect, buf.String())
+				}
+			}
+		})
+	}
+}
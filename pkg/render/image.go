@@ -23,8 +23,6 @@ import (
 	"image/color"
 	"image/png"
 	"math"
-	"math/rand"
-	"time"
 
 	"github.com/linuxsuren/api-testing/pkg/util"
 )
@@ -46,13 +44,11 @@ func generateRandomImage(width, height int) (data string, err error) {
 
 	img := image.NewRGBA(image.Rect(0, 0, width, height))
 	blockSize := int(math.Max(float64(width), float64(height)) / 4)
-	rand.Seed(time.Now().UnixNano())
-
 	for y := 0; y < height; y += blockSize {
 		for x := 0; x < width; x += blockSize {
-			r := uint8(rand.Intn(255))
-			g := uint8(rand.Intn(255))
-			b := uint8(rand.Intn(255))
+			r := uint8(util.Intn(255))
+			g := uint8(util.Intn(255))
+			b := uint8(util.Intn(255))
 			col := color.RGBA{R: r, G: g, B: b, A: 255}
 
 			for iy := y; iy < y+blockSize && iy < height; iy++ {
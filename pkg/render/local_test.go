@@ -24,5 +24,5 @@ import (
 func TestReadFile(t *testing.T) {
 	data, err := readFile("data/templateUsage.yaml")
 	assert.Nil(t, err)
-	assert.Equal(t, "data:application/octet-stream;base64,cmFuZEltYWdlOiB8CiAge3sgcmFuZEltYWdlIDEwMCAxMDAgfX0KcmFuZEFzY2lpOiB8CiAge3sgcmFuZEFzY2lpIDUgfX0KcmFuZFBkZjogfAogIHt7IHJhbmRQZGYgImNvbnRlbnQiIH19CnJhbmRaaXA6IHwKICB7eyByYW5kWmlwIDUgfX0K", data)
+	assert.Equal(t, "data:application/octet-stream;base64,cmFuZEltYWdlOiB8CiAge3sgcmFuZEltYWdlIDEwMCAxMDAgfX0KcmFuZEFzY2lpOiB8CiAge3sgcmFuZEFzY2lpIDUgfX0KcmFuZFBkZjogfAogIHt7IHJhbmRQZGYgImNvbnRlbnQiIH19CnJhbmRaaXA6IHwKICB7eyByYW5kWmlwIDUgfX0Kand0U2lnbjogfAogIHt7IGp3dFNpZ24gIkhTMjU2IiAoZGljdCAic3ViIiAicmljayIgImV4cCIgMTg5MzQ1NjAwMCkgInNlY3JldCIgfX0KaG1hY1NpZ246IHwKICB7eyBobWFjU2lnbiAic2hhMjU2IiAic2VjcmV0IiAiY29udGVudCIgfX0KZmFrZU5hbWU6IHwKICB7eyBmYWtlTmFtZSB9fSBvciB7eyBmYWtlTmFtZSAiemhfQ04iIH19CmZha2VBZGRyZXNzOiB8CiAge3sgZmFrZUFkZHJlc3MgImRlX0RFIiB9fQpmYWtlSUJBTjogfAogIHt7IGZha2VJQkFOICJERSIgfX0KZmFrZUNyZWRpdENhcmQ6IHwKICB7eyBmYWtlQ3JlZGl0Q2FyZCAibWFzdGVyY2FyZCIgfX0KZmFrZU5hdGlvbmFsSUQ6IHwKICB7eyBmYWtlTmF0aW9uYWxJRCAiemhfQ04iIH19CmZha2VEYXRlOiB8CiAge3sgZmFrZURhdGUgIjIwMjAtMDEtMDEiICIyMDI0LTEyLTMxIiB9fQpsb3JlbVBhcmFncmFwaDogfAogIHt7IGxvcmVtUGFyYWdyYXBoIDMgfX0K", data)
 }
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"encoding/base64"
+	"fmt"
+	"text/template"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+const (
+	alphabetic   = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	numeric      = "0123456789"
+	alphanumeric = alphabetic + numeric
+)
+
+// seededFuncs overrides the crypto-backed random functions of sprig, such as: uuidv4,
+// all of them take the shared random source, so that the same seed renders the same data
+func seededFuncs() template.FuncMap {
+	return template.FuncMap{
+		"randAlphaNum": func(count int) string {
+			return randString(count, alphanumeric)
+		},
+		"randAlpha": func(count int) string {
+			return randString(count, alphabetic)
+		},
+		"randNumeric": func(count int) string {
+			return randString(count, numeric)
+		},
+		"randAscii": func(count int) string {
+			chars := make([]byte, 0, '~'-' '+1)
+			for c := byte(' '); c <= '~'; c++ {
+				chars = append(chars, c)
+			}
+			return randString(count, string(chars))
+		},
+		"randBytes": func(count int) (string, error) {
+			buf := make([]byte, count)
+			if _, err := util.RandReader.Read(buf); err != nil {
+				return "", err
+			}
+			return base64.StdEncoding.EncodeToString(buf), nil
+		},
+		"randInt": func(min, max int) int {
+			return util.Intn(max-min) + min
+		},
+		"shuffle": func(text string) string {
+			runes := []rune(text)
+			result := make([]rune, len(runes))
+			for i, j := range util.Perm(len(runes)) {
+				result[i] = runes[j]
+			}
+			return string(result)
+		},
+		"uuidv4": uuidv4,
+	}
+}
+
+func randString(count int, chars string) string {
+	result := make([]byte, count)
+	for i := range result {
+		result[i] = chars[util.Intn(len(chars))]
+	}
+	return string(result)
+}
+
+// uuidv4 returns a version 4 UUID which is generated from the shared random source
+func uuidv4() string {
+	var id [16]byte
+	_, _ = util.RandReader.Read(id[:])
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:])
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// SignJWT creates a JSON Web Token, the claims could be a map or a JSON string.
+// The key is the secret of the HS algorithms, or the PEM encoded private key of the RS and ES algorithms
+func SignJWT(algorithm string, claims interface{}, key string) (token string, err error) {
+	var payload []byte
+	switch val := claims.(type) {
+	case string:
+		if !json.Valid([]byte(val)) {
+			err = fmt.Errorf("the claims are not a valid JSON: %s", val)
+			return
+		}
+		payload = []byte(val)
+	default:
+		if payload, err = json.Marshal(val); err != nil {
+			return
+		}
+	}
+
+	algorithm = strings.ToUpper(util.EmptyThenDefault(algorithm, "HS256"))
+	var header []byte
+	if header, err = json.Marshal(map[string]string{"alg": algorithm, "typ": "JWT"}); err != nil {
+		return
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var signature []byte
+	if signature, err = signJWTContent(algorithm, unsigned, key); err == nil {
+		token = unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+	}
+	return
+}
+
+func signJWTContent(algorithm, content, key string) (signature []byte, err error) {
+	if len(algorithm) != len("HS256") {
+		err = fmt.Errorf("not supported JWT algorithm: %q", algorithm)
+		return
+	}
+
+	var hashFunc crypto.Hash
+	switch algorithm[2:] {
+	case "256":
+		hashFunc = crypto.SHA256
+	case "384":
+		hashFunc = crypto.SHA384
+	case "512":
+		hashFunc = crypto.SHA512
+	default:
+		err = fmt.Errorf("not supported JWT algorithm: %q", algorithm)
+		return
+	}
+
+	switch algorithm[:2] {
+	case "HS":
+		mac := hmac.New(hashFunc.New, []byte(key))
+		mac.Write([]byte(content))
+		signature = mac.Sum(nil)
+	case "RS":
+		var privateKey *rsa.PrivateKey
+		if privateKey, err = parseRSAPrivateKey(key); err == nil {
+			signature, err = rsa.SignPKCS1v15(rand.Reader, privateKey, hashFunc, digest(hashFunc, content))
+		}
+	case "ES":
+		var privateKey *ecdsa.PrivateKey
+		if privateKey, err = parseECPrivateKey(key); err == nil {
+			var r, s *big.Int
+			if r, s, err = ecdsa.Sign(rand.Reader, privateKey, digest(hashFunc, content)); err == nil {
+				// the signature is the fixed-size r and s
+				size := (privateKey.Curve.Params().BitSize + 7) / 8
+				signature = append(r.FillBytes(make([]byte, size)), s.FillBytes(make([]byte, size))...)
+			}
+		}
+	default:
+		err = fmt.Errorf("not supported JWT algorithm: %q", algorithm)
+	}
+	return
+}
+
+// HMACSign returns the hex encoded HMAC of the content, the algorithm could be sha1, sha256 or sha512
+func HMACSign(algorithm, secret, content string) (signature string, err error) {
+	var newHash func() hash.Hash
+	switch strings.ToLower(util.EmptyThenDefault(algorithm, "sha256")) {
+	case "sha1":
+		newHash = sha1.New
+	case "sha256":
+		newHash = sha256.New
+	case "sha512":
+		newHash = sha512.New
+	default:
+		err = fmt.Errorf("not supported HMAC algorithm: %q", algorithm)
+		return
+	}
+
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write([]byte(content))
+	signature = hex.EncodeToString(mac.Sum(nil))
+	return
+}
+
+func digest(hashFunc crypto.Hash, content string) []byte {
+	h := hashFunc.New()
+	h.Write([]byte(content))
+	return h.Sum(nil)
+}
+
+func parsePrivateKey(key string) (privateKey interface{}, err error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		err = errors.New("failed to decode the PEM private key")
+		return
+	}
+
+	if privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return
+	}
+	if privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return
+	}
+	privateKey, err = x509.ParseECPrivateKey(block.Bytes)
+	return
+}
+
+func parseRSAPrivateKey(key string) (privateKey *rsa.PrivateKey, err error) {
+	var parsed interface{}
+	if parsed, err = parsePrivateKey(key); err == nil {
+		var ok bool
+		if privateKey, ok = parsed.(*rsa.PrivateKey); !ok {
+			err = errors.New("the private key is not a RSA key")
+		}
+	}
+	return
+}
+
+func parseECPrivateKey(key string) (privateKey *ecdsa.PrivateKey, err error) {
+	var parsed interface{}
+	if parsed, err = parsePrivateKey(key); err == nil {
+		var ok bool
+		if privateKey, ok = parsed.(*ecdsa.PrivateKey); !ok {
+			err = errors.New("the private key is not an ECDSA key")
+		}
+	}
+	return
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignJWT(t *testing.T) {
+	t.Run("HS256", func(t *testing.T) {
+		token, err := SignJWT("HS256", map[string]interface{}{"sub": "rick"}, "secret")
+		if assert.NoError(t, err) {
+			parts := strings.Split(token, ".")
+			assert.Equal(t, 3, len(parts))
+			assert.Equal(t, `{"alg":"HS256","typ":"JWT"}`, decodeSegment(t, parts[0]))
+			assert.Equal(t, `{"sub":"rick"}`, decodeSegment(t, parts[1]))
+
+			mac := hmac.New(sha256.New, []byte("secret"))
+			mac.Write([]byte(parts[0] + "." + parts[1]))
+			assert.Equal(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), parts[2])
+		}
+	})
+
+	t.Run("RS256", func(t *testing.T) {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		assert.NoError(t, err)
+		key := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+
+		token, err := SignJWT("RS256", `{"sub":"rick"}`, string(key))
+		if assert.NoError(t, err) {
+			parts := strings.Split(token, ".")
+			signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+			assert.NoError(t, rsa.VerifyPKCS1v15(&privateKey.PublicKey, crypto.SHA256,
+				digest(crypto.SHA256, parts[0]+"."+parts[1]), signature))
+		}
+	})
+
+	t.Run("ES384", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		assert.NoError(t, err)
+		data, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		assert.NoError(t, err)
+		key := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: data})
+
+		token, err := SignJWT("ES384", `{"sub":"rick"}`, string(key))
+		if assert.NoError(t, err) {
+			parts := strings.Split(token, ".")
+			signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+			if assert.Equal(t, 96, len(signature)) {
+				r, s := new(big.Int).SetBytes(signature[:48]), new(big.Int).SetBytes(signature[48:])
+				assert.True(t, ecdsa.Verify(&privateKey.PublicKey, digest(crypto.SHA384, parts[0]+"."+parts[1]), r, s))
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := SignJWT("none", `{}`, "secret")
+		assert.ErrorContains(t, err, "not supported JWT algorithm")
+
+		_, err = SignJWT("HS128", `{}`, "secret")
+		assert.ErrorContains(t, err, "not supported JWT algorithm")
+
+		_, err = SignJWT("HS256", `{`, "secret")
+		assert.ErrorContains(t, err, "not a valid JSON")
+
+		_, err = SignJWT("RS256", `{}`, "secret")
+		assert.ErrorContains(t, err, "failed to decode the PEM private key")
+	})
+
+	t.Run("template", func(t *testing.T) {
+		result, err := Render("jwt", `{{ jwtSign "HS256" (dict "sub" "rick") "secret" }}`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(strings.Split(result, ".")))
+	})
+}
+
+func TestHMACSign(t *testing.T) {
+	signature, err := HMACSign("sha256", "key", "The quick brown fox jumps over the lazy dog")
+	assert.NoError(t, err)
+	assert.Equal(t, "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", signature)
+
+	signature, err = HMACSign("", "key", "The quick brown fox jumps over the lazy dog")
+	assert.NoError(t, err)
+	assert.Equal(t, "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", signature)
+
+	_, err = HMACSign("md4", "key", "content")
+	assert.Error(t, err)
+
+	result, err := Render("hmac", `{{ hmacSign "sha1" "key" "The quick brown fox jumps over the lazy dog" }}`, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "de7c9b85b8b78aa6bc8a7a36f70a90701c9db4d9", result)
+}
+
+func decodeSegment(t *testing.T, segment string) string {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	assert.NoError(t, err)
+	return string(data)
+}
@@ -28,7 +28,6 @@ import (
 	"fmt"
 	"io"
 	"math"
-	mathrand "math/rand"
 	"net/url"
 	"strconv"
 	"strings"
@@ -72,7 +71,7 @@ func Render(name, text string, ctx interface{}) (result string, err error) {
 func RenderAsBytes(name, text string, ctx interface{}) (data []byte, err error) {
 	var tpl *template.Template
 	if tpl, err = template.New(name).
-		Funcs(FuncMap()).
+		Funcs(funcMapWith(contextFunctions(ctx))).
 		Parse(text); err == nil {
 		buf := new(bytes.Buffer)
 		if err = tpl.Execute(buf, ctx); err == nil {
@@ -90,8 +89,13 @@ func RenderAsReader(name, text string, ctx interface{}) (reader io.Reader, err e
 	return
 }
 
-// FuncMap returns all the supported functions
+// FuncMap returns all the supported functions, including the global user-defined ones
 func FuncMap() template.FuncMap {
+	return funcMapWith(nil)
+}
+
+// builtinFuncMap returns the sprig and advanced functions
+func builtinFuncMap() template.FuncMap {
 	funcs := sprig.FuncMap()
 	for _, item := range GetAdvancedFuncs() {
 		if item.FuncName == "" || item.Func == nil {
@@ -104,6 +108,11 @@ func FuncMap() template.FuncMap {
 	funcs["randPdf"] = generateRandomPdf
 	funcs["randZip"] = generateRandomZip
 	funcs["readFile"] = readFile
+	funcs["jwtSign"] = SignJWT
+	funcs["hmacSign"] = HMACSign
+	for name, fn := range seededFuncs() {
+		funcs[name] = fn
+	}
 	return funcs
 }
 
@@ -113,6 +122,13 @@ func FuncUsage(funcName string) (usage string) {
 	if err := yaml.Unmarshal(templateUsage, usageMap); err == nil {
 		usage = usageMap[funcName]
 	}
+	if usage == "" {
+		for _, item := range GetUserDefinedTemplates().Items {
+			if item.Name == funcName {
+				usage = item.Description
+			}
+		}
+	}
 	return
 }
 
@@ -151,6 +167,7 @@ var advancedFuncs = []AdvancedFunc{{
 	Func: func(name string) string {
 		val, err := secretGetter.GetSecret(name)
 		if err == nil {
+			secret.RegisterMaskValue(val.Value)
 			return val.Value
 		}
 		return err.Error()
@@ -206,7 +223,7 @@ var advancedFuncs = []AdvancedFunc{{
 }, {
 	FuncName: "randFloat",
 	Func: func(from float64, to float64) float64 {
-		return mathrand.Float64()*(to-from) + from
+		return util.Float64()*(to-from) + from
 	},
 }, {
 	FuncName: "randEnum",
@@ -244,7 +261,7 @@ var advancedFuncs = []AdvancedFunc{{
 				newItems = append(newItems, item.Object)
 			}
 		}
-		return newItems[mathrand.Intn(len(newItems))]
+		return newItems[util.Intn(len(newItems))]
 	},
 }, {
 	FuncName: "randEmail",
@@ -320,7 +337,7 @@ func ipV4Increase(segs []string, index int) string {
 }
 
 func randNorm(mean, stdDev float64) float64 {
-	return mean + stdDev*mathrand.NormFloat64()
+	return mean + stdDev*util.NormFloat64()
 }
 
 func randLogNorm(mean, stdDev float64) float64 {
@@ -328,7 +345,7 @@ func randLogNorm(mean, stdDev float64) float64 {
 }
 
 func randItem[T any](items ...T) T {
-	return items[mathrand.Intn(len(items))]
+	return items[util.Intn(len(items))]
 }
 
 // WeightEnum is a weight enum
@@ -26,6 +26,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/linuxsuren/api-testing/pkg/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -396,3 +397,25 @@ func TestGetEngineVersion(t *testing.T) {
 	ver := GetEngineVersion()
 	assert.Empty(t, ver)
 }
+
+func TestSeededFuncs(t *testing.T) {
+	defer util.SetSeed(util.Seed())
+
+	tpl := `{{ randAlpha 8 }} {{ randAlphaNum 8 }} {{ randNumeric 8 }} {{ randAscii 8 }} {{ randBytes 8 }} {{ randInt 1 100 }}
+{{ shuffle "abcdef" }} {{ uuidv4 }} {{ randEnum "a" "b" "c" }} {{ randFloat 1 2 }} {{ randEmail }} {{ randomKubernetesName }}`
+	util.SetSeed(42)
+	first, err := Render("seed", tpl, nil)
+	assert.NoError(t, err)
+
+	util.SetSeed(42)
+	second, err := Render("seed", tpl, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	util.SetSeed(43)
+	third, err := Render("seed", tpl, nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, third)
+
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, uuidv4())
+}
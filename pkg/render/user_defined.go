@@ -16,30 +16,70 @@ limitations under the License.
 package render
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"os"
+	"regexp"
+	"strings"
+	"sync"
 	"text/template"
 
 	"gopkg.in/yaml.v3"
 )
 
+// ContextKeyFunctions is the key of the user-defined functions in the data context,
+// the functions are only available in the renders with the data context
+const ContextKeyFunctions = "__functions__"
+
+// UserDefinedFile is the file name of the global user-defined functions in the config directory
+const UserDefinedFile = "functions.yaml"
+
+// maxUserDefinedDepth limits the nested calls of the user-defined functions
+const maxUserDefinedDepth = 16
+
 type UserDefinedTemplates struct {
-	Items []UserDefinedTemplate
+	Items []UserDefinedTemplate `yaml:"items" json:"items"`
 }
 
+// UserDefinedTemplate is a template function which is defined by a template.
+// The arguments are in the render context by the names of the params, and all of them are in .args.
+// For instance, the render `Hello {{.name}}` with the params [name] is called as `{{ greet "Rick" }}`.
 type UserDefinedTemplate struct {
-	Name   string
-	Render string
+	Name        string   `yaml:"name" json:"name"`
+	Render      string   `yaml:"render" json:"render"`
+	Params      []string `yaml:"params,omitempty" json:"params,omitempty"`
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
 }
 
+var funcNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Validate checks the names and parses the templates, the functions could call each other
 func (t *UserDefinedTemplates) Validate() (err error) {
+	names := map[string]bool{}
 	for _, item := range t.Items {
-		_, rErr := Render(item.Name, item.Render, nil)
-		err = errors.Join(err, rErr)
+		if !funcNameRegex.MatchString(item.Name) {
+			err = errors.Join(err, fmt.Errorf("invalid function name: %q", item.Name))
+		} else if names[item.Name] {
+			err = errors.Join(err, fmt.Errorf("duplicated function: %s", item.Name))
+		}
+		names[item.Name] = true
+	}
+	if err != nil {
+		// the template engine panics with the invalid function names
+		return
+	}
+
+	funcs := funcMapWith(t.Items)
+	for _, item := range t.Items {
+		if _, pErr := template.New(item.Name).Funcs(funcs).Parse(item.Render); pErr != nil {
+			err = errors.Join(err, pErr)
+		}
 	}
 	return
 }
 
+// ConflictWith returns an error if any function exists in the funcMap
 func (t *UserDefinedTemplates) ConflictWith(funcMap template.FuncMap) (conflict error) {
 	for _, item := range t.Items {
 		if _, ok := funcMap[item.Name]; ok {
@@ -50,6 +90,11 @@ func (t *UserDefinedTemplates) ConflictWith(funcMap template.FuncMap) (conflict
 	return
 }
 
+// Check validates the functions, and makes sure there is no conflict with the builtin functions
+func (t *UserDefinedTemplates) Check() error {
+	return errors.Join(t.Validate(), t.ConflictWith(builtinFuncMap()))
+}
+
 func ParseUserDefinedTemplates(data []byte) (templates *UserDefinedTemplates, err error) {
 	templates = &UserDefinedTemplates{}
 	err = yaml.Unmarshal(data, templates)
@@ -67,3 +112,111 @@ func ParseUserDefinedTemplatesFromFile(filePath string) (templates *UserDefinedT
 	}
 	return
 }
+
+var userDefined = struct {
+	sync.RWMutex
+	items []UserDefinedTemplate
+}{}
+
+// SetUserDefinedTemplates replaces the global user-defined functions, which are available in all the renders.
+// It fails when the functions are invalid or conflict with the builtin ones.
+func SetUserDefinedTemplates(templates *UserDefinedTemplates) (err error) {
+	if templates == nil {
+		templates = &UserDefinedTemplates{}
+	}
+	if err = templates.Check(); err == nil {
+		userDefined.Lock()
+		userDefined.items = append([]UserDefinedTemplate{}, templates.Items...)
+		userDefined.Unlock()
+	}
+	return
+}
+
+// GetUserDefinedTemplates returns the global user-defined functions
+func GetUserDefinedTemplates() *UserDefinedTemplates {
+	userDefined.RLock()
+	defer userDefined.RUnlock()
+	return &UserDefinedTemplates{
+		Items: append([]UserDefinedTemplate{}, userDefined.items...),
+	}
+}
+
+// LoadUserDefinedTemplates loads the global user-defined functions from a file, it is fine if the file does not exist
+func LoadUserDefinedTemplates(filePath string) (err error) {
+	var templates *UserDefinedTemplates
+	if templates, err = ParseUserDefinedTemplatesFromFile(filePath); err == nil {
+		err = SetUserDefinedTemplates(templates)
+	} else if errors.Is(err, os.ErrNotExist) {
+		err = nil
+	}
+	return
+}
+
+// SaveUserDefinedTemplates sets the global user-defined functions, then writes them into a file
+func SaveUserDefinedTemplates(filePath string, templates *UserDefinedTemplates) (err error) {
+	if err = SetUserDefinedTemplates(templates); err == nil {
+		var data []byte
+		if data, err = yaml.Marshal(GetUserDefinedTemplates()); err == nil {
+			err = os.WriteFile(filePath, data, 0644)
+		}
+	}
+	return
+}
+
+// Signature returns the signature of the function, such as greet(name)
+func (t UserDefinedTemplate) Signature() string {
+	return fmt.Sprintf("%s(%s)", t.Name, strings.Join(t.Params, ", "))
+}
+
+// funcMapWith returns the builtin and global user-defined functions together with the ones of a render scope,
+// the scoped functions override the global ones which have the same names
+func funcMapWith(scoped []UserDefinedTemplate) template.FuncMap {
+	funcs := builtinFuncMap()
+	depth := 0
+	for _, item := range append(GetUserDefinedTemplates().Items, scoped...) {
+		funcs[item.Name] = item.toFunc(funcs, &depth)
+	}
+	return funcs
+}
+
+// contextFunctions returns the user-defined functions in the data context
+func contextFunctions(ctx interface{}) (functions []UserDefinedTemplate) {
+	if data, ok := ctx.(map[string]interface{}); ok {
+		functions, _ = data[ContextKeyFunctions].([]UserDefinedTemplate)
+	}
+	return
+}
+
+// toFunc creates a template function, the funcs is shared so that the functions could call each other
+func (t UserDefinedTemplate) toFunc(funcs template.FuncMap, depth *int) func(args ...interface{}) (string, error) {
+	return func(args ...interface{}) (result string, err error) {
+		if *depth >= maxUserDefinedDepth {
+			err = fmt.Errorf("the calls of %s are nested more than %d levels", t.Name, maxUserDefinedDepth)
+			return
+		}
+		*depth++
+		defer func() {
+			*depth--
+		}()
+
+		if len(args) < len(t.Params) {
+			err = fmt.Errorf("%s requires %d arguments, got %d", t.Signature(), len(t.Params), len(args))
+			return
+		}
+		ctx := map[string]interface{}{
+			"args": args,
+		}
+		for i, name := range t.Params {
+			ctx[name] = args[i]
+		}
+
+		var tpl *template.Template
+		if tpl, err = template.New(t.Name).Funcs(funcs).Parse(t.Render); err == nil {
+			buf := new(bytes.Buffer)
+			if err = tpl.Execute(buf, ctx); err == nil {
+				result = strings.TrimSpace(buf.String())
+			}
+		}
+		return
+	}
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package render_test
 
 import (
+	"path"
 	"testing"
 
 	"github.com/linuxsuren/api-testing/pkg/render"
@@ -35,3 +36,105 @@ func TestUserDefinedTemplates(t *testing.T) {
 	assert.NoError(t, tpl.Validate())
 	assert.Error(t, tpl.ConflictWith(render.FuncMap()))
 }
+
+func TestRegisterUserDefinedTemplates(t *testing.T) {
+	defer func() {
+		_ = render.SetUserDefinedTemplates(nil)
+	}()
+
+	err := render.SetUserDefinedTemplates(&render.UserDefinedTemplates{
+		Items: []render.UserDefinedTemplate{{
+			Name:   "greet",
+			Render: `Hello {{ .name }}`,
+			Params: []string{"name"},
+		}, {
+			Name:   "greetAll",
+			Render: `{{ range .args }}{{ greet . }};{{ end }}`,
+		}, {
+			Name:   "loop",
+			Render: `{{ loop }}`,
+		}},
+	})
+	assert.NoError(t, err)
+
+	t.Run("parameters", func(t *testing.T) {
+		result, err := render.Render("greet", `{{ greet "Rick" }}`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello Rick", result)
+
+		_, err = render.Render("greet", `{{ greet }}`, nil)
+		assert.ErrorContains(t, err, "greet(name) requires 1 arguments")
+	})
+
+	t.Run("composition", func(t *testing.T) {
+		result, err := render.Render("greetAll", `{{ greetAll "Rick" "Morty" }}`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello Rick;Hello Morty;", result)
+	})
+
+	t.Run("nested too deep", func(t *testing.T) {
+		_, err := render.Render("loop", `{{ loop }}`, nil)
+		assert.ErrorContains(t, err, "nested more than 16 levels")
+	})
+
+	t.Run("render scope", func(t *testing.T) {
+		ctx := map[string]interface{}{
+			render.ContextKeyFunctions: []render.UserDefinedTemplate{{
+				Name:   "greet",
+				Render: `Hi {{ .name }}`,
+				Params: []string{"name"},
+			}},
+		}
+		result, err := render.Render("scoped", `{{ greet "Rick" }}`, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "Hi Rick", result)
+
+		result, err = render.Render("global", `{{ greet "Rick" }}`, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello Rick", result)
+	})
+
+	t.Run("listed with the builtin functions", func(t *testing.T) {
+		assert.Contains(t, render.FuncMap(), "greet")
+		assert.Contains(t, render.FuncMap(), "randInt")
+	})
+
+	t.Run("conflict with the builtin functions", func(t *testing.T) {
+		err := render.SetUserDefinedTemplates(&render.UserDefinedTemplates{
+			Items: []render.UserDefinedTemplate{{Name: "randInt", Render: "1"}},
+		})
+		assert.ErrorContains(t, err, "conflict with existing function: randInt")
+		assert.Equal(t, 3, len(render.GetUserDefinedTemplates().Items))
+	})
+
+	t.Run("invalid functions", func(t *testing.T) {
+		err := render.SetUserDefinedTemplates(&render.UserDefinedTemplates{
+			Items: []render.UserDefinedTemplate{{Name: "a-b", Render: "1"}, {Name: "c", Render: "{{"}, {Name: "c", Render: "1"}},
+		})
+		assert.ErrorContains(t, err, `invalid function name: "a-b"`)
+		assert.ErrorContains(t, err, "duplicated function: c")
+	})
+}
+
+func TestLoadAndSaveUserDefinedTemplates(t *testing.T) {
+	defer func() {
+		_ = render.SetUserDefinedTemplates(nil)
+	}()
+	filePath := path.Join(t.TempDir(), render.UserDefinedFile)
+
+	assert.NoError(t, render.LoadUserDefinedTemplates(filePath))
+	assert.Empty(t, render.GetUserDefinedTemplates().Items)
+
+	err := render.SaveUserDefinedTemplates(filePath, &render.UserDefinedTemplates{
+		Items: []render.UserDefinedTemplate{{Name: "greet", Render: "Hello {{ .name }}", Params: []string{"name"}}},
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, render.SetUserDefinedTemplates(nil))
+	assert.NoError(t, render.LoadUserDefinedTemplates(filePath))
+	if assert.Equal(t, 1, len(render.GetUserDefinedTemplates().Items)) {
+		assert.Equal(t, "greet(name)", render.GetUserDefinedTemplates().Items[0].Signature())
+	}
+
+	assert.Error(t, render.LoadUserDefinedTemplates("testdata/function-with-conflicts.yaml"))
+}
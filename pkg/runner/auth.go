@@ -0,0 +1,319 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// signRequest signs the request with the auth of a suite. The OAuth2 tokens are fetched by the client,
+// and cached in the scope, such as: the store and suite name
+func signRequest(request *http.Request, client *http.Client, auth *testing.Auth, scope string) (err error) {
+	if auth == nil {
+		return
+	}
+
+	var missing bool
+	switch auth.Mode {
+	case testing.AuthModeJWT:
+		if missing = auth.JWT == nil; !missing {
+			err = signJWTRequest(request, auth.JWT, time.Now())
+		}
+	case testing.AuthModeHMAC:
+		if missing = auth.HMAC == nil; !missing {
+			err = signHMACRequest(request, auth.HMAC)
+		}
+	case testing.AuthModeAWS:
+		if missing = auth.AWS == nil; !missing {
+			err = signAWSRequest(request, auth.AWS, time.Now())
+		}
+	case testing.AuthModeOAuth2:
+		if missing = auth.OAuth2 == nil; !missing {
+			var token *oauth2.Token
+			if token, err = getOAuth2Token(client, scope, auth.OAuth2); err == nil {
+				token.SetAuthHeader(request)
+			} else {
+				err = fmt.Errorf("failed to fetch the OAuth2 token: %w", err)
+			}
+		}
+	default:
+		err = fmt.Errorf("not supported auth mode: %q", auth.Mode)
+	}
+
+	if missing {
+		err = fmt.Errorf("the setting of the %s auth is required", auth.Mode)
+	}
+	return
+}
+
+func signJWTRequest(request *http.Request, auth *testing.JWTAuth, now time.Time) (err error) {
+	claims := map[string]interface{}{}
+	for key, val := range auth.Claims {
+		claims[key] = val
+	}
+	if auth.ExpiresIn != "" {
+		var expiresIn time.Duration
+		if expiresIn, err = time.ParseDuration(auth.ExpiresIn); err != nil {
+			return
+		}
+		claims["iat"] = now.Unix()
+		claims["exp"] = now.Add(expiresIn).Unix()
+	}
+
+	var token string
+	if token, err = render.SignJWT(auth.Algorithm, claims, auth.Secret); err == nil {
+		request.Header.Set(util.Authorization, "Bearer "+token)
+	}
+	return
+}
+
+// signHMACRequest signs the content: method + "\n" + path with query + "\n" + body
+func signHMACRequest(request *http.Request, auth *testing.HMACAuth) (err error) {
+	var body []byte
+	if body, err = readRequestBody(request); err != nil {
+		return
+	}
+
+	content := request.Method + "\n" + request.URL.RequestURI() + "\n" + string(body)
+	var signature string
+	if signature, err = render.HMACSign(auth.Algorithm, auth.Secret, content); err == nil {
+		request.Header.Set(util.EmptyThenDefault(auth.Header, "X-Signature"), signature)
+	}
+	return
+}
+
+const (
+	awsAlgorithm   = "AWS4-HMAC-SHA256"
+	awsTimeFormat  = "20060102T150405Z"
+	awsDateFormat  = "20060102"
+	awsDateHeader  = "X-Amz-Date"
+	awsTokenHeader = "X-Amz-Security-Token"
+	awsHashHeader  = "X-Amz-Content-Sha256"
+)
+
+// signAWSRequest signs the request with AWS Signature Version 4,
+// see also https://docs.aws.amazon.com/IAM/latest/UserGuide/create-signed-request.html
+func signAWSRequest(request *http.Request, auth *testing.AWSAuth, now time.Time) (err error) {
+	var body []byte
+	if body, err = readRequestBody(request); err != nil {
+		return
+	}
+	payloadHash := sha256Hex(body)
+
+	now = now.UTC()
+	request.Header.Set(awsDateHeader, now.Format(awsTimeFormat))
+	if auth.SessionToken != "" {
+		request.Header.Set(awsTokenHeader, auth.SessionToken)
+	}
+	if auth.Service == "s3" {
+		request.Header.Set(awsHashHeader, payloadHash)
+	}
+
+	signedHeaders, canonicalHeaders := awsCanonicalHeaders(request)
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		util.EmptyThenDefault(request.URL.EscapedPath(), "/"),
+		awsCanonicalQuery(request.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{now.Format(awsDateFormat), auth.Region, auth.Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{awsAlgorithm, now.Format(awsTimeFormat), scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := []byte("AWS4" + auth.SecretKey)
+	for _, item := range []string{now.Format(awsDateFormat), auth.Region, auth.Service, "aws4_request"} {
+		key = hmacSHA256(key, item)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	request.Header.Set(util.Authorization, fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		awsAlgorithm, auth.AccessKey, scope, signedHeaders, signature))
+	return
+}
+
+// awsCanonicalHeaders returns the signed headers, they are the host, content type and the x-amz-* headers
+func awsCanonicalHeaders(request *http.Request) (signedHeaders, canonicalHeaders string) {
+	headers := map[string]string{
+		"host": util.EmptyThenDefault(request.Host, request.URL.Host),
+	}
+	for key, values := range request.Header {
+		name := strings.ToLower(key)
+		if name == "content-type" || strings.HasPrefix(name, "x-amz-") {
+			var trimmed []string
+			for _, val := range values {
+				trimmed = append(trimmed, strings.Join(strings.Fields(val), " "))
+			}
+			headers[name] = strings.Join(trimmed, ",")
+		}
+	}
+
+	var names []string
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	builder := strings.Builder{}
+	for _, name := range names {
+		builder.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders = strings.Join(names, ";")
+	canonicalHeaders = builder.String()
+	return
+}
+
+func awsCanonicalQuery(query url.Values) string {
+	var pairs []string
+	for key, values := range query {
+		for _, val := range values {
+			pairs = append(pairs, awsEscape(key)+"="+awsEscape(val))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+func awsEscape(text string) string {
+	return strings.ReplaceAll(url.QueryEscape(text), "+", "%20")
+}
+
+func hmacSHA256(key []byte, content string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(content))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// readRequestBody reads a copy of the request body
+func readRequestBody(request *http.Request) (body []byte, err error) {
+	if request.GetBody == nil {
+		return
+	}
+
+	var reader io.ReadCloser
+	if reader, err = request.GetBody(); err == nil {
+		defer reader.Close()
+		body, err = io.ReadAll(reader)
+	}
+	return
+}
+
+var (
+	oauth2Tokens = struct {
+		sync.Mutex
+		tokens map[string]*oauth2.Token
+	}{
+		tokens: map[string]*oauth2.Token{},
+	}
+
+	// oauth2TokenLocks has a lock for each cache key, a slow token endpoint only blocks the requests with the same credentials
+	oauth2TokenLocks sync.Map
+)
+
+// getOAuth2Token returns the cached token until it expires, or fetches a new one with the HTTP client of the suite.
+// The tokens are cached by the store, the suite and the hashed credentials
+func getOAuth2Token(client *http.Client, scope string, auth *testing.OAuth2Auth) (token *oauth2.Token, err error) {
+	grant := util.EmptyThenDefault(auth.Grant, "client_credentials")
+	key := strings.Join([]string{scope, grant, auth.TokenURL, auth.ClientID, auth.Username, strings.Join(auth.Scopes, " "),
+		sha256Hex([]byte(auth.ClientSecret + "\x00" + auth.Password))}, "|")
+
+	lock, _ := oauth2TokenLocks.LoadOrStore(key, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	oauth2Tokens.Lock()
+	cached := oauth2Tokens.tokens[key]
+	oauth2Tokens.Unlock()
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, client)
+	var source oauth2.TokenSource
+	if grant == "password" {
+		source = &passwordTokenSource{
+			ctx: ctx,
+			config: &oauth2.Config{
+				ClientID:     auth.ClientID,
+				ClientSecret: auth.ClientSecret,
+				Endpoint:     oauth2.Endpoint{TokenURL: auth.TokenURL},
+				Scopes:       auth.Scopes,
+			},
+			username: auth.Username,
+			password: auth.Password,
+			last:     cached,
+		}
+	} else {
+		source = (&clientcredentials.Config{
+			ClientID:     auth.ClientID,
+			ClientSecret: auth.ClientSecret,
+			TokenURL:     auth.TokenURL,
+			Scopes:       auth.Scopes,
+		}).TokenSource(ctx)
+	}
+
+	if token, err = oauth2.ReuseTokenSource(cached, source).Token(); err == nil {
+		oauth2Tokens.Lock()
+		oauth2Tokens.tokens[key] = token
+		oauth2Tokens.Unlock()
+	}
+	return
+}
+
+// passwordTokenSource fetches the tokens with the password grant, it tries the refresh token first if there is one
+type passwordTokenSource struct {
+	ctx      context.Context
+	config   *oauth2.Config
+	username string
+	password string
+	last     *oauth2.Token
+}
+
+func (s *passwordTokenSource) Token() (token *oauth2.Token, err error) {
+	ctx := s.ctx
+	if s.last != nil && s.last.RefreshToken != "" {
+		if token, err = s.config.TokenSource(ctx, s.last).Token(); err == nil {
+			s.last = token
+			return
+		}
+	}
+
+	if token, err = s.config.PasswordCredentialsToken(ctx, s.username, s.password); err == nil {
+		s.last = token
+	}
+	return
+}
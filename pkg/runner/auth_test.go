@@ -0,0 +1,287 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignRequest(t *testing.T) {
+	t.Run("no auth", func(t *testing.T) {
+		request, _ := http.NewRequest(http.MethodGet, urlFoo, nil)
+		assert.NoError(t, signRequest(request, http.DefaultClient, nil, "suite"))
+		assert.Empty(t, request.Header.Get(util.Authorization))
+	})
+
+	t.Run("jwt", func(t *testing.T) {
+		request, _ := http.NewRequest(http.MethodGet, urlFoo, nil)
+		now := time.Unix(1700000000, 0)
+		err := signJWTRequest(request, &atest.JWTAuth{
+			Secret:    "secret",
+			Claims:    map[string]interface{}{"sub": "rick"},
+			ExpiresIn: "1h",
+		}, now)
+		assert.NoError(t, err)
+
+		token := strings.TrimPrefix(request.Header.Get(util.Authorization), "Bearer ")
+		parts := strings.Split(token, ".")
+		if assert.Equal(t, 3, len(parts)) {
+			payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+			claims := map[string]interface{}{}
+			assert.NoError(t, json.Unmarshal(payload, &claims))
+			assert.Equal(t, "rick", claims["sub"])
+			assert.Equal(t, float64(1700000000), claims["iat"])
+			assert.Equal(t, float64(1700003600), claims["exp"])
+		}
+
+		err = signJWTRequest(request, &atest.JWTAuth{ExpiresIn: "invalid"}, now)
+		assert.Error(t, err)
+	})
+
+	t.Run("hmac", func(t *testing.T) {
+		request, _ := http.NewRequest(http.MethodPost, urlFoo+"?name=rick", strings.NewReader("hello"))
+		err := signRequest(request, http.DefaultClient, &atest.Auth{
+			Mode: atest.AuthModeHMAC,
+			HMAC: &atest.HMACAuth{Secret: "secret", Header: "X-Sign"},
+		}, "suite")
+		assert.NoError(t, err)
+
+		expected := hmacSHA256([]byte("secret"), "POST\n/foo?name=rick\nhello")
+		assert.Equal(t, hex.EncodeToString(expected), request.Header.Get("X-Sign"))
+	})
+
+	t.Run("aws-sigv4", func(t *testing.T) {
+		// the get-vanilla case of the AWS Signature Version 4 test suite
+		request, _ := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+		now, _ := time.Parse(awsTimeFormat, "20150830T123600Z")
+		err := signAWSRequest(request, &atest.AWSAuth{
+			AccessKey: "AKIDEXAMPLE",
+			SecretKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+			Region:    "us-east-1",
+			Service:   "service",
+		}, now)
+		assert.NoError(t, err)
+		assert.Equal(t, "20150830T123600Z", request.Header.Get(awsDateHeader))
+		assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, "+
+			"SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+			request.Header.Get(util.Authorization))
+	})
+
+	t.Run("aws-sigv4 with session token", func(t *testing.T) {
+		request, _ := http.NewRequest(http.MethodPut, "https://bucket.s3.amazonaws.com/a b?b=2&a=1", strings.NewReader("data"))
+		err := signRequest(request, http.DefaultClient, &atest.Auth{
+			Mode: atest.AuthModeAWS,
+			AWS: &atest.AWSAuth{
+				AccessKey:    "ak",
+				SecretKey:    "sk",
+				SessionToken: "token",
+				Region:       "us-east-1",
+				Service:      "s3",
+			},
+		}, "suite")
+		assert.NoError(t, err)
+		assert.Equal(t, "token", request.Header.Get(awsTokenHeader))
+		assert.Equal(t, sha256Hex([]byte("data")), request.Header.Get(awsHashHeader))
+		assert.Contains(t, request.Header.Get(util.Authorization),
+			"SignedHeaders=host;x-amz-content-sha256;x-amz-date;x-amz-security-token")
+		assert.Equal(t, "a=1&b=2", awsCanonicalQuery(request.URL.Query()))
+	})
+
+	t.Run("oauth2 client credentials", func(t *testing.T) {
+		defer gock.Off()
+		gock.New(urlFoo).Post("/token").
+			Times(1).
+			Reply(http.StatusOK).
+			JSON(`{"access_token":"client-token","token_type":"Bearer","expires_in":3600}`)
+
+		auth := &atest.Auth{
+			Mode: atest.AuthModeOAuth2,
+			OAuth2: &atest.OAuth2Auth{
+				TokenURL:     urlFoo + "/token",
+				ClientID:     "client",
+				ClientSecret: "secret",
+			},
+		}
+		for i := 0; i < 2; i++ {
+			request, _ := http.NewRequest(http.MethodGet, urlFoo, nil)
+			assert.NoError(t, signRequest(request, http.DefaultClient, auth, "client-credentials"))
+			assert.Equal(t, "Bearer client-token", request.Header.Get(util.Authorization))
+		}
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("oauth2 tokens are cached by the scope and credentials", func(t *testing.T) {
+		defer gock.Off()
+		gock.New(urlFoo).Post("/token").
+			Times(3).
+			Reply(http.StatusOK).
+			JSON(`{"access_token":"cached-token","token_type":"Bearer","expires_in":3600}`)
+
+		client := &http.Client{}
+		gock.InterceptClient(client)
+		sign := func(secret, scope string) {
+			request, _ := http.NewRequest(http.MethodGet, urlFoo, nil)
+			assert.NoError(t, signRequest(request, client, &atest.Auth{
+				Mode: atest.AuthModeOAuth2,
+				OAuth2: &atest.OAuth2Auth{
+					TokenURL:     urlFoo + "/token",
+					ClientID:     "cached",
+					ClientSecret: secret,
+				},
+			}, scope))
+		}
+		sign("secret", "local/suite")
+		sign("secret", "local/suite")
+		sign("another", "local/suite")
+		sign("secret", "git/suite")
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("a slow token endpoint does not block the others", func(t *testing.T) {
+		started, release := make(chan struct{}), make(chan struct{})
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+			w.Header().Set(util.ContentType, util.JSON)
+			_, _ = w.Write([]byte(`{"access_token":"slow-token","token_type":"Bearer","expires_in":3600}`))
+		}))
+		defer slow.Close()
+		fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(util.ContentType, util.JSON)
+			_, _ = w.Write([]byte(`{"access_token":"fast-token","token_type":"Bearer","expires_in":3600}`))
+		}))
+		defer fast.Close()
+
+		client := &http.Client{Transport: &http.Transport{}}
+		sign := func(tokenURL string) (*http.Request, error) {
+			request, _ := http.NewRequest(http.MethodGet, urlFoo, nil)
+			return request, signRequest(request, client, &atest.Auth{
+				Mode:   atest.AuthModeOAuth2,
+				OAuth2: &atest.OAuth2Auth{TokenURL: tokenURL, ClientID: "concurrent"},
+			}, "concurrent")
+		}
+
+		slowDone := make(chan error)
+		go func() {
+			_, err := sign(slow.URL)
+			slowDone <- err
+		}()
+		<-started
+
+		fastDone := make(chan struct{})
+		go func() {
+			request, err := sign(fast.URL)
+			assert.NoError(t, err)
+			assert.Equal(t, "Bearer fast-token", request.Header.Get(util.Authorization))
+			close(fastDone)
+		}()
+		select {
+		case <-fastDone:
+		case <-time.After(5 * time.Second):
+			t.Error("the token fetching is blocked by the slow endpoint")
+		}
+
+		close(release)
+		assert.NoError(t, <-slowDone)
+	})
+
+	t.Run("oauth2 password", func(t *testing.T) {
+		defer gock.Off()
+		gock.New(urlFoo).Post("/token").
+			BodyString("username=rick").
+			Reply(http.StatusOK).
+			JSON(`{"access_token":"password-token","token_type":"Bearer","expires_in":3600}`)
+
+		request, _ := http.NewRequest(http.MethodGet, urlFoo, nil)
+		err := signRequest(request, http.DefaultClient, &atest.Auth{
+			Mode: atest.AuthModeOAuth2,
+			OAuth2: &atest.OAuth2Auth{
+				Grant:    "password",
+				TokenURL: urlFoo + "/token",
+				ClientID: "client",
+				Username: "rick",
+				Password: "pass",
+			},
+		}, "password")
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer password-token", request.Header.Get(util.Authorization))
+	})
+
+	t.Run("oauth2 failed", func(t *testing.T) {
+		defer gock.Off()
+		gock.New(urlFoo).Post("/token").Reply(http.StatusUnauthorized)
+
+		request, _ := http.NewRequest(http.MethodGet, urlFoo, nil)
+		err := signRequest(request, http.DefaultClient, &atest.Auth{
+			Mode:   atest.AuthModeOAuth2,
+			OAuth2: &atest.OAuth2Auth{TokenURL: urlFoo + "/token", ClientID: "failed"},
+		}, "failed")
+		assert.ErrorContains(t, err, "failed to fetch the OAuth2 token")
+	})
+
+	t.Run("missing setting", func(t *testing.T) {
+		request, _ := http.NewRequest(http.MethodGet, urlFoo, nil)
+		for _, mode := range []string{atest.AuthModeJWT, atest.AuthModeHMAC, atest.AuthModeAWS, atest.AuthModeOAuth2} {
+			err := signRequest(request, http.DefaultClient, &atest.Auth{Mode: mode}, "suite")
+			assert.EqualError(t, err, "the setting of the "+mode+" auth is required")
+		}
+	})
+
+	t.Run("unknown mode", func(t *testing.T) {
+		request, _ := http.NewRequest(http.MethodGet, urlFoo, nil)
+		err := signRequest(request, http.DefaultClient, &atest.Auth{Mode: "fake"}, "suite")
+		assert.EqualError(t, err, `not supported auth mode: "fake"`)
+	})
+}
+
+func TestSignedHeadersAreNotLogged(t *testing.T) {
+	defer gock.Off()
+	gock.New(urlFoo).Get("/").MatchHeader(util.Authorization, "^Bearer ").Reply(http.StatusOK)
+
+	buf := new(bytes.Buffer)
+	runner := NewSimpleTestCaseRunner()
+	runner.WithSuite(&atest.TestSuite{
+		Auth: &atest.Auth{
+			Mode: atest.AuthModeJWT,
+			JWT:  &atest.JWTAuth{Secret: "secret"},
+		},
+	})
+	runner.WithOutputWriter(buf)
+	runner.WithWriteLevel("info")
+	_, err := runner.RunTestCase(&atest.TestCase{
+		Request: atest.Request{API: urlFoo + "/"},
+		Expect:  atest.Response{StatusCode: http.StatusOK},
+	}, nil, context.TODO())
+	assert.NoError(t, err)
+	assert.True(t, gock.IsDone())
+	assert.Contains(t, buf.String(), "request header")
+	assert.NotContains(t, buf.String(), "Bearer")
+}
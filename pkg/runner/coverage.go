@@ -0,0 +1,124 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/apispec"
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// NewCoverageRecord creates the coverage record of a request, the status code is zero if it is unknown
+func NewCoverageRecord(request testing.Request, statusCode int) *apispec.CoverageRecord {
+	record := &apispec.CoverageRecord{
+		Method:     util.EmptyThenDefault(request.Method, http.MethodGet),
+		URL:        request.API,
+		StatusCode: statusCode,
+	}
+
+	names := map[string][]string{
+		"header": sortedKeys(request.Header),
+		"cookie": sortedKeys(request.Cookie),
+	}
+	for key := range request.Query {
+		names["query"] = append(names["query"], key)
+	}
+	if requestURL, err := url.Parse(request.API); err == nil {
+		for key := range requestURL.Query() {
+			names["query"] = append(names["query"], key)
+		}
+	}
+	sort.Strings(names["query"])
+
+	for _, in := range []string{"query", "header", "cookie"} {
+		for _, name := range names[in] {
+			record.Parameters = append(record.Parameters, apispec.Parameter{Name: name, In: in})
+		}
+	}
+	return record
+}
+
+// GetSuiteCoverageRecords returns the coverage records of the HTTP test cases without sending any requests,
+// the status codes are the expected ones
+func GetSuiteCoverageRecords(suite *testing.TestSuite) (records []apispec.CoverageRecord) {
+	if suite.Spec.RPC != nil {
+		return
+	}
+
+	dataContext := map[string]interface{}{}
+	if err := suite.Render(dataContext); err != nil {
+		suite.API = templateRegex.ReplaceAllString(suite.API, "placeholder")
+	}
+	for _, testCase := range suite.Items {
+		request := testCase.Request
+		if api, err := render.Render("api", request.API, dataContext); err == nil {
+			request.API = strings.TrimSpace(api)
+		} else {
+			request.API = templateRegex.ReplaceAllString(request.API, "placeholder")
+		}
+		request.RenderAPI(suite.API)
+
+		statusCode := util.ZeroThenDefault(testCase.Expect.StatusCode, http.StatusOK)
+		records = appendCoverageRecord(records, NewCoverageRecord(request, statusCode))
+	}
+	return
+}
+
+var templateRegex = regexp.MustCompile(`{{.*?}}`)
+
+// appendCoverageRecord appends the record if there is not the same one
+func appendCoverageRecord(records []apispec.CoverageRecord, record *apispec.CoverageRecord) []apispec.CoverageRecord {
+	if record == nil {
+		return records
+	}
+	for _, item := range records {
+		if reflect.DeepEqual(item, *record) {
+			return records
+		}
+	}
+	return append(records, *record)
+}
+
+// getCoverageReport returns the detailed coverage report when the API coverage is a full API specification
+func getCoverageReport(results []ReportResult, apiCoverage apispec.APICoverage) *apispec.CoverageReport {
+	apiSpec, ok := apiCoverage.(apispec.APISpec)
+	if !ok || apiSpec == nil {
+		return nil
+	}
+
+	var records []apispec.CoverageRecord
+	for _, result := range results {
+		records = append(records, result.Coverage...)
+	}
+	return apispec.NewCoverageReport(apiSpec, records)
+}
+
+func sortedKeys(data map[string]string) (keys []string) {
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/apispec"
+	"github.com/linuxsuren/api-testing/pkg/runner"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCoverageRecord(t *testing.T) {
+	record := runner.NewCoverageRecord(atest.Request{
+		API:    "http://localhost/users?size=1",
+		Query:  atest.SortedKeysStringMap{"page": "1"},
+		Header: map[string]string{"X-Trace": "abc"},
+		Cookie: map[string]string{"session": "abc"},
+	}, 200)
+	assert.Equal(t, &apispec.CoverageRecord{
+		Method: "GET",
+		URL:    "http://localhost/users?size=1",
+		Parameters: []apispec.Parameter{
+			{Name: "page", In: "query"},
+			{Name: "size", In: "query"},
+			{Name: "X-Trace", In: "header"},
+			{Name: "session", In: "cookie"},
+		},
+		StatusCode: 200,
+	}, record)
+}
+
+func TestGetSuiteCoverageRecords(t *testing.T) {
+	records := runner.GetSuiteCoverageRecords(&atest.TestSuite{
+		API:   "http://localhost:8080/api/v1",
+		Param: map[string]string{"name": "linuxsuren"},
+		Items: []atest.TestCase{{
+			Request: atest.Request{API: "/users/{{.param.name}}"},
+		}, {
+			Request: atest.Request{API: "/users/{{.param.name}}"},
+		}, {
+			Request: atest.Request{API: "/users/{{ unknown }}", Method: "DELETE"},
+			Expect:  atest.Response{StatusCode: 204},
+		}},
+	})
+	assert.Equal(t, []apispec.CoverageRecord{{
+		Method:     "GET",
+		URL:        "http://localhost:8080/api/v1/users/linuxsuren",
+		StatusCode: 200,
+	}, {
+		Method:     "DELETE",
+		URL:        "http://localhost:8080/api/v1/users/placeholder",
+		StatusCode: 204,
+	}}, records)
+
+	assert.Empty(t, runner.GetSuiteCoverageRecords(&atest.TestSuite{
+		Spec:  atest.APISpec{RPC: &atest.RPCDesc{}},
+		Items: []atest.TestCase{{}},
+	}))
+}
+
+func TestWriteCoverageReport(t *testing.T) {
+	apiSpec, err := apispec.ParseURLToAPISpec("../apispec/testdata/openapi.yaml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	results := []runner.ReportResult{{
+		Name: "list",
+		Coverage: []apispec.CoverageRecord{{
+			Method:     "GET",
+			URL:        "http://localhost:8080/api/v1/users",
+			StatusCode: 200,
+		}, {
+			Method:     "GET",
+			URL:        "http://localhost:8080/api/v1/unknown",
+			StatusCode: 404,
+		}},
+	}}
+	report := apispec.NewCoverageReport(apiSpec, results[0].Coverage)
+
+	t.Run("std", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		assert.NoError(t, runner.WriteCoverageReport(buf, report, "std"))
+		assert.Equal(t, `API Coverage: operations: 1/4, parameters: 0/4, status codes: 1/5
+GET /users requests: 1, missing parameters: query:page, header:X-Trace, missing status codes: default
+POST /users requests: 0, missing status codes: 201
+GET /users/{name} requests: 0, missing parameters: path:name, missing status codes: 200
+DELETE /users/{name} requests: 0, missing parameters: path:name, missing status codes: 204
+GET /api/v1/unknown is undocumented
+`, buf.String())
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		assert.NoError(t, runner.WriteCoverageReport(buf, report, "md"))
+		assert.Contains(t, buf.String(), "| GET /users | 1 | query:page, header:X-Trace | 200 | default |  |")
+		assert.Contains(t, buf.String(), "* GET /api/v1/unknown")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		assert.NoError(t, runner.WriteCoverageReport(buf, report, "json"))
+		actual := &apispec.CoverageReport{}
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), actual))
+		assert.Equal(t, report, actual)
+	})
+
+	t.Run("not supported format", func(t *testing.T) {
+		assert.Error(t, runner.WriteCoverageReport(new(bytes.Buffer), report, "fake"))
+	})
+
+	t.Run("report writers", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		assert.NoError(t, runner.NewResultWriter(buf).WithAPICoverage(apiSpec).Output(results))
+		assert.Contains(t, buf.String(), "\nAPI Coverage: operations: 1/4")
+
+		buf.Reset()
+		assert.NoError(t, runner.NewMarkdownResultWriter(buf).WithAPICoverage(apiSpec).Output(results))
+		assert.Contains(t, buf.String(), "| POST /users | 0 |  |  | 201 |  |")
+
+		buf.Reset()
+		assert.NoError(t, runner.NewHTMLResultWriter(buf).WithAPICoverage(apiSpec).Output(results))
+		assert.Contains(t, buf.String(), "<caption>API Coverage: operations: 1/4")
+
+		buf.Reset()
+		coverageBuf := new(bytes.Buffer)
+		jsonWriter := runner.NewJSONResultWriter(buf).WithAPICoverage(apiSpec)
+		jsonWriter.(runner.CoverageWriterSetter).WithCoverageWriter(coverageBuf)
+		assert.NoError(t, jsonWriter.Output(results))
+		var jsonResults []runner.ReportResult
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &jsonResults))
+		assert.Len(t, jsonResults, 1)
+		coverage := &apispec.CoverageReport{}
+		assert.NoError(t, json.Unmarshal(coverageBuf.Bytes(), coverage))
+		assert.Equal(t, report, coverage)
+
+		buf.Reset()
+		jsonWriter = runner.NewJSONResultWriter(buf).WithAPICoverage(apiSpec)
+		jsonWriter.(runner.CoverageWriterSetter).WithCoverageInReport()
+		assert.NoError(t, jsonWriter.Output(results))
+		jsonReport := struct {
+			Results  []runner.ReportResult   `json:"results"`
+			Coverage *apispec.CoverageReport `json:"coverage"`
+		}{}
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &jsonReport))
+		assert.Len(t, jsonReport.Results, 1)
+		assert.Equal(t, report, jsonReport.Coverage)
+	})
+}
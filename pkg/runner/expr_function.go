@@ -24,7 +24,10 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
+	"reflect"
+	"regexp"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/expr-lang/expr"
@@ -32,6 +35,9 @@ import (
 	"github.com/expr-lang/expr/builtin"
 	"github.com/expr-lang/expr/vm"
 	"github.com/linuxsuren/api-testing/pkg/logging"
+	"github.com/linuxsuren/api-testing/pkg/render"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
 )
 
 var (
@@ -128,8 +134,180 @@ func ExprFuncHTTPReady(params ...interface{}) (res interface{}, err error) {
 	return
 }
 
+// ExprFuncJSONPath is an expr function for querying the data with a JSONPath, the data could be a JSON string
+func ExprFuncJSONPath(params ...interface{}) (res interface{}, err error) {
+	if len(params) != 2 {
+		err = fmt.Errorf("usage: jsonpath(data, path)")
+		return
+	}
+
+	data := params[0]
+	if text, ok := data.(string); ok {
+		if err = json.Unmarshal([]byte(text), &data); err != nil {
+			return
+		}
+	}
+	res, err = util.JSONPath(data, fmt.Sprint(params[1]))
+	return
+}
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// iso8601Layouts are the accepted layouts of ISO 8601, the fractional seconds are optional
+var iso8601Layouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// ExprFuncISO8601 is an expr function for checking if a string is an ISO 8601 date or time
+func ExprFuncISO8601(params ...interface{}) (res interface{}, err error) {
+	if len(params) != 1 {
+		err = fmt.Errorf("usage: isISO8601(text)")
+		return
+	}
+
+	res = false
+	text, ok := params[0].(string)
+	for i := 0; ok && i < len(iso8601Layouts); i++ {
+		if _, parseErr := time.Parse(iso8601Layouts[i], text); parseErr == nil {
+			res = true
+			break
+		}
+	}
+	return
+}
+
+// NewSuiteExprOptions returns the expr functions of the assertions and helpers which are declared in a suite
+func NewSuiteExprOptions(suite *testing.TestSuite) (opts []expr.Option) {
+	if suite == nil {
+		return
+	}
+
+	// the functions are compiled lazily, then they are able to call each other
+	shared := &opts
+	depth := new(int)
+	for _, item := range suite.Assertions {
+		opts = append(opts, newExprFunction(item, true, shared, depth))
+	}
+	for _, item := range suite.Helpers {
+		opts = append(opts, newExprFunction(item, false, shared, depth))
+	}
+	return
+}
+
+// maxExprFunctionDepth limits the nested calls of the assertions and helpers of a suite
+const maxExprFunctionDepth = 16
+
+func newExprFunction(fn testing.ExprFunction, assertion bool, opts *[]expr.Option, depth *int) expr.Option {
+	var once sync.Once
+	var program *vm.Program
+	var compileErr error
+
+	var types []any
+	if assertion {
+		types = append(types, new(func(...any) bool))
+	}
+	return expr.Function(fn.Name, func(params ...any) (res any, err error) {
+		if len(params) != len(fn.Params) {
+			err = fmt.Errorf("%s requires %d params, but got %d", fn.Name, len(fn.Params), len(params))
+			return
+		}
+
+		if *depth >= maxExprFunctionDepth {
+			err = fmt.Errorf("the calls of %s are nested more than %d levels", fn.Name, maxExprFunctionDepth)
+			return
+		}
+		*depth++
+		defer func() {
+			*depth--
+		}()
+
+		once.Do(func() {
+			program, compileErr = expr.Compile(fn.Expr, *opts...)
+		})
+		if err = compileErr; err != nil {
+			err = fmt.Errorf("failed to compile %s: %v", fn.Name, err)
+			return
+		}
+
+		env := map[string]any{}
+		for i, name := range fn.Params {
+			env[name] = params[i]
+		}
+		if res, err = expr.Run(program, env); err == nil && assertion {
+			if _, ok := res.(bool); !ok {
+				err = fmt.Errorf("the assertion %s should return a bool, but got %v", fn.Name, res)
+			}
+		}
+		return
+	}, types...)
+}
+
+// responseExprOptions returns the expr functions which read the HTTP response
+func responseExprOptions(header http.Header, elapsed time.Duration) []expr.Option {
+	return []expr.Option{
+		expr.Function("header", func(params ...any) (res any, err error) {
+			res = header.Get(fmt.Sprint(params[0]))
+			return
+		}, new(func(string) string)),
+		expr.Function("within", func(params ...any) (res any, err error) {
+			var limit time.Duration
+			switch val := params[0].(type) {
+			case time.Duration:
+				limit = val
+			case string:
+				if limit, err = time.ParseDuration(val); err != nil {
+					return
+				}
+			default:
+				err = fmt.Errorf("the duration should be a string or duration, but got %v", val)
+				return
+			}
+			res = elapsed <= limit
+			return
+		}, new(func(any) bool)),
+		expr.Patch(durationPatcher{}),
+	}
+}
+
 func init() {
 	builtin.Builtins = append(builtin.Builtins, []*ast.Function{
+		{
+			Name: "jsonpath",
+			Func: ExprFuncJSONPath,
+		},
+		{
+			Name: "regexMatch",
+			Func: func(params ...interface{}) (res any, err error) {
+				if len(params) != 2 {
+					err = fmt.Errorf("usage: regexMatch(text, pattern)")
+					return
+				}
+				res, err = regexp.MatchString(fmt.Sprint(params[1]), fmt.Sprint(params[0]))
+				return
+			},
+			Types: []reflect.Type{reflect.TypeOf(new(func(any, string) bool)).Elem()},
+		},
+		{
+			Name: "isUUID",
+			Func: func(params ...interface{}) (res any, err error) {
+				if len(params) != 1 {
+					err = fmt.Errorf("usage: isUUID(text)")
+					return
+				}
+				text, ok := params[0].(string)
+				res = ok && uuidRegexp.MatchString(text)
+				return
+			},
+			Types: []reflect.Type{reflect.TypeOf(new(func(any) bool)).Elem()},
+		},
+		{
+			Name:  "isISO8601",
+			Func:  ExprFuncISO8601,
+			Types: []reflect.Type{reflect.TypeOf(new(func(any) bool)).Elem()},
+		},
 		{
 			Name: "sleep",
 			Func: ExprFuncSleep,
@@ -169,5 +347,27 @@ func init() {
 				return
 			},
 		},
+		{
+			Name: "jwtSign",
+			Func: func(params ...interface{}) (res any, err error) {
+				if len(params) != 3 {
+					err = fmt.Errorf("jwtSign requires the algorithm, claims and key")
+					return
+				}
+				res, err = render.SignJWT(fmt.Sprint(params[0]), params[1], fmt.Sprint(params[2]))
+				return
+			},
+		},
+		{
+			Name: "hmacSign",
+			Func: func(params ...interface{}) (res any, err error) {
+				if len(params) != 3 {
+					err = fmt.Errorf("hmacSign requires the algorithm, secret and content")
+					return
+				}
+				res, err = render.HMACSign(fmt.Sprint(params[0]), fmt.Sprint(params[1]), fmt.Sprint(params[2]))
+				return
+			},
+		},
 	}...)
 }
@@ -52,5 +52,5 @@ func (r *graphql) RunTestCase(testcase *testing.TestCase, dataContext any, ctx c
 }
 
 func (s *graphql) WithSuite(suite *testing.TestSuite) {
-	// not need this parameter
+	s.TestCaseRunner.WithSuite(suite)
 }
@@ -32,10 +32,12 @@ import (
 
 	"github.com/bufbuild/protocompile"
 	"github.com/bufbuild/protocompile/linker"
+	"github.com/expr-lang/expr"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/grpcreflect"
 	"github.com/linuxsuren/api-testing/pkg/apispec"
 	"github.com/linuxsuren/api-testing/pkg/compare"
+	"github.com/linuxsuren/api-testing/pkg/generator"
 	"github.com/linuxsuren/api-testing/pkg/logging"
 	"github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
@@ -102,7 +104,7 @@ func (r *gRPCTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext
 
 	defer func() {
 		if err == nil {
-			err = runJob(testcase.After, dataContext, output)
+			err = r.runAfterJobs(testcase, dataContext, output)
 		}
 	}()
 
@@ -111,7 +113,7 @@ func (r *gRPCTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext
 		return
 	}
 
-	if err = runJob(testcase.Before, dataContext, nil); err != nil {
+	if err = r.runBeforeJobs(testcase, dataContext); err != nil {
 		return
 	}
 
@@ -149,7 +151,7 @@ func (r *gRPCTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext
 	r.response.Body = record.Body
 	r.log.Debug("response body: %s\n", record.Body)
 
-	output, err = verifyResponsePayload(md, testcase.Name, testcase.Expect, respsStr)
+	output, err = verifyResponsePayload(md, testcase.Name, testcase.Expect, respsStr, r.exprOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +176,7 @@ func (r *gRPCTestCaseRunner) getConnection(host string) (conn *grpc.ClientConn,
 }
 
 func (r *gRPCTestCaseRunner) GetSuggestedAPIs(suite *testing.TestSuite, api string) (result []*testing.TestCase, err error) {
+	synthesizer := generator.NewProtoPayloadSynthesizer(nil)
 	if suite.Spec.RPC.ServerReflection {
 		var conn *grpc.ClientConn
 		if conn, err = r.getConnection(suite.API); err != nil {
@@ -197,12 +200,7 @@ func (r *gRPCTestCaseRunner) GetSuggestedAPIs(suite *testing.TestSuite, api stri
 
 			for _, item := range svcs {
 				for _, fdb := range item.GetMethods() {
-					result = append(result, &testing.TestCase{
-						Name: fdb.GetName(),
-						Request: testing.Request{
-							API: fmt.Sprintf("/%s/%s", svc, fdb.GetName()),
-						},
-					})
+					result = append(result, suggestedCases(synthesizer, fmt.Sprintf("/%s/%s", svc, fdb.GetName()), api, fdb.UnwrapMethod())...)
 				}
 			}
 		}
@@ -223,26 +221,64 @@ func (r *gRPCTestCaseRunner) GetSuggestedAPIs(suite *testing.TestSuite, api stri
 			for m := 0; m < methodCount; m++ {
 				method := svc.Methods().Get(m)
 				methodName := string(method.Name())
-				api := "/" + string(method.FullName())
-				api = strings.ReplaceAll(api, "."+methodName, "/"+methodName)
-
-				result = append(result, &testing.TestCase{
-					Name: string(methodName),
-					Request: testing.Request{
-						API: api,
-					},
-				})
+				methodAPI := "/" + string(method.FullName())
+				methodAPI = strings.ReplaceAll(methodAPI, "."+methodName, "/"+methodName)
+
+				result = append(result, suggestedCases(synthesizer, methodAPI, api, method)...)
 			}
 		}
 	}
 	return
 }
 
+// suggestedCases returns the test case of a method with a synthesized payload.
+// All methods are suggested when the expected API is empty, otherwise only the
+// expected one is suggested together with its invalid payloads.
+func suggestedCases(synthesizer generator.ProtoPayloadSynthesizer, api, expectedAPI string,
+	method protoreflect.MethodDescriptor) (result []*testing.TestCase) {
+	if expectedAPI != "" && expectedAPI != api {
+		return
+	}
+
+	name := string(method.Name())
+	result = append(result, &testing.TestCase{
+		Name: name,
+		Request: testing.Request{
+			API:  api,
+			Body: suggestedBody(method, synthesizer.Valid(method.Input())),
+		},
+	})
+	if expectedAPI == "" {
+		return
+	}
+
+	for _, invalid := range synthesizer.Invalid(method.Input()) {
+		result = append(result, &testing.TestCase{
+			Name: fmt.Sprintf("%s invalid: %s", name, invalid.Reason),
+			Request: testing.Request{
+				API:  api,
+				Body: suggestedBody(method, invalid.Payload),
+			},
+		})
+	}
+	return
+}
+
+// suggestedBody wraps the payload with an array for the client streaming method
+func suggestedBody(method protoreflect.MethodDescriptor, payload map[string]any) testing.RequestBody {
+	var value any = payload
+	if method.IsStreamingClient() {
+		value = []any{payload}
+	}
+	data, _ := json.MarshalIndent(value, "", "  ")
+	return testing.NewRequestBody(string(data))
+}
+
 func (r *gRPCTestCaseRunner) GetResponseRecord() SimpleResponse {
 	return r.response
 }
 func (s *gRPCTestCaseRunner) WithSuite(suite *testing.TestSuite) {
-	// not need this parameter
+	s.UnimplementedRunner.WithSuite(suite)
 }
 
 func invokeRequest(ctx context.Context, md protoreflect.MethodDescriptor, payload string, conn *grpc.ClientConn) (response []string, err error) {
@@ -627,7 +663,7 @@ sendLoop:
 	}
 }
 
-func verifyResponsePayload(md protoreflect.MethodDescriptor, caseName string, expect testing.Response, jsonPayload []string) (output any, err error) {
+func verifyResponsePayload(md protoreflect.MethodDescriptor, caseName string, expect testing.Response, jsonPayload []string, opts ...expr.Option) (output any, err error) {
 	mapOutput := map[string]any{
 		"data": func() []map[string]any {
 			r := make([]map[string]any, len(jsonPayload))
@@ -644,7 +680,16 @@ func verifyResponsePayload(md protoreflect.MethodDescriptor, caseName string, ex
 		return
 	}
 
-	err = Verify(expect, mapOutput)
+	documents := make([][]byte, len(jsonPayload))
+	for i := range jsonPayload {
+		documents[i] = []byte(jsonPayload[i])
+	}
+	if err = VerifyQuery(expect.Query, documents...); err != nil {
+		err = fmt.Errorf("case: %s, the query assertion failed:\n%v", caseName, err)
+		return
+	}
+
+	err = Verify(expect, mapOutput, opts...)
 	if err != nil {
 		return nil, err
 	}
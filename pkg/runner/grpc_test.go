@@ -23,6 +23,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -349,6 +350,7 @@ func doGRPCTest(t *testing.T, l net.Listener, sec *atest.Secure, desc *atest.RPC
 				Expect: atest.Response{
 					Body:   getJSONOrCache("stream", nil),
 					Verify: []string{`len(data) == 3`},
+					Query:  []atest.QueryAssertion{{JMESPath: "[*].ExpectLen", Match: "all", Equal: 3}},
 				},
 			},
 			verify: func(t *testing.T, output any, err error) {
@@ -640,6 +642,34 @@ func TestGRPCGetSuggestedAPIs(t *testing.T) {
 		assert.NoError(t, err, err)
 		assert.NotEmpty(t, result)
 		assert.Equal(t, "/grpctest.Main/Unary", result[0].Request.API)
+		assert.Equal(t, "{}", result[0].Request.Body.String())
+		for _, item := range result {
+			if item.Name == "ClientStream" {
+				assert.True(t, strings.HasPrefix(item.Request.Body.String(), "["))
+			}
+		}
+	})
+
+	t.Run("invalid payloads of an API", func(t *testing.T) {
+		runner := NewGRPCTestCaseRunner("", atest.RPCDesc{
+			ProtoFile: protoFile,
+		})
+		result, err := runner.GetSuggestedAPIs(&atest.TestSuite{
+			Spec: atest.APISpec{
+				RPC: &atest.RPCDesc{
+					ProtoFile: protoFile,
+				},
+			},
+		}, basic)
+		assert.NoError(t, err)
+		if assert.Greater(t, len(result), 1) {
+			assert.Equal(t, "TestBasicType", result[0].Name)
+			assert.True(t, json.Valid([]byte(result[0].Request.Body.String())))
+			for _, item := range result[1:] {
+				assert.Equal(t, basic, item.Request.API)
+				assert.True(t, strings.HasPrefix(item.Name, "TestBasicType invalid: "), item.Name)
+			}
+		}
 	})
 
 	t.Run("not found proto file", func(t *testing.T) {
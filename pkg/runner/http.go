@@ -30,15 +30,15 @@ import (
 	"strings"
 	"time"
 
-	"github.com/go-openapi/spec"
-
 	"github.com/andreyvit/diff"
 	"github.com/expr-lang/expr"
 	"github.com/expr-lang/expr/vm"
 	"github.com/linuxsuren/api-testing/pkg/apispec"
+	"github.com/linuxsuren/api-testing/pkg/compare"
 	"github.com/linuxsuren/api-testing/pkg/render"
 	"github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/tidwall/gjson"
 	"github.com/xeipuuv/gojsonschema"
 )
 
@@ -53,6 +53,8 @@ type ReportResult struct {
 	QPS              int
 	Error            int
 	LastErrorMessage string
+	// Coverage has the distinct requests for the API coverage
+	Coverage []apispec.CoverageRecord `json:"-"`
 }
 
 // ReportResultSlice is the alias type of ReportResult slice
@@ -80,6 +82,9 @@ type simpleTestCaseRunner struct {
 	simpleResponse  SimpleResponse
 	cookies         []*http.Cookie
 	apiSuggestLimit int
+	spec            testing.APISpec
+	suiteName       string
+	auth            *testing.Auth
 }
 
 // NewSimpleTestCaseRunner creates the instance of the simple test case runner
@@ -112,6 +117,16 @@ func (c ContextKey) ParentDir() ContextKey {
 	return ContextKey("parentDir")
 }
 
+// Store returns the key of the store name
+func (c ContextKey) Store() ContextKey {
+	return ContextKey("store")
+}
+
+// UpdateSnapshots returns the key of the flag which indicates overwriting the snapshots
+func (c ContextKey) UpdateSnapshots() ContextKey {
+	return ContextKey("updateSnapshots")
+}
+
 // GetContextValueOrEmpty returns the value of the context key, if not exist, return empty string
 func (c ContextKey) GetContextValueOrEmpty(ctx context.Context) string {
 	if ctx.Value(c) != nil {
@@ -124,6 +139,7 @@ func (c ContextKey) GetContextValueOrEmpty(ctx context.Context) string {
 func (r *simpleTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataContext interface{}, ctx context.Context) (output interface{}, err error) {
 	r.log.Info("start to run: '%s'\n", testcase.Name)
 	record := NewReportRecord()
+	var statusCode int
 	defer func(rr *ReportRecord) {
 		rr.Group = testcase.Group
 		rr.Name = testcase.Name
@@ -131,12 +147,13 @@ func (r *simpleTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataConte
 		rr.Error = err
 		rr.API = testcase.Request.API
 		rr.Method = testcase.Request.Method
+		rr.Coverage = NewCoverageRecord(testcase.Request, statusCode)
 		r.testReporter.PutRecord(rr)
 	}(record)
 
 	defer func() {
 		if err == nil {
-			err = runJob(testcase.After, dataContext, output)
+			err = r.runAfterJobs(testcase, dataContext, output)
 		}
 	}()
 
@@ -194,7 +211,7 @@ func (r *simpleTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataConte
 		request.Header.Add(key, val)
 	}
 
-	if err = runJob(testcase.Before, dataContext, nil); err != nil {
+	if err = r.runBeforeJobs(testcase, dataContext); err != nil {
 		return
 	}
 
@@ -207,19 +224,26 @@ func (r *simpleTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataConte
 			Value: v,
 		})
 	}
-	r.log.Info("start to send request to %v with method %s\n", request.URL, request.Method)
-	r.log.Info("request header %v\n", request.Header)
-
 	// TODO only do this for unit testing, should remove it once we have a better way
 	if strings.HasPrefix(testcase.Request.API, "http://") && r.proxy == nil {
 		client = http.DefaultClient
 	}
 
+	r.log.Info("start to send request to %v with method %s\n", request.URL, request.Method)
+	// the headers are logged before signing, the tokens and signatures are not written into the output
+	r.log.Info("request header %v\n", request.Header)
+	authScope := NewContextKeyBuilder().Store().GetContextValueOrEmpty(ctx) + "/" + r.suiteName
+	if err = signRequest(request, client, r.auth, authScope); err != nil {
+		return
+	}
+
 	// send the HTTP request
 	var resp *http.Response
-	if resp, err = client.Do(request); err != nil {
+	tracer := newRequestTracer()
+	if resp, err = client.Do(request.WithContext(tracer.withContext(request.Context()))); err != nil {
 		return
 	}
+	statusCode = resp.StatusCode
 
 	r.log.Debug("test case %q, status code: %d\n", testcase.Name, resp.StatusCode)
 
@@ -249,22 +273,32 @@ func (r *simpleTestCaseRunner) RunTestCase(testcase *testing.TestCase, dataConte
 		record.Body = string(responseBodyData)
 		r.log.Trace("response body: %s\n", record.Body)
 
-		if output, rErr = verifyResponseBodyData(testcase.Name, testcase.Expect, respType, responseBodyData); rErr != nil {
+		stats := tracer.stats(resp, int64(len(responseBodyData)))
+		err = errors.Join(err, verifyResponseStats(testcase.Name, testcase.Expect, stats))
+
+		opts := append(responseExprOptions(resp.Header, stats.Duration), r.exprOptions...)
+		if output, rErr = verifyResponseBodyData(testcase.Name, testcase.Expect, respType, responseBodyData, stats.Env(), opts...); rErr != nil {
 			err = errors.Join(err, rErr)
 			return
 		}
 
-		err = errors.Join(err, jsonSchemaValidation(testcase.Expect.Schema, responseBodyData))
+		err = errors.Join(err, jsonSchemaValidation(testcase.Expect.Schema, responseBodyData),
+			r.validateContract(contextDir, request, resp, responseBodyData),
+			verifySnapshot(ctx, r.suiteName, testcase, r.simpleResponse.Header, responseBodyData))
 	} else {
+		size := resp.ContentLength
 		switch respType {
 		case util.OctetStream, util.Image, util.ImagePNG:
 			var data []byte
 			if data, err = io.ReadAll(resp.Body); err == nil {
+				size = int64(len(data))
 				r.simpleResponse.RawBody = data
 				r.simpleResponse, err = HandleLargeResponseBody(r.simpleResponse, testcase.Group, testcase.Name)
 			}
 		}
 		r.log.Debug("skip to read the body due to it is not struct content: %q\n", respType)
+		err = errors.Join(err, r.validateContract(contextDir, request, resp, nil),
+			verifyResponseStats(testcase.Name, testcase.Expect, tracer.stats(resp, size)))
 	}
 
 	r.cookies = append(r.cookies, resp.Cookies()...)
@@ -311,64 +345,53 @@ func ammendHeaders(headers http.Header, body []byte) {
 }
 
 func (r *simpleTestCaseRunner) GetSuggestedAPIs(suite *testing.TestSuite, api string) (result []*testing.TestCase, err error) {
-	if suite.Spec.URL == "" || suite.Spec.Kind != "swagger" {
+	if suite.Spec.URL == "" || (suite.Spec.Kind != "swagger" && suite.Spec.Kind != "openapi") {
 		return
 	}
 
-	var swagger *spec.Swagger
-	if swagger, err = apispec.ParseURLToSwagger(suite.Spec.URL); err == nil && swagger != nil {
-		swaggerAPI := apispec.NewSwaggerAPI(swagger)
-		for api, methods := range swaggerAPI.ApiMap {
-			for _, method := range methods {
-				testcase := &testing.TestCase{
-					Name: swagger.ID,
-					Request: testing.Request{
-						API:    api,
-						Method: strings.ToUpper(method),
-						Query:  make(testing.SortedKeysStringMap),
-					},
-				}
+	var apiSpec apispec.APISpec
+	if apiSpec, err = apispec.ParseURLToAPISpec(suite.Spec.URL); err == nil && apiSpec != nil {
+		for _, operation := range apiSpec.GetOperations() {
+			testcase := &testing.TestCase{
+				Name: util.EmptyThenDefault(operation.ID, operation.Method+" "+operation.Path),
+				Request: testing.Request{
+					API:    operation.Path,
+					Method: operation.Method,
+					Query:  make(testing.SortedKeysStringMap),
+					Header: make(map[string]string),
+				},
+			}
 
-				switch testcase.Request.Method {
-				case http.MethodGet:
-					for _, param := range swagger.Paths.Paths[api].Get.Parameters {
-						switch param.In {
-						case "query":
-							// TODO should have a better way to provide the initial value
-							(&(testcase.Request)).Query[param.Name] = generateRandomValue(param)
-						}
-					}
-					testcase.Name = swagger.Paths.Paths[api].Get.ID
-				case http.MethodPost:
-					testcase.Name = swagger.Paths.Paths[api].Post.ID
-				case http.MethodPut:
-					testcase.Name = swagger.Paths.Paths[api].Put.ID
-				case http.MethodDelete:
-					testcase.Name = swagger.Paths.Paths[api].Delete.ID
-				case http.MethodPatch:
-					testcase.Name = swagger.Paths.Paths[api].Patch.ID
+			for _, param := range operation.Parameters {
+				switch param.In {
+				case "query":
+					// TODO should have a better way to provide the initial value
+					(&(testcase.Request)).Query[param.Name] = generateRandomValue(param)
+				case "header":
+					testcase.Request.Header[param.Name] = fmt.Sprintf("%v", generateRandomValue(param))
 				}
-				result = append(result, testcase)
-				if len(result) >= r.apiSuggestLimit {
+			}
+
+			if operation.RequestBody != nil {
+				var body string
+				if body, err = operation.RequestBody.GenerateSample(); err != nil {
 					return
 				}
+				testcase.Request.Body = testing.NewRequestBody(body)
+				testcase.Request.Header[util.ContentType] = operation.RequestBody.ContentType
+			}
+
+			result = append(result, testcase)
+			if len(result) >= r.apiSuggestLimit {
+				return
 			}
 		}
 	}
 	return
 }
 
-func generateRandomValue(param spec.Parameter) interface{} {
-	switch param.Format {
-	case "int32", "int64":
-		return 101
-	case "boolean":
-		return true
-	case "string":
-		return "random"
-	default:
-		return "random"
-	}
+func generateRandomValue(param apispec.Parameter) interface{} {
+	return param.GenerateSample()
 }
 
 func (r *simpleTestCaseRunner) withSimpleResponseRecord(resp *http.Response) {
@@ -400,6 +423,40 @@ func (r *simpleTestCaseRunner) WithAPISuggestLimit(limit int) {
 	r.apiSuggestLimit = limit
 }
 
+// WithSuite sets the suite, the responses will be validated against its API document
+func (r *simpleTestCaseRunner) WithSuite(suite *testing.TestSuite) {
+	r.UnimplementedRunner.WithSuite(suite)
+	if suite != nil {
+		r.spec = suite.Spec
+		r.suiteName = suite.Name
+		r.auth = suite.Auth
+	}
+}
+
+// validateContract validates the response against the API document of the suite, a relative local document
+// is resolved from the directory of the suite. The violations are test failures in the strict mode, or warnings
+func (r *simpleTestCaseRunner) validateContract(contextDir string, request *http.Request, resp *http.Response, body []byte) (err error) {
+	if !r.spec.IsContractEnabled() {
+		return
+	}
+
+	specURL := r.spec.URL
+	if contextDir != "" && !strings.Contains(specURL, "://") && !filepath.IsAbs(specURL) {
+		specURL = filepath.Join(contextDir, specURL)
+	}
+
+	var validator apispec.ContractValidator
+	if validator, err = apispec.GetContractValidator(specURL); err == nil {
+		err = validator.Validate(request.Method, request.URL.String(), resp.StatusCode, resp.Header, body)
+	}
+
+	if err != nil && r.spec.Contract != testing.ContractStrict {
+		r.log.Info("[WARN] %v\n", err)
+		err = nil
+	}
+	return
+}
+
 func expectInt(name string, expect, actual int) (err error) {
 	if expect != actual {
 		err = fmt.Errorf("case: %s, expect %d, actual %d", name, expect, actual)
@@ -429,7 +486,27 @@ func jsonSchemaValidation(schema string, body []byte) (err error) {
 	return
 }
 
-func verifyResponseBodyData(caseName string, expect testing.Response, responseType string, responseBodyData []byte) (output interface{}, err error) {
+func matchResponseBody(bodyMatch *testing.BodyMatch, body []byte) (err error) {
+	if !gjson.Valid(bodyMatch.Body) {
+		err = fmt.Errorf("the expected body is not a valid JSON: %s", bodyMatch.Body)
+		return
+	}
+	if !gjson.ValidBytes(body) {
+		err = fmt.Errorf("the response body is not a valid JSON: %s", string(body))
+		return
+	}
+
+	err = compare.Match("body", gjson.Parse(bodyMatch.Body), gjson.ParseBytes(body), compare.Options{
+		Ignore:    bodyMatch.Ignore,
+		Unordered: bodyMatch.Unordered,
+		Tolerance: bodyMatch.Tolerance,
+		Subset:    bodyMatch.Subset,
+	})
+	return
+}
+
+func verifyResponseBodyData(caseName string, expect testing.Response, responseType string, responseBodyData []byte,
+	env map[string]interface{}, opts ...expr.Option) (output interface{}, err error) {
 	if expect.Body != "" {
 		if string(responseBodyData) != strings.TrimSpace(expect.Body) {
 			err = fmt.Errorf("case: %s, got different response body, diff: \n%s", caseName,
@@ -438,6 +515,18 @@ func verifyResponseBodyData(caseName string, expect testing.Response, responseTy
 		}
 	}
 
+	if expect.BodyMatch != nil {
+		if err = matchResponseBody(expect.BodyMatch, responseBodyData); err != nil {
+			err = fmt.Errorf("case: %s, the response body is not matched:\n%v", caseName, err)
+			return
+		}
+	}
+
+	if err = VerifyQuery(expect.Query, responseBodyData); err != nil {
+		err = fmt.Errorf("case: %s, the query assertion failed:\n%v", caseName, err)
+		return
+	}
+
 	verifier := NewBodyVerify(responseType, expect)
 	if verifier == nil {
 		runnerLogger.Info("no body verify support with", "response type", responseType)
@@ -448,16 +537,18 @@ func verifyResponseBodyData(caseName string, expect testing.Response, responseTy
 		return
 	}
 
-	mapOutput := map[string]interface{}{
-		"data": output,
+	mapOutput := map[string]interface{}{}
+	for key, val := range env {
+		mapOutput[key] = val
 	}
+	mapOutput["data"] = output
 	if err = verifier.Verify(responseBodyData); err == nil {
-		err = Verify(expect, mapOutput)
+		err = Verify(expect, mapOutput, opts...)
 	}
 	return
 }
 
-func runJob(job *testing.Job, ctx interface{}, current interface{}) (err error) {
+func runJob(job *testing.Job, ctx interface{}, current interface{}, opts ...expr.Option) (err error) {
 	if job == nil {
 		return
 	}
@@ -474,7 +565,7 @@ func runJob(job *testing.Job, ctx interface{}, current interface{}) (err error)
 			break
 		}
 
-		if program, err = expr.Compile(exprText, expr.Env(env)); err != nil {
+		if program, err = expr.Compile(exprText, append([]expr.Option{expr.Env(env)}, opts...)...); err != nil {
 			fmt.Printf("failed to compile: %q, %v\n", exprText, err)
 			return
 		}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	"github.com/expr-lang/expr/ast"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+)
+
+// ResponseTiming has the durations of the phases of a HTTP request
+type ResponseTiming struct {
+	DNS     time.Duration
+	Connect time.Duration
+	TLS     time.Duration
+	// TTFB is the duration from sending the request to getting the first byte of the response
+	TTFB time.Duration
+}
+
+// ResponseStats is the statistics of a HTTP response, they are available in the verify environment
+type ResponseStats struct {
+	Duration time.Duration
+	// Size is the length of the response body, it is -1 if unknown
+	Size   int64
+	Timing ResponseTiming
+	// URL is the final URL after the redirects
+	URL string
+}
+
+// Env returns the variables of the verify environment, such as: size, url, timing.ttfb and timing.duration.
+// The variable duration is patched to timing.duration by durationPatcher
+func (s ResponseStats) Env() map[string]interface{} {
+	return map[string]interface{}{
+		"size": s.Size,
+		"url":  s.URL,
+		"timing": map[string]interface{}{
+			"duration": s.Duration,
+			"dns":      s.Timing.DNS,
+			"connect":  s.Timing.Connect,
+			"tls":      s.Timing.TLS,
+			"ttfb":     s.Timing.TTFB,
+		},
+	}
+}
+
+// durationPatcher refers the variable duration to timing.duration, because duration is the name of a builtin function.
+// The calls like duration("1m") are parsed as the builtin function, they are not changed
+type durationPatcher struct{}
+
+// Visit replaces the identifier duration with timing.duration
+func (durationPatcher) Visit(node *ast.Node) {
+	if identifier, ok := (*node).(*ast.IdentifierNode); ok && identifier.Value == "duration" {
+		ast.Patch(node, &ast.MemberNode{
+			Node:     &ast.IdentifierNode{Value: "timing"},
+			Property: &ast.StringNode{Value: "duration"},
+		})
+	}
+}
+
+// requestTracer records the timing phases of a HTTP request via httptrace
+type requestTracer struct {
+	lock         sync.Mutex
+	start        time.Time
+	dnsStart     time.Time
+	connectStart time.Time
+	tlsStart     time.Time
+	timing       ResponseTiming
+}
+
+func newRequestTracer() *requestTracer {
+	return &requestTracer{start: time.Now()}
+}
+
+func (t *requestTracer) withContext(ctx context.Context) context.Context {
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.record(func() { t.dnsStart = time.Now() })
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.record(func() { t.timing.DNS = time.Since(t.dnsStart) })
+		},
+		ConnectStart: func(string, string) {
+			t.record(func() { t.connectStart = time.Now() })
+		},
+		ConnectDone: func(string, string, error) {
+			t.record(func() { t.timing.Connect = time.Since(t.connectStart) })
+		},
+		TLSHandshakeStart: func() {
+			t.record(func() { t.tlsStart = time.Now() })
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.record(func() { t.timing.TLS = time.Since(t.tlsStart) })
+		},
+		GotFirstResponseByte: func() {
+			t.record(func() { t.timing.TTFB = time.Since(t.start) })
+		},
+	})
+}
+
+func (t *requestTracer) record(fn func()) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	fn()
+}
+
+// stats returns the statistics of the response, the duration ends when this function is called
+func (t *requestTracer) stats(resp *http.Response, size int64) (stats ResponseStats) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	stats = ResponseStats{
+		Duration: time.Since(t.start),
+		Size:     size,
+		Timing:   t.timing,
+	}
+	if resp.Request != nil && resp.Request.URL != nil {
+		stats.URL = resp.Request.URL.String()
+	}
+	return
+}
+
+// verifyResponseStats checks the duration and the body size of the response
+func verifyResponseStats(caseName string, expect testing.Response, stats ResponseStats) (err error) {
+	if expect.MaxDuration != "" {
+		var maxDuration time.Duration
+		if maxDuration, err = time.ParseDuration(expect.MaxDuration); err != nil {
+			err = fmt.Errorf("case: %s, invalid maxDuration %q: %v", caseName, expect.MaxDuration, err)
+			return
+		}
+		if stats.Duration > maxDuration {
+			err = fmt.Errorf("case: %s, expect the duration is less than %v, actual %v", caseName, maxDuration, stats.Duration)
+			return
+		}
+	}
+
+	if stats.Size < 0 {
+		return
+	}
+	if expect.MaxBodySize > 0 && stats.Size > expect.MaxBodySize {
+		err = fmt.Errorf("case: %s, expect the body size is not greater than %d bytes, actual %d", caseName, expect.MaxBodySize, stats.Size)
+	} else if expect.MinBodySize > 0 && stats.Size < expect.MinBodySize {
+		err = fmt.Errorf("case: %s, expect the body size is not less than %d bytes, actual %d", caseName, expect.MinBodySize, stats.Size)
+	}
+	return
+}
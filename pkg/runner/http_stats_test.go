@@ -0,0 +1,105 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseStats(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/new", http.StatusFound)
+	})
+	mux.HandleFunc("/new", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(util.ContentType, util.JSON)
+		_, _ = w.Write([]byte(`{"name":"rick"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	run := func(expect atest.Response) error {
+		runner := NewSimpleTestCaseRunner()
+		_, err := runner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{API: server.URL + "/old"},
+			Expect:  expect,
+		}, nil, context.TODO())
+		return err
+	}
+
+	assert.NoError(t, run(atest.Response{
+		MaxDuration: "1m",
+		MaxBodySize: 15,
+		MinBodySize: 15,
+		Verify: []string{
+			`size == 15`,
+			`timing.duration < duration("1m")`,
+			`duration < duration("1m") && duration == timing.duration`,
+			`duration("1m") > duration`,
+			`timing.ttfb > duration("0s") && timing.ttfb <= timing.duration`,
+			`timing.connect > duration("0s")`,
+			`url endsWith "/new"`,
+			`data.name == "rick"`,
+		},
+	}))
+	assert.ErrorContains(t, run(atest.Response{MaxDuration: "1ns"}), "expect the duration is less than 1ns")
+	assert.ErrorContains(t, run(atest.Response{MaxBodySize: 10}), "expect the body size is not greater than 10 bytes, actual 15")
+	assert.ErrorContains(t, run(atest.Response{MinBodySize: 20}), "expect the body size is not less than 20 bytes, actual 15")
+	assert.ErrorContains(t, run(atest.Response{MaxDuration: "fake"}), `invalid maxDuration "fake"`)
+}
+
+func TestVerifyResponseStats(t *testing.T) {
+	stats := ResponseStats{Duration: time.Second, Size: -1}
+	assert.NoError(t, verifyResponseStats("fake", atest.Response{MaxDuration: "2s", MinBodySize: 1}, stats))
+	assert.Error(t, verifyResponseStats("fake", atest.Response{MaxDuration: "500ms"}, stats))
+
+	assert.Equal(t, map[string]interface{}{
+		"size": int64(-1),
+		"url":  "",
+		"timing": map[string]interface{}{
+			"duration": time.Second,
+			"dns":      time.Duration(0),
+			"connect":  time.Duration(0),
+			"tls":      time.Duration(0),
+			"ttfb":     time.Duration(0),
+		},
+	}, stats.Env())
+}
+
+func TestDurationPatcher(t *testing.T) {
+	env := map[string]interface{}{
+		"data":   map[string]interface{}{"duration": 3},
+		"timing": map[string]interface{}{"duration": time.Second},
+	}
+	for _, exprText := range []string{
+		`duration == duration("1s")`,
+		`data.duration == 3`,
+		`within(duration("1m"))`,
+	} {
+		ok, err := verify(exprText, env, responseExprOptions(http.Header{}, time.Second)...)
+		assert.NoError(t, err, exprText)
+		assert.True(t, ok, exprText)
+	}
+}
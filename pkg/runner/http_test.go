@@ -19,15 +19,18 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	_ "embed"
 
-	"github.com/go-openapi/spec"
 	"github.com/h2non/gock"
+	"github.com/linuxsuren/api-testing/pkg/apispec"
 	atest "github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
 	fakeruntime "github.com/linuxsuren/go-fake-runtime"
@@ -229,6 +232,65 @@ func TestTestCase(t *testing.T) {
 			},
 		},
 		prepare: prepareForFoo,
+	}, {
+		name: "body match",
+		testCase: &atest.TestCase{
+			Request: fooRequest,
+			Expect: atest.Response{
+				BodyMatch: &atest.BodyMatch{
+					Body:      `{"name": "$regex:^linux", "number": 1.05}`,
+					Tolerance: 0.1,
+				},
+			},
+		},
+		prepare: prepareForFoo,
+		verify:  noError,
+	}, {
+		name: "body not match",
+		testCase: &atest.TestCase{
+			Request: fooRequest,
+			Expect: atest.Response{
+				BodyMatch: &atest.BodyMatch{
+					Body:   `{"name": "rick"}`,
+					Ignore: []string{"number"},
+				},
+			},
+		},
+		prepare: prepareForFoo,
+		verify: func(t *testing.T, _ interface{}, err error) {
+			assert.ErrorContains(t, err, "compare: field body.name: expect rick but got linuxsuren")
+		},
+	}, {
+		name: "query assertion",
+		testCase: &atest.TestCase{
+			Request: fooRequest,
+			Expect: atest.Response{
+				Query: []atest.QueryAssertion{{
+					JSONPath: "$.name",
+					Regex:    "^linux",
+				}, {
+					JMESPath: "number",
+					Type:     "number",
+				}},
+			},
+		},
+		prepare: prepareForFoo,
+		verify:  noError,
+	}, {
+		name: "query assertion failed",
+		testCase: &atest.TestCase{
+			Request: fooRequest,
+			Expect: atest.Response{
+				Query: []atest.QueryAssertion{{
+					JSONPath: "$.name",
+					Equal:    "rick",
+				}},
+			},
+		},
+		prepare: prepareForFoo,
+		verify: func(t *testing.T, _ interface{}, err error) {
+			assert.ErrorContains(t, err, `query "$.name": expect rick but got linuxsuren`)
+		},
 	}, {
 		name: "invalid filed finding",
 		testCase: &atest.TestCase{
@@ -461,6 +523,90 @@ func TestJSONSchemaValidation(t *testing.T) {
 	}
 }
 
+func TestContractValidation(t *testing.T) {
+	defer gock.Off()
+
+	newRunner := func(contract string) TestCaseRunner {
+		runner := NewSimpleTestCaseRunner()
+		runner.WithSuite(&atest.TestSuite{
+			Spec: atest.APISpec{
+				Kind:     "openapi",
+				URL:      "../apispec/testdata/openapi.yaml",
+				Contract: contract,
+			},
+		})
+		return runner
+	}
+	testCase := &atest.TestCase{
+		Request: atest.Request{
+			API: "http://localhost/api/v1/users",
+		},
+		Expect: atest.Response{
+			StatusCode: http.StatusOK,
+		},
+	}
+
+	t.Run("strict", func(t *testing.T) {
+		gock.New("http://localhost").Get("/api/v1/users").Reply(http.StatusOK).JSON(`[{"email":"user@example.com"}]`)
+		_, err := newRunner(atest.ContractStrict).RunTestCase(testCase, nil, context.TODO())
+		assert.ErrorContains(t, err, "contract violation of GET /users")
+	})
+
+	t.Run("warn", func(t *testing.T) {
+		gock.New("http://localhost").Get("/api/v1/users").Reply(http.StatusOK).JSON(`[{"email":"user@example.com"}]`)
+		buf := new(bytes.Buffer)
+		runner := newRunner(atest.ContractWarn)
+		runner.WithOutputWriter(buf)
+		runner.WithWriteLevel("info")
+		_, err := runner.RunTestCase(testCase, nil, context.TODO())
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "[WARN] contract violation")
+	})
+
+	t.Run("warn by default", func(t *testing.T) {
+		gock.New("http://localhost").Get("/api/v1/users").Reply(http.StatusOK).JSON(`[{"email":"user@example.com"}]`)
+		buf := new(bytes.Buffer)
+		runner := newRunner("")
+		runner.WithOutputWriter(buf)
+		runner.WithWriteLevel("info")
+		_, err := runner.RunTestCase(testCase, nil, context.TODO())
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "[WARN] contract violation")
+	})
+
+	t.Run("relative to the suite", func(t *testing.T) {
+		gock.New("http://localhost").Get("/api/v1/users").Reply(http.StatusOK).JSON(`[{"email":"user@example.com"}]`)
+		runner := NewSimpleTestCaseRunner()
+		runner.WithSuite(&atest.TestSuite{
+			Spec: atest.APISpec{
+				Kind:     "openapi",
+				URL:      "testdata/openapi.yaml",
+				Contract: atest.ContractStrict,
+			},
+		})
+		ctx := context.WithValue(context.TODO(), NewContextKeyBuilder().ParentDir(), "../apispec")
+		_, err := runner.RunTestCase(testCase, nil, ctx)
+		assert.ErrorContains(t, err, "contract violation of GET /users")
+	})
+
+	t.Run("none", func(t *testing.T) {
+		gock.New("http://localhost").Get("/api/v1/users").Reply(http.StatusOK).JSON(`[{"email":"user@example.com"}]`)
+		buf := new(bytes.Buffer)
+		runner := newRunner(atest.ContractNone)
+		runner.WithOutputWriter(buf)
+		runner.WithWriteLevel("info")
+		_, err := runner.RunTestCase(testCase, nil, context.TODO())
+		assert.NoError(t, err)
+		assert.NotContains(t, buf.String(), "contract violation")
+	})
+
+	t.Run("matches the contract", func(t *testing.T) {
+		gock.New("http://localhost").Get("/api/v1/users").Reply(http.StatusOK).JSON(`[{"name":"linuxsuren"}]`)
+		_, err := newRunner(atest.ContractStrict).RunTestCase(testCase, nil, context.TODO())
+		assert.NoError(t, err)
+	})
+}
+
 func TestRunJob(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -487,6 +633,71 @@ func TestRunJob(t *testing.T) {
 	}
 }
 
+func TestSuiteHooksAndFunctions(t *testing.T) {
+	defer gock.Off()
+	dir := t.TempDir()
+	beforeFile, afterFile := filepath.Join(dir, "before"), filepath.Join(dir, "after")
+
+	suite := &atest.TestSuite{
+		Name:   "users",
+		Before: &atest.Job{Items: []string{fmt.Sprintf(`writeFile(%q, "before")`, filepath.ToSlash(beforeFile))}},
+		After:  &atest.Job{Items: []string{fmt.Sprintf(`writeFile(%q, "after")`, filepath.ToSlash(afterFile))}},
+		Assertions: []atest.ExprFunction{{
+			Name:   "isUser",
+			Params: []string{"user"},
+			Expr:   `isUUID(user.id) && hasName(user)`,
+		}},
+		Helpers: []atest.ExprFunction{{
+			Name:   "hasName",
+			Params: []string{"user"},
+			Expr:   `user.name != ""`,
+		}},
+	}
+	run := func(verify ...string) error {
+		gock.New(urlFoo).Reply(http.StatusOK).
+			SetHeader(util.ContentType, util.JSON).
+			SetHeader("X-Total", "1").
+			BodyString(`{"id": "4f6a1c2e-8b1d-4c5e-9f3a-2b7c8d9e0f1a", "name": "rick"}`)
+
+		runner := NewSimpleTestCaseRunner()
+		runner.WithSuite(suite)
+		_, err := runner.RunTestCase(&atest.TestCase{
+			Request: atest.Request{API: urlFoo},
+			Expect:  atest.Response{Verify: verify},
+		}, nil, context.TODO())
+		return err
+	}
+
+	assert.NoError(t, run(`isUser(data)`, `header("x-total") == "1"`, `within("1m")`, `within(duration("1m"))`))
+	// the hooks of the suite are not part of a test case
+	assert.NoFileExists(t, beforeFile)
+	assert.NoError(t, RunSuiteJob(suite.Before, suite, nil))
+	assert.NoError(t, RunSuiteJob(suite.After, suite, nil))
+	data, err := os.ReadFile(beforeFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "before", string(data))
+	data, err = os.ReadFile(afterFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "after", string(data))
+	assert.NoError(t, RunSuiteJob(nil, suite, nil))
+
+	assert.Error(t, run(`within("0s")`))
+	assert.Error(t, run(`within(1)`))
+	assert.Error(t, run(`header("x-total") == "2"`))
+}
+
+func TestExprFunctionDepth(t *testing.T) {
+	suite := &atest.TestSuite{
+		Helpers: []atest.ExprFunction{{
+			Name:   "loop",
+			Params: []string{"n"},
+			Expr:   `loop(n + 1)`,
+		}},
+	}
+	err := RunSuiteJob(&atest.Job{Items: []string{"loop(1)"}}, suite, nil)
+	assert.ErrorContains(t, err, "the calls of loop are nested more than 16 levels")
+}
+
 func TestContextKey(t *testing.T) {
 	assert.Equal(t, ContextKey("parentDir"), NewContextKeyBuilder().ParentDir())
 
@@ -560,6 +771,23 @@ func TestGetSuggestedAPIs(t *testing.T) {
 	assert.NotEmpty(t, result)
 	method := result[0].Request.Method
 	assert.Equal(t, strings.ToUpper(method), method)
+
+	// OpenAPI 3
+	gock.New(urlFoo).Get("openapi.yaml").Reply(http.StatusOK).File("../apispec/testdata/openapi.yaml")
+	result, err = runner.GetSuggestedAPIs(&atest.TestSuite{
+		Spec: atest.APISpec{
+			Kind: "openapi",
+			URL:  urlFoo + "/openapi.yaml",
+		},
+	}, "")
+	assert.NoError(t, err)
+	if assert.Len(t, result, 4) {
+		assert.Equal(t, "listUsers", result[0].Name)
+		assert.EqualValues(t, 1, result[0].Request.Query["page"])
+		assert.Equal(t, "createUser", result[1].Name)
+		assert.Equal(t, util.JSON, result[1].Request.Header[util.ContentType])
+		assert.Contains(t, result[1].Request.Body.String(), `"name":"linuxsuren"`)
+	}
 }
 
 func TestIsStructContent(t *testing.T) {
@@ -592,26 +820,26 @@ func TestIsStructContent(t *testing.T) {
 
 func TestGenerateRandomValue(t *testing.T) {
 	tests := []struct {
-		param    spec.Parameter
+		param    apispec.Parameter
 		expected interface{}
 	}{
 		{
-			param: spec.Parameter{
-				SimpleSchema: spec.SimpleSchema{
+			param: apispec.Parameter{
+				Schema: &apispec.Schema{
 					Format: "int32",
 				},
 			},
 			expected: 101,
 		}, {
-			param: spec.Parameter{
-				SimpleSchema: spec.SimpleSchema{
+			param: apispec.Parameter{
+				Schema: &apispec.Schema{
 					Format: "boolean",
 				},
 			},
 			expected: true,
 		}, {
-			param: spec.Parameter{
-				SimpleSchema: spec.SimpleSchema{
+			param: apispec.Parameter{
+				Schema: &apispec.Schema{
 					Format: "string",
 				},
 			},
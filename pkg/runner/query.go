@@ -0,0 +1,267 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// VerifyQuery evaluates the query assertions against the JSON documents. A document could have
+// several JSON values, such as: NDJSON. All the values are queried as an array if there are more than one
+func VerifyQuery(queries []testing.QueryAssertion, documents ...[]byte) (err error) {
+	if len(queries) == 0 {
+		return
+	}
+
+	var data interface{}
+	if data, err = parseQueryDocuments(documents); err != nil {
+		return
+	}
+
+	for _, query := range queries {
+		err = errors.Join(err, verifyQuery(query, data))
+	}
+	return
+}
+
+func parseQueryDocuments(documents [][]byte) (data interface{}, err error) {
+	var values []interface{}
+	for _, document := range documents {
+		decoder := json.NewDecoder(bytes.NewReader(document))
+		for {
+			var value interface{}
+			if err = decoder.Decode(&value); err == io.EOF {
+				err = nil
+				break
+			} else if err != nil {
+				err = fmt.Errorf("failed to parse the JSON document: %v", err)
+				return
+			}
+			values = append(values, value)
+		}
+	}
+
+	switch len(values) {
+	case 0:
+		err = errors.New("no JSON document to query")
+	case 1:
+		data = values[0]
+	default:
+		data = values
+	}
+	return
+}
+
+func verifyQuery(query testing.QueryAssertion, data interface{}) (err error) {
+	var result interface{}
+	name := query.JSONPath
+	switch {
+	case query.JSONPath != "" && query.JMESPath != "":
+		err = fmt.Errorf("only one of jsonpath and jmespath is allowed, but got %q and %q", query.JSONPath, query.JMESPath)
+		return
+	case query.JSONPath != "":
+		result, err = util.JSONPath(data, query.JSONPath)
+	case query.JMESPath != "":
+		name = query.JMESPath
+		result, err = util.JMESPath(data, query.JMESPath)
+	default:
+		err = errors.New("jsonpath or jmespath is required in the query assertion")
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("query %q: %v", name, err)
+		}
+	}()
+
+	if query.Count != nil {
+		if err = expectQueryCount(*query.Count, result); err != nil {
+			return
+		}
+	}
+
+	if query.Match == "" {
+		err = checkQueryValue(query, result)
+		return
+	}
+
+	items, ok := result.([]interface{})
+	if !ok {
+		err = fmt.Errorf("match %s expects an array, but got %s", query.Match, util.JSONTypeOf(result))
+		return
+	}
+
+	switch query.Match {
+	case "all":
+		for i, item := range items {
+			if itemErr := checkQueryValue(query, item); itemErr != nil {
+				err = fmt.Errorf("item %d: %v", i, itemErr)
+				return
+			}
+		}
+	case "any":
+		for _, item := range items {
+			if checkQueryValue(query, item) == nil {
+				return
+			}
+		}
+		err = fmt.Errorf("none of the %d items matches", len(items))
+	case "none":
+		for i, item := range items {
+			if checkQueryValue(query, item) == nil {
+				err = fmt.Errorf("item %d should not match, but got %v", i, item)
+				return
+			}
+		}
+	default:
+		err = fmt.Errorf("not supported match %q, it should be one of all, any and none", query.Match)
+	}
+	return
+}
+
+func expectQueryCount(count int, result interface{}) (err error) {
+	length := -1
+	switch val := result.(type) {
+	case []interface{}:
+		length = len(val)
+	case map[string]interface{}:
+		length = len(val)
+	case string:
+		length = len([]rune(val))
+	case nil:
+		length = 0
+	}
+
+	if length < 0 {
+		err = fmt.Errorf("expect %d items, but got %s", count, util.JSONTypeOf(result))
+	} else if length != count {
+		err = fmt.Errorf("expect %d items, but got %d", count, length)
+	}
+	return
+}
+
+// checkQueryValue checks the value with all the operators of the query assertion
+func checkQueryValue(query testing.QueryAssertion, value interface{}) (err error) {
+	if query.Equal != nil {
+		if expect := normalizeQueryValue(query.Equal); !reflect.DeepEqual(expect, value) {
+			err = fmt.Errorf("expect %v but got %v", expect, value)
+			return
+		}
+	}
+
+	if query.Contains != nil {
+		if err = expectQueryContains(normalizeQueryValue(query.Contains), value); err != nil {
+			return
+		}
+	}
+
+	if query.Type != "" {
+		if actual := util.JSONTypeOf(value); actual != query.Type {
+			err = fmt.Errorf("expect type %s but got %s", query.Type, actual)
+			return
+		}
+	}
+
+	if query.Regex != "" {
+		var reg *regexp.Regexp
+		if reg, err = regexp.Compile(query.Regex); err != nil {
+			err = fmt.Errorf("invalid regex %q: %v", query.Regex, err)
+			return
+		}
+
+		if str, ok := value.(string); !ok {
+			err = fmt.Errorf("expect a string to match %s, but got %s", query.Regex, util.JSONTypeOf(value))
+			return
+		} else if !reg.MatchString(str) {
+			err = fmt.Errorf("expect to match %s but got %s", query.Regex, str)
+			return
+		}
+	}
+
+	for _, compare := range []struct {
+		operator string
+		expect   *float64
+		ok       func(actual, expect float64) bool
+	}{
+		{operator: ">", expect: query.GreaterThan, ok: func(actual, expect float64) bool { return actual > expect }},
+		{operator: ">=", expect: query.GreaterThanOrEqual, ok: func(actual, expect float64) bool { return actual >= expect }},
+		{operator: "<", expect: query.LessThan, ok: func(actual, expect float64) bool { return actual < expect }},
+		{operator: "<=", expect: query.LessThanOrEqual, ok: func(actual, expect float64) bool { return actual <= expect }},
+	} {
+		if compare.expect == nil {
+			continue
+		}
+
+		num, ok := value.(float64)
+		if !ok {
+			err = fmt.Errorf("expect a number %s %v, but got %s", compare.operator, *compare.expect, util.JSONTypeOf(value))
+			return
+		}
+		if !compare.ok(num, *compare.expect) {
+			err = fmt.Errorf("expect %v %s %v", num, compare.operator, *compare.expect)
+			return
+		}
+	}
+	return
+}
+
+func expectQueryContains(expect, value interface{}) (err error) {
+	switch val := value.(type) {
+	case string:
+		if str, ok := expect.(string); !ok || !strings.Contains(val, str) {
+			err = fmt.Errorf("expect %q contains %v", val, expect)
+		}
+	case []interface{}:
+		for _, item := range val {
+			if reflect.DeepEqual(item, expect) {
+				return
+			}
+		}
+		err = fmt.Errorf("expect %v contains %v", val, expect)
+	case map[string]interface{}:
+		key, ok := expect.(string)
+		if _, found := val[key]; !ok || !found {
+			err = fmt.Errorf("expect the object contains the key %v", expect)
+		}
+	default:
+		err = fmt.Errorf("expect a string, array or object contains %v, but got %s", expect, util.JSONTypeOf(value))
+	}
+	return
+}
+
+// normalizeQueryValue converts the expected value which comes from YAML, such as: int to float64
+func normalizeQueryValue(value interface{}) (result interface{}) {
+	result = value
+	if data, err := json.Marshal(value); err == nil {
+		_ = json.Unmarshal(data, &result)
+	}
+	return
+}
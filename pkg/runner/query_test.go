@@ -0,0 +1,170 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"testing"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestVerifyQuery(t *testing.T) {
+	body := []byte(`{
+		"total": 3,
+		"items": [
+			{"id": "a1", "price": 5, "tags": ["new"], "status": "active"},
+			{"id": "a2", "price": 20, "tags": [], "status": "active"},
+			{"id": "a3", "price": 15, "tags": ["sale"], "status": "deleted"}
+		],
+		"owner": {"name": "rick"}
+	}`)
+
+	tests := []struct {
+		name  string
+		query string
+		err   string
+	}{{
+		name: "count, type and equal",
+		query: `
+- jsonpath: $.items
+  count: 3
+  type: array
+- jmespath: total
+  equal: 3
+- jsonpath: $.owner
+  equal: {name: rick}
+  contains: name`,
+	}, {
+		name: "all, any and none",
+		query: `
+- jsonpath: $.items[*].price
+  match: all
+  gt: 0
+  lte: 20
+- jmespath: items[*].status
+  match: any
+  equal: deleted
+- jmespath: items[?status == 'active'].id
+  match: none
+  regex: ^b`,
+	}, {
+		name: "contains",
+		query: `
+- jsonpath: $.items[0].tags
+  contains: new
+- jmespath: items[*].id
+  contains: a2
+- jsonpath: $.owner.name
+  contains: ic`,
+	}, {
+		name: "count of a filter",
+		query: `
+- jmespath: items[?price >= ` + "`15`" + `]
+  count: 2
+- jsonpath: $.fake
+  count: 0`,
+	}, {
+		name: "all failed",
+		query: `
+- jsonpath: $.items[*].price
+  match: all
+  lt: 10`,
+		err: `query "$.items[*].price": item 1: expect 20 < 10`,
+	}, {
+		name: "any failed",
+		query: `
+- jsonpath: $.items[*].tags
+  match: any
+  contains: fake`,
+		err: "none of the 3 items matches",
+	}, {
+		name: "none failed",
+		query: `
+- jsonpath: $..id
+  match: none
+  regex: ^a\d$`,
+		err: "item 0 should not match, but got a1",
+	}, {
+		name: "wrong count",
+		query: `
+- jsonpath: $.items
+  count: 2`,
+		err: "expect 2 items, but got 3",
+	}, {
+		name: "wrong type",
+		query: `
+- jmespath: owner
+  type: array`,
+		err: "expect type array but got object",
+	}, {
+		name: "not a number",
+		query: `
+- jsonpath: $.owner.name
+  gt: 1`,
+		err: "expect a number > 1, but got string",
+	}, {
+		name: "match without an array",
+		query: `
+- jsonpath: $.total
+  match: all`,
+		err: "match all expects an array, but got number",
+	}, {
+		name: "invalid match",
+		query: `
+- jsonpath: $.items
+  match: fake`,
+		err: `not supported match "fake"`,
+	}, {
+		name: "both queries",
+		query: `
+- jsonpath: $.items
+  jmespath: items`,
+		err: "only one of jsonpath and jmespath is allowed",
+	}, {
+		name:  "no query",
+		query: `- count: 1`,
+		err:   "jsonpath or jmespath is required",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queries []atest.QueryAssertion
+			assert.NoError(t, yaml.Unmarshal([]byte(tt.query), &queries))
+
+			err := VerifyQuery(queries, body)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestVerifyQueryDocuments(t *testing.T) {
+	count := 3
+	queries := []atest.QueryAssertion{{JSONPath: "$[*].id", Count: &count}}
+	assert.NoError(t, VerifyQuery(queries, []byte(`{"id": 1}`), []byte("{\"id\": 2}\n{\"id\": 3}")))
+
+	one := 1
+	assert.NoError(t, VerifyQuery([]atest.QueryAssertion{{JSONPath: "$.id", Equal: one}}, []byte(`{"id": 1}`)))
+
+	assert.NoError(t, VerifyQuery(nil, []byte("invalid")))
+	assert.ErrorContains(t, VerifyQuery(queries, []byte("invalid")), "failed to parse the JSON document")
+	assert.ErrorContains(t, VerifyQuery(queries, []byte(" ")), "no JSON document to query")
+}
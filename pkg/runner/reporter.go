@@ -19,6 +19,8 @@ package runner
 import (
 	"fmt"
 	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/apispec"
 )
 
 // TestReporter is the interface of the report
@@ -39,6 +41,8 @@ type ReportRecord struct {
 	BeginTime time.Time
 	EndTime   time.Time
 	Error     error
+	// Coverage is the exercised request of the API, it is nil for the non-HTTP requests
+	Coverage *apispec.CoverageRecord
 }
 
 // Duration returns the duration between begin and end time
@@ -100,6 +100,7 @@ func (r *memoryTestReporter) ExportAllReportResults() (result ReportResultSlice,
 
 			item.Last = getLaterTime(record.EndTime, item.Last)
 			item.LastErrorMessage = getOriginalStringWhenEmpty(item.LastErrorMessage, record.GetErrorMessage())
+			item.Coverage = appendCoverageRecord(item.Coverage, record.Coverage)
 		} else {
 			resultWithTotal[id] = &ReportResultWithTotal{
 				ReportResult: ReportResult{
@@ -115,6 +116,7 @@ func (r *memoryTestReporter) ExportAllReportResults() (result ReportResultSlice,
 				Total: duration,
 			}
 			resultWithTotal[id].LastErrorMessage = record.GetErrorMessage()
+			resultWithTotal[id].Coverage = appendCoverageRecord(nil, record.Coverage)
 		}
 	}
 
@@ -22,6 +22,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/expr-lang/expr"
 	"github.com/linuxsuren/api-testing/pkg/testing"
 	fakeruntime "github.com/linuxsuren/go-fake-runtime"
 )
@@ -85,6 +86,7 @@ type UnimplementedRunner struct {
 	execer       fakeruntime.Execer
 	Secure       *testing.Secure
 	proxy        *testing.Proxy
+	exprOptions  []expr.Option
 }
 
 func (r *UnimplementedRunner) RunTestCase(testcase *testing.TestCase, dataContext interface{}, ctx context.Context) (output interface{}, err error) {
@@ -130,5 +132,21 @@ func (r *UnimplementedRunner) WithAPISuggestLimit(int) {
 func (s *UnimplementedRunner) WithSuite(suite *testing.TestSuite) {
 	if suite != nil {
 		s.Secure = suite.Spec.Secure
+		s.exprOptions = NewSuiteExprOptions(suite)
 	}
 }
+
+// runBeforeJobs runs the before job of the test case
+func (s *UnimplementedRunner) runBeforeJobs(testcase *testing.TestCase, dataContext interface{}) (err error) {
+	return runJob(testcase.Before, dataContext, nil, s.exprOptions...)
+}
+
+// runAfterJobs runs the after job of the test case
+func (s *UnimplementedRunner) runAfterJobs(testcase *testing.TestCase, dataContext, output interface{}) (err error) {
+	return runJob(testcase.After, dataContext, output, s.exprOptions...)
+}
+
+// RunSuiteJob runs the before or after job of a suite, it is expected to run once around all the test cases
+func RunSuiteJob(job *testing.Job, suite *testing.TestSuite, dataContext interface{}) (err error) {
+	return runJob(job, dataContext, nil, NewSuiteExprOptions(suite)...)
+}
@@ -31,7 +31,7 @@ func GetTestSuiteRunner(suite *testing.TestSuite) TestCaseRunner {
 	kind := suite.Spec.Kind
 
 	switch kind {
-	case "swagger", "":
+	case "swagger", "openapi", "":
 		kind = "http"
 	}
 
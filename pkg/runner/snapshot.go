@@ -0,0 +1,159 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/compare"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/tidwall/gjson"
+)
+
+// SnapshotDir is the directory of the snapshot files, it is next to the test suite file
+const SnapshotDir = "__snapshots__"
+
+// ResponseSnapshot is the normalized response which is saved in the snapshot file
+type ResponseSnapshot struct {
+	Header map[string]string `json:"header,omitempty"`
+	Body   interface{}       `json:"body"`
+}
+
+// NewResponseSnapshot normalizes the response with the snapshot setting,
+// the JSON body is kept as an object, and the redacted values are replaced with $any
+func NewResponseSnapshot(setting *testing.Snapshot, header map[string]string, body []byte) (snapshot *ResponseSnapshot) {
+	if setting == nil {
+		setting = &testing.Snapshot{}
+	}
+
+	snapshot = &ResponseSnapshot{Body: string(body)}
+	if json.Valid(body) {
+		var data interface{}
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		if decoder.Decode(&data) == nil {
+			snapshot.Body = compare.Redact(data, setting.Redact)
+		}
+	}
+
+	for _, key := range setting.Header {
+		for name, val := range header {
+			if strings.EqualFold(name, key) {
+				if snapshot.Header == nil {
+					snapshot.Header = map[string]string{}
+				}
+				snapshot.Header[http.CanonicalHeaderKey(key)] = val
+			}
+		}
+	}
+	return
+}
+
+// Marshal returns the indented JSON of the snapshot, the keys are sorted
+func (s *ResponseSnapshot) Marshal() (data []byte, err error) {
+	buf := new(bytes.Buffer)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(s); err == nil {
+		data = buf.Bytes()
+	}
+	return
+}
+
+// SaveSnapshot writes the snapshot into the file
+func SaveSnapshot(path string, snapshot *ResponseSnapshot) (err error) {
+	var data []byte
+	if data, err = snapshot.Marshal(); err != nil {
+		return
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err == nil {
+		err = os.WriteFile(path, data, 0644)
+	}
+	return
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^\p{L}\p{N}._-]+`)
+
+// SnapshotPath returns the path of the snapshot file of a test case, the dir is the parent directory of the suite
+func SnapshotPath(dir, suite string, testcase *testing.TestCase) string {
+	name := testcase.Name
+	if testcase.Expect.Snapshot != nil && testcase.Expect.Snapshot.Name != "" {
+		name = testcase.Expect.Snapshot.Name
+	}
+	return filepath.Join(dir, SnapshotDir, snapshotFileName(suite), snapshotFileName(name)+".json")
+}
+
+func snapshotFileName(name string) string {
+	return strings.Trim(unsafeFileNameChars.ReplaceAllString(name, "_"), "_")
+}
+
+// verifySnapshot compares the response with the snapshot file,
+// the snapshot is saved when it does not exist, or the update mode is enabled
+func verifySnapshot(ctx context.Context, suite string, testcase *testing.TestCase, header map[string]string, body []byte) (err error) {
+	setting := testcase.Expect.Snapshot
+	if setting == nil {
+		return
+	}
+
+	keys := NewContextKeyBuilder()
+	dir := keys.ParentDir().GetContextValueOrEmpty(ctx)
+	if dir == "" {
+		err = fmt.Errorf("case: %s, the snapshots are not supported because the directory of suite %s is unknown", testcase.Name, suite)
+		return
+	}
+	path := SnapshotPath(dir, suite, testcase)
+	current := NewResponseSnapshot(setting, header, body)
+
+	var data []byte
+	if data, err = os.ReadFile(path); os.IsNotExist(err) || ctx.Value(keys.UpdateSnapshots()) == true {
+		runnerLogger.Info("save the snapshot", "path", path)
+		err = SaveSnapshot(path, current)
+		return
+	} else if err != nil {
+		return
+	}
+	if !gjson.ValidBytes(data) {
+		err = fmt.Errorf("the snapshot %s is not a valid JSON", path)
+		return
+	}
+
+	var currentData []byte
+	if currentData, err = current.Marshal(); err != nil {
+		return
+	}
+
+	ignore := make([]string, len(setting.Redact))
+	for i, item := range setting.Redact {
+		ignore[i] = "body." + item
+	}
+	if err = compare.Match("snapshot", gjson.ParseBytes(data), gjson.ParseBytes(currentData), compare.Options{
+		Ignore: ignore,
+	}); err != nil {
+		err = fmt.Errorf("case: %s, the response is different from the snapshot %s, run with --update-snapshots to accept it:\n%v",
+			testcase.Name, path, err)
+	}
+	return
+}
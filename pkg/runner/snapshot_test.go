@@ -0,0 +1,126 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/h2non/gock"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot(t *testing.T) {
+	defer gock.Off()
+	dir := t.TempDir()
+
+	testCase := &atest.TestCase{
+		Name: "get user",
+		Request: atest.Request{
+			API: urlFoo,
+		},
+		Expect: atest.Response{
+			Snapshot: &atest.Snapshot{
+				Header: []string{"x-request-id", "content-type"},
+				Redact: []string{"id", "items.*.updatedAt"},
+			},
+		},
+	}
+	runIn := func(parentDir, body string, update bool) error {
+		gock.New(urlFoo).Reply(http.StatusOK).
+			SetHeader(util.ContentType, util.JSON).
+			SetHeader("X-Request-Id", "1").
+			BodyString(body)
+
+		ctx := context.WithValue(context.Background(), NewContextKeyBuilder().ParentDir(), parentDir)
+		ctx = context.WithValue(ctx, NewContextKeyBuilder().UpdateSnapshots(), update)
+
+		runner := NewSimpleTestCaseRunner()
+		runner.WithOutputWriter(io.Discard)
+		runner.WithSuite(&atest.TestSuite{Name: "users"})
+		_, err := runner.RunTestCase(testCase, nil, ctx)
+		return err
+	}
+	run := func(body string, update bool) error {
+		return runIn(dir, body, update)
+	}
+	path := filepath.Join(dir, SnapshotDir, "users", "get_user.json")
+
+	// save on the first run
+	assert.NoError(t, run(`{"name":"rick","id":1,"url":"a&b","items":[{"updatedAt":1,"price":1.50}]}`, false))
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, `{
+  "header": {
+    "Content-Type": "application/json",
+    "X-Request-Id": "1"
+  },
+  "body": {
+    "id": "$any",
+    "items": [
+      {
+        "price": 1.50,
+        "updatedAt": "$any"
+      }
+    ],
+    "name": "rick",
+    "url": "a&b"
+  }
+}
+`, string(data))
+
+	assert.NoError(t, run(`{"id":2,"name":"rick","url":"a&b","items":[{"updatedAt":2,"price":1.5}]}`, false))
+
+	err = run(`{"id":2,"name":"linuxsuren","url":"a&b","items":[{"updatedAt":2,"price":1.5}]}`, false)
+	assert.ErrorContains(t, err, "compare: field snapshot.body.name: expect rick but got linuxsuren")
+	assert.ErrorContains(t, err, "--update-snapshots")
+
+	// accept the changes in the update mode
+	assert.NoError(t, run(`{"id":2,"name":"linuxsuren","url":"a&b","items":[]}`, true))
+	assert.NoError(t, run(`{"id":3,"name":"linuxsuren","url":"a&b","items":[]}`, false))
+
+	t.Run("invalid snapshot", func(t *testing.T) {
+		assert.NoError(t, os.WriteFile(path, []byte("invalid"), 0644))
+		assert.ErrorContains(t, run(`{}`, false), "not a valid JSON")
+	})
+
+	t.Run("unknown suite directory", func(t *testing.T) {
+		assert.ErrorContains(t, runIn("", `{}`, true), "the directory of suite users is unknown")
+	})
+}
+
+func TestResponseSnapshot(t *testing.T) {
+	snapshot := NewResponseSnapshot(nil, map[string]string{"Server": "fake"}, []byte("plain text"))
+	assert.Equal(t, &ResponseSnapshot{Body: "plain text"}, snapshot)
+
+	data, err := snapshot.Marshal()
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"body\": \"plain text\"\n}\n", string(data))
+
+	assert.Equal(t, filepath.Join("dir", SnapshotDir, "user_suite", "list_users.json"),
+		SnapshotPath("dir", "user suite", &atest.TestCase{Name: "list/users"}))
+	assert.Equal(t, filepath.Join(SnapshotDir, "users", "custom.json"),
+		SnapshotPath("", "users", &atest.TestCase{Name: "list", Expect: atest.Response{
+			Snapshot: &atest.Snapshot{Name: "custom"},
+		}}))
+}
@@ -31,11 +31,11 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-// Verify if the data satisfies the expression.
-func Verify(expect testing.Response, data map[string]any) (err error) {
+// Verify if the data satisfies the expression, the options could provide the extra functions.
+func Verify(expect testing.Response, data map[string]any, opts ...expr.Option) (err error) {
 	for _, verifyExpr := range expect.Verify {
 		var ok bool
-		if ok, err = verify(verifyExpr, data); !ok {
+		if ok, err = verify(verifyExpr, data, opts...); !ok {
 			err = fmt.Errorf("failed to verify: %q, %v", verifyExpr, err)
 			return
 		}
@@ -44,7 +44,7 @@ func Verify(expect testing.Response, data map[string]any) (err error) {
 	for _, verifyCon := range expect.ConditionalVerify {
 		pass := true
 		for _, con := range verifyCon.Condition {
-			if ok, _ := verify(con, data); !ok {
+			if ok, _ := verify(con, data, opts...); !ok {
 				pass = false
 				break
 			}
@@ -53,7 +53,7 @@ func Verify(expect testing.Response, data map[string]any) (err error) {
 		if pass {
 			for _, verifyExpr := range verifyCon.Verify {
 				var ok bool
-				if ok, err = verify(verifyExpr, data); !ok {
+				if ok, err = verify(verifyExpr, data, opts...); !ok {
 					err = fmt.Errorf("failed to verify: %q, %v", verifyExpr, err)
 					return
 				}
@@ -63,11 +63,11 @@ func Verify(expect testing.Response, data map[string]any) (err error) {
 	return
 }
 
-func verify(verify string, data map[string]any) (ok bool, err error) {
+func verify(verify string, data map[string]any, opts ...expr.Option) (ok bool, err error) {
 	var program *vm.Program
-	if program, err = expr.Compile(verify, expr.Env(data),
+	if program, err = expr.Compile(verify, append([]expr.Option{expr.Env(data),
 		expr.AsBool(), kubernetes.PodValidatorFunc(),
-		kubernetes.KubernetesValidatorFunc()); err != nil {
+		kubernetes.KubernetesValidatorFunc()}, opts...)...); err != nil {
 		return
 	}
 
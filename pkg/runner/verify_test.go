@@ -82,3 +82,69 @@ func TestVerify(t *testing.T) {
 var expectJSONObj = map[string]interface{}{
 	"name": "linuxsuren",
 }
+
+func TestVerifyBuiltinFunctions(t *testing.T) {
+	data := map[string]any{
+		"data": map[string]any{
+			"id":        "4f6a1c2e-8b1d-4c5e-9f3a-2b7c8d9e0f1a",
+			"createdAt": "2025-01-01T08:00:00.123+08:00",
+			"items":     []any{map[string]any{"name": "rick"}, map[string]any{"name": "linuxsuren"}},
+		},
+	}
+
+	err := runner.Verify(atest.Response{
+		Verify: []string{
+			`jsonpath(data, "$.items[1].name") == "linuxsuren"`,
+			`len(jsonpath(data, "$.items[*].name")) == 2`,
+			`jsonpath('{"a": [1]}', "$.a[0]") == 1`,
+			`regexMatch(data.items[0].name, "^r")`,
+			`isUUID(data.id)`,
+			`!isUUID(data.items)`,
+			`isISO8601(data.createdAt)`,
+			`isISO8601("2025-01-01")`,
+			`!isISO8601("01/01/2025")`,
+		},
+	}, data)
+	assert.NoError(t, err)
+
+	err = runner.Verify(atest.Response{Verify: []string{`jsonpath(data, "$.items[?(@.name)]") == nil`}}, data)
+	assert.ErrorContains(t, err, "not supported selector")
+}
+
+func TestSuiteExprOptions(t *testing.T) {
+	assert.Nil(t, runner.NewSuiteExprOptions(nil))
+
+	opts := runner.NewSuiteExprOptions(&atest.TestSuite{
+		Assertions: []atest.ExprFunction{{
+			Name:   "isPage",
+			Params: []string{"body", "size"},
+			Expr:   `len(body.items) <= size && hasName(body.items[0])`,
+		}, {
+			Name:   "notBool",
+			Params: []string{"body"},
+			Expr:   `body.items`,
+		}},
+		Helpers: []atest.ExprFunction{{
+			Name:   "hasName",
+			Params: []string{"item"},
+			Expr:   `item.name != ""`,
+		}, {
+			Name: "invalid",
+			Expr: `1 +`,
+		}},
+	})
+
+	data := map[string]any{
+		"data": map[string]any{
+			"items": []any{map[string]any{"name": "rick"}},
+		},
+	}
+	assert.NoError(t, runner.Verify(atest.Response{Verify: []string{"isPage(data, 10)"}}, data, opts...))
+	assert.Error(t, runner.Verify(atest.Response{Verify: []string{"isPage(data, 0)"}}, data, opts...))
+	assert.ErrorContains(t, runner.Verify(atest.Response{Verify: []string{"isPage(data)"}}, data, opts...),
+		"isPage requires 2 params, but got 1")
+	assert.ErrorContains(t, runner.Verify(atest.Response{Verify: []string{"notBool(data)"}}, data, opts...),
+		"should return a bool")
+	assert.ErrorContains(t, runner.Verify(atest.Response{Verify: []string{"invalid() == 1"}}, data, opts...),
+		"failed to compile invalid")
+}
@@ -27,5 +27,7 @@ type ReportResultWriter interface {
 	Output([]ReportResult) error
 	WithAPICoverage(apiCoverage apispec.APICoverage) ReportResultWriter
 	WithResourceUsage([]ResourceUsage) ReportResultWriter
+	// WithSeed sets the seed of the random data, a run could be replayed with it
+	WithSeed(seed int64) ReportResultWriter
 	GetWriter() io.Writer
 }
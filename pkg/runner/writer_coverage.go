@@ -0,0 +1,83 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/apispec"
+	"github.com/linuxsuren/api-testing/pkg/render"
+)
+
+// WriteCoverageReport writes the API coverage report, the format could be std, markdown, md, html or json
+func WriteCoverageReport(w io.Writer, report *apispec.CoverageReport, format string) (err error) {
+	switch format {
+	case "", "std":
+		_, err = fmt.Fprintf(w, "API Coverage: %s\n", report.Summary())
+		for _, operation := range report.Operations {
+			_, _ = fmt.Fprintf(w, "%s %s requests: %d", operation.Method, operation.Path, operation.Requests)
+			printCoverageItems(w, "missing parameters", operation.MissingParameters())
+			printCoverageItems(w, "missing status codes", operation.MissingStatusCodes())
+			if codes := operation.UndocumentedStatusCodes; len(codes) > 0 {
+				_, _ = fmt.Fprintf(w, ", undocumented status codes: %s", strings.Trim(fmt.Sprint(codes), "[]"))
+			}
+			_, _ = fmt.Fprintln(w)
+		}
+		for _, request := range report.Undocumented {
+			_, _ = fmt.Fprintf(w, "%s is undocumented\n", request)
+		}
+	case "markdown", "md":
+		err = render.RenderThenPrint("md-coverage", markdownCoverageTpl, report, w)
+	case "html":
+		err = render.RenderThenPrint("html-coverage", htmlCoverageTpl, report, w)
+	case "json":
+		var data []byte
+		if data, err = json.MarshalIndent(report, "", "  "); err == nil {
+			_, err = fmt.Fprintln(w, string(data))
+		}
+	default:
+		err = fmt.Errorf("not supported coverage report format: %q", format)
+	}
+	return
+}
+
+func printCoverageItems(w io.Writer, title string, items []string) {
+	if len(items) > 0 {
+		_, _ = fmt.Fprintf(w, ", %s: %s", title, strings.Join(items, ", "))
+	}
+}
+
+// renderCoverageReport renders the coverage section of a report, it is empty without a full API specification
+func renderCoverageReport(results []ReportResult, apiCoverage apispec.APICoverage, format string) (section string, err error) {
+	if report := getCoverageReport(results, apiCoverage); report != nil {
+		buf := new(strings.Builder)
+		if err = WriteCoverageReport(buf, report, format); err == nil {
+			section = strings.TrimRight(buf.String(), "\n")
+		}
+	}
+	return
+}
+
+//go:embed data/coverage.md
+var markdownCoverageTpl string
+
+//go:embed data/coverage.html
+var htmlCoverageTpl string
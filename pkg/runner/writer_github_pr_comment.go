@@ -37,6 +37,7 @@ var (
 
 type githubPRCommentWriter struct {
 	*GithubPRCommentOption
+	seed int64
 }
 
 func NewGithubPRCommentWriter(opt *GithubPRCommentOption) (ReportResultWriter, error) {
@@ -101,7 +102,7 @@ func (w *githubPRCommentWriter) Output(result []ReportResult) (err error) {
 	}
 
 	buf := new(bytes.Buffer)
-	mdWriter := NewMarkdownResultWriter(buf)
+	mdWriter := NewMarkdownResultWriter(buf).WithSeed(w.seed)
 	if err = mdWriter.Output(result); err == nil {
 		content := buf.String() + "\n\n" + w.Identity
 
@@ -194,6 +195,12 @@ func (w *githubPRCommentWriter) WithResourceUsage([]ResourceUsage) ReportResultW
 	return w
 }
 
+// WithSeed sets the seed of the random data
+func (w *githubPRCommentWriter) WithSeed(seed int64) ReportResultWriter {
+	w.seed = seed
+	return w
+}
+
 func (w *githubPRCommentWriter) GetWriter() io.Writer {
 	return os.Stdout
 }
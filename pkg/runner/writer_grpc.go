@@ -120,6 +120,11 @@ func (w *grpcResultWriter) WithResourceUsage([]ResourceUsage) ReportResultWriter
 	return w
 }
 
+// WithSeed does nothing, the payload is defined by the target gRPC method
+func (w *grpcResultWriter) WithSeed(int64) ReportResultWriter {
+	return w
+}
+
 func (w *grpcResultWriter) GetWriter() io.Writer {
 	return nil
 }
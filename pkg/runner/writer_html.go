@@ -27,6 +27,7 @@ import (
 type htmlResultWriter struct {
 	writer       io.Writer
 	apiConverage apispec.APICoverage
+	seed         int64
 }
 
 // NewHTMLResultWriter creates a new htmlResultWriter
@@ -36,7 +37,17 @@ func NewHTMLResultWriter(writer io.Writer) ReportResultWriter {
 
 // Output writes the HTML base report to target writer
 func (w *htmlResultWriter) Output(result []ReportResult) (err error) {
-	return render.RenderThenPrint("html-report", htmlReport, result, w.writer)
+	report := &htmlReportData{Items: result, Seed: w.seed}
+	if report.Coverage, err = renderCoverageReport(result, w.apiConverage, "html"); err == nil {
+		err = render.RenderThenPrint("html-report", htmlReport, report, w.writer)
+	}
+	return
+}
+
+type htmlReportData struct {
+	Items    []ReportResult
+	Coverage string
+	Seed     int64
 }
 
 // WithAPIConverage sets the api coverage
@@ -49,6 +60,12 @@ func (w *htmlResultWriter) WithResourceUsage([]ResourceUsage) ReportResultWriter
 	return w
 }
 
+// WithSeed sets the seed of the random data
+func (w *htmlResultWriter) WithSeed(seed int64) ReportResultWriter {
+	w.seed = seed
+	return w
+}
+
 func (w *htmlResultWriter) GetWriter() io.Writer {
 	return nil
 }
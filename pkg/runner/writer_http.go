@@ -149,6 +149,11 @@ func (w *httpResultWriter) WithResourceUsage([]ResourceUsage) ReportResultWriter
 	return w
 }
 
+// WithSeed does nothing, the data of the report template is the results
+func (w *httpResultWriter) WithSeed(int64) ReportResultWriter {
+	return w
+}
+
 func (w *httpResultWriter) GetWriter() io.Writer {
 	return nil
 }
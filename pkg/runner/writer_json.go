@@ -26,7 +26,22 @@ import (
 )
 
 type jsonResultWriter struct {
-	writer io.Writer
+	writer           io.Writer
+	coverageWriter   io.Writer
+	coverageInReport bool
+	apiConverage     apispec.APICoverage
+}
+
+// CoverageWriterSetter is able to write the API coverage into a separated writer, or into the report
+type CoverageWriterSetter interface {
+	WithCoverageWriter(writer io.Writer)
+	WithCoverageInReport()
+}
+
+// jsonReportWithCoverage is the JSON report which has the API coverage
+type jsonReportWithCoverage struct {
+	Results  []ReportResult          `json:"results"`
+	Coverage *apispec.CoverageReport `json:"coverage,omitempty"`
 }
 
 // NewJSONResultWriter creates a new jsonResultWriter
@@ -34,17 +49,41 @@ func NewJSONResultWriter(writer io.Writer) ReportResultWriter {
 	return &jsonResultWriter{writer: writer}
 }
 
-// Output writes the JSON base report to target writer
+// Output writes the JSON base report to target writer, it is the array of results by default.
+// The API coverage is written into the coverage writer if there is one, or into the report
+// as an object of the results and coverage
 func (w *jsonResultWriter) Output(result []ReportResult) (err error) {
-	jsonData, err := json.Marshal(result)
-	if err == nil {
+	coverage := getCoverageReport(result, w.apiConverage)
+
+	var report interface{} = result
+	if w.coverageInReport {
+		report = jsonReportWithCoverage{Results: result, Coverage: coverage}
+	}
+
+	var jsonData []byte
+	if jsonData, err = json.Marshal(report); err == nil {
 		_, err = fmt.Fprint(w.writer, string(jsonData))
 	}
+
+	if err == nil && coverage != nil && w.coverageWriter != nil {
+		err = WriteCoverageReport(w.coverageWriter, coverage, "json")
+	}
 	return
 }
 
+// WithCoverageWriter sets the writer of the API coverage
+func (w *jsonResultWriter) WithCoverageWriter(writer io.Writer) {
+	w.coverageWriter = writer
+}
+
+// WithCoverageInReport puts the API coverage into the report
+func (w *jsonResultWriter) WithCoverageInReport() {
+	w.coverageInReport = true
+}
+
 // WithAPIConverage sets the api coverage
 func (w *jsonResultWriter) WithAPICoverage(apiConverage apispec.APICoverage) ReportResultWriter {
+	w.apiConverage = apiConverage
 	return w
 }
 
@@ -52,6 +91,11 @@ func (w *jsonResultWriter) WithResourceUsage([]ResourceUsage) ReportResultWriter
 	return w
 }
 
+// WithSeed does nothing, the report is kept as the array of results and the seed is printed by the run command
+func (w *jsonResultWriter) WithSeed(int64) ReportResultWriter {
+	return w
+}
+
 func (w *jsonResultWriter) GetWriter() io.Writer {
 	return w.writer
 }
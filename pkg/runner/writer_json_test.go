@@ -19,6 +19,7 @@ package runner_test
 import (
 	"bytes"
 	_ "embed"
+	"strings"
 	"testing"
 
 	"github.com/linuxsuren/api-testing/pkg/runner"
@@ -50,6 +51,14 @@ func TestJSONResultWriter(t *testing.T) {
 	assert.Nil(t, err)
 	assert.JSONEq(t, jsonResult, buf.String())
 	assert.NotNil(t, writer.WithResourceUsage(nil))
+
+	t.Run("with a seed", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		err := runner.NewJSONResultWriter(buf).WithSeed(42).Output([]runner.ReportResult{{Name: "foo", API: "api"}})
+		assert.NoError(t, err)
+		assert.NotContains(t, buf.String(), "seed")
+		assert.True(t, strings.HasPrefix(buf.String(), "[{"))
+	})
 }
 
 //go:embed testdata/json-result.json
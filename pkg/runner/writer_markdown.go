@@ -28,6 +28,7 @@ type markdownResultWriter struct {
 	writer        io.Writer
 	apiConverage  apispec.APICoverage
 	resourceUsage []ResourceUsage
+	seed          int64
 }
 
 // NewMarkdownResultWriter creates the Markdown writer
@@ -40,6 +41,7 @@ func (w *markdownResultWriter) Output(result []ReportResult) (err error) {
 	report := &markdownReport{
 		Total: len(result),
 		Items: result,
+		Seed:  w.seed,
 	}
 	if len(w.resourceUsage) > 0 {
 		report.LastResourceUsage = w.resourceUsage[len(w.resourceUsage)-1]
@@ -54,6 +56,9 @@ func (w *markdownResultWriter) Output(result []ReportResult) (err error) {
 		}
 	}
 	report.Converage.Covered, report.Converage.Total = apiConverageCount(result, w.apiConverage)
+	if report.Converage.Detail, err = renderCoverageReport(result, w.apiConverage, "markdown"); err != nil {
+		return
+	}
 
 	return render.RenderThenPrint("md-report", markdownReportTpl, report, w.writer)
 }
@@ -69,6 +74,12 @@ func (w *markdownResultWriter) WithResourceUsage(resurceUage []ResourceUsage) Re
 	return w
 }
 
+// WithSeed sets the seed of the random data
+func (w *markdownResultWriter) WithSeed(seed int64) ReportResultWriter {
+	w.seed = seed
+	return w
+}
+
 func (w *markdownResultWriter) GetWriter() io.Writer {
 	return w.writer
 }
@@ -80,11 +91,14 @@ type markdownReport struct {
 	LastResourceUsage ResourceUsage
 	Errors            []string
 	Converage         converage
+	Seed              int64
 }
 
 type converage struct {
 	Covered int
 	Total   int
+	// Detail is the coverage of the parameters and status codes
+	Detail string
 }
 
 //go:embed data/report.md
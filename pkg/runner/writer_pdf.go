@@ -36,6 +36,7 @@ var (
 
 type pdfResultWriter struct {
 	writer io.Writer
+	seed   int64
 }
 
 // NewPDFResultWriter creates a new PDFResultWriter
@@ -108,6 +109,10 @@ func (w *pdfResultWriter) Output(result []ReportResult) (err error) {
 		pdf.Cell(nil, "LastErrorMessage:")
 		pdf.SetXY(50, Y_start+line_bias*8)
 		pdf.Cell(nil, api.LastErrorMessage)
+		if w.seed != 0 {
+			pdf.SetXY(50, Y_start+line_bias*9)
+			pdf.Cell(nil, "Seed:   "+strconv.FormatInt(w.seed, 10))
+		}
 
 		if api.Error != 0 {
 			pdf.Image("../pkg/runner/data/imgs/warn.jpg", 30, Y_start+line_bias*6-5, nil)
@@ -127,6 +132,12 @@ func (w *pdfResultWriter) WithResourceUsage([]ResourceUsage) ReportResultWriter
 	return w
 }
 
+// WithSeed sets the seed of the random data
+func (w *pdfResultWriter) WithSeed(seed int64) ReportResultWriter {
+	w.seed = seed
+	return w
+}
+
 func (w *pdfResultWriter) GetWriter() io.Writer {
 	return w.writer
 }
@@ -27,6 +27,7 @@ import (
 type stdResultWriter struct {
 	writer       io.Writer
 	apiConverage apispec.APICoverage
+	seed         int64
 }
 
 // NewResultWriter creates a result writer with the specific io.Writer
@@ -56,6 +57,9 @@ func (w *stdResultWriter) Output(results []ReportResult) error {
 	}
 
 	_, _ = fmt.Fprintf(w.writer, "Test case count: %d\n", len(results))
+	if w.seed != 0 {
+		_, _ = fmt.Fprintf(w.writer, "Seed: %d\n", w.seed)
+	}
 	apiConveragePrint(results, w.apiConverage, w.writer)
 	return nil
 }
@@ -70,11 +74,23 @@ func (w *stdResultWriter) WithResourceUsage([]ResourceUsage) ReportResultWriter
 	return w
 }
 
+// WithSeed sets the seed of the random data
+func (w *stdResultWriter) WithSeed(seed int64) ReportResultWriter {
+	w.seed = seed
+	return w
+}
+
 func (w *stdResultWriter) GetWriter() io.Writer {
 	return w.writer
 }
 
 func apiConveragePrint(result []ReportResult, apiConverage apispec.APICoverage, w io.Writer) {
+	if report := getCoverageReport(result, apiConverage); report != nil {
+		fmt.Fprintln(w)
+		_ = WriteCoverageReport(w, report, "std")
+		return
+	}
+
 	covered, total := apiConverageCount(result, apiConverage)
 	if total > 0 {
 		fmt.Fprintf(w, "\nAPI Coverage: %d/%d\n", covered, total)
@@ -85,6 +101,9 @@ func apiConverageCount(result []ReportResult, apiConverage apispec.APICoverage)
 	if apiConverage == nil {
 		return
 	}
+	if report := getCoverageReport(result, apiConverage); report != nil {
+		return report.OperationCount()
+	}
 
 	for _, item := range result {
 		if apiConverage.HaveAPI(item.API, "GET") {
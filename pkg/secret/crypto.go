@@ -0,0 +1,195 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// KeySize is the size of the AES-256 key
+	KeySize = 32
+	// SaltSize is the size of the salt which is used to derive a key from a passphrase
+	SaltSize = 16
+
+	encryptedPrefix  = "enc:v1:"
+	pbkdf2Iterations = 210000
+)
+
+// Encryptor encrypts and decrypts the secret values
+type Encryptor interface {
+	Encrypt(plain string) (string, error)
+	Decrypt(encrypted string) (string, error)
+	// KeyID identifies the key without exposing it
+	KeyID() string
+}
+
+type aesEncryptor struct {
+	aead  cipher.AEAD
+	keyID string
+}
+
+// NewAESEncryptor creates an AES-GCM encryptor with a 32 bytes key
+func NewAESEncryptor(key []byte) (encryptor Encryptor, err error) {
+	if len(key) != KeySize {
+		err = fmt.Errorf("the key size should be %d, but got %d", KeySize, len(key))
+		return
+	}
+
+	var block cipher.Block
+	if block, err = aes.NewCipher(key); err != nil {
+		return
+	}
+
+	var aead cipher.AEAD
+	if aead, err = cipher.NewGCM(block); err == nil {
+		encryptor = &aesEncryptor{
+			aead:  aead,
+			keyID: GetKeyID(key),
+		}
+	}
+	return
+}
+
+// Encrypt returns the encrypted value with the prefix "enc:v1:"
+func (e *aesEncryptor) Encrypt(plain string) (encrypted string, err error) {
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return
+	}
+
+	data := e.aead.Seal(nonce, nonce, []byte(plain), nil)
+	encrypted = encryptedPrefix + base64.StdEncoding.EncodeToString(data)
+	return
+}
+
+// Decrypt returns the plain value, the value without the prefix "enc:v1:" is taken as a plain one
+func (e *aesEncryptor) Decrypt(encrypted string) (plain string, err error) {
+	if !IsEncrypted(encrypted) {
+		plain = encrypted
+		return
+	}
+
+	var data []byte
+	if data, err = base64.StdEncoding.DecodeString(strings.TrimPrefix(encrypted, encryptedPrefix)); err != nil {
+		return
+	}
+
+	nonceSize := e.aead.NonceSize()
+	if len(data) < nonceSize {
+		err = errors.New("invalid encrypted value")
+		return
+	}
+
+	var result []byte
+	if result, err = e.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil); err == nil {
+		plain = string(result)
+	}
+	return
+}
+
+func (e *aesEncryptor) KeyID() string {
+	return e.keyID
+}
+
+// IsEncrypted checks if the value was encrypted by the Encryptor
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
+
+// GetKeyID returns the short fingerprint of a key
+func GetKeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// GenerateKey generates a random key
+func GenerateKey() (key []byte, err error) {
+	key = make([]byte, KeySize)
+	_, err = rand.Read(key)
+	return
+}
+
+// GenerateSalt generates a random salt for the passphrase
+func GenerateSalt() (salt []byte, err error) {
+	salt = make([]byte, SaltSize)
+	_, err = rand.Read(salt)
+	return
+}
+
+// KeyFromPassphrase derives a key from the passphrase with PBKDF2
+func KeyFromPassphrase(passphrase string, salt []byte) ([]byte, error) {
+	return pbkdf2.Key(sha256.New, passphrase, salt, pbkdf2Iterations, KeySize)
+}
+
+// ReadKeyFile reads the base64 encoded key from a file
+func ReadKeyFile(keyFile string) (key []byte, err error) {
+	var data []byte
+	if data, err = os.ReadFile(keyFile); err == nil {
+		key, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	}
+	return
+}
+
+// WriteKeyFile writes the key into a file which only the owner can read and write
+func WriteKeyFile(keyFile string, key []byte) (err error) {
+	if err = os.MkdirAll(filepath.Dir(keyFile), 0700); err == nil {
+		err = WriteFileAtomic(keyFile, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600)
+	}
+	return
+}
+
+// LoadOrCreateKeyFile reads the key from a file, a new key will be generated if the file does not exist
+func LoadOrCreateKeyFile(keyFile string) (key []byte, err error) {
+	if key, err = ReadKeyFile(keyFile); err != nil && errors.Is(err, os.ErrNotExist) {
+		if key, err = GenerateKey(); err == nil {
+			err = WriteKeyFile(keyFile, key)
+		}
+	}
+	return
+}
+
+// WriteFileAtomic writes data into a temporary file then renames it to the target file
+func WriteFileAtomic(name string, data []byte, perm os.FileMode) (err error) {
+	var file *os.File
+	if file, err = os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+"-*"); err != nil {
+		return
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = file.Write(data); err == nil {
+		err = file.Chmod(perm)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), name)
+	}
+	return
+}
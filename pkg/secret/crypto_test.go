@@ -0,0 +1,112 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAESEncryptor(t *testing.T) {
+	_, err := NewAESEncryptor([]byte("short"))
+	assert.Error(t, err)
+
+	key, err := GenerateKey()
+	assert.NoError(t, err)
+	encryptor, err := NewAESEncryptor(key)
+	assert.NoError(t, err)
+	assert.Equal(t, GetKeyID(key), encryptor.KeyID())
+
+	encrypted, err := encryptor.Encrypt("value")
+	assert.NoError(t, err)
+	assert.True(t, IsEncrypted(encrypted))
+	assert.NotContains(t, encrypted, "value")
+
+	plain, err := encryptor.Decrypt(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, "value", plain)
+
+	plain, err = encryptor.Decrypt("plain")
+	assert.NoError(t, err)
+	assert.Equal(t, "plain", plain)
+
+	_, err = encryptor.Decrypt(encryptedPrefix + "invalid")
+	assert.Error(t, err)
+	_, err = encryptor.Decrypt(encryptedPrefix + "YWJj")
+	assert.Error(t, err)
+
+	otherKey, _ := GenerateKey()
+	other, err := NewAESEncryptor(otherKey)
+	assert.NoError(t, err)
+	_, err = other.Decrypt(encrypted)
+	assert.Error(t, err)
+}
+
+func TestKeyFromPassphrase(t *testing.T) {
+	salt, err := GenerateSalt()
+	assert.NoError(t, err)
+
+	key, err := KeyFromPassphrase("passphrase", salt)
+	assert.NoError(t, err)
+	assert.Len(t, key, KeySize)
+
+	same, err := KeyFromPassphrase("passphrase", salt)
+	assert.NoError(t, err)
+	assert.Equal(t, key, same)
+
+	otherSalt, _ := GenerateSalt()
+	other, err := KeyFromPassphrase("passphrase", otherSalt)
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
+
+func TestKeyFile(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "sub", "secret.key")
+
+	key, err := LoadOrCreateKeyFile(keyFile)
+	assert.NoError(t, err)
+	assert.Len(t, key, KeySize)
+
+	info, err := os.Stat(keyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := LoadOrCreateKeyFile(keyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, key, loaded)
+
+	assert.NoError(t, os.WriteFile(keyFile, []byte("!invalid"), 0600))
+	_, err = LoadOrCreateKeyFile(keyFile)
+	assert.Error(t, err)
+}
+
+func TestMask(t *testing.T) {
+	assert.Equal(t, "no secret", Mask("no secret"))
+
+	RegisterMaskValue("abc", "my-token", "my-token-long")
+	assert.Equal(t, "abc: ******, ******", Mask("abc: my-token, my-token-long"))
+
+	buf := new(bytes.Buffer)
+	n, err := NewMaskWriter(buf).Write([]byte("Authorization: my-token"))
+	assert.NoError(t, err)
+	assert.Equal(t, len("Authorization: my-token"), n)
+	assert.Equal(t, "Authorization: ******", buf.String())
+}
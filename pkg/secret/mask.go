@@ -0,0 +1,97 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MaskText replaces the secret values
+const MaskText = "******"
+
+// the short values are ignored, otherwise lots of normal text will be masked
+const minMaskLength = 4
+
+var masker = &valueMasker{values: map[string]struct{}{}}
+
+type valueMasker struct {
+	lock     sync.RWMutex
+	values   map[string]struct{}
+	replacer *strings.Replacer
+}
+
+// RegisterMaskValue records a secret value which will be masked by Mask
+func RegisterMaskValue(values ...string) {
+	masker.lock.Lock()
+	defer masker.lock.Unlock()
+
+	changed := false
+	for _, value := range values {
+		if _, ok := masker.values[value]; !ok && len(value) >= minMaskLength {
+			masker.values[value] = struct{}{}
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+
+	// replace the longer values first
+	sorted := make([]string, 0, len(masker.values))
+	for value := range masker.values {
+		sorted = append(sorted, value)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return len(sorted[i]) > len(sorted[j])
+	})
+
+	pairs := make([]string, 0, len(sorted)*2)
+	for _, value := range sorted {
+		pairs = append(pairs, value, MaskText)
+	}
+	masker.replacer = strings.NewReplacer(pairs...)
+}
+
+// Mask replaces all the registered secret values in the text
+func Mask(text string) string {
+	masker.lock.RLock()
+	defer masker.lock.RUnlock()
+	if masker.replacer == nil {
+		return text
+	}
+	return masker.replacer.Replace(text)
+}
+
+type maskWriter struct {
+	writer io.Writer
+}
+
+// NewMaskWriter creates a writer which masks the secret values before writing.
+// A secret value might not be masked if it was split into multiple writes.
+func NewMaskWriter(writer io.Writer) io.Writer {
+	return &maskWriter{writer: writer}
+}
+
+func (w *maskWriter) Write(p []byte) (n int, err error) {
+	if _, err = io.WriteString(w.writer, Mask(string(p))); err == nil {
+		n = len(p)
+	}
+	return
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/audit"
+	"github.com/linuxsuren/api-testing/pkg/oauth"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"google.golang.org/grpc"
+)
+
+// AuditedMethods maps the mutating and running gRPC methods to the kind of the target resource
+var AuditedMethods = map[string]string{
+	"/server.Runner/Run":                      audit.KindTestCase,
+	"/server.Runner/RunTestSuite":             audit.KindSuite,
+	"/server.Runner/RunTestCase":              audit.KindTestCase,
+	"/server.Runner/BatchRun":                 audit.KindTestCase,
+	"/server.Runner/CreateTestSuite":          audit.KindSuite,
+	"/server.Runner/ImportTestSuite":          audit.KindSuite,
+	"/server.Runner/UpdateTestSuite":          audit.KindSuite,
+	"/server.Runner/DeleteTestSuite":          audit.KindSuite,
+	"/server.Runner/DuplicateTestSuite":       audit.KindSuite,
+	"/server.Runner/RenameTestSuite":          audit.KindSuite,
+	"/server.Runner/RollbackSuiteRevision":    audit.KindSuite,
+	"/server.Runner/CreateTestCase":           audit.KindTestCase,
+	"/server.Runner/UpdateTestCase":           audit.KindTestCase,
+	"/server.Runner/DeleteTestCase":           audit.KindTestCase,
+	"/server.Runner/DuplicateTestCase":        audit.KindTestCase,
+	"/server.Runner/RenameTestCase":           audit.KindTestCase,
+	"/server.Runner/AcceptSnapshot":           audit.KindTestCase,
+	"/server.Runner/DeleteHistoryTestCase":    audit.KindHistory,
+	"/server.Runner/DeleteAllHistoryTestCase": audit.KindHistory,
+	"/server.Runner/CreateStore":              audit.KindStore,
+	"/server.Runner/UpdateStore":              audit.KindStore,
+	"/server.Runner/DeleteStore":              audit.KindStore,
+	"/server.Runner/SyncStore":                audit.KindStore,
+	"/server.Runner/CreateSecret":             audit.KindSecret,
+	"/server.Runner/UpdateSecret":             audit.KindSecret,
+	"/server.Runner/DeleteSecret":             audit.KindSecret,
+	"/server.Runner/CreateRoleBinding":        audit.KindRoleBinding,
+	"/server.Runner/UpdateRoleBinding":        audit.KindRoleBinding,
+	"/server.Runner/DeleteRoleBinding":        audit.KindRoleBinding,
+	"/server.Runner/UpdateFunctions":          audit.KindFunction,
+	"/server.Mock/Reload":                     audit.KindMock,
+}
+
+// AuditorSetter is able to accept an auditor
+type AuditorSetter interface {
+	WithAuditor(audit.Auditor)
+}
+
+// WithAuditor sets the auditor which is used to query the audit log
+func (s *server) WithAuditor(auditor audit.Auditor) {
+	s.auditor = auditor
+}
+
+var errAuditDisabled = errors.New("audit log is disabled")
+
+// QueryAuditLog returns the audit entries which match the query, the latest one comes first
+func (s *server) QueryAuditLog(ctx context.Context, in *AuditQuery) (reply *AuditEntries, err error) {
+	if s.auditor == nil {
+		err = errAuditDisabled
+		return
+	}
+
+	var entries []audit.Entry
+	if entries, err = s.auditor.Query(ToNormalAuditQuery(in)); err == nil {
+		reply = &AuditEntries{}
+		for _, entry := range entries {
+			reply.Data = append(reply.Data, ToGRPCAuditEntry(entry))
+		}
+	}
+	return
+}
+
+// NewAuditInterceptors creates the interceptors which record the mutating calls and the runs
+func NewAuditInterceptors(auditor audit.Auditor) []grpc.ServerOption {
+	inter := &auditInter{
+		auditor: auditor,
+		methods: AuditedMethods,
+	}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(inter.unaryInterceptor),
+		grpc.ChainStreamInterceptor(inter.streamInterceptor),
+	}
+}
+
+type auditInter struct {
+	auditor audit.Auditor
+	methods map[string]string
+}
+
+func (a *auditInter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (resp interface{}, err error) {
+	kind, ok := a.methods[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	var oldObj interface{}
+	s, isRunner := info.Server.(*server)
+	if isRunner {
+		oldObj = s.auditSnapshot(ctx, info.FullMethod, req)
+	}
+
+	resp, err = handler(ctx, req)
+
+	entry := newAuditEntry(ctx, info.FullMethod, kind, req)
+	setAuditResult(&entry, resp, err)
+	if entry.Success && oldObj != nil {
+		entry.Summary = audit.Diff(oldObj, toAuditObject(req))
+	}
+	a.auditor.Record(entry)
+	return
+}
+
+// streamInterceptor records the stream once it's finished, the target resource comes from the first message
+func (a *auditInter) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) (err error) {
+	kind, ok := a.methods[info.FullMethod]
+	if !ok {
+		return handler(srv, ss)
+	}
+
+	stream := &auditServerStream{ServerStream: ss}
+	err = handler(srv, stream)
+
+	entry := newAuditEntry(ss.Context(), info.FullMethod, kind, stream.firstMsg)
+	entry.Summary = fmt.Sprintf("received %d message(s)", stream.count)
+	setAuditResult(&entry, nil, err)
+	a.auditor.Record(entry)
+	return
+}
+
+type auditServerStream struct {
+	grpc.ServerStream
+	firstMsg interface{}
+	count    int
+}
+
+// RecvMsg receives a message and keeps the first one
+func (s *auditServerStream) RecvMsg(m interface{}) (err error) {
+	if err = s.ServerStream.RecvMsg(m); err == nil {
+		if s.firstMsg == nil {
+			s.firstMsg = m
+		}
+		s.count++
+	}
+	return
+}
+
+type errorGetter interface {
+	GetError() string
+}
+
+func setAuditResult(entry *audit.Entry, resp interface{}, err error) {
+	if err != nil {
+		entry.Error = err.Error()
+	} else if getter, ok := resp.(errorGetter); ok {
+		entry.Error = getter.GetError()
+	}
+	entry.Success = entry.Error == ""
+}
+
+func newAuditEntry(ctx context.Context, fullMethod, kind string, req interface{}) (entry audit.Entry) {
+	res := ResolveResource(ctx, fullMethod, req)
+	entry = audit.Entry{
+		Time:   time.Now(),
+		Method: fullMethod,
+		Kind:   kind,
+		Store:  res.Store,
+		Suite:  res.Suite,
+	}
+	if user := oauth.GetUserFromContext(ctx); user != nil {
+		entry.User = user.Name
+	}
+
+	switch in := req.(type) {
+	case *TestSuiteIdentity:
+		entry.Name = in.Name
+	case *TestSuite:
+		entry.Name = in.Name
+	case *TestSuiteSource:
+		entry.Name = in.Url
+		entry.Summary = fmt.Sprintf("import from %s", in.Kind)
+	case *TestSuiteDuplicate:
+		entry.Name = in.SourceSuiteName
+		entry.Summary = fmt.Sprintf("%s -> %s", in.SourceSuiteName, in.TargetSuiteName)
+	case *SuiteRevisionIdentity:
+		entry.TestCase = in.Testcase
+		entry.Name = in.Revision
+	case *TestCaseIdentity:
+		entry.TestCase = in.Testcase
+	case *SnapshotAcceptance:
+		entry.TestCase = in.Testcase
+		entry.Summary = "accept the snapshot"
+	case *TestCaseWithSuite:
+		if in.Data != nil {
+			entry.TestCase = in.Data.Name
+		}
+	case *TestCaseDuplicate:
+		entry.TestCase = in.SourceCaseName
+		entry.Summary = fmt.Sprintf("%s/%s -> %s/%s", in.SourceSuiteName, in.SourceCaseName,
+			in.TargetSuiteName, in.TargetCaseName)
+	case *TestTask:
+		entry.TestCase = in.CaseName
+	case *BatchTestTask:
+		entry.TestCase = in.CaseName
+	case *HistoryTestCase:
+		entry.TestCase = in.CaseName
+		entry.Name = in.ID
+	case *Store:
+		entry.Name = in.Name
+	case *StoreSyncRequest:
+		entry.Name = in.Name
+		entry.Summary = fmt.Sprintf("pull: %t, push: %t", in.Pull, in.Push)
+	case *Secret:
+		// never record the value of a secret
+		entry.Name = in.Name
+	case *RoleBinding:
+		entry.Name = in.Name
+	case *UserDefinedFunctions:
+		var names []string
+		for _, item := range in.Data {
+			names = append(names, item.Name)
+		}
+		entry.Summary = strings.Join(names, ", ")
+	}
+	return
+}
+
+// auditSnapshot returns the current object which is going to be updated
+func (s *server) auditSnapshot(ctx context.Context, fullMethod string, req interface{}) (obj interface{}) {
+	switch in := req.(type) {
+	case *TestSuite:
+		if fullMethod != "/server.Runner/UpdateTestSuite" {
+			return
+		}
+		loader := s.getLoader(ctx)
+		defer loader.Close()
+		if suite, err := loader.GetTestSuite(in.Name, false); err == nil {
+			obj = &suite
+		}
+	case *TestCaseWithSuite:
+		if fullMethod != "/server.Runner/UpdateTestCase" || in.Data == nil {
+			return
+		}
+		loader := s.getLoader(ctx)
+		defer loader.Close()
+		if testCase, err := loader.GetTestCase(in.SuiteName, in.Data.Name); err == nil {
+			obj = testCase
+		}
+	case *Store:
+		if fullMethod != "/server.Runner/UpdateStore" {
+			return
+		}
+		if store, err := testing.NewStoreFactory(s.configDir).GetStore(in.Name); err == nil && store != nil {
+			obj = *store
+		}
+	case *RoleBinding:
+		if fullMethod != "/server.Runner/UpdateRoleBinding" {
+			return
+		}
+		if binding, err := s.policyManager.GetRoleBinding(in.Name); err == nil && binding != nil {
+			obj = *binding
+		}
+	}
+	return
+}
+
+// toAuditObject converts the request to the object which is comparable with the snapshot
+func toAuditObject(req interface{}) (obj interface{}) {
+	switch in := req.(type) {
+	case *TestSuite:
+		obj = ToNormalSuite(in)
+	case *TestCaseWithSuite:
+		obj = ToNormalTestCase(in.Data)
+	case *Store:
+		store := ToNormalStore(in)
+		handleStore(&store)
+		obj = store
+	case *RoleBinding:
+		obj = ToNormalRoleBinding(in)
+	}
+	return
+}
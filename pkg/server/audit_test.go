@@ -0,0 +1,152 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/audit"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	hub "github.com/linuxsuren/oauth-hub"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := audit.NewFileSink(filepath.Join(dir, "audit.log"), 0, 0)
+	assert.NoError(t, err)
+	auditor := audit.NewAuditor(sink)
+	defer auditor.Close()
+
+	remoteServer := NewRemoteServer(atest.NewFileWriter(""), nil, nil, nil, dir, 1024*1024*4)
+	remoteServer.(AuditorSetter).WithAuditor(auditor)
+	inter := &auditInter{auditor: auditor, methods: AuditedMethods}
+
+	hub.SetUser("audit-token", &hub.UserInfo{Name: "alice"})
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs("auth", "audit-token", HeaderKeyStoreName, "orm"))
+
+	call := func(method string, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+		return inter.unaryInterceptor(ctx, req, &grpc.UnaryServerInfo{
+			Server:     remoteServer,
+			FullMethod: "/server.Runner/" + method,
+		}, handler)
+	}
+
+	t.Run("not audited", func(t *testing.T) {
+		_, err := call("GetSuites", &Empty{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &Suites{}, nil
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("update with diff", func(t *testing.T) {
+		_, err := remoteServer.CreateRoleBinding(ctx, &RoleBinding{Name: "editors", Role: "editor", Users: []string{"bob"}})
+		assert.NoError(t, err)
+
+		_, err = call("UpdateRoleBinding", &RoleBinding{Name: "editors", Role: "viewer", Users: []string{"bob"}},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return remoteServer.UpdateRoleBinding(ctx, req.(*RoleBinding))
+			})
+		assert.NoError(t, err)
+	})
+
+	t.Run("secret value is not recorded", func(t *testing.T) {
+		_, err := call("CreateSecret", &Secret{Name: "token", Value: "secret-value"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &CommonResult{Success: true}, nil
+			})
+		assert.NoError(t, err)
+	})
+
+	t.Run("failed run", func(t *testing.T) {
+		_, err := call("RunTestCase", &TestCaseIdentity{Suite: "orders", Testcase: "create"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &TestCaseResult{Error: "status code mismatch"}, nil
+			})
+		assert.NoError(t, err)
+
+		_, err = call("DeleteTestSuite", &TestSuiteIdentity{Name: "orders"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, errors.New("fake")
+			})
+		assert.Error(t, err)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		stream := &fakeServerStream{
+			p:      new(uint32),
+			Ctx:    ctx,
+			Inputs: []any{&TestSuiteIdentity{Name: "orders"}, &TestSuiteIdentity{Name: "orders"}},
+		}
+		err := inter.streamInterceptor(remoteServer, stream, &grpc.StreamServerInfo{
+			FullMethod: "/server.Runner/RunTestSuite",
+		}, func(srv interface{}, ss grpc.ServerStream) error {
+			in := &TestSuiteIdentity{}
+			for ss.RecvMsg(in) == nil {
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+
+	reply, err := remoteServer.QueryAuditLog(ctx, &AuditQuery{})
+	assert.NoError(t, err)
+	if assert.Len(t, reply.Data, 5) {
+		run := reply.Data[0]
+		assert.Equal(t, "/server.Runner/RunTestSuite", run.Method)
+		assert.Equal(t, "orders", run.Suite)
+		assert.Equal(t, "received 2 message(s)", run.Summary)
+
+		deletion := reply.Data[1]
+		assert.False(t, deletion.Success)
+		assert.Equal(t, "fake", deletion.Error)
+
+		failedRun := reply.Data[2]
+		assert.Equal(t, "create", failedRun.Testcase)
+		assert.Equal(t, "orm", failedRun.Store)
+		assert.False(t, failedRun.Success)
+		assert.Equal(t, "status code mismatch", failedRun.Error)
+
+		secret := reply.Data[3]
+		assert.Equal(t, audit.KindSecret, secret.Kind)
+		assert.Equal(t, "token", secret.Name)
+
+		update := reply.Data[4]
+		assert.Equal(t, "alice", update.User)
+		assert.Equal(t, "editors", update.Name)
+		assert.Equal(t, "changed: role", update.Summary)
+		assert.True(t, update.Success)
+	}
+	for _, entry := range reply.Data {
+		assert.False(t, strings.Contains(entry.String(), "secret-value"))
+	}
+
+	reply, err = remoteServer.QueryAuditLog(ctx, &AuditQuery{Kind: audit.KindSecret})
+	assert.NoError(t, err)
+	assert.Len(t, reply.Data, 1)
+}
+
+func TestQueryAuditLogWithoutAuditor(t *testing.T) {
+	remoteServer := NewRemoteServer(atest.NewFileWriter(""), nil, nil, nil, t.TempDir(), 1024*1024*4)
+	_, err := remoteServer.QueryAuditLog(context.TODO(), &AuditQuery{})
+	assert.Error(t, err)
+}
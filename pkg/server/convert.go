@@ -16,8 +16,12 @@ limitations under the License.
 package server
 
 import (
+	"encoding/json"
 	"strings"
 
+	"github.com/linuxsuren/api-testing/pkg/audit"
+	"github.com/linuxsuren/api-testing/pkg/oauth"
+	"github.com/linuxsuren/api-testing/pkg/render"
 	"github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -68,6 +72,97 @@ func ToNormalStore(store *Store) (result testing.Store) {
 	return
 }
 
+// ToGRPCRoleBinding converts the normal role binding to GRPC role binding
+func ToGRPCRoleBinding(binding oauth.RoleBinding) *RoleBinding {
+	return &RoleBinding{
+		Name:   binding.Name,
+		Role:   string(binding.Role),
+		Users:  binding.Users,
+		Groups: binding.Groups,
+		Store:  binding.Store,
+		Suite:  binding.Suite,
+	}
+}
+
+// ToNormalRoleBinding converts the GRPC role binding to normal role binding
+func ToNormalRoleBinding(binding *RoleBinding) oauth.RoleBinding {
+	return oauth.RoleBinding{
+		Name:   binding.Name,
+		Role:   oauth.Role(binding.Role),
+		Users:  binding.Users,
+		Groups: binding.Groups,
+		Store:  binding.Store,
+		Suite:  binding.Suite,
+	}
+}
+
+// ToGRPCAuditEntry converts the normal audit entry to GRPC audit entry
+func ToGRPCAuditEntry(entry audit.Entry) *AuditEntry {
+	return &AuditEntry{
+		Time:     timestamppb.New(entry.Time),
+		User:     entry.User,
+		Method:   entry.Method,
+		Kind:     entry.Kind,
+		Store:    entry.Store,
+		Suite:    entry.Suite,
+		Testcase: entry.TestCase,
+		Name:     entry.Name,
+		Summary:  entry.Summary,
+		Success:  entry.Success,
+		Error:    entry.Error,
+	}
+}
+
+// ToNormalAuditEntry converts the GRPC audit entry to normal audit entry
+func ToNormalAuditEntry(entry *AuditEntry) (result audit.Entry) {
+	result = audit.Entry{
+		User:     entry.User,
+		Method:   entry.Method,
+		Kind:     entry.Kind,
+		Store:    entry.Store,
+		Suite:    entry.Suite,
+		TestCase: entry.Testcase,
+		Name:     entry.Name,
+		Summary:  entry.Summary,
+		Success:  entry.Success,
+		Error:    entry.Error,
+	}
+	if entry.Time != nil {
+		result.Time = entry.Time.AsTime()
+	}
+	return
+}
+
+// ToNormalAuditQuery converts the GRPC audit query to normal audit filter
+func ToNormalAuditQuery(query *AuditQuery) (filter audit.Filter) {
+	filter = audit.Filter{
+		User:   query.User,
+		Method: query.Method,
+		Kind:   query.Kind,
+		Store:  query.Store,
+		Suite:  query.Suite,
+		Limit:  int(query.Limit),
+	}
+	if query.Since != nil {
+		filter.Since = query.Since.AsTime()
+	}
+	if query.Until != nil {
+		filter.Until = query.Until.AsTime()
+	}
+	return
+}
+
+// ToGRPCSuiteRevision converts the normal suite revision to GRPC suite revision
+func ToGRPCSuiteRevision(revision testing.SuiteRevision) *SuiteRevision {
+	return &SuiteRevision{
+		Id:         revision.ID,
+		Suite:      revision.Suite,
+		Testcase:   revision.TestCase,
+		Action:     revision.Action,
+		CreateTime: timestamppb.New(revision.CreateTime),
+	}
+}
+
 func ConvertToGRPCHistoryTestCase(historyTestcase testing.HistoryTestCase) (result *HistoryTestCase) {
 	req := historyTestcase.Data.Request
 	res := historyTestcase.Data.Expect
@@ -110,9 +205,20 @@ func ToGRPCSuite(suite *testing.TestSuite) (result *TestSuite) {
 		Api:   suite.API,
 		Param: mapToPair(suite.Param),
 		Spec: &APISpec{
-			Kind: suite.Spec.Kind,
-			Url:  suite.Spec.URL,
+			Kind:     suite.Spec.Kind,
+			Url:      suite.Spec.URL,
+			Contract: suite.Spec.Contract,
 		},
+		Functions:  ToGRPCFunctions(suite.Functions),
+		Auth:       ToGRPCAuth(suite.Auth),
+		Assertions: ToGRPCExprFunctions(suite.Assertions),
+		Helpers:    ToGRPCExprFunctions(suite.Helpers),
+	}
+	if suite.Before != nil {
+		result.Before = suite.Before.Items
+	}
+	if suite.After != nil {
+		result.After = suite.After.Items
 	}
 	if suite.Proxy != nil {
 		result.Proxy = &ProxyConfig{
@@ -144,9 +250,19 @@ func ToGRPCSuite(suite *testing.TestSuite) (result *TestSuite) {
 
 func ToNormalSuite(suite *TestSuite) (result *testing.TestSuite) {
 	result = &testing.TestSuite{
-		Name:  suite.Name,
-		API:   suite.Api,
-		Param: pairToMap(suite.Param),
+		Name:       suite.Name,
+		API:        suite.Api,
+		Param:      pairToMap(suite.Param),
+		Functions:  ToNormalFunctions(suite.Functions),
+		Auth:       ToNormalAuth(suite.Auth),
+		Assertions: ToNormalExprFunctions(suite.Assertions),
+		Helpers:    ToNormalExprFunctions(suite.Helpers),
+	}
+	if len(suite.Before) > 0 {
+		result.Before = &testing.Job{Items: suite.Before}
+	}
+	if len(suite.After) > 0 {
+		result.After = &testing.Job{Items: suite.After}
 	}
 	if suite.Proxy != nil {
 		result.Proxy = &testing.Proxy{
@@ -157,8 +273,9 @@ func ToNormalSuite(suite *TestSuite) (result *testing.TestSuite) {
 	}
 	if suite.Spec != nil {
 		result.Spec = testing.APISpec{
-			Kind: suite.Spec.Kind,
-			URL:  suite.Spec.Url,
+			Kind:     suite.Spec.Kind,
+			URL:      suite.Spec.Url,
+			Contract: suite.Spec.Contract,
 		}
 		if suite.Spec.Secure != nil {
 			result.Spec.Secure = &testing.Secure{
@@ -182,6 +299,222 @@ func ToNormalSuite(suite *TestSuite) (result *testing.TestSuite) {
 	return
 }
 
+// ToGRPCFunctions converts the user-defined template functions
+func ToGRPCFunctions(functions []render.UserDefinedTemplate) (result []*UserDefinedFunction) {
+	for _, item := range functions {
+		result = append(result, &UserDefinedFunction{
+			Name:        item.Name,
+			Render:      item.Render,
+			Params:      item.Params,
+			Description: item.Description,
+		})
+	}
+	return
+}
+
+// ToNormalFunctions converts the gRPC user-defined template functions
+func ToNormalFunctions(functions []*UserDefinedFunction) (result []render.UserDefinedTemplate) {
+	for _, item := range functions {
+		result = append(result, render.UserDefinedTemplate{
+			Name:        item.Name,
+			Render:      item.Render,
+			Params:      item.Params,
+			Description: item.Description,
+		})
+	}
+	return
+}
+
+// ToGRPCExprFunctions converts the assertions or helpers of a suite
+func ToGRPCExprFunctions(functions []testing.ExprFunction) (result []*ExprFunction) {
+	for _, item := range functions {
+		result = append(result, &ExprFunction{
+			Name:   item.Name,
+			Params: item.Params,
+			Expr:   item.Expr,
+		})
+	}
+	return
+}
+
+// ToNormalExprFunctions converts the gRPC assertions or helpers of a suite
+func ToNormalExprFunctions(functions []*ExprFunction) (result []testing.ExprFunction) {
+	for _, item := range functions {
+		result = append(result, testing.ExprFunction{
+			Name:   item.Name,
+			Params: item.Params,
+			Expr:   item.Expr,
+		})
+	}
+	return
+}
+
+// ToGRPCQueryAssertions converts the query assertions, the expected values are JSON strings
+func ToGRPCQueryAssertions(queries []testing.QueryAssertion) (result []*QueryAssertion) {
+	for _, item := range queries {
+		query := &QueryAssertion{
+			Jsonpath: item.JSONPath,
+			Jmespath: item.JMESPath,
+			Match:    item.Match,
+			Type:     item.Type,
+			Regex:    item.Regex,
+			Gt:       item.GreaterThan,
+			Gte:      item.GreaterThanOrEqual,
+			Lt:       item.LessThan,
+			Lte:      item.LessThanOrEqual,
+			Equal:    toJSONValue(item.Equal),
+			Contains: toJSONValue(item.Contains),
+		}
+		if item.Count != nil {
+			count := int32(*item.Count)
+			query.Count = &count
+		}
+		result = append(result, query)
+	}
+	return
+}
+
+// ToNormalQueryAssertions converts the gRPC query assertions, the invalid JSON values are taken as strings
+func ToNormalQueryAssertions(queries []*QueryAssertion) (result []testing.QueryAssertion) {
+	for _, item := range queries {
+		query := testing.QueryAssertion{
+			JSONPath:           strings.TrimSpace(item.Jsonpath),
+			JMESPath:           strings.TrimSpace(item.Jmespath),
+			Match:              item.Match,
+			Type:               item.Type,
+			Regex:              item.Regex,
+			GreaterThan:        item.Gt,
+			GreaterThanOrEqual: item.Gte,
+			LessThan:           item.Lt,
+			LessThanOrEqual:    item.Lte,
+			Equal:              fromJSONValue(item.Equal),
+			Contains:           fromJSONValue(item.Contains),
+		}
+		if item.Count != nil {
+			count := int(*item.Count)
+			query.Count = &count
+		}
+		result = append(result, query)
+	}
+	return
+}
+
+func toJSONValue(value interface{}) (result string) {
+	if value == nil {
+		return
+	}
+	if data, err := json.Marshal(value); err == nil {
+		result = string(data)
+	}
+	return
+}
+
+func fromJSONValue(value string) (result interface{}) {
+	if value == "" {
+		return
+	}
+	if err := json.Unmarshal([]byte(value), &result); err != nil {
+		result = value
+	}
+	return
+}
+
+// ToGRPCAuth converts the auth of a suite, the JWT claims are a JSON object
+func ToGRPCAuth(auth *testing.Auth) (result *SuiteAuth) {
+	if auth == nil {
+		return
+	}
+
+	result = &SuiteAuth{Mode: auth.Mode}
+	if auth.JWT != nil {
+		result.Jwt = &JWTAuth{
+			Algorithm: auth.JWT.Algorithm,
+			Secret:    auth.JWT.Secret,
+			ExpiresIn: auth.JWT.ExpiresIn,
+		}
+		if len(auth.JWT.Claims) > 0 {
+			if data, err := json.Marshal(auth.JWT.Claims); err == nil {
+				result.Jwt.Claims = string(data)
+			}
+		}
+	}
+	if auth.HMAC != nil {
+		result.Hmac = &HMACAuth{
+			Algorithm: auth.HMAC.Algorithm,
+			Secret:    auth.HMAC.Secret,
+			Header:    auth.HMAC.Header,
+		}
+	}
+	if auth.AWS != nil {
+		result.Aws = &AWSAuth{
+			AccessKey:    auth.AWS.AccessKey,
+			SecretKey:    auth.AWS.SecretKey,
+			SessionToken: auth.AWS.SessionToken,
+			Region:       auth.AWS.Region,
+			Service:      auth.AWS.Service,
+		}
+	}
+	if auth.OAuth2 != nil {
+		result.Oauth2 = &OAuth2Auth{
+			Grant:        auth.OAuth2.Grant,
+			TokenURL:     auth.OAuth2.TokenURL,
+			ClientID:     auth.OAuth2.ClientID,
+			ClientSecret: auth.OAuth2.ClientSecret,
+			Username:     auth.OAuth2.Username,
+			Password:     auth.OAuth2.Password,
+			Scopes:       auth.OAuth2.Scopes,
+		}
+	}
+	return
+}
+
+// ToNormalAuth converts the gRPC auth of a suite
+func ToNormalAuth(auth *SuiteAuth) (result *testing.Auth) {
+	if auth == nil || auth.Mode == "" {
+		return
+	}
+
+	result = &testing.Auth{Mode: auth.Mode}
+	if auth.Jwt != nil {
+		result.JWT = &testing.JWTAuth{
+			Algorithm: auth.Jwt.Algorithm,
+			Secret:    auth.Jwt.Secret,
+			ExpiresIn: auth.Jwt.ExpiresIn,
+		}
+		if auth.Jwt.Claims != "" {
+			_ = json.Unmarshal([]byte(auth.Jwt.Claims), &result.JWT.Claims)
+		}
+	}
+	if auth.Hmac != nil {
+		result.HMAC = &testing.HMACAuth{
+			Algorithm: auth.Hmac.Algorithm,
+			Secret:    auth.Hmac.Secret,
+			Header:    auth.Hmac.Header,
+		}
+	}
+	if auth.Aws != nil {
+		result.AWS = &testing.AWSAuth{
+			AccessKey:    auth.Aws.AccessKey,
+			SecretKey:    auth.Aws.SecretKey,
+			SessionToken: auth.Aws.SessionToken,
+			Region:       auth.Aws.Region,
+			Service:      auth.Aws.Service,
+		}
+	}
+	if auth.Oauth2 != nil {
+		result.OAuth2 = &testing.OAuth2Auth{
+			Grant:        auth.Oauth2.Grant,
+			TokenURL:     auth.Oauth2.TokenURL,
+			ClientID:     auth.Oauth2.ClientID,
+			ClientSecret: auth.Oauth2.ClientSecret,
+			Username:     auth.Oauth2.Username,
+			Password:     auth.Oauth2.Password,
+			Scopes:       auth.Oauth2.Scopes,
+		}
+	}
+	return
+}
+
 func ToNormalSuiteYAML(suite *TestSuite) ([]byte, error) {
 	result := ToNormalSuite(suite)
 	return testing.ToYAML(result)
@@ -205,6 +538,26 @@ func ToGRPCTestCase(testCase testing.TestCase) (result *TestCase) {
 		BodyFieldsExpect: mapInterToPair(testCase.Expect.BodyFieldsExpect),
 		Verify:           testCase.Expect.Verify,
 		Schema:           testCase.Expect.Schema,
+		MaxDuration:      testCase.Expect.MaxDuration,
+		MaxBodySize:      testCase.Expect.MaxBodySize,
+		MinBodySize:      testCase.Expect.MinBodySize,
+		Query:            ToGRPCQueryAssertions(testCase.Expect.Query),
+	}
+	if bodyMatch := testCase.Expect.BodyMatch; bodyMatch != nil {
+		resp.BodyMatch = &BodyMatch{
+			Body:      bodyMatch.Body,
+			Ignore:    bodyMatch.Ignore,
+			Unordered: bodyMatch.Unordered,
+			Tolerance: bodyMatch.Tolerance,
+			Subset:    bodyMatch.Subset,
+		}
+	}
+	if snapshot := testCase.Expect.Snapshot; snapshot != nil {
+		resp.Snapshot = &Snapshot{
+			Name:   snapshot.Name,
+			Header: snapshot.Header,
+			Redact: snapshot.Redact,
+		}
 	}
 
 	result = &TestCase{
@@ -240,6 +593,26 @@ func ToNormalTestCase(in *TestCase) (result testing.TestCase) {
 		result.Expect.ConditionalVerify = convertConditionalVerify(resp.ConditionalVerify)
 		result.Expect.BodyFieldsExpect = pairToInterMap(resp.BodyFieldsExpect)
 		result.Expect.Header = pairToMap(resp.Header)
+		result.Expect.MaxDuration = strings.TrimSpace(resp.MaxDuration)
+		result.Expect.MaxBodySize = resp.MaxBodySize
+		result.Expect.MinBodySize = resp.MinBodySize
+		result.Expect.Query = ToNormalQueryAssertions(resp.Query)
+		if bodyMatch := resp.BodyMatch; bodyMatch != nil && strings.TrimSpace(bodyMatch.Body) != "" {
+			result.Expect.BodyMatch = &testing.BodyMatch{
+				Body:      strings.TrimSpace(bodyMatch.Body),
+				Ignore:    bodyMatch.Ignore,
+				Unordered: bodyMatch.Unordered,
+				Tolerance: bodyMatch.Tolerance,
+				Subset:    bodyMatch.Subset,
+			}
+		}
+		if snapshot := resp.Snapshot; snapshot != nil {
+			result.Expect.Snapshot = &testing.Snapshot{
+				Name:   snapshot.Name,
+				Header: snapshot.Header,
+				Redact: snapshot.Redact,
+			}
+		}
 	}
 	return
 }
@@ -282,8 +655,9 @@ func ToGRPCHistoryTestCaseResult(historyTestResult testing.HistoryTestResult) (r
 
 func ToGRPCTestSuiteSpec(spec testing.APISpec) (result *APISpec) {
 	result = &APISpec{
-		Kind: spec.Kind,
-		Url:  spec.URL,
+		Kind:     spec.Kind,
+		Url:      spec.URL,
+		Contract: spec.Contract,
 	}
 	if spec.RPC != nil {
 		result.Rpc = &RPC{
@@ -173,6 +173,25 @@ func TestToNormalSuite(t *testing.T) {
 	}))
 }
 
+func TestSuiteExprFunctionsConversion(t *testing.T) {
+	suite := &atest.TestSuite{
+		Name:   "test",
+		Before: &atest.Job{Items: []string{"sleep(1)"}},
+		After:  &atest.Job{Items: []string{"sleep(2)"}},
+		Assertions: []atest.ExprFunction{{
+			Name: "isPage", Params: []string{"body"}, Expr: "body.total >= 0",
+		}},
+		Helpers: []atest.ExprFunction{{
+			Name: "first", Params: []string{"items"}, Expr: "items[0]",
+		}},
+	}
+	result := ToNormalSuite(ToGRPCSuite(suite))
+	assert.Equal(t, suite.Before, result.Before)
+	assert.Equal(t, suite.After, result.After)
+	assert.Equal(t, suite.Assertions, result.Assertions)
+	assert.Equal(t, suite.Helpers, result.Helpers)
+}
+
 func TestConvertToGRPCHistoryTestCase(t *testing.T) {
 	now := time.Now().UTC()
 	result := ConvertToGRPCHistoryTestCase(atest.HistoryTestCase{
@@ -351,3 +370,75 @@ proxy:
 var defaultInterMap = map[string]interface{}{"foo": "bar"}
 var defaultMap map[string]string = map[string]string{"foo": "bar"}
 var defaultPairs []*Pair = []*Pair{{Key: "foo", Value: "bar"}}
+
+func TestBodyMatchConversion(t *testing.T) {
+	testCase := atest.TestCase{
+		Name: "test",
+		Expect: atest.Response{
+			BodyMatch: &atest.BodyMatch{
+				Body:      `{"id": "$any"}`,
+				Ignore:    []string{"createdAt"},
+				Unordered: []string{"items"},
+				Tolerance: 0.1,
+				Subset:    true,
+			},
+		},
+	}
+	assert.Equal(t, testCase.Expect.BodyMatch, ToNormalTestCase(ToGRPCTestCase(testCase)).Expect.BodyMatch)
+
+	assert.Nil(t, ToNormalTestCase(&TestCase{Response: &Response{BodyMatch: &BodyMatch{}}}).Expect.BodyMatch)
+}
+
+func TestSnapshotConversion(t *testing.T) {
+	testCase := atest.TestCase{
+		Name: "test",
+		Expect: atest.Response{
+			Snapshot: &atest.Snapshot{
+				Name:   "users",
+				Header: []string{"Content-Type"},
+				Redact: []string{"id"},
+			},
+		},
+	}
+	assert.Equal(t, testCase.Expect.Snapshot, ToNormalTestCase(ToGRPCTestCase(testCase)).Expect.Snapshot)
+}
+
+func TestQueryAssertionsConversion(t *testing.T) {
+	count, gt := 2, 1.5
+	queries := []atest.QueryAssertion{{
+		JSONPath:    "$.items[*].price",
+		Match:       "all",
+		Count:       &count,
+		GreaterThan: &gt,
+		Equal:       map[string]interface{}{"name": "rick"},
+	}, {
+		JMESPath: "items[*].id",
+		Contains: "a1",
+		Type:     "array",
+		Regex:    "^a",
+	}}
+
+	grpcQueries := ToGRPCQueryAssertions(queries)
+	assert.Equal(t, `{"name":"rick"}`, grpcQueries[0].Equal)
+	assert.Equal(t, `"a1"`, grpcQueries[1].Contains)
+	assert.Equal(t, int32(2), *grpcQueries[0].Count)
+	assert.Nil(t, grpcQueries[1].Count)
+	assert.Equal(t, queries, ToNormalQueryAssertions(grpcQueries))
+
+	assert.Equal(t, "plain", ToNormalQueryAssertions([]*QueryAssertion{{Equal: "plain"}})[0].Equal)
+}
+
+func TestResponseLimitsConversion(t *testing.T) {
+	testCase := atest.TestCase{
+		Name: "test",
+		Expect: atest.Response{
+			MaxDuration: "500ms",
+			MaxBodySize: 1024,
+			MinBodySize: 1,
+		},
+	}
+	expect := ToNormalTestCase(ToGRPCTestCase(testCase)).Expect
+	assert.Equal(t, "500ms", expect.MaxDuration)
+	assert.Equal(t, int64(1024), expect.MaxBodySize)
+	assert.Equal(t, int64(1), expect.MinBodySize)
+}
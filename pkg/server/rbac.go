@@ -0,0 +1,248 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/linuxsuren/api-testing/pkg/oauth"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"google.golang.org/grpc/metadata"
+)
+
+// MethodPermissions maps the gRPC methods to the required permissions
+var MethodPermissions = map[string]oauth.Permission{
+	"/server.Runner/Run":                          oauth.PermissionRun,
+	"/server.Runner/RunTestSuite":                 oauth.PermissionRun,
+	"/server.Runner/GetSuites":                    oauth.PermissionRead,
+	"/server.Runner/CreateTestSuite":              oauth.PermissionWrite,
+	"/server.Runner/ImportTestSuite":              oauth.PermissionWrite,
+	"/server.Runner/GenerateTestSuite":            oauth.PermissionRead,
+	"/server.Runner/GetTestSuite":                 oauth.PermissionRead,
+	"/server.Runner/UpdateTestSuite":              oauth.PermissionWrite,
+	"/server.Runner/DeleteTestSuite":              oauth.PermissionWrite,
+	"/server.Runner/DuplicateTestSuite":           oauth.PermissionWrite,
+	"/server.Runner/RenameTestSuite":              oauth.PermissionWrite,
+	"/server.Runner/GetTestSuiteYaml":             oauth.PermissionRead,
+	"/server.Runner/ListSuiteRevisions":           oauth.PermissionRead,
+	"/server.Runner/DiffSuiteRevisions":           oauth.PermissionRead,
+	"/server.Runner/RollbackSuiteRevision":        oauth.PermissionWrite,
+	"/server.Runner/ListTestCase":                 oauth.PermissionRead,
+	"/server.Runner/RunTestCase":                  oauth.PermissionRun,
+	"/server.Runner/AcceptSnapshot":               oauth.PermissionWrite,
+	"/server.Runner/BatchRun":                     oauth.PermissionRun,
+	"/server.Runner/GetTestCase":                  oauth.PermissionRead,
+	"/server.Runner/CreateTestCase":               oauth.PermissionWrite,
+	"/server.Runner/UpdateTestCase":               oauth.PermissionWrite,
+	"/server.Runner/DeleteTestCase":               oauth.PermissionWrite,
+	"/server.Runner/DuplicateTestCase":            oauth.PermissionWrite,
+	"/server.Runner/RenameTestCase":               oauth.PermissionWrite,
+	"/server.Runner/GetSuggestedAPIs":             oauth.PermissionRead,
+	"/server.Runner/GetHistorySuites":             oauth.PermissionRead,
+	"/server.Runner/GetHistoryTestCaseWithResult": oauth.PermissionRead,
+	"/server.Runner/GetHistoryTestCase":           oauth.PermissionRead,
+	"/server.Runner/DeleteHistoryTestCase":        oauth.PermissionWrite,
+	"/server.Runner/DeleteAllHistoryTestCase":     oauth.PermissionWrite,
+	"/server.Runner/GetTestCaseAllHistory":        oauth.PermissionRead,
+	"/server.Runner/ListCodeGenerator":            oauth.PermissionRead,
+	"/server.Runner/GenerateCode":                 oauth.PermissionRead,
+	"/server.Runner/HistoryGenerateCode":          oauth.PermissionRead,
+	"/server.Runner/ListConverter":                oauth.PermissionRead,
+	"/server.Runner/ConvertTestSuite":             oauth.PermissionRead,
+	"/server.Runner/PopularHeaders":               oauth.PermissionNone,
+	"/server.Runner/FunctionsQuery":               oauth.PermissionNone,
+	"/server.Runner/FunctionsQueryStream":         oauth.PermissionNone,
+	"/server.Runner/UpdateFunctions":              oauth.PermissionAdmin,
+	"/server.Runner/GetSchema":                    oauth.PermissionNone,
+	"/server.Runner/GetVersion":                   oauth.PermissionNone,
+	"/server.Runner/Sample":                       oauth.PermissionNone,
+	"/server.Runner/DownloadResponseFile":         oauth.PermissionRun,
+	"/server.Runner/GetStoreKinds":                oauth.PermissionNone,
+	"/server.Runner/GetStores":                    oauth.PermissionNone,
+	"/server.Runner/CreateStore":                  oauth.PermissionWrite,
+	"/server.Runner/UpdateStore":                  oauth.PermissionAdmin,
+	"/server.Runner/DeleteStore":                  oauth.PermissionAdmin,
+	"/server.Runner/VerifyStore":                  oauth.PermissionRead,
+	"/server.Runner/SyncStore":                    oauth.PermissionWrite,
+	"/server.Runner/GetSecrets":                   oauth.PermissionRead,
+	"/server.Runner/CreateSecret":                 oauth.PermissionAdmin,
+	"/server.Runner/DeleteSecret":                 oauth.PermissionAdmin,
+	"/server.Runner/UpdateSecret":                 oauth.PermissionAdmin,
+	"/server.Runner/GetRoleBindings":              oauth.PermissionAdmin,
+	"/server.Runner/CreateRoleBinding":            oauth.PermissionAdmin,
+	"/server.Runner/UpdateRoleBinding":            oauth.PermissionAdmin,
+	"/server.Runner/DeleteRoleBinding":            oauth.PermissionAdmin,
+	"/server.Runner/QueryAuditLog":                oauth.PermissionAdmin,
+	"/server.Runner/PProf":                        oauth.PermissionAdmin,
+	"/server.Runner/CallAI":                       oauth.PermissionRun,
+	"/server.Runner/GetAICapabilities":            oauth.PermissionRead,
+
+	"/server.UIExtension/GetMenus":        oauth.PermissionNone,
+	"/server.UIExtension/GetPageOfJS":     oauth.PermissionNone,
+	"/server.UIExtension/GetPageOfCSS":    oauth.PermissionNone,
+	"/server.UIExtension/GetPageOfStatic": oauth.PermissionNone,
+	"/server.UIExtension/GetPageOfServer": oauth.PermissionNone,
+	"/server.ThemeExtension/GetThemes":    oauth.PermissionNone,
+	"/server.ThemeExtension/GetTheme":     oauth.PermissionNone,
+	"/server.ThemeExtension/GetBindings":  oauth.PermissionNone,
+	"/server.ThemeExtension/GetBinding":   oauth.PermissionNone,
+	"/server.DataServer/Query":            oauth.PermissionRun,
+	"/server.Mock/Reload":                 oauth.PermissionWrite,
+	"/server.Mock/GetConfig":              oauth.PermissionRead,
+	"/server.Mock/LogWatch":               oauth.PermissionRead,
+}
+
+// ResolveResource returns the target store and suite of a gRPC request.
+// The store comes from the request metadata unless the request is about a store.
+func ResolveResource(ctx context.Context, fullMethod string, req interface{}) (res oauth.Resource) {
+	res.Store = getStoreName(ctx)
+
+	switch in := req.(type) {
+	case *Store:
+		res.Store = in.Name
+	case *StoreSyncRequest:
+		res.Store = in.Name
+	case *SimpleQuery:
+		if strings.HasSuffix(fullMethod, "/VerifyStore") {
+			res.Store = in.Name
+		}
+	case *TestSuiteIdentity:
+		res.Suite = in.Name
+	case *TestSuite:
+		res.Suite = in.Name
+	case *TestCaseIdentity:
+		res.Suite = in.Suite
+	case *TestCaseWithSuite:
+		res.Suite = in.SuiteName
+	case *TestSuiteDuplicate:
+		res.Suite = in.SourceSuiteName
+		if strings.HasSuffix(fullMethod, "/DuplicateTestSuite") {
+			res.Suite = in.TargetSuiteName
+		}
+	case *TestCaseDuplicate:
+		res.Suite = in.SourceSuiteName
+		if strings.HasSuffix(fullMethod, "/DuplicateTestCase") {
+			res.Suite = in.TargetSuiteName
+		}
+	case *BatchTestTask:
+		res.Suite = in.SuiteName
+	case *CodeGenerateRequest:
+		res.Suite = in.TestSuite
+	case *TestCase:
+		res.Suite = in.SuiteName
+	case *HistoryTestCase:
+		res.Suite = in.SuiteName
+	case *SuiteRevisionQuery:
+		res.Suite = in.Suite
+	case *SuiteRevisionDiffRequest:
+		res.Suite = in.Suite
+	case *SuiteRevisionIdentity:
+		res.Suite = in.Suite
+	case *SnapshotAcceptance:
+		res.Suite = in.Suite
+	case *RoleBinding:
+		res.Store = in.Store
+		res.Suite = in.Suite
+	}
+	return
+}
+
+// NewResourceResolver creates a resolver which takes the scope of an existing role binding from the policy manager,
+// instead of the request, when updating or deleting it
+func NewResourceResolver(manager oauth.PolicyManager) oauth.ResourceResolver {
+	return func(ctx context.Context, fullMethod string, req interface{}) (res oauth.Resource) {
+		res = ResolveResource(ctx, fullMethod, req)
+		in, ok := req.(*RoleBinding)
+		if !ok || (!strings.HasSuffix(fullMethod, "/UpdateRoleBinding") && !strings.HasSuffix(fullMethod, "/DeleteRoleBinding")) {
+			return
+		}
+		if binding, err := manager.GetRoleBinding(in.Name); err == nil && binding != nil {
+			res = oauth.Resource{Store: binding.Store, Suite: binding.Suite}
+		}
+		return
+	}
+}
+
+// PolicyManagerSetter is able to accept a policy manager
+type PolicyManagerSetter interface {
+	WithPolicyManager(oauth.PolicyManager)
+}
+
+// WithPolicyManager sets the policy manager which is shared with the RBAC interceptors
+func (s *server) WithPolicyManager(manager oauth.PolicyManager) {
+	s.policyManager = manager
+}
+
+// NewStoreOwnerGetter creates a getter which finds the store owner from the config directory
+func NewStoreOwnerGetter(configDir string) oauth.StoreOwnerGetter {
+	return func(name string) (owner string) {
+		if store, err := testing.NewStoreFactory(configDir).GetStore(name); err == nil && store != nil {
+			owner = store.Owner
+		}
+		return
+	}
+}
+
+func getStoreName(ctx context.Context) (name string) {
+	if mdd, ok := metadata.FromIncomingContext(ctx); ok {
+		if storeNameMeta := mdd.Get(HeaderKeyStoreName); len(storeNameMeta) > 0 {
+			name = strings.TrimSpace(storeNameMeta[0])
+		}
+	}
+	name = util.EmptyThenDefault(name, "local")
+	return
+}
+
+// GetRoleBindings returns all the role bindings
+func (s *server) GetRoleBindings(ctx context.Context, in *Empty) (reply *RoleBindings, err error) {
+	var bindings []oauth.RoleBinding
+	if bindings, err = s.policyManager.GetRoleBindings(); err == nil {
+		reply = &RoleBindings{}
+		for _, binding := range bindings {
+			reply.Data = append(reply.Data, ToGRPCRoleBinding(binding))
+		}
+	}
+	return
+}
+
+// CreateRoleBinding creates a role binding
+func (s *server) CreateRoleBinding(ctx context.Context, in *RoleBinding) (reply *CommonResult, err error) {
+	reply = &CommonResult{}
+	if err = s.policyManager.CreateRoleBinding(ToNormalRoleBinding(in)); err == nil {
+		reply.Success = true
+	}
+	return
+}
+
+// UpdateRoleBinding updates a role binding
+func (s *server) UpdateRoleBinding(ctx context.Context, in *RoleBinding) (reply *CommonResult, err error) {
+	reply = &CommonResult{}
+	if err = s.policyManager.UpdateRoleBinding(ToNormalRoleBinding(in)); err == nil {
+		reply.Success = true
+	}
+	return
+}
+
+// DeleteRoleBinding deletes a role binding
+func (s *server) DeleteRoleBinding(ctx context.Context, in *RoleBinding) (reply *CommonResult, err error) {
+	reply = &CommonResult{}
+	if err = s.policyManager.DeleteRoleBinding(in.Name); err == nil {
+		reply.Success = true
+	}
+	return
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/oauth"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestResolveResource(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(HeaderKeyStoreName, "orm"))
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		fullMethod string
+		req        interface{}
+		expect     oauth.Resource
+	}{{
+		name:   "default store",
+		ctx:    context.TODO(),
+		req:    &Empty{},
+		expect: oauth.Resource{Store: "local"},
+	}, {
+		name:   "suite",
+		ctx:    ctx,
+		req:    &TestSuiteIdentity{Name: "suite"},
+		expect: oauth.Resource{Store: "orm", Suite: "suite"},
+	}, {
+		name:   "test case",
+		ctx:    ctx,
+		req:    &TestCaseIdentity{Suite: "suite", Testcase: "case"},
+		expect: oauth.Resource{Store: "orm", Suite: "suite"},
+	}, {
+		name:   "store",
+		ctx:    ctx,
+		req:    &Store{Name: "git"},
+		expect: oauth.Resource{Store: "git"},
+	}, {
+		name:       "verify store",
+		ctx:        ctx,
+		fullMethod: "/server.Runner/VerifyStore",
+		req:        &SimpleQuery{Name: "git"},
+		expect:     oauth.Resource{Store: "git"},
+	}, {
+		name:       "duplicate suite",
+		ctx:        ctx,
+		fullMethod: "/server.Runner/DuplicateTestSuite",
+		req:        &TestSuiteDuplicate{SourceSuiteName: "source", TargetSuiteName: "target"},
+		expect:     oauth.Resource{Store: "orm", Suite: "target"},
+	}, {
+		name:       "rename case",
+		ctx:        ctx,
+		fullMethod: "/server.Runner/RenameTestCase",
+		req:        &TestCaseDuplicate{SourceSuiteName: "source", TargetSuiteName: "target"},
+		expect:     oauth.Resource{Store: "orm", Suite: "source"},
+	}, {
+		name:   "batch run",
+		ctx:    ctx,
+		req:    &BatchTestTask{SuiteName: "suite"},
+		expect: oauth.Resource{Store: "orm", Suite: "suite"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, ResolveResource(tt.ctx, tt.fullMethod, tt.req))
+		})
+	}
+}
+
+func TestMethodPermissions(t *testing.T) {
+	for _, method := range Runner_ServiceDesc.Methods {
+		_, ok := MethodPermissions["/server.Runner/"+method.MethodName]
+		assert.True(t, ok, "missing permission of %q", method.MethodName)
+	}
+	for _, stream := range Runner_ServiceDesc.Streams {
+		_, ok := MethodPermissions["/server.Runner/"+stream.StreamName]
+		assert.True(t, ok, "missing permission of %q", stream.StreamName)
+	}
+}
+
+func TestRoleBindings(t *testing.T) {
+	ctx := context.TODO()
+	dir := t.TempDir()
+	server := NewRemoteServer(atest.NewFileWriter(""), nil, nil, nil, dir, 1024*1024*4)
+
+	reply, err := server.CreateRoleBinding(ctx, &RoleBinding{
+		Name:  "editors",
+		Role:  "editor",
+		Users: []string{"alice"},
+		Suite: "orders",
+	})
+	assert.NoError(t, err)
+	assert.True(t, reply.Success)
+
+	_, err = server.CreateRoleBinding(ctx, &RoleBinding{Name: "invalid", Role: "fake"})
+	assert.Error(t, err)
+
+	reply, err = server.UpdateRoleBinding(ctx, &RoleBinding{
+		Name:   "editors",
+		Role:   "viewer",
+		Groups: []string{"dev"},
+		Suite:  "orders",
+	})
+	assert.NoError(t, err)
+	assert.True(t, reply.Success)
+
+	_, err = server.UpdateRoleBinding(ctx, &RoleBinding{
+		Name:   "editors",
+		Role:   "admin",
+		Groups: []string{"dev"},
+	})
+	assert.ErrorContains(t, err, "cannot be changed")
+
+	var bindings *RoleBindings
+	bindings, err = server.GetRoleBindings(ctx, &Empty{})
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(bindings.Data)) {
+		assert.Equal(t, "viewer", bindings.Data[0].Role)
+		assert.Equal(t, []string{"dev"}, bindings.Data[0].Groups)
+	}
+
+	reply, err = server.DeleteRoleBinding(ctx, &RoleBinding{Name: "editors"})
+	assert.NoError(t, err)
+	assert.True(t, reply.Success)
+
+	bindings, err = server.GetRoleBindings(ctx, &Empty{})
+	assert.NoError(t, err)
+	assert.Empty(t, bindings.Data)
+}
+
+func TestNewResourceResolver(t *testing.T) {
+	manager := oauth.NewPolicyManager(t.TempDir())
+	assert.NoError(t, manager.CreateRoleBinding(oauth.RoleBinding{
+		Name:  "global",
+		Role:  oauth.RoleAdmin,
+		Users: []string{"root"},
+	}))
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(HeaderKeyStoreName, "orm"))
+	resolver := NewResourceResolver(manager)
+
+	// the scope of an existing binding comes from the stored one
+	global := &RoleBinding{Name: "global", Store: "orm"}
+	assert.Equal(t, oauth.Resource{}, resolver(ctx, "/server.Runner/UpdateRoleBinding", global))
+	assert.Equal(t, oauth.Resource{}, resolver(ctx, "/server.Runner/DeleteRoleBinding", global))
+	assert.Equal(t, oauth.Resource{Store: "orm"}, resolver(ctx, "/server.Runner/CreateRoleBinding", global))
+
+	assert.Equal(t, oauth.Resource{Store: "orm", Suite: "orders"},
+		resolver(ctx, "/server.Runner/DeleteRoleBinding", &RoleBinding{Name: "fake", Store: "orm", Suite: "orders"}))
+	assert.Equal(t, oauth.Resource{Store: "orm", Suite: "suite"},
+		resolver(ctx, "/server.Runner/GetSuite", &TestSuiteIdentity{Name: "suite"}))
+}
+
+func TestNewStoreOwnerGetter(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "stores.yaml"), []byte(`stores:
+- name: git
+  owner: alice`), 0644)
+	assert.NoError(t, err)
+
+	getter := NewStoreOwnerGetter(dir)
+	assert.Equal(t, "alice", getter("git"))
+	assert.Empty(t, getter("fake"))
+}
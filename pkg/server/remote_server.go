@@ -47,11 +47,13 @@ import (
 
 	_ "embed"
 
+	"github.com/linuxsuren/api-testing/pkg/audit"
 	"github.com/linuxsuren/api-testing/pkg/generator"
 	"github.com/linuxsuren/api-testing/pkg/logging"
 	"github.com/linuxsuren/api-testing/pkg/oauth"
 	"github.com/linuxsuren/api-testing/pkg/render"
 	"github.com/linuxsuren/api-testing/pkg/runner"
+	"github.com/linuxsuren/api-testing/pkg/secret"
 	"github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
 	"github.com/linuxsuren/api-testing/pkg/version"
@@ -77,7 +79,9 @@ type server struct {
 	configDir          string
 	storeExtMgr        ExtManager
 
-	secretServer SecretServiceServer
+	secretServer  SecretServiceServer
+	auditor       audit.Auditor
+	policyManager oauth.PolicyManager
 
 	grpcMaxRecvMsgSize int
 }
@@ -130,6 +134,7 @@ func NewRemoteServer(loader testing.Writer, storeWriterFactory testing.StoreWrit
 		configDir:          configDir,
 		secretServer:       secretServer,
 		storeExtMgr:        storeExtMgr,
+		policyManager:      oauth.NewPolicyManager(configDir),
 		grpcMaxRecvMsgSize: grpcMaxRecvMsgSize,
 	}
 }
@@ -217,7 +222,7 @@ func (s *server) getLoader(ctx context.Context) (loader testing.Writer) {
 		storeNameMeta := mdd.Get(HeaderKeyStoreName)
 		if len(storeNameMeta) > 0 {
 			storeName := strings.TrimSpace(storeNameMeta[0])
-			if storeName == "local" || storeName == "" {
+			if storeName == testing.LocalStoreName || storeName == "" {
 				return
 			}
 
@@ -227,6 +232,15 @@ func (s *server) getLoader(ctx context.Context) (loader testing.Writer) {
 				remoteServerLogger.Info("failed to get loader", "name", storeName, "error", err)
 				loader = testing.NewNonWriter()
 			}
+
+			if setter, ok := loader.(testing.StoreNameSetter); ok {
+				setter.WithStoreName(storeName)
+			}
+			if setter, ok := loader.(testing.AuthorSetter); ok {
+				if user := oauth.GetUserFromContext(ctx); user != nil {
+					setter.WithAuthor(user.Name, user.Email)
+				}
+			}
 		}
 	}
 	return
@@ -269,8 +283,17 @@ func (s *server) Run(ctx context.Context, task *TestTask) (reply *TestResult, er
 
 	buf := new(bytes.Buffer)
 	reply = &TestResult{}
+	ctx = context.WithValue(ctx, runner.NewContextKeyBuilder().Store(), getStoreName(ctx))
 
-	for _, testCase := range suite.Items {
+	// the hooks of the suite run once around all its test cases
+	items := suite.Items
+	beforeErr := runner.RunSuiteJob(suite.Before, suite, dataContext)
+	if beforeErr != nil {
+		reply.Error = fmt.Sprintf("failed to run the before job of suite %s: %v", suite.Name, beforeErr)
+		items = nil
+	}
+
+	for _, testCase := range items {
 		suiteRunner := runner.GetTestSuiteRunner(suite)
 		suiteRunner.WithOutputWriter(buf)
 		suiteRunner.WithWriteLevel(task.Level)
@@ -293,7 +316,7 @@ func (s *server) Run(ctx context.Context, task *TestTask) (reply *TestResult, er
 				Body:       resp.Body,
 				Header:     mapToPair(resp.Header),
 				Id:         testCase.ID,
-				Output:     buf.String(),
+				Output:     secret.Mask(buf.String()),
 			})
 		}
 
@@ -316,10 +339,16 @@ func (s *server) Run(ctx context.Context, task *TestTask) (reply *TestResult, er
 		}(historyHeader)
 	}
 
+	if beforeErr == nil {
+		if afterErr := runner.RunSuiteJob(suite.After, suite, dataContext); afterErr != nil {
+			reply.Error = strings.TrimSpace(fmt.Sprintf("%s\nfailed to run the after job of suite %s: %v", reply.Error, suite.Name, afterErr))
+		}
+	}
+
 	if reply.Error != "" {
 		fmt.Fprintln(buf, reply.Error)
 	}
-	reply.Message = buf.String()
+	reply.Message = secret.Mask(buf.String())
 	return
 }
 
@@ -555,13 +584,12 @@ func (s *server) CreateTestSuite(ctx context.Context, in *TestSuiteIdentity) (re
 
 func (s *server) ImportTestSuite(ctx context.Context, in *TestSuiteSource) (result *CommonResult, err error) {
 	result = &CommonResult{}
-	var dataImporter generator.Importer
-	switch in.Kind {
-	case "postman":
-		dataImporter = generator.NewPostmanImporter()
-	case "native", "native-inline", "":
-		dataImporter = generator.NewNativeImporter()
-	default:
+	kind := in.Kind
+	if kind == "native-inline" || kind == "" {
+		kind = "native"
+	}
+	dataImporter := generator.GetTestSuiteImporter(kind)
+	if dataImporter == nil {
 		result.Success = false
 		result.Message = fmt.Sprintf("not support kind: %s", in.Kind)
 		return
@@ -569,15 +597,7 @@ func (s *server) ImportTestSuite(ctx context.Context, in *TestSuiteSource) (resu
 
 	remoteServerLogger.Logger.Info("import test suite", "kind", in.Kind, "url", in.Url)
 	var suite *testing.TestSuite
-	if in.Url != "" {
-		suite, err = dataImporter.ConvertFromURL(in.Url)
-	} else if in.Data != "" {
-		suite, err = dataImporter.Convert([]byte(in.Data))
-	} else {
-		err = errors.New("url or data is required")
-	}
-
-	if err != nil {
+	if suite, err = convertTestSuiteSource(dataImporter, in); err != nil {
 		result.Success = false
 		result.Message = err.Error()
 		return
@@ -589,6 +609,12 @@ func (s *server) ImportTestSuite(ctx context.Context, in *TestSuiteSource) (resu
 	if err = loader.CreateSuite(suite.Name, suite.API); err != nil {
 		return
 	}
+	if suite.Spec.Kind != "" || len(suite.Param) > 0 {
+		// keep the spec and parameters which are not part of the creation
+		if err = loader.UpdateSuite(*suite); err != nil {
+			return
+		}
+	}
 
 	for _, item := range suite.Items {
 		if err = loader.CreateTestCase(suite.Name, item); err != nil {
@@ -599,6 +625,53 @@ func (s *server) ImportTestSuite(ctx context.Context, in *TestSuiteSource) (resu
 	return
 }
 
+// GenerateTestSuite generates a test suite from the Swagger 2.0 or OpenAPI 3.x document, the suite is returned as YAML without saving
+func (s *server) GenerateTestSuite(ctx context.Context, in *TestSuiteSource) (result *CommonResult, err error) {
+	result = &CommonResult{}
+
+	var suite *testing.TestSuite
+	if suite, err = convertTestSuiteSource(generator.NewOpenAPIImporter(), in); err != nil {
+		result.Message = err.Error()
+		err = nil
+		return
+	}
+
+	var data []byte
+	if data, err = testing.ToYAML(suite); err == nil {
+		result.Success = true
+		result.Message = string(data)
+	}
+	return
+}
+
+func convertTestSuiteSource(dataImporter generator.Importer, in *TestSuiteSource) (suite *testing.TestSuite, err error) {
+	if in.Url != "" {
+		if err = checkRemoteSourceURL(in.Url); err == nil {
+			suite, err = dataImporter.ConvertFromURL(in.Url)
+		}
+	} else if in.Data != "" {
+		suite, err = dataImporter.Convert([]byte(in.Data))
+	} else {
+		err = errors.New("url or data is required")
+	}
+	return
+}
+
+// checkRemoteSourceURL makes sure the source comes from HTTP(S) or the data directory,
+// the files of the server must not be read by the clients
+func checkRemoteSourceURL(sourceURL string) (err error) {
+	switch {
+	case strings.HasPrefix(sourceURL, "http://"), strings.HasPrefix(sourceURL, "https://"):
+	case strings.HasPrefix(sourceURL, "atest://"):
+		if !filepath.IsLocal(strings.TrimPrefix(sourceURL, "atest://")) {
+			err = fmt.Errorf("invalid source %q, it must be inside the data directory", sourceURL)
+		}
+	default:
+		err = fmt.Errorf("not supported source %q, only http://, https:// and atest:// are allowed", sourceURL)
+	}
+	return
+}
+
 func (s *server) GetTestSuite(ctx context.Context, in *TestSuiteIdentity) (result *TestSuite, err error) {
 	loader := s.getLoader(ctx)
 	defer loader.Close()
@@ -776,7 +849,7 @@ func (s *server) RunTestCase(ctx context.Context, in *TestCaseIdentity) (result
 
 		var reply *TestResult
 		var lastItem *TestCaseResult
-		if reply, err = s.Run(ctx, task); err == nil && len(reply.TestCaseResult) > 0 {
+		if reply, err = s.Run(withSnapshotDir(ctx, loader, in.Suite), task); err == nil && len(reply.TestCaseResult) > 0 {
 			lastIndex := len(reply.TestCaseResult) - 1
 			lastItem = reply.TestCaseResult[lastIndex]
 
@@ -963,12 +1036,70 @@ func (s *server) ListCodeGenerator(ctx context.Context, in *Empty) (reply *Simpl
 			Key: name,
 		})
 	}
+	for name := range s.getStoreTemplates(ctx, generator.TemplatePluginKindGenerator) {
+		if _, ok := generators[name]; !ok {
+			reply.Data = append(reply.Data, &Pair{
+				Key: name,
+			})
+		}
+	}
+	return
+}
+
+// getCodeGenerator returns the registered code generator, or the one from the templates of the store
+func (s *server) getCodeGenerator(ctx context.Context, name string) (instance generator.CodeGenerator) {
+	if instance = generator.GetCodeGenerator(name); instance == nil {
+		if text, ok := s.getStoreTemplates(ctx, generator.TemplatePluginKindGenerator)[name]; ok {
+			if templateGenerator, err := generator.NewTemplateCodeGenerator(name, text); err == nil {
+				instance = templateGenerator
+			} else {
+				remoteServerLogger.Info("invalid code generator template", "name", name, "error", err)
+			}
+		}
+	}
+	return
+}
+
+// getTestSuiteConverter returns the registered converter, or the one from the templates of the store
+func (s *server) getTestSuiteConverter(ctx context.Context, name string) (instance generator.TestSuiteConverter) {
+	if instance = generator.GetTestSuiteConverter(name); instance == nil {
+		if text, ok := s.getStoreTemplates(ctx, generator.TemplatePluginKindConverter)[name]; ok {
+			if templateConverter, err := generator.NewTemplateConverter(name, text); err == nil {
+				instance = templateConverter
+			} else {
+				remoteServerLogger.Info("invalid converter template", "name", name, "error", err)
+			}
+		}
+	}
+	return
+}
+
+// getStoreTemplates returns the templates of a kind from the store of the request, such as a store extension
+func (s *server) getStoreTemplates(ctx context.Context, kind string) (templates map[string]string) {
+	templates = map[string]string{}
+	loader := s.getLoader(ctx)
+	defer loader.Close()
+
+	provider, ok := loader.(testing.TemplateProvider)
+	if !ok {
+		return
+	}
+	items, err := provider.GetTemplates()
+	if err != nil {
+		remoteServerLogger.Info("failed to get the templates", "error", err)
+		return
+	}
+	for _, item := range items {
+		if item.Kind == kind {
+			templates[item.Name] = item.Text
+		}
+	}
 	return
 }
 
 func (s *server) GenerateCode(ctx context.Context, in *CodeGenerateRequest) (reply *CommonResult, err error) {
 	reply = &CommonResult{}
-	instance := generator.GetCodeGenerator(in.Generator)
+	instance := s.getCodeGenerator(ctx, in.Generator)
 	if instance == nil {
 		reply.Success = false
 		reply.Message = fmt.Sprintf("generator '%s' not found", in.Generator)
@@ -1005,7 +1136,7 @@ func (s *server) GenerateCode(ctx context.Context, in *CodeGenerateRequest) (rep
 
 func (s *server) HistoryGenerateCode(ctx context.Context, in *CodeGenerateRequest) (reply *CommonResult, err error) {
 	reply = &CommonResult{}
-	instance := generator.GetCodeGenerator(in.Generator)
+	instance := s.getCodeGenerator(ctx, in.Generator)
 	if instance == nil {
 		reply.Success = false
 		reply.Message = fmt.Sprintf("generator '%s' not found", in.Generator)
@@ -1037,13 +1168,20 @@ func (s *server) ListConverter(ctx context.Context, in *Empty) (reply *SimpleLis
 			Key: name,
 		})
 	}
+	for name := range s.getStoreTemplates(ctx, generator.TemplatePluginKindConverter) {
+		if _, ok := converters[name]; !ok {
+			reply.Data = append(reply.Data, &Pair{
+				Key: name,
+			})
+		}
+	}
 	return
 }
 
 func (s *server) ConvertTestSuite(ctx context.Context, in *CodeGenerateRequest) (reply *CommonResult, err error) {
 	reply = &CommonResult{}
 
-	instance := generator.GetTestSuiteConverter(in.Generator)
+	instance := s.getTestSuiteConverter(ctx, in.Generator)
 	if instance == nil {
 		reply.Success = false
 		reply.Message = fmt.Sprintf("converter '%s' not found", in.Generator)
@@ -1115,12 +1253,21 @@ func (s *server) FunctionsQuery(ctx context.Context, in *SimpleQuery) (reply *Pa
 			}
 		}
 	} else {
+		signatures := map[string]string{}
+		for _, item := range render.GetUserDefinedTemplates().Items {
+			signatures[item.Name] = item.Signature()
+		}
+
 		for name, fn := range render.FuncMap() {
 			lowerName := strings.ToLower(name)
+			signature, userDefined := signatures[name]
+			if in.Kind == "userDefined" && !userDefined {
+				continue
+			}
 			if in.Name == "" || strings.Contains(lowerName, in.Name) {
 				reply.Data = append(reply.Data, &Pair{
 					Key:         name,
-					Value:       fmt.Sprintf("%v", reflect.TypeOf(fn)),
+					Value:       util.EmptyThenDefault(signature, fmt.Sprintf("%v", reflect.TypeOf(fn))),
 					Description: render.FuncUsage(name),
 				})
 			}
@@ -1129,6 +1276,19 @@ func (s *server) FunctionsQuery(ctx context.Context, in *SimpleQuery) (reply *Pa
 	return
 }
 
+// UpdateFunctions replaces the global user-defined template functions, and saves them into the config directory
+func (s *server) UpdateFunctions(ctx context.Context, in *UserDefinedFunctions) (reply *CommonResult, err error) {
+	reply = &CommonResult{}
+	functions := &render.UserDefinedTemplates{Items: ToNormalFunctions(in.Data)}
+	if err = render.SaveUserDefinedTemplates(filepath.Join(s.configDir, render.UserDefinedFile), functions); err != nil {
+		reply.Message = err.Error()
+		err = nil
+	} else {
+		reply.Success = true
+	}
+	return
+}
+
 // FunctionsQueryStream works like FunctionsQuery but is implemented in bidirectional streaming
 func (s *server) FunctionsQueryStream(srv Runner_FunctionsQueryStreamServer) error {
 	ctx := srv.Context()
@@ -1179,6 +1339,14 @@ func (s *server) GetStoreKinds(context.Context, *Empty) (kinds *StoreKinds, err
 				Dependencies: convertStoreKindDependencies(store.Dependencies),
 			})
 		}
+		for _, kind := range testing.GetBuiltinStoreKinds(s.storeWriterFactory) {
+			kinds.Data = append(kinds.Data, &StoreKind{
+				Name:       kind.Name,
+				Enabled:    true,
+				Params:     convertStoreKindParams(kind.Params),
+				Categories: kind.Categories,
+			})
+		}
 	}
 	return
 }
@@ -1289,7 +1457,7 @@ func (s *server) CreateStore(ctx context.Context, in *Store) (reply *Store, err
 	store := ToNormalStore(in)
 
 	handleStore(&store)
-	if err = storeFactory.CreateStore(store); err == nil && s.storeExtMgr != nil {
+	if err = storeFactory.CreateStore(store); err == nil && s.needExtension(store) {
 		err = s.storeExtMgr.Start(store.Kind.Name, store.Kind.URL)
 	}
 	return
@@ -1299,7 +1467,7 @@ func (s *server) UpdateStore(ctx context.Context, in *Store) (reply *Store, err
 	storeFactory := testing.NewStoreFactory(s.configDir)
 	store := ToNormalStore(in)
 	handleStore(&store)
-	if err = storeFactory.UpdateStore(store); err == nil && s.storeExtMgr != nil {
+	if err = storeFactory.UpdateStore(store); err == nil && s.needExtension(store) {
 		// TODO need to restart extension if config was changed
 		err = s.storeExtMgr.Start(store.Kind.Name, store.Kind.URL)
 	}
@@ -1324,6 +1492,40 @@ func (s *server) VerifyStore(ctx context.Context, in *SimpleQuery) (reply *Exten
 	return
 }
 
+// SyncStore pulls and pushes the changes of a store, both of them are done if neither is specified
+func (s *server) SyncStore(ctx context.Context, in *StoreSyncRequest) (reply *CommonResult, err error) {
+	reply = &CommonResult{}
+	var loader testing.Writer
+	if loader, err = s.getLoaderByStoreName(in.Name); err != nil {
+		return
+	}
+	defer loader.Close()
+
+	syncer, ok := loader.(testing.Syncer)
+	if !ok {
+		err = fmt.Errorf("store %s does not support sync", in.Name)
+		return
+	}
+
+	pull, push := in.Pull, in.Push
+	if !pull && !push {
+		pull, push = true, true
+	}
+	if pull {
+		err = syncer.Pull()
+	}
+	if err == nil && push {
+		err = syncer.Push()
+	}
+	reply.Success = err == nil
+	return
+}
+
+// needExtension checks if the store requires an extension, the builtin kinds run inside the server
+func (s *server) needExtension(store testing.Store) bool {
+	return s.storeExtMgr != nil && !testing.IsBuiltinStoreKind(s.storeWriterFactory, store.Kind.Name)
+}
+
 func handleStore(store *testing.Store) {
 	if store.Kind.URL == "" && runtime.GOOS != "windows" {
 		store.Kind.URL = fmt.Sprintf("unix://%s", home.GetExtensionSocketPath(store.Kind.Name))
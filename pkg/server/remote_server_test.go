@@ -32,6 +32,7 @@ import (
 
 	"github.com/h2non/gock"
 	"github.com/linuxsuren/api-testing/pkg/mock"
+	"github.com/linuxsuren/api-testing/pkg/render"
 	atest "github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/linuxsuren/api-testing/pkg/util"
 	"github.com/linuxsuren/api-testing/sample"
@@ -718,6 +719,39 @@ func TestFunctionsQuery(t *testing.T) {
 			assert.Equal(t, 1, len(reply.Data))
 		}
 	})
+
+	t.Run("user-defined functions", func(t *testing.T) {
+		defer func() {
+			_ = render.SetUserDefinedTemplates(nil)
+		}()
+
+		result, err := server.UpdateFunctions(ctx, &UserDefinedFunctions{
+			Data: []*UserDefinedFunction{{
+				Name:        "greet",
+				Render:      "Hello {{ .name }}",
+				Params:      []string{"name"},
+				Description: "Say hello",
+			}},
+		})
+		if assert.NoError(t, err) {
+			assert.True(t, result.Success)
+		}
+
+		reply, err := server.FunctionsQuery(ctx, &SimpleQuery{Kind: "userDefined"})
+		if assert.NoError(t, err) && assert.Equal(t, 1, len(reply.Data)) {
+			assert.Equal(t, "greet", reply.Data[0].Key)
+			assert.Equal(t, "greet(name)", reply.Data[0].Value)
+			assert.Equal(t, "Say hello", reply.Data[0].Description)
+		}
+
+		result, err = server.UpdateFunctions(ctx, &UserDefinedFunctions{
+			Data: []*UserDefinedFunction{{Name: "randNumeric", Render: "1"}},
+		})
+		if assert.NoError(t, err) {
+			assert.False(t, result.Success)
+			assert.Contains(t, result.Message, "conflict with existing function")
+		}
+	})
 }
 
 func TestCodeGenerator(t *testing.T) {
@@ -776,7 +810,9 @@ func TestCodeGenerator(t *testing.T) {
 	t.Run("ListConverter", func(t *testing.T) {
 		list, err := server.ListConverter(ctx, &Empty{})
 		assert.NoError(t, err)
-		assert.Equal(t, 2, len(list.Data))
+		for _, name := range []string{"jmeter", "raw", "postman", "openapi", "k6"} {
+			assert.Contains(t, list.Data, &Pair{Key: name})
+		}
 	})
 
 	t.Run("ConvertTestSuite no converter given", func(t *testing.T) {
@@ -830,6 +866,77 @@ func TestCodeGenerator(t *testing.T) {
 		assert.Error(t, err, err)
 		assert.False(t, result.Success)
 	})
+
+	openAPIData, err := os.ReadFile("../apispec/testdata/openapi.yaml")
+	assert.NoError(t, err)
+
+	t.Run("ImportTestSuite, import from OpenAPI", func(t *testing.T) {
+		defer gock.Off()
+		gock.New(urlFoo).Get("/openapi.yaml").Reply(http.StatusOK).BodyString(string(openAPIData))
+
+		result, err := server.ImportTestSuite(ctx, &TestSuiteSource{
+			Kind: "openapi",
+			Url:  urlFoo + "/openapi.yaml",
+		})
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+
+		var suite *TestSuite
+		suite, err = server.GetTestSuite(ctx, &TestSuiteIdentity{Name: "users"})
+		assert.NoError(t, err)
+		assert.Equal(t, "openapi", suite.Spec.Kind)
+	})
+
+	t.Run("ImportTestSuite, import from cURL", func(t *testing.T) {
+		result, err := server.ImportTestSuite(ctx, &TestSuiteSource{
+			Kind: "curl",
+			Data: "curl -X POST http://localhost/api/orders -d name=book",
+		})
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+
+		var testcase *TestCase
+		testcase, err = server.GetTestCase(ctx, &TestCaseIdentity{Suite: "curl", Testcase: "POST /api/orders"})
+		assert.NoError(t, err)
+		assert.Equal(t, "/api/orders", testcase.Request.Api)
+	})
+
+	t.Run("ImportTestSuite, local file is not allowed", func(t *testing.T) {
+		result, err := server.ImportTestSuite(ctx, &TestSuiteSource{
+			Kind: "openapi",
+			Url:  "../apispec/testdata/openapi.yaml",
+		})
+		assert.Error(t, err)
+		assert.False(t, result.Success)
+		assert.Contains(t, result.Message, "only http://, https:// and atest:// are allowed")
+	})
+
+	t.Run("GenerateTestSuite", func(t *testing.T) {
+		defer gock.Off()
+		gock.New(urlFoo).Get("/openapi.yaml").Reply(http.StatusOK).BodyString(string(openAPIData))
+
+		result, err := server.GenerateTestSuite(ctx, &TestSuiteSource{
+			Url: urlFoo + "/openapi.yaml",
+		})
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+
+		var suite *atest.TestSuite
+		suite, err = atest.Parse([]byte(result.Message))
+		assert.NoError(t, err)
+		assert.Len(t, suite.Items, 4)
+
+		result, err = server.GenerateTestSuite(ctx, &TestSuiteSource{})
+		assert.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Equal(t, "url or data is required", result.Message)
+
+		for _, source := range []string{"/etc/passwd", "file:///etc/passwd", "atest://../../etc/passwd"} {
+			result, err = server.GenerateTestSuite(ctx, &TestSuiteSource{Url: source})
+			assert.NoError(t, err)
+			assert.False(t, result.Success, source)
+		}
+	})
 }
 
 func TestFunctionsQueryStream(t *testing.T) {
@@ -922,6 +1029,17 @@ func TestStoreManager(t *testing.T) {
 		_, err := server.UpdateStore(ctx, &Store{})
 		assert.Error(t, err)
 	})
+
+	t.Run("SyncStore, not supported", func(t *testing.T) {
+		server, clean := getRemoteServerInTempDir()
+		defer clean()
+
+		_, err := server.CreateStore(ctx, &Store{Name: "fake"})
+		assert.NoError(t, err)
+
+		_, err = server.SyncStore(ctx, &StoreSyncRequest{Name: "fake"})
+		assert.ErrorContains(t, err, "does not support sync")
+	})
 }
 
 func TestFakeSecretServer(t *testing.T) {
@@ -958,6 +1076,53 @@ func TestRemoteRunnerAdapter(t *testing.T) {
 //go:embed testdata/extension.yaml
 var extensionConfig []byte
 
+func TestStoreTemplates(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	server := NewRemoteServer(&templateWriter{Writer: atest.NewFileWriter(dir)}, nil, nil, nil, dir, 1024*1024*4)
+	_, err := server.CreateTestSuite(ctx, &TestSuiteIdentity{Name: "users", Api: "http://localhost"})
+	assert.NoError(t, err)
+	_, err = server.CreateTestCase(ctx, &TestCaseWithSuite{
+		SuiteName: "users",
+		Data:      &TestCase{Name: "list", Request: &Request{Api: "/users"}},
+	})
+	assert.NoError(t, err)
+
+	generators, err := server.ListCodeGenerator(ctx, &Empty{})
+	assert.NoError(t, err)
+	assert.Contains(t, generators.Data, &Pair{Key: "http-file"})
+	assert.NotContains(t, generators.Data, &Pair{Key: "csv"})
+
+	result, err := server.GenerateCode(ctx, &CodeGenerateRequest{Generator: "http-file", TestSuite: "users", TestCase: "list"})
+	assert.NoError(t, err)
+	assert.Equal(t, "GET http://localhost/users", result.Message)
+
+	converters, err := server.ListConverter(ctx, &Empty{})
+	assert.NoError(t, err)
+	assert.Contains(t, converters.Data, &Pair{Key: "csv"})
+
+	result, err = server.ConvertTestSuite(ctx, &CodeGenerateRequest{Generator: "csv", TestSuite: "users"})
+	assert.NoError(t, err)
+	assert.Equal(t, "list", result.Message)
+
+	result, err = server.ConvertTestSuite(ctx, &CodeGenerateRequest{Generator: "broken", TestSuite: "users"})
+	assert.NoError(t, err)
+	assert.False(t, result.Success)
+}
+
+// templateWriter provides the templates like a store extension
+type templateWriter struct {
+	atest.Writer
+}
+
+func (w *templateWriter) GetTemplates() ([]atest.Template, error) {
+	return []atest.Template{
+		{Name: "http-file", Kind: "generator", Text: "{{ .Request.Method }} {{ .Request.API }}"},
+		{Name: "csv", Kind: "converter", Text: "{{ range .Items }}{{ .Name }}{{ end }}"},
+		{Name: "broken", Kind: "converter", Text: "{{ .Name"},
+	}, nil
+}
+
 func getRemoteServerInTempDir() (server RunnerServer, call func()) {
 	dir, _ := os.MkdirTemp(os.TempDir(), "remote-server-test")
 	call = func() { os.RemoveAll(dir) }
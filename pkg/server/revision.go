@@ -0,0 +1,158 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// ListSuiteRevisions returns the revisions of a suite, or the revisions which changed the test case
+func (s *server) ListSuiteRevisions(ctx context.Context, in *SuiteRevisionQuery) (reply *SuiteRevisions, err error) {
+	loader := s.getLoader(ctx)
+	defer loader.Close()
+
+	var revisions []testing.SuiteRevision
+	if revisions, err = loader.ListSuiteRevisions(in.Suite); err == nil {
+		reply = &SuiteRevisions{}
+		for _, revision := range revisions {
+			if in.Testcase != "" && revision.TestCase != in.Testcase {
+				continue
+			}
+			reply.Data = append(reply.Data, ToGRPCSuiteRevision(revision))
+		}
+	}
+	return
+}
+
+// DiffSuiteRevisions compares two revisions of a suite or a test case,
+// the current definition will be compared if the target revision is empty
+func (s *server) DiffSuiteRevisions(ctx context.Context, in *SuiteRevisionDiffRequest) (reply *SuiteRevisionDiff, err error) {
+	if in.From == "" {
+		err = errors.New("the source revision is required")
+		return
+	}
+
+	loader := s.getLoader(ctx)
+	defer loader.Close()
+
+	var fromSuite, toSuite testing.TestSuite
+	if fromSuite, err = loader.GetSuiteRevision(in.Suite, in.From); err != nil {
+		return
+	}
+	if in.To == "" {
+		toSuite, err = loader.GetTestSuite(in.Suite, true)
+	} else {
+		toSuite, err = loader.GetSuiteRevision(in.Suite, in.To)
+	}
+	if err != nil {
+		return
+	}
+
+	var changes []util.FieldChange
+	if in.Testcase != "" {
+		changes = testing.DiffTestCases(fromSuite.FindTestCase(in.Testcase), toSuite.FindTestCase(in.Testcase))
+	} else {
+		changes = testing.DiffTestSuites(&fromSuite, &toSuite)
+	}
+
+	reply = &SuiteRevisionDiff{}
+	for _, change := range changes {
+		reply.Changes = append(reply.Changes, &FieldChange{
+			Path:     change.Path,
+			Type:     change.Type,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+	return
+}
+
+// RollbackSuiteRevision restores a suite, or only a test case of it, to the definition of a revision
+func (s *server) RollbackSuiteRevision(ctx context.Context, in *SuiteRevisionIdentity) (reply *CommonResult, err error) {
+	reply = &CommonResult{}
+	loader := s.getLoader(ctx)
+	defer loader.Close()
+
+	var target testing.TestSuite
+	if target, err = loader.GetSuiteRevision(in.Suite, in.Revision); err != nil {
+		return
+	}
+
+	var current *testing.TestSuite
+	if current, _, err = loader.GetSuite(in.Suite); err != nil {
+		return
+	}
+	if current == nil {
+		if err = loader.CreateSuite(in.Suite, target.API); err != nil {
+			return
+		}
+		current = &testing.TestSuite{Name: in.Suite}
+	}
+
+	if in.Testcase != "" {
+		err = rollbackTestCase(loader, in.Suite, current.FindTestCase(in.Testcase), target.FindTestCase(in.Testcase), in.Testcase)
+	} else {
+		err = rollbackSuite(loader, current, &target)
+	}
+	if err == nil {
+		reply.Success = true
+		reply.Message = fmt.Sprintf("rolled back to revision %s", in.Revision)
+	}
+	return
+}
+
+func rollbackSuite(loader testing.Writer, current, target *testing.TestSuite) (err error) {
+	suite := *target
+	suite.Name = current.Name
+	suite.Items = nil
+	if err = loader.UpdateSuite(suite); err != nil {
+		return
+	}
+
+	for _, item := range current.Items {
+		if target.FindTestCase(item.Name) == nil {
+			if err = loader.DeleteTestCase(current.Name, item.Name); err != nil {
+				return
+			}
+		}
+	}
+	for i := range target.Items {
+		item := &target.Items[i]
+		if err = rollbackTestCase(loader, current.Name, current.FindTestCase(item.Name), item, item.Name); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func rollbackTestCase(loader testing.Writer, suite string, current, target *testing.TestCase, name string) (err error) {
+	switch {
+	case current == nil && target == nil:
+		err = fmt.Errorf("test case %q does not exist in the revision", name)
+	case target == nil:
+		err = loader.DeleteTestCase(suite, name)
+	case current == nil:
+		err = loader.CreateTestCase(suite, *target)
+	case len(testing.DiffTestCases(current, target)) > 0:
+		err = loader.UpdateTestCase(suite, *target)
+	}
+	return
+}
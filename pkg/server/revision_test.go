@@ -0,0 +1,94 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuiteRevisions(t *testing.T) {
+	ctx := context.Background()
+	server, clean := getRemoteServerInTempDir()
+	defer clean()
+
+	_, err := server.CreateTestSuite(ctx, &TestSuiteIdentity{Name: "orders", Api: "http://localhost"})
+	assert.NoError(t, err)
+	_, err = server.CreateTestCase(ctx, &TestCaseWithSuite{
+		SuiteName: "orders",
+		Data: &TestCase{
+			Name:    "list",
+			Request: &Request{Api: "/orders"},
+		},
+	})
+	assert.NoError(t, err)
+
+	var revisions *SuiteRevisions
+	revisions, err = server.ListSuiteRevisions(ctx, &SuiteRevisionQuery{Suite: "orders", Testcase: "list"})
+	assert.NoError(t, err)
+	if !assert.Len(t, revisions.Data, 1) {
+		return
+	}
+	createdRevision := revisions.Data[0].Id
+
+	_, err = server.UpdateTestCase(ctx, &TestCaseWithSuite{
+		SuiteName: "orders",
+		Data: &TestCase{
+			Name:    "list",
+			Request: &Request{Api: "/orders", Method: "POST"},
+		},
+	})
+	assert.NoError(t, err)
+
+	t.Run("diff with the current", func(t *testing.T) {
+		diff, err := server.DiffSuiteRevisions(ctx, &SuiteRevisionDiffRequest{
+			Suite:    "orders",
+			Testcase: "list",
+			From:     createdRevision,
+		})
+		assert.NoError(t, err)
+		if assert.Len(t, diff.Changes, 1) {
+			assert.Equal(t, "request.method", diff.Changes[0].Path)
+			assert.Equal(t, "POST", diff.Changes[0].NewValue)
+		}
+	})
+
+	t.Run("diff without the source revision", func(t *testing.T) {
+		_, err := server.DiffSuiteRevisions(ctx, &SuiteRevisionDiffRequest{Suite: "orders"})
+		assert.Error(t, err)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		reply, err := server.RollbackSuiteRevision(ctx, &SuiteRevisionIdentity{
+			Suite:    "orders",
+			Revision: createdRevision,
+		})
+		assert.NoError(t, err)
+		assert.True(t, reply.Success)
+
+		var testCase *TestCase
+		testCase, err = server.GetTestCase(ctx, &TestCaseIdentity{Suite: "orders", Testcase: "list"})
+		assert.NoError(t, err)
+		assert.Empty(t, testCase.Request.Method)
+	})
+
+	t.Run("rollback a non-existing revision", func(t *testing.T) {
+		_, err := server.RollbackSuiteRevision(ctx, &SuiteRevisionIdentity{Suite: "orders", Revision: "fake"})
+		assert.Error(t, err)
+	})
+}
@@ -22,82 +22,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type ServerProxyRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *ServerProxyRequest) Reset() {
-	*x = ServerProxyRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ServerProxyRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ServerProxyRequest) ProtoMessage() {}
-
-func (x *ServerProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ServerProxyRequest.ProtoReflect.Descriptor instead.
-func (*ServerProxyRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{0}
-}
-
-type ServerProxyResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *ServerProxyResponse) Reset() {
-	*x = ServerProxyResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ServerProxyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ServerProxyResponse) ProtoMessage() {}
-
-func (x *ServerProxyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ServerProxyResponse.ProtoReflect.Descriptor instead.
-func (*ServerProxyResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{1}
-}
-
 type Menu struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -112,7 +36,7 @@ type Menu struct {
 func (x *Menu) Reset() {
 	*x = Menu{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[2]
+		mi := &file_pkg_server_server_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -125,7 +49,7 @@ func (x *Menu) String() string {
 func (*Menu) ProtoMessage() {}
 
 func (x *Menu) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[2]
+	mi := &file_pkg_server_server_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -138,7 +62,7 @@ func (x *Menu) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Menu.ProtoReflect.Descriptor instead.
 func (*Menu) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{2}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *Menu) GetName() string {
@@ -180,7 +104,7 @@ type MenuList struct {
 func (x *MenuList) Reset() {
 	*x = MenuList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[3]
+		mi := &file_pkg_server_server_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -193,7 +117,7 @@ func (x *MenuList) String() string {
 func (*MenuList) ProtoMessage() {}
 
 func (x *MenuList) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[3]
+	mi := &file_pkg_server_server_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -206,7 +130,7 @@ func (x *MenuList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuList.ProtoReflect.Descriptor instead.
 func (*MenuList) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{3}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *MenuList) GetData() []*Menu {
@@ -227,7 +151,7 @@ type Suites struct {
 func (x *Suites) Reset() {
 	*x = Suites{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[4]
+		mi := &file_pkg_server_server_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -240,7 +164,7 @@ func (x *Suites) String() string {
 func (*Suites) ProtoMessage() {}
 
 func (x *Suites) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[4]
+	mi := &file_pkg_server_server_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +177,7 @@ func (x *Suites) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Suites.ProtoReflect.Descriptor instead.
 func (*Suites) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{4}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Suites) GetData() map[string]*Items {
@@ -275,7 +199,7 @@ type Items struct {
 func (x *Items) Reset() {
 	*x = Items{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[5]
+		mi := &file_pkg_server_server_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -288,7 +212,7 @@ func (x *Items) String() string {
 func (*Items) ProtoMessage() {}
 
 func (x *Items) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[5]
+	mi := &file_pkg_server_server_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +225,7 @@ func (x *Items) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Items.ProtoReflect.Descriptor instead.
 func (*Items) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{5}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Items) GetData() []string {
@@ -329,7 +253,7 @@ type HistorySuites struct {
 func (x *HistorySuites) Reset() {
 	*x = HistorySuites{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[6]
+		mi := &file_pkg_server_server_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -342,7 +266,7 @@ func (x *HistorySuites) String() string {
 func (*HistorySuites) ProtoMessage() {}
 
 func (x *HistorySuites) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[6]
+	mi := &file_pkg_server_server_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,7 +279,7 @@ func (x *HistorySuites) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistorySuites.ProtoReflect.Descriptor instead.
 func (*HistorySuites) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{6}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *HistorySuites) GetData() map[string]*HistoryItems {
@@ -376,7 +300,7 @@ type HistoryItems struct {
 func (x *HistoryItems) Reset() {
 	*x = HistoryItems{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[7]
+		mi := &file_pkg_server_server_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -389,7 +313,7 @@ func (x *HistoryItems) String() string {
 func (*HistoryItems) ProtoMessage() {}
 
 func (x *HistoryItems) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[7]
+	mi := &file_pkg_server_server_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +326,7 @@ func (x *HistoryItems) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryItems.ProtoReflect.Descriptor instead.
 func (*HistoryItems) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{7}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *HistoryItems) GetData() []*HistoryCaseIdentity {
@@ -427,7 +351,7 @@ type HistoryCaseIdentity struct {
 func (x *HistoryCaseIdentity) Reset() {
 	*x = HistoryCaseIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[8]
+		mi := &file_pkg_server_server_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -440,7 +364,7 @@ func (x *HistoryCaseIdentity) String() string {
 func (*HistoryCaseIdentity) ProtoMessage() {}
 
 func (x *HistoryCaseIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[8]
+	mi := &file_pkg_server_server_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +377,7 @@ func (x *HistoryCaseIdentity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryCaseIdentity.ProtoReflect.Descriptor instead.
 func (*HistoryCaseIdentity) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{8}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *HistoryCaseIdentity) GetSuite() string {
@@ -504,7 +428,7 @@ type TestCaseIdentity struct {
 func (x *TestCaseIdentity) Reset() {
 	*x = TestCaseIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[9]
+		mi := &file_pkg_server_server_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -517,7 +441,7 @@ func (x *TestCaseIdentity) String() string {
 func (*TestCaseIdentity) ProtoMessage() {}
 
 func (x *TestCaseIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[9]
+	mi := &file_pkg_server_server_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,7 +454,7 @@ func (x *TestCaseIdentity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestCaseIdentity.ProtoReflect.Descriptor instead.
 func (*TestCaseIdentity) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{9}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TestCaseIdentity) GetSuite() string {
@@ -567,7 +491,7 @@ type TestSuiteSource struct {
 func (x *TestSuiteSource) Reset() {
 	*x = TestSuiteSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[10]
+		mi := &file_pkg_server_server_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -580,7 +504,7 @@ func (x *TestSuiteSource) String() string {
 func (*TestSuiteSource) ProtoMessage() {}
 
 func (x *TestSuiteSource) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[10]
+	mi := &file_pkg_server_server_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,7 +517,7 @@ func (x *TestSuiteSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestSuiteSource.ProtoReflect.Descriptor instead.
 func (*TestSuiteSource) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{10}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TestSuiteSource) GetKind() string {
@@ -622,17 +546,23 @@ type TestSuite struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Api   string       `protobuf:"bytes,2,opt,name=api,proto3" json:"api,omitempty"`
-	Param []*Pair      `protobuf:"bytes,3,rep,name=param,proto3" json:"param,omitempty"`
-	Spec  *APISpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
-	Proxy *ProxyConfig `protobuf:"bytes,5,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Api        string                 `protobuf:"bytes,2,opt,name=api,proto3" json:"api,omitempty"`
+	Param      []*Pair                `protobuf:"bytes,3,rep,name=param,proto3" json:"param,omitempty"`
+	Spec       *APISpec               `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	Proxy      *ProxyConfig           `protobuf:"bytes,5,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	Functions  []*UserDefinedFunction `protobuf:"bytes,6,rep,name=functions,proto3" json:"functions,omitempty"`
+	Auth       *SuiteAuth             `protobuf:"bytes,7,opt,name=auth,proto3" json:"auth,omitempty"`
+	Before     []string               `protobuf:"bytes,8,rep,name=before,proto3" json:"before,omitempty"`
+	After      []string               `protobuf:"bytes,9,rep,name=after,proto3" json:"after,omitempty"`
+	Assertions []*ExprFunction        `protobuf:"bytes,10,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	Helpers    []*ExprFunction        `protobuf:"bytes,11,rep,name=helpers,proto3" json:"helpers,omitempty"`
 }
 
 func (x *TestSuite) Reset() {
 	*x = TestSuite{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[11]
+		mi := &file_pkg_server_server_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +575,7 @@ func (x *TestSuite) String() string {
 func (*TestSuite) ProtoMessage() {}
 
 func (x *TestSuite) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[11]
+	mi := &file_pkg_server_server_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +588,7 @@ func (x *TestSuite) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestSuite.ProtoReflect.Descriptor instead.
 func (*TestSuite) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{11}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TestSuite) GetName() string {
@@ -696,32 +626,75 @@ func (x *TestSuite) GetProxy() *ProxyConfig {
 	return nil
 }
 
-type TestSuiteWithCase struct {
+func (x *TestSuite) GetFunctions() []*UserDefinedFunction {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+func (x *TestSuite) GetAuth() *SuiteAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *TestSuite) GetBefore() []string {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *TestSuite) GetAfter() []string {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *TestSuite) GetAssertions() []*ExprFunction {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+func (x *TestSuite) GetHelpers() []*ExprFunction {
+	if x != nil {
+		return x.Helpers
+	}
+	return nil
+}
+
+type ExprFunction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Suite *TestSuite `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
-	Case  *TestCase  `protobuf:"bytes,2,opt,name=case,proto3" json:"case,omitempty"`
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Params []string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty"`
+	Expr   string   `protobuf:"bytes,3,opt,name=expr,proto3" json:"expr,omitempty"`
 }
 
-func (x *TestSuiteWithCase) Reset() {
-	*x = TestSuiteWithCase{}
+func (x *ExprFunction) Reset() {
+	*x = ExprFunction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[12]
+		mi := &file_pkg_server_server_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestSuiteWithCase) String() string {
+func (x *ExprFunction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestSuiteWithCase) ProtoMessage() {}
+func (*ExprFunction) ProtoMessage() {}
 
-func (x *TestSuiteWithCase) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[12]
+func (x *ExprFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,53 +705,61 @@ func (x *TestSuiteWithCase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestSuiteWithCase.ProtoReflect.Descriptor instead.
-func (*TestSuiteWithCase) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ExprFunction.ProtoReflect.Descriptor instead.
+func (*ExprFunction) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TestSuiteWithCase) GetSuite() *TestSuite {
+func (x *ExprFunction) GetName() string {
 	if x != nil {
-		return x.Suite
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *TestSuiteWithCase) GetCase() *TestCase {
+func (x *ExprFunction) GetParams() []string {
 	if x != nil {
-		return x.Case
+		return x.Params
 	}
 	return nil
 }
 
-type APISpec struct {
+func (x *ExprFunction) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+type SuiteAuth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Kind   string  `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
-	Url    string  `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Rpc    *RPC    `protobuf:"bytes,3,opt,name=rpc,proto3" json:"rpc,omitempty"`
-	Secure *Secure `protobuf:"bytes,4,opt,name=secure,proto3" json:"secure,omitempty"`
+	Mode   string      `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"` // jwt, hmac, aws-sigv4 or oauth2
+	Jwt    *JWTAuth    `protobuf:"bytes,2,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Hmac   *HMACAuth   `protobuf:"bytes,3,opt,name=hmac,proto3" json:"hmac,omitempty"`
+	Aws    *AWSAuth    `protobuf:"bytes,4,opt,name=aws,proto3" json:"aws,omitempty"`
+	Oauth2 *OAuth2Auth `protobuf:"bytes,5,opt,name=oauth2,proto3" json:"oauth2,omitempty"`
 }
 
-func (x *APISpec) Reset() {
-	*x = APISpec{}
+func (x *SuiteAuth) Reset() {
+	*x = SuiteAuth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[13]
+		mi := &file_pkg_server_server_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *APISpec) String() string {
+func (x *SuiteAuth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APISpec) ProtoMessage() {}
+func (*SuiteAuth) ProtoMessage() {}
 
-func (x *APISpec) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[13]
+func (x *SuiteAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,68 +770,74 @@ func (x *APISpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APISpec.ProtoReflect.Descriptor instead.
-func (*APISpec) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SuiteAuth.ProtoReflect.Descriptor instead.
+func (*SuiteAuth) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *APISpec) GetKind() string {
+func (x *SuiteAuth) GetMode() string {
 	if x != nil {
-		return x.Kind
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *APISpec) GetUrl() string {
+func (x *SuiteAuth) GetJwt() *JWTAuth {
 	if x != nil {
-		return x.Url
+		return x.Jwt
 	}
-	return ""
+	return nil
 }
 
-func (x *APISpec) GetRpc() *RPC {
+func (x *SuiteAuth) GetHmac() *HMACAuth {
 	if x != nil {
-		return x.Rpc
+		return x.Hmac
 	}
 	return nil
 }
 
-func (x *APISpec) GetSecure() *Secure {
+func (x *SuiteAuth) GetAws() *AWSAuth {
 	if x != nil {
-		return x.Secure
+		return x.Aws
 	}
 	return nil
 }
 
-type Secure struct {
+func (x *SuiteAuth) GetOauth2() *OAuth2Auth {
+	if x != nil {
+		return x.Oauth2
+	}
+	return nil
+}
+
+type JWTAuth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Insecure   bool   `protobuf:"varint,1,opt,name=insecure,proto3" json:"insecure,omitempty"`
-	Cert       string `protobuf:"bytes,2,opt,name=cert,proto3" json:"cert,omitempty"`
-	Ca         string `protobuf:"bytes,3,opt,name=ca,proto3" json:"ca,omitempty"`
-	ServerName string `protobuf:"bytes,4,opt,name=serverName,proto3" json:"serverName,omitempty"`
-	Key        string `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	Algorithm string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Secret    string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Claims    string `protobuf:"bytes,3,opt,name=claims,proto3" json:"claims,omitempty"` // JSON object
+	ExpiresIn string `protobuf:"bytes,4,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
 }
 
-func (x *Secure) Reset() {
-	*x = Secure{}
+func (x *JWTAuth) Reset() {
+	*x = JWTAuth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[14]
+		mi := &file_pkg_server_server_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Secure) String() string {
+func (x *JWTAuth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Secure) ProtoMessage() {}
+func (*JWTAuth) ProtoMessage() {}
 
-func (x *Secure) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[14]
+func (x *JWTAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -861,75 +848,66 @@ func (x *Secure) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Secure.ProtoReflect.Descriptor instead.
-func (*Secure) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *Secure) GetInsecure() bool {
-	if x != nil {
-		return x.Insecure
-	}
-	return false
+// Deprecated: Use JWTAuth.ProtoReflect.Descriptor instead.
+func (*JWTAuth) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Secure) GetCert() string {
+func (x *JWTAuth) GetAlgorithm() string {
 	if x != nil {
-		return x.Cert
+		return x.Algorithm
 	}
 	return ""
 }
 
-func (x *Secure) GetCa() string {
+func (x *JWTAuth) GetSecret() string {
 	if x != nil {
-		return x.Ca
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *Secure) GetServerName() string {
+func (x *JWTAuth) GetClaims() string {
 	if x != nil {
-		return x.ServerName
+		return x.Claims
 	}
 	return ""
 }
 
-func (x *Secure) GetKey() string {
+func (x *JWTAuth) GetExpiresIn() string {
 	if x != nil {
-		return x.Key
+		return x.ExpiresIn
 	}
 	return ""
 }
 
-type RPC struct {
+type HMACAuth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Import           []string `protobuf:"bytes,1,rep,name=import,proto3" json:"import,omitempty"`
-	ServerReflection bool     `protobuf:"varint,2,opt,name=serverReflection,proto3" json:"serverReflection,omitempty"`
-	Protofile        string   `protobuf:"bytes,3,opt,name=protofile,proto3" json:"protofile,omitempty"`
-	Protoset         string   `protobuf:"bytes,4,opt,name=protoset,proto3" json:"protoset,omitempty"`
-	Raw              string   `protobuf:"bytes,5,opt,name=raw,proto3" json:"raw,omitempty"`
+	Algorithm string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Secret    string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Header    string `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
 }
 
-func (x *RPC) Reset() {
-	*x = RPC{}
+func (x *HMACAuth) Reset() {
+	*x = HMACAuth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[15]
+		mi := &file_pkg_server_server_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RPC) String() string {
+func (x *HMACAuth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RPC) ProtoMessage() {}
+func (*HMACAuth) ProtoMessage() {}
 
-func (x *RPC) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[15]
+func (x *HMACAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -940,73 +918,61 @@ func (x *RPC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RPC.ProtoReflect.Descriptor instead.
-func (*RPC) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *RPC) GetImport() []string {
-	if x != nil {
-		return x.Import
-	}
-	return nil
-}
-
-func (x *RPC) GetServerReflection() bool {
-	if x != nil {
-		return x.ServerReflection
-	}
-	return false
+// Deprecated: Use HMACAuth.ProtoReflect.Descriptor instead.
+func (*HMACAuth) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RPC) GetProtofile() string {
+func (x *HMACAuth) GetAlgorithm() string {
 	if x != nil {
-		return x.Protofile
+		return x.Algorithm
 	}
 	return ""
 }
 
-func (x *RPC) GetProtoset() string {
+func (x *HMACAuth) GetSecret() string {
 	if x != nil {
-		return x.Protoset
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *RPC) GetRaw() string {
+func (x *HMACAuth) GetHeader() string {
 	if x != nil {
-		return x.Raw
+		return x.Header
 	}
 	return ""
 }
 
-type TestSuiteIdentity struct {
+type AWSAuth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Api  string `protobuf:"bytes,2,opt,name=api,proto3" json:"api,omitempty"`
-	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	AccessKey    string `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	SecretKey    string `protobuf:"bytes,2,opt,name=secretKey,proto3" json:"secretKey,omitempty"`
+	SessionToken string `protobuf:"bytes,3,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	Region       string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Service      string `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (x *TestSuiteIdentity) Reset() {
-	*x = TestSuiteIdentity{}
+func (x *AWSAuth) Reset() {
+	*x = AWSAuth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[16]
+		mi := &file_pkg_server_server_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestSuiteIdentity) String() string {
+func (x *AWSAuth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestSuiteIdentity) ProtoMessage() {}
+func (*AWSAuth) ProtoMessage() {}
 
-func (x *TestSuiteIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[16]
+func (x *AWSAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,58 +983,77 @@ func (x *TestSuiteIdentity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestSuiteIdentity.ProtoReflect.Descriptor instead.
-func (*TestSuiteIdentity) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AWSAuth.ProtoReflect.Descriptor instead.
+func (*AWSAuth) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *TestSuiteIdentity) GetName() string {
+func (x *AWSAuth) GetAccessKey() string {
 	if x != nil {
-		return x.Name
+		return x.AccessKey
 	}
 	return ""
 }
 
-func (x *TestSuiteIdentity) GetApi() string {
+func (x *AWSAuth) GetSecretKey() string {
 	if x != nil {
-		return x.Api
+		return x.SecretKey
 	}
 	return ""
 }
 
-func (x *TestSuiteIdentity) GetKind() string {
+func (x *AWSAuth) GetSessionToken() string {
 	if x != nil {
-		return x.Kind
+		return x.SessionToken
 	}
 	return ""
 }
 
-type TestSuiteDuplicate struct {
+func (x *AWSAuth) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *AWSAuth) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+type OAuth2Auth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SourceSuiteName string `protobuf:"bytes,1,opt,name=sourceSuiteName,proto3" json:"sourceSuiteName,omitempty"`
-	TargetSuiteName string `protobuf:"bytes,2,opt,name=targetSuiteName,proto3" json:"targetSuiteName,omitempty"`
+	Grant        string   `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	TokenURL     string   `protobuf:"bytes,2,opt,name=tokenURL,proto3" json:"tokenURL,omitempty"`
+	ClientID     string   `protobuf:"bytes,3,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	ClientSecret string   `protobuf:"bytes,4,opt,name=clientSecret,proto3" json:"clientSecret,omitempty"`
+	Username     string   `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Password     string   `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	Scopes       []string `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
 }
 
-func (x *TestSuiteDuplicate) Reset() {
-	*x = TestSuiteDuplicate{}
+func (x *OAuth2Auth) Reset() {
+	*x = OAuth2Auth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[17]
+		mi := &file_pkg_server_server_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestSuiteDuplicate) String() string {
+func (x *OAuth2Auth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestSuiteDuplicate) ProtoMessage() {}
+func (*OAuth2Auth) ProtoMessage() {}
 
-func (x *TestSuiteDuplicate) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[17]
+func (x *OAuth2Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1079,53 +1064,86 @@ func (x *TestSuiteDuplicate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestSuiteDuplicate.ProtoReflect.Descriptor instead.
-func (*TestSuiteDuplicate) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use OAuth2Auth.ProtoReflect.Descriptor instead.
+func (*OAuth2Auth) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *TestSuiteDuplicate) GetSourceSuiteName() string {
+func (x *OAuth2Auth) GetGrant() string {
 	if x != nil {
-		return x.SourceSuiteName
+		return x.Grant
 	}
 	return ""
 }
 
-func (x *TestSuiteDuplicate) GetTargetSuiteName() string {
+func (x *OAuth2Auth) GetTokenURL() string {
 	if x != nil {
-		return x.TargetSuiteName
+		return x.TokenURL
 	}
 	return ""
 }
 
-type TestCaseDuplicate struct {
+func (x *OAuth2Auth) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *OAuth2Auth) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OAuth2Auth) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *OAuth2Auth) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *OAuth2Auth) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type TestSuiteWithCase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SourceSuiteName string `protobuf:"bytes,1,opt,name=sourceSuiteName,proto3" json:"sourceSuiteName,omitempty"`
-	SourceCaseName  string `protobuf:"bytes,2,opt,name=sourceCaseName,proto3" json:"sourceCaseName,omitempty"`
-	TargetSuiteName string `protobuf:"bytes,3,opt,name=targetSuiteName,proto3" json:"targetSuiteName,omitempty"`
-	TargetCaseName  string `protobuf:"bytes,4,opt,name=targetCaseName,proto3" json:"targetCaseName,omitempty"`
+	Suite *TestSuite `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	Case  *TestCase  `protobuf:"bytes,2,opt,name=case,proto3" json:"case,omitempty"`
 }
 
-func (x *TestCaseDuplicate) Reset() {
-	*x = TestCaseDuplicate{}
+func (x *TestSuiteWithCase) Reset() {
+	*x = TestSuiteWithCase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[18]
+		mi := &file_pkg_server_server_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestCaseDuplicate) String() string {
+func (x *TestSuiteWithCase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestCaseDuplicate) ProtoMessage() {}
+func (*TestSuiteWithCase) ProtoMessage() {}
 
-func (x *TestCaseDuplicate) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[18]
+func (x *TestSuiteWithCase) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,69 +1154,54 @@ func (x *TestCaseDuplicate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestCaseDuplicate.ProtoReflect.Descriptor instead.
-func (*TestCaseDuplicate) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *TestCaseDuplicate) GetSourceSuiteName() string {
-	if x != nil {
-		return x.SourceSuiteName
-	}
-	return ""
-}
-
-func (x *TestCaseDuplicate) GetSourceCaseName() string {
-	if x != nil {
-		return x.SourceCaseName
-	}
-	return ""
+// Deprecated: Use TestSuiteWithCase.ProtoReflect.Descriptor instead.
+func (*TestSuiteWithCase) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *TestCaseDuplicate) GetTargetSuiteName() string {
+func (x *TestSuiteWithCase) GetSuite() *TestSuite {
 	if x != nil {
-		return x.TargetSuiteName
+		return x.Suite
 	}
-	return ""
+	return nil
 }
 
-func (x *TestCaseDuplicate) GetTargetCaseName() string {
+func (x *TestSuiteWithCase) GetCase() *TestCase {
 	if x != nil {
-		return x.TargetCaseName
+		return x.Case
 	}
-	return ""
+	return nil
 }
 
-type TestTask struct {
+type APISpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data       string            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Kind       string            `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	CaseName   string            `protobuf:"bytes,3,opt,name=caseName,proto3" json:"caseName,omitempty"`
-	Level      string            `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
-	Env        map[string]string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Parameters []*Pair           `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	Kind     string  `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Url      string  `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Rpc      *RPC    `protobuf:"bytes,3,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	Secure   *Secure `protobuf:"bytes,4,opt,name=secure,proto3" json:"secure,omitempty"`
+	Contract string  `protobuf:"bytes,5,opt,name=contract,proto3" json:"contract,omitempty"`
 }
 
-func (x *TestTask) Reset() {
-	*x = TestTask{}
+func (x *APISpec) Reset() {
+	*x = APISpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[19]
+		mi := &file_pkg_server_server_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestTask) String() string {
+func (x *APISpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestTask) ProtoMessage() {}
+func (*APISpec) ProtoMessage() {}
 
-func (x *TestTask) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[19]
+func (x *APISpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,82 +1212,75 @@ func (x *TestTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestTask.ProtoReflect.Descriptor instead.
-func (*TestTask) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *TestTask) GetData() string {
-	if x != nil {
-		return x.Data
-	}
-	return ""
+// Deprecated: Use APISpec.ProtoReflect.Descriptor instead.
+func (*APISpec) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TestTask) GetKind() string {
+func (x *APISpec) GetKind() string {
 	if x != nil {
 		return x.Kind
 	}
 	return ""
 }
 
-func (x *TestTask) GetCaseName() string {
+func (x *APISpec) GetUrl() string {
 	if x != nil {
-		return x.CaseName
+		return x.Url
 	}
 	return ""
 }
 
-func (x *TestTask) GetLevel() string {
+func (x *APISpec) GetRpc() *RPC {
 	if x != nil {
-		return x.Level
+		return x.Rpc
 	}
-	return ""
+	return nil
 }
 
-func (x *TestTask) GetEnv() map[string]string {
+func (x *APISpec) GetSecure() *Secure {
 	if x != nil {
-		return x.Env
+		return x.Secure
 	}
 	return nil
 }
 
-func (x *TestTask) GetParameters() []*Pair {
+func (x *APISpec) GetContract() string {
 	if x != nil {
-		return x.Parameters
+		return x.Contract
 	}
-	return nil
+	return ""
 }
 
-type BatchTestTask struct {
+type Secure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SuiteName  string  `protobuf:"bytes,1,opt,name=suiteName,proto3" json:"suiteName,omitempty"`
-	CaseName   string  `protobuf:"bytes,2,opt,name=caseName,proto3" json:"caseName,omitempty"`
-	Parameters []*Pair `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`
-	Count      int32   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	Interval   string  `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Insecure   bool   `protobuf:"varint,1,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	Cert       string `protobuf:"bytes,2,opt,name=cert,proto3" json:"cert,omitempty"`
+	Ca         string `protobuf:"bytes,3,opt,name=ca,proto3" json:"ca,omitempty"`
+	ServerName string `protobuf:"bytes,4,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	Key        string `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *BatchTestTask) Reset() {
-	*x = BatchTestTask{}
+func (x *Secure) Reset() {
+	*x = Secure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[20]
+		mi := &file_pkg_server_server_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchTestTask) String() string {
+func (x *Secure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchTestTask) ProtoMessage() {}
+func (*Secure) ProtoMessage() {}
 
-func (x *BatchTestTask) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[20]
+func (x *Secure) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,73 +1291,75 @@ func (x *BatchTestTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchTestTask.ProtoReflect.Descriptor instead.
-func (*BatchTestTask) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Secure.ProtoReflect.Descriptor instead.
+func (*Secure) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *BatchTestTask) GetSuiteName() string {
+func (x *Secure) GetInsecure() bool {
 	if x != nil {
-		return x.SuiteName
+		return x.Insecure
 	}
-	return ""
+	return false
 }
 
-func (x *BatchTestTask) GetCaseName() string {
+func (x *Secure) GetCert() string {
 	if x != nil {
-		return x.CaseName
+		return x.Cert
 	}
 	return ""
 }
 
-func (x *BatchTestTask) GetParameters() []*Pair {
+func (x *Secure) GetCa() string {
 	if x != nil {
-		return x.Parameters
+		return x.Ca
 	}
-	return nil
+	return ""
 }
 
-func (x *BatchTestTask) GetCount() int32 {
+func (x *Secure) GetServerName() string {
 	if x != nil {
-		return x.Count
+		return x.ServerName
 	}
-	return 0
+	return ""
 }
 
-func (x *BatchTestTask) GetInterval() string {
+func (x *Secure) GetKey() string {
 	if x != nil {
-		return x.Interval
+		return x.Key
 	}
 	return ""
 }
 
-type TestResult struct {
+type RPC struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message        string            `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Error          string            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	TestCaseResult []*TestCaseResult `protobuf:"bytes,3,rep,name=testCaseResult,proto3" json:"testCaseResult,omitempty"`
+	Import           []string `protobuf:"bytes,1,rep,name=import,proto3" json:"import,omitempty"`
+	ServerReflection bool     `protobuf:"varint,2,opt,name=serverReflection,proto3" json:"serverReflection,omitempty"`
+	Protofile        string   `protobuf:"bytes,3,opt,name=protofile,proto3" json:"protofile,omitempty"`
+	Protoset         string   `protobuf:"bytes,4,opt,name=protoset,proto3" json:"protoset,omitempty"`
+	Raw              string   `protobuf:"bytes,5,opt,name=raw,proto3" json:"raw,omitempty"`
 }
 
-func (x *TestResult) Reset() {
-	*x = TestResult{}
+func (x *RPC) Reset() {
+	*x = RPC{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[21]
+		mi := &file_pkg_server_server_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestResult) String() string {
+func (x *RPC) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestResult) ProtoMessage() {}
+func (*RPC) ProtoMessage() {}
 
-func (x *TestResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[21]
+func (x *RPC) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1372,61 +1370,73 @@ func (x *TestResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestResult.ProtoReflect.Descriptor instead.
-func (*TestResult) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use RPC.ProtoReflect.Descriptor instead.
+func (*RPC) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *TestResult) GetMessage() string {
+func (x *RPC) GetImport() []string {
 	if x != nil {
-		return x.Message
+		return x.Import
+	}
+	return nil
+}
+
+func (x *RPC) GetServerReflection() bool {
+	if x != nil {
+		return x.ServerReflection
+	}
+	return false
+}
+
+func (x *RPC) GetProtofile() string {
+	if x != nil {
+		return x.Protofile
 	}
 	return ""
 }
 
-func (x *TestResult) GetError() string {
+func (x *RPC) GetProtoset() string {
 	if x != nil {
-		return x.Error
+		return x.Protoset
 	}
 	return ""
 }
 
-func (x *TestResult) GetTestCaseResult() []*TestCaseResult {
+func (x *RPC) GetRaw() string {
 	if x != nil {
-		return x.TestCaseResult
+		return x.Raw
 	}
-	return nil
+	return ""
 }
 
-type HistoryTestResult struct {
+type TestSuiteIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message        string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Error          string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	TestCaseResult []*TestCaseResult      `protobuf:"bytes,3,rep,name=testCaseResult,proto3" json:"testCaseResult,omitempty"`
-	Data           *HistoryTestCase       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Api  string `protobuf:"bytes,2,opt,name=api,proto3" json:"api,omitempty"`
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
 }
 
-func (x *HistoryTestResult) Reset() {
-	*x = HistoryTestResult{}
+func (x *TestSuiteIdentity) Reset() {
+	*x = TestSuiteIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[22]
+		mi := &file_pkg_server_server_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HistoryTestResult) String() string {
+func (x *TestSuiteIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistoryTestResult) ProtoMessage() {}
+func (*TestSuiteIdentity) ProtoMessage() {}
 
-func (x *HistoryTestResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[22]
+func (x *TestSuiteIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1437,72 +1447,61 @@ func (x *HistoryTestResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HistoryTestResult.ProtoReflect.Descriptor instead.
-func (*HistoryTestResult) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use TestSuiteIdentity.ProtoReflect.Descriptor instead.
+func (*TestSuiteIdentity) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *HistoryTestResult) GetMessage() string {
+func (x *TestSuiteIdentity) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-func (x *HistoryTestResult) GetError() string {
+func (x *TestSuiteIdentity) GetApi() string {
 	if x != nil {
-		return x.Error
+		return x.Api
 	}
 	return ""
 }
 
-func (x *HistoryTestResult) GetTestCaseResult() []*TestCaseResult {
-	if x != nil {
-		return x.TestCaseResult
-	}
-	return nil
-}
-
-func (x *HistoryTestResult) GetData() *HistoryTestCase {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *HistoryTestResult) GetCreateTime() *timestamppb.Timestamp {
+func (x *TestSuiteIdentity) GetKind() string {
 	if x != nil {
-		return x.CreateTime
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-type HelloReply struct {
+type SuiteRevision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Suite      string                 `protobuf:"bytes,2,opt,name=suite,proto3" json:"suite,omitempty"`
+	Testcase   string                 `protobuf:"bytes,3,opt,name=testcase,proto3" json:"testcase,omitempty"`
+	Action     string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createTime,proto3" json:"createTime,omitempty"`
 }
 
-func (x *HelloReply) Reset() {
-	*x = HelloReply{}
+func (x *SuiteRevision) Reset() {
+	*x = SuiteRevision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[23]
+		mi := &file_pkg_server_server_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HelloReply) String() string {
+func (x *SuiteRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HelloReply) ProtoMessage() {}
+func (*SuiteRevision) ProtoMessage() {}
 
-func (x *HelloReply) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[23]
+func (x *SuiteRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1513,50 +1512,71 @@ func (x *HelloReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HelloReply.ProtoReflect.Descriptor instead.
-func (*HelloReply) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SuiteRevision.ProtoReflect.Descriptor instead.
+func (*SuiteRevision) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *HelloReply) GetMessage() string {
+func (x *SuiteRevision) GetId() string {
 	if x != nil {
-		return x.Message
+		return x.Id
 	}
 	return ""
 }
 
-func (x *HelloReply) GetError() string {
+func (x *SuiteRevision) GetSuite() string {
 	if x != nil {
-		return x.Error
+		return x.Suite
 	}
 	return ""
 }
 
-type YamlData struct {
+func (x *SuiteRevision) GetTestcase() string {
+	if x != nil {
+		return x.Testcase
+	}
+	return ""
+}
+
+func (x *SuiteRevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SuiteRevision) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type SuiteRevisions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data []*SuiteRevision `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *YamlData) Reset() {
-	*x = YamlData{}
+func (x *SuiteRevisions) Reset() {
+	*x = SuiteRevisions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[24]
+		mi := &file_pkg_server_server_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *YamlData) String() string {
+func (x *SuiteRevisions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*YamlData) ProtoMessage() {}
+func (*SuiteRevisions) ProtoMessage() {}
 
-func (x *YamlData) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[24]
+func (x *SuiteRevisions) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,45 +1587,44 @@ func (x *YamlData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use YamlData.ProtoReflect.Descriptor instead.
-func (*YamlData) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SuiteRevisions.ProtoReflect.Descriptor instead.
+func (*SuiteRevisions) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *YamlData) GetData() []byte {
+func (x *SuiteRevisions) GetData() []*SuiteRevision {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type Suite struct {
+type SuiteRevisionQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Api   string      `protobuf:"bytes,2,opt,name=api,proto3" json:"api,omitempty"`
-	Items []*TestCase `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Suite    string `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	Testcase string `protobuf:"bytes,2,opt,name=testcase,proto3" json:"testcase,omitempty"`
 }
 
-func (x *Suite) Reset() {
-	*x = Suite{}
+func (x *SuiteRevisionQuery) Reset() {
+	*x = SuiteRevisionQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[25]
+		mi := &file_pkg_server_server_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Suite) String() string {
+func (x *SuiteRevisionQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Suite) ProtoMessage() {}
+func (*SuiteRevisionQuery) ProtoMessage() {}
 
-func (x *Suite) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[25]
+func (x *SuiteRevisionQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1616,58 +1635,52 @@ func (x *Suite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Suite.ProtoReflect.Descriptor instead.
-func (*Suite) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SuiteRevisionQuery.ProtoReflect.Descriptor instead.
+func (*SuiteRevisionQuery) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Suite) GetName() string {
+func (x *SuiteRevisionQuery) GetSuite() string {
 	if x != nil {
-		return x.Name
+		return x.Suite
 	}
 	return ""
 }
 
-func (x *Suite) GetApi() string {
+func (x *SuiteRevisionQuery) GetTestcase() string {
 	if x != nil {
-		return x.Api
+		return x.Testcase
 	}
 	return ""
 }
 
-func (x *Suite) GetItems() []*TestCase {
-	if x != nil {
-		return x.Items
-	}
-	return nil
-}
-
-type TestCaseWithSuite struct {
+type SuiteRevisionIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SuiteName string    `protobuf:"bytes,1,opt,name=suiteName,proto3" json:"suiteName,omitempty"`
-	Data      *TestCase `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Suite    string `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	Testcase string `protobuf:"bytes,2,opt,name=testcase,proto3" json:"testcase,omitempty"`
+	Revision string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (x *TestCaseWithSuite) Reset() {
-	*x = TestCaseWithSuite{}
+func (x *SuiteRevisionIdentity) Reset() {
+	*x = SuiteRevisionIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[26]
+		mi := &file_pkg_server_server_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestCaseWithSuite) String() string {
+func (x *SuiteRevisionIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestCaseWithSuite) ProtoMessage() {}
+func (*SuiteRevisionIdentity) ProtoMessage() {}
 
-func (x *TestCaseWithSuite) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[26]
+func (x *SuiteRevisionIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1678,50 +1691,60 @@ func (x *TestCaseWithSuite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestCaseWithSuite.ProtoReflect.Descriptor instead.
-func (*TestCaseWithSuite) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use SuiteRevisionIdentity.ProtoReflect.Descriptor instead.
+func (*SuiteRevisionIdentity) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *TestCaseWithSuite) GetSuiteName() string {
+func (x *SuiteRevisionIdentity) GetSuite() string {
 	if x != nil {
-		return x.SuiteName
+		return x.Suite
 	}
 	return ""
 }
 
-func (x *TestCaseWithSuite) GetData() *TestCase {
+func (x *SuiteRevisionIdentity) GetTestcase() string {
 	if x != nil {
-		return x.Data
+		return x.Testcase
 	}
-	return nil
+	return ""
 }
 
-type TestCases struct {
+func (x *SuiteRevisionIdentity) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+type SnapshotAcceptance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*TestCase `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Suite    string  `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	Testcase string  `protobuf:"bytes,2,opt,name=testcase,proto3" json:"testcase,omitempty"`
+	Body     string  `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Header   []*Pair `protobuf:"bytes,4,rep,name=header,proto3" json:"header,omitempty"`
 }
 
-func (x *TestCases) Reset() {
-	*x = TestCases{}
+func (x *SnapshotAcceptance) Reset() {
+	*x = SnapshotAcceptance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[27]
+		mi := &file_pkg_server_server_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestCases) String() string {
+func (x *SnapshotAcceptance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestCases) ProtoMessage() {}
+func (*SnapshotAcceptance) ProtoMessage() {}
 
-func (x *TestCases) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[27]
+func (x *SnapshotAcceptance) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1732,47 +1755,68 @@ func (x *TestCases) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestCases.ProtoReflect.Descriptor instead.
-func (*TestCases) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SnapshotAcceptance.ProtoReflect.Descriptor instead.
+func (*SnapshotAcceptance) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *TestCases) GetData() []*TestCase {
+func (x *SnapshotAcceptance) GetSuite() string {
 	if x != nil {
-		return x.Data
+		return x.Suite
+	}
+	return ""
+}
+
+func (x *SnapshotAcceptance) GetTestcase() string {
+	if x != nil {
+		return x.Testcase
+	}
+	return ""
+}
+
+func (x *SnapshotAcceptance) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SnapshotAcceptance) GetHeader() []*Pair {
+	if x != nil {
+		return x.Header
 	}
 	return nil
 }
 
-type TestCase struct {
+type SuiteRevisionDiffRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	SuiteName string    `protobuf:"bytes,2,opt,name=suiteName,proto3" json:"suiteName,omitempty"`
-	Request   *Request  `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
-	Response  *Response `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
-	Server    string    `protobuf:"bytes,5,opt,name=server,proto3" json:"server,omitempty"`
+	Suite    string `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	Testcase string `protobuf:"bytes,2,opt,name=testcase,proto3" json:"testcase,omitempty"`
+	From     string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// compare with the current definition if it is empty
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (x *TestCase) Reset() {
-	*x = TestCase{}
+func (x *SuiteRevisionDiffRequest) Reset() {
+	*x = SuiteRevisionDiffRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[28]
+		mi := &file_pkg_server_server_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestCase) String() string {
+func (x *SuiteRevisionDiffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestCase) ProtoMessage() {}
+func (*SuiteRevisionDiffRequest) ProtoMessage() {}
 
-func (x *TestCase) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[28]
+func (x *SuiteRevisionDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1783,81 +1827,67 @@ func (x *TestCase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestCase.ProtoReflect.Descriptor instead.
-func (*TestCase) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SuiteRevisionDiffRequest.ProtoReflect.Descriptor instead.
+func (*SuiteRevisionDiffRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TestCase) GetName() string {
+func (x *SuiteRevisionDiffRequest) GetSuite() string {
 	if x != nil {
-		return x.Name
+		return x.Suite
 	}
 	return ""
 }
 
-func (x *TestCase) GetSuiteName() string {
+func (x *SuiteRevisionDiffRequest) GetTestcase() string {
 	if x != nil {
-		return x.SuiteName
+		return x.Testcase
 	}
 	return ""
 }
 
-func (x *TestCase) GetRequest() *Request {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-func (x *TestCase) GetResponse() *Response {
+func (x *SuiteRevisionDiffRequest) GetFrom() string {
 	if x != nil {
-		return x.Response
+		return x.From
 	}
-	return nil
+	return ""
 }
 
-func (x *TestCase) GetServer() string {
+func (x *SuiteRevisionDiffRequest) GetTo() string {
 	if x != nil {
-		return x.Server
+		return x.To
 	}
 	return ""
 }
 
-type HistoryTestCase struct {
+type FieldChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CaseName         string                 `protobuf:"bytes,1,opt,name=caseName,proto3" json:"caseName,omitempty"`
-	SuiteName        string                 `protobuf:"bytes,2,opt,name=suiteName,proto3" json:"suiteName,omitempty"`
-	HistorySuiteName string                 `protobuf:"bytes,3,opt,name=historySuiteName,proto3" json:"historySuiteName,omitempty"`
-	CreateTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createTime,proto3" json:"createTime,omitempty"`
-	SuiteParam       []*Pair                `protobuf:"bytes,5,rep,name=suiteParam,proto3" json:"suiteParam,omitempty"`
-	SuiteSpec        *APISpec               `protobuf:"bytes,6,opt,name=suiteSpec,proto3" json:"suiteSpec,omitempty"`
-	SuiteApi         string                 `protobuf:"bytes,7,opt,name=suiteApi,proto3" json:"suiteApi,omitempty"`
-	Request          *Request               `protobuf:"bytes,8,opt,name=request,proto3" json:"request,omitempty"`
-	Response         *Response              `protobuf:"bytes,9,opt,name=response,proto3" json:"response,omitempty"`
-	ID               string                 `protobuf:"bytes,10,opt,name=ID,proto3" json:"ID,omitempty"`
-	HistoryHeader    []*Pair                `protobuf:"bytes,11,rep,name=historyHeader,proto3" json:"historyHeader,omitempty"`
+	Path     string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	OldValue string `protobuf:"bytes,3,opt,name=oldValue,proto3" json:"oldValue,omitempty"`
+	NewValue string `protobuf:"bytes,4,opt,name=newValue,proto3" json:"newValue,omitempty"`
 }
 
-func (x *HistoryTestCase) Reset() {
-	*x = HistoryTestCase{}
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[29]
+		mi := &file_pkg_server_server_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HistoryTestCase) String() string {
+func (x *FieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistoryTestCase) ProtoMessage() {}
+func (*FieldChange) ProtoMessage() {}
 
-func (x *HistoryTestCase) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[29]
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,113 +1898,112 @@ func (x *HistoryTestCase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HistoryTestCase.ProtoReflect.Descriptor instead.
-func (*HistoryTestCase) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *HistoryTestCase) GetCaseName() string {
+func (x *FieldChange) GetPath() string {
 	if x != nil {
-		return x.CaseName
+		return x.Path
 	}
 	return ""
 }
 
-func (x *HistoryTestCase) GetSuiteName() string {
+func (x *FieldChange) GetType() string {
 	if x != nil {
-		return x.SuiteName
+		return x.Type
 	}
 	return ""
 }
 
-func (x *HistoryTestCase) GetHistorySuiteName() string {
+func (x *FieldChange) GetOldValue() string {
 	if x != nil {
-		return x.HistorySuiteName
+		return x.OldValue
 	}
 	return ""
 }
 
-func (x *HistoryTestCase) GetCreateTime() *timestamppb.Timestamp {
+func (x *FieldChange) GetNewValue() string {
 	if x != nil {
-		return x.CreateTime
+		return x.NewValue
 	}
-	return nil
+	return ""
 }
 
-func (x *HistoryTestCase) GetSuiteParam() []*Pair {
-	if x != nil {
-		return x.SuiteParam
-	}
-	return nil
-}
+type SuiteRevisionDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *HistoryTestCase) GetSuiteSpec() *APISpec {
-	if x != nil {
-		return x.SuiteSpec
-	}
-	return nil
+	Changes []*FieldChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *HistoryTestCase) GetSuiteApi() string {
-	if x != nil {
-		return x.SuiteApi
+func (x *SuiteRevisionDiff) Reset() {
+	*x = SuiteRevisionDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *HistoryTestCase) GetRequest() *Request {
-	if x != nil {
-		return x.Request
-	}
-	return nil
+func (x *SuiteRevisionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *HistoryTestCase) GetResponse() *Response {
-	if x != nil {
-		return x.Response
+func (*SuiteRevisionDiff) ProtoMessage() {}
+
+func (x *SuiteRevisionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *HistoryTestCase) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
+// Deprecated: Use SuiteRevisionDiff.ProtoReflect.Descriptor instead.
+func (*SuiteRevisionDiff) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *HistoryTestCase) GetHistoryHeader() []*Pair {
+func (x *SuiteRevisionDiff) GetChanges() []*FieldChange {
 	if x != nil {
-		return x.HistoryHeader
+		return x.Changes
 	}
 	return nil
 }
 
-type HistoryTestCases struct {
+type TestSuiteDuplicate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*HistoryTestCase `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	SourceSuiteName string `protobuf:"bytes,1,opt,name=sourceSuiteName,proto3" json:"sourceSuiteName,omitempty"`
+	TargetSuiteName string `protobuf:"bytes,2,opt,name=targetSuiteName,proto3" json:"targetSuiteName,omitempty"`
 }
 
-func (x *HistoryTestCases) Reset() {
-	*x = HistoryTestCases{}
+func (x *TestSuiteDuplicate) Reset() {
+	*x = TestSuiteDuplicate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[30]
+		mi := &file_pkg_server_server_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HistoryTestCases) String() string {
+func (x *TestSuiteDuplicate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistoryTestCases) ProtoMessage() {}
+func (*TestSuiteDuplicate) ProtoMessage() {}
 
-func (x *HistoryTestCases) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[30]
+func (x *TestSuiteDuplicate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1985,49 +2014,53 @@ func (x *HistoryTestCases) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HistoryTestCases.ProtoReflect.Descriptor instead.
-func (*HistoryTestCases) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use TestSuiteDuplicate.ProtoReflect.Descriptor instead.
+func (*TestSuiteDuplicate) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *HistoryTestCases) GetData() []*HistoryTestCase {
+func (x *TestSuiteDuplicate) GetSourceSuiteName() string {
 	if x != nil {
-		return x.Data
+		return x.SourceSuiteName
 	}
-	return nil
+	return ""
 }
 
-type Request struct {
+func (x *TestSuiteDuplicate) GetTargetSuiteName() string {
+	if x != nil {
+		return x.TargetSuiteName
+	}
+	return ""
+}
+
+type TestCaseDuplicate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Api    string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
-	Method string  `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	Header []*Pair `protobuf:"bytes,3,rep,name=header,proto3" json:"header,omitempty"`
-	Query  []*Pair `protobuf:"bytes,4,rep,name=query,proto3" json:"query,omitempty"`
-	Cookie []*Pair `protobuf:"bytes,5,rep,name=cookie,proto3" json:"cookie,omitempty"`
-	Form   []*Pair `protobuf:"bytes,6,rep,name=form,proto3" json:"form,omitempty"`
-	Body   string  `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	SourceSuiteName string `protobuf:"bytes,1,opt,name=sourceSuiteName,proto3" json:"sourceSuiteName,omitempty"`
+	SourceCaseName  string `protobuf:"bytes,2,opt,name=sourceCaseName,proto3" json:"sourceCaseName,omitempty"`
+	TargetSuiteName string `protobuf:"bytes,3,opt,name=targetSuiteName,proto3" json:"targetSuiteName,omitempty"`
+	TargetCaseName  string `protobuf:"bytes,4,opt,name=targetCaseName,proto3" json:"targetCaseName,omitempty"`
 }
 
-func (x *Request) Reset() {
-	*x = Request{}
+func (x *TestCaseDuplicate) Reset() {
+	*x = TestCaseDuplicate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[31]
+		mi := &file_pkg_server_server_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Request) String() string {
+func (x *TestCaseDuplicate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Request) ProtoMessage() {}
+func (*TestCaseDuplicate) ProtoMessage() {}
 
-func (x *Request) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[31]
+func (x *TestCaseDuplicate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2038,91 +2071,69 @@ func (x *Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Request.ProtoReflect.Descriptor instead.
-func (*Request) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use TestCaseDuplicate.ProtoReflect.Descriptor instead.
+func (*TestCaseDuplicate) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Request) GetApi() string {
+func (x *TestCaseDuplicate) GetSourceSuiteName() string {
 	if x != nil {
-		return x.Api
+		return x.SourceSuiteName
 	}
 	return ""
 }
 
-func (x *Request) GetMethod() string {
+func (x *TestCaseDuplicate) GetSourceCaseName() string {
 	if x != nil {
-		return x.Method
+		return x.SourceCaseName
 	}
 	return ""
 }
 
-func (x *Request) GetHeader() []*Pair {
+func (x *TestCaseDuplicate) GetTargetSuiteName() string {
 	if x != nil {
-		return x.Header
+		return x.TargetSuiteName
 	}
-	return nil
+	return ""
 }
 
-func (x *Request) GetQuery() []*Pair {
+func (x *TestCaseDuplicate) GetTargetCaseName() string {
 	if x != nil {
-		return x.Query
+		return x.TargetCaseName
 	}
-	return nil
+	return ""
 }
 
-func (x *Request) GetCookie() []*Pair {
-	if x != nil {
-		return x.Cookie
-	}
-	return nil
-}
+type TestTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Request) GetForm() []*Pair {
-	if x != nil {
-		return x.Form
-	}
-	return nil
-}
-
-func (x *Request) GetBody() string {
-	if x != nil {
-		return x.Body
-	}
-	return ""
-}
-
-type Response struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	StatusCode        int32                `protobuf:"varint,1,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
-	Body              string               `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	Header            []*Pair              `protobuf:"bytes,3,rep,name=header,proto3" json:"header,omitempty"`
-	BodyFieldsExpect  []*Pair              `protobuf:"bytes,4,rep,name=bodyFieldsExpect,proto3" json:"bodyFieldsExpect,omitempty"`
-	Verify            []string             `protobuf:"bytes,5,rep,name=verify,proto3" json:"verify,omitempty"`
-	ConditionalVerify []*ConditionalVerify `protobuf:"bytes,6,rep,name=ConditionalVerify,proto3" json:"ConditionalVerify,omitempty"`
-	Schema            string               `protobuf:"bytes,7,opt,name=schema,proto3" json:"schema,omitempty"`
+	Data       string            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Kind       string            `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	CaseName   string            `protobuf:"bytes,3,opt,name=caseName,proto3" json:"caseName,omitempty"`
+	Level      string            `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	Env        map[string]string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Parameters []*Pair           `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty"`
 }
 
-func (x *Response) Reset() {
-	*x = Response{}
+func (x *TestTask) Reset() {
+	*x = TestTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[32]
+		mi := &file_pkg_server_server_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Response) String() string {
+func (x *TestTask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Response) ProtoMessage() {}
+func (*TestTask) ProtoMessage() {}
 
-func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[32]
+func (x *TestTask) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2133,86 +2144,82 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Response.ProtoReflect.Descriptor instead.
-func (*Response) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use TestTask.ProtoReflect.Descriptor instead.
+func (*TestTask) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Response) GetStatusCode() int32 {
+func (x *TestTask) GetData() string {
 	if x != nil {
-		return x.StatusCode
+		return x.Data
 	}
-	return 0
+	return ""
 }
 
-func (x *Response) GetBody() string {
+func (x *TestTask) GetKind() string {
 	if x != nil {
-		return x.Body
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *Response) GetHeader() []*Pair {
+func (x *TestTask) GetCaseName() string {
 	if x != nil {
-		return x.Header
+		return x.CaseName
 	}
-	return nil
+	return ""
 }
 
-func (x *Response) GetBodyFieldsExpect() []*Pair {
+func (x *TestTask) GetLevel() string {
 	if x != nil {
-		return x.BodyFieldsExpect
+		return x.Level
 	}
-	return nil
+	return ""
 }
 
-func (x *Response) GetVerify() []string {
+func (x *TestTask) GetEnv() map[string]string {
 	if x != nil {
-		return x.Verify
+		return x.Env
 	}
 	return nil
 }
 
-func (x *Response) GetConditionalVerify() []*ConditionalVerify {
+func (x *TestTask) GetParameters() []*Pair {
 	if x != nil {
-		return x.ConditionalVerify
+		return x.Parameters
 	}
 	return nil
 }
 
-func (x *Response) GetSchema() string {
-	if x != nil {
-		return x.Schema
-	}
-	return ""
-}
-
-type ConditionalVerify struct {
+type BatchTestTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Condition []string `protobuf:"bytes,1,rep,name=condition,proto3" json:"condition,omitempty"`
-	Verify    []string `protobuf:"bytes,2,rep,name=verify,proto3" json:"verify,omitempty"`
+	SuiteName  string  `protobuf:"bytes,1,opt,name=suiteName,proto3" json:"suiteName,omitempty"`
+	CaseName   string  `protobuf:"bytes,2,opt,name=caseName,proto3" json:"caseName,omitempty"`
+	Parameters []*Pair `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	Count      int32   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Interval   string  `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
 }
 
-func (x *ConditionalVerify) Reset() {
-	*x = ConditionalVerify{}
+func (x *BatchTestTask) Reset() {
+	*x = BatchTestTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[33]
+		mi := &file_pkg_server_server_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConditionalVerify) String() string {
+func (x *BatchTestTask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConditionalVerify) ProtoMessage() {}
+func (*BatchTestTask) ProtoMessage() {}
 
-func (x *ConditionalVerify) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[33]
+func (x *BatchTestTask) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,55 +2230,73 @@ func (x *ConditionalVerify) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConditionalVerify.ProtoReflect.Descriptor instead.
-func (*ConditionalVerify) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use BatchTestTask.ProtoReflect.Descriptor instead.
+func (*BatchTestTask) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ConditionalVerify) GetCondition() []string {
+func (x *BatchTestTask) GetSuiteName() string {
 	if x != nil {
-		return x.Condition
+		return x.SuiteName
 	}
-	return nil
+	return ""
 }
 
-func (x *ConditionalVerify) GetVerify() []string {
+func (x *BatchTestTask) GetCaseName() string {
 	if x != nil {
-		return x.Verify
+		return x.CaseName
+	}
+	return ""
+}
+
+func (x *BatchTestTask) GetParameters() []*Pair {
+	if x != nil {
+		return x.Parameters
 	}
 	return nil
 }
 
-type TestCaseResult struct {
+func (x *BatchTestTask) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BatchTestTask) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+type TestResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusCode int32   `protobuf:"varint,1,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
-	Body       string  `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	Header     []*Pair `protobuf:"bytes,3,rep,name=header,proto3" json:"header,omitempty"`
-	Error      string  `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	Id         string  `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
-	Output     string  `protobuf:"bytes,6,opt,name=output,proto3" json:"output,omitempty"`
+	Message        string            `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Error          string            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	TestCaseResult []*TestCaseResult `protobuf:"bytes,3,rep,name=testCaseResult,proto3" json:"testCaseResult,omitempty"`
 }
 
-func (x *TestCaseResult) Reset() {
-	*x = TestCaseResult{}
+func (x *TestResult) Reset() {
+	*x = TestResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[34]
+		mi := &file_pkg_server_server_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestCaseResult) String() string {
+func (x *TestResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestCaseResult) ProtoMessage() {}
+func (*TestResult) ProtoMessage() {}
 
-func (x *TestCaseResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[34]
+func (x *TestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2282,80 +2307,61 @@ func (x *TestCaseResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestCaseResult.ProtoReflect.Descriptor instead.
-func (*TestCaseResult) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *TestCaseResult) GetStatusCode() int32 {
-	if x != nil {
-		return x.StatusCode
-	}
-	return 0
+// Deprecated: Use TestResult.ProtoReflect.Descriptor instead.
+func (*TestResult) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *TestCaseResult) GetBody() string {
+func (x *TestResult) GetMessage() string {
 	if x != nil {
-		return x.Body
+		return x.Message
 	}
 	return ""
 }
 
-func (x *TestCaseResult) GetHeader() []*Pair {
-	if x != nil {
-		return x.Header
-	}
-	return nil
-}
-
-func (x *TestCaseResult) GetError() string {
+func (x *TestResult) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-func (x *TestCaseResult) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *TestCaseResult) GetOutput() string {
+func (x *TestResult) GetTestCaseResult() []*TestCaseResult {
 	if x != nil {
-		return x.Output
+		return x.TestCaseResult
 	}
-	return ""
+	return nil
 }
 
-type Pair struct {
+type HistoryTestResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value       string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Message        string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Error          string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	TestCaseResult []*TestCaseResult      `protobuf:"bytes,3,rep,name=testCaseResult,proto3" json:"testCaseResult,omitempty"`
+	Data           *HistoryTestCase       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createTime,proto3" json:"createTime,omitempty"`
 }
 
-func (x *Pair) Reset() {
-	*x = Pair{}
+func (x *HistoryTestResult) Reset() {
+	*x = HistoryTestResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[35]
+		mi := &file_pkg_server_server_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pair) String() string {
+func (x *HistoryTestResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pair) ProtoMessage() {}
+func (*HistoryTestResult) ProtoMessage() {}
 
-func (x *Pair) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[35]
+func (x *HistoryTestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2366,105 +2372,72 @@ func (x *Pair) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pair.ProtoReflect.Descriptor instead.
-func (*Pair) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use HistoryTestResult.ProtoReflect.Descriptor instead.
+func (*HistoryTestResult) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Pair) GetKey() string {
+func (x *HistoryTestResult) GetMessage() string {
 	if x != nil {
-		return x.Key
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Pair) GetValue() string {
+func (x *HistoryTestResult) GetError() string {
 	if x != nil {
-		return x.Value
+		return x.Error
 	}
 	return ""
 }
 
-func (x *Pair) GetDescription() string {
+func (x *HistoryTestResult) GetTestCaseResult() []*TestCaseResult {
 	if x != nil {
-		return x.Description
+		return x.TestCaseResult
 	}
-	return ""
+	return nil
 }
 
-type Pairs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Data []*Pair `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-}
-
-func (x *Pairs) Reset() {
-	*x = Pairs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[36]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Pairs) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Pairs) ProtoMessage() {}
-
-func (x *Pairs) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[36]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *HistoryTestResult) GetData() *HistoryTestCase {
+	if x != nil {
+		return x.Data
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Pairs.ProtoReflect.Descriptor instead.
-func (*Pairs) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{36}
+	return nil
 }
 
-func (x *Pairs) GetData() []*Pair {
+func (x *HistoryTestResult) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Data
+		return x.CreateTime
 	}
 	return nil
 }
 
-type SimpleQuery struct {
+type HelloReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *SimpleQuery) Reset() {
-	*x = SimpleQuery{}
+func (x *HelloReply) Reset() {
+	*x = HelloReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[37]
+		mi := &file_pkg_server_server_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SimpleQuery) String() string {
+func (x *HelloReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimpleQuery) ProtoMessage() {}
+func (*HelloReply) ProtoMessage() {}
 
-func (x *SimpleQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[37]
+func (x *HelloReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2475,50 +2448,50 @@ func (x *SimpleQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimpleQuery.ProtoReflect.Descriptor instead.
-func (*SimpleQuery) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use HelloReply.ProtoReflect.Descriptor instead.
+func (*HelloReply) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SimpleQuery) GetName() string {
+func (x *HelloReply) GetMessage() string {
 	if x != nil {
-		return x.Name
+		return x.Message
 	}
 	return ""
 }
 
-func (x *SimpleQuery) GetKind() string {
+func (x *HelloReply) GetError() string {
 	if x != nil {
-		return x.Kind
+		return x.Error
 	}
 	return ""
 }
 
-type Stores struct {
+type YamlData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*Store `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *Stores) Reset() {
-	*x = Stores{}
+func (x *YamlData) Reset() {
+	*x = YamlData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[38]
+		mi := &file_pkg_server_server_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Stores) String() string {
+func (x *YamlData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stores) ProtoMessage() {}
+func (*YamlData) ProtoMessage() {}
 
-func (x *Stores) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[38]
+func (x *YamlData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2529,53 +2502,45 @@ func (x *Stores) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stores.ProtoReflect.Descriptor instead.
-func (*Stores) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use YamlData.ProtoReflect.Descriptor instead.
+func (*YamlData) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Stores) GetData() []*Store {
+func (x *YamlData) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type Store struct {
+type Suite struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Owner       string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Description string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Url         string     `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	Username    string     `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
-	Password    string     `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
-	Properties  []*Pair    `protobuf:"bytes,7,rep,name=properties,proto3" json:"properties,omitempty"`
-	Kind        *StoreKind `protobuf:"bytes,8,opt,name=kind,proto3" json:"kind,omitempty"`
-	Ready       bool       `protobuf:"varint,9,opt,name=ready,proto3" json:"ready,omitempty"`
-	ReadOnly    bool       `protobuf:"varint,10,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
-	Disabled    bool       `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Api   string      `protobuf:"bytes,2,opt,name=api,proto3" json:"api,omitempty"`
+	Items []*TestCase `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *Store) Reset() {
-	*x = Store{}
+func (x *Suite) Reset() {
+	*x = Suite{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[39]
+		mi := &file_pkg_server_server_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Store) String() string {
+func (x *Suite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Store) ProtoMessage() {}
+func (*Suite) ProtoMessage() {}
 
-func (x *Store) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[39]
+func (x *Suite) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2586,113 +2551,112 @@ func (x *Store) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Store.ProtoReflect.Descriptor instead.
-func (*Store) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use Suite.ProtoReflect.Descriptor instead.
+func (*Suite) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *Store) GetName() string {
+func (x *Suite) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Store) GetOwner() string {
+func (x *Suite) GetApi() string {
 	if x != nil {
-		return x.Owner
+		return x.Api
 	}
 	return ""
 }
 
-func (x *Store) GetDescription() string {
+func (x *Suite) GetItems() []*TestCase {
 	if x != nil {
-		return x.Description
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *Store) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
+type TestCaseWithSuite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Store) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+	SuiteName string    `protobuf:"bytes,1,opt,name=suiteName,proto3" json:"suiteName,omitempty"`
+	Data      *TestCase `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *Store) GetPassword() string {
-	if x != nil {
-		return x.Password
+func (x *TestCaseWithSuite) Reset() {
+	*x = TestCaseWithSuite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Store) GetProperties() []*Pair {
-	if x != nil {
-		return x.Properties
-	}
-	return nil
+func (x *TestCaseWithSuite) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Store) GetKind() *StoreKind {
-	if x != nil {
-		return x.Kind
+func (*TestCaseWithSuite) ProtoMessage() {}
+
+func (x *TestCaseWithSuite) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Store) GetReady() bool {
-	if x != nil {
-		return x.Ready
-	}
-	return false
+// Deprecated: Use TestCaseWithSuite.ProtoReflect.Descriptor instead.
+func (*TestCaseWithSuite) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Store) GetReadOnly() bool {
+func (x *TestCaseWithSuite) GetSuiteName() string {
 	if x != nil {
-		return x.ReadOnly
+		return x.SuiteName
 	}
-	return false
+	return ""
 }
 
-func (x *Store) GetDisabled() bool {
+func (x *TestCaseWithSuite) GetData() *TestCase {
 	if x != nil {
-		return x.Disabled
+		return x.Data
 	}
-	return false
+	return nil
 }
 
-type StoreKinds struct {
+type TestCases struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*StoreKind `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data []*TestCase `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *StoreKinds) Reset() {
-	*x = StoreKinds{}
+func (x *TestCases) Reset() {
+	*x = TestCases{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[40]
+		mi := &file_pkg_server_server_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StoreKinds) String() string {
+func (x *TestCases) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreKinds) ProtoMessage() {}
+func (*TestCases) ProtoMessage() {}
 
-func (x *StoreKinds) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[40]
+func (x *TestCases) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2703,34 +2667,117 @@ func (x *StoreKinds) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreKinds.ProtoReflect.Descriptor instead.
-func (*StoreKinds) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use TestCases.ProtoReflect.Descriptor instead.
+func (*TestCases) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *StoreKinds) GetData() []*StoreKind {
+func (x *TestCases) GetData() []*TestCase {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type StoreKind struct {
+type TestCase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Url          string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Enabled      bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Dependencies []*StoreKindDependency `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
-	Link         string                 `protobuf:"bytes,5,opt,name=link,proto3" json:"link,omitempty"`
-	Params       []*StoreKindParam      `protobuf:"bytes,6,rep,name=params,proto3" json:"params,omitempty"`
-	Categories   []string               `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
-}
-
-func (x *StoreKind) Reset() {
-	*x = StoreKind{}
+	Name      string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SuiteName string    `protobuf:"bytes,2,opt,name=suiteName,proto3" json:"suiteName,omitempty"`
+	Request   *Request  `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	Response  *Response `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	Server    string    `protobuf:"bytes,5,opt,name=server,proto3" json:"server,omitempty"`
+}
+
+func (x *TestCase) Reset() {
+	*x = TestCase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCase) ProtoMessage() {}
+
+func (x *TestCase) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCase.ProtoReflect.Descriptor instead.
+func (*TestCase) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TestCase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestCase) GetSuiteName() string {
+	if x != nil {
+		return x.SuiteName
+	}
+	return ""
+}
+
+func (x *TestCase) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *TestCase) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TestCase) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+type HistoryTestCase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaseName         string                 `protobuf:"bytes,1,opt,name=caseName,proto3" json:"caseName,omitempty"`
+	SuiteName        string                 `protobuf:"bytes,2,opt,name=suiteName,proto3" json:"suiteName,omitempty"`
+	HistorySuiteName string                 `protobuf:"bytes,3,opt,name=historySuiteName,proto3" json:"historySuiteName,omitempty"`
+	CreateTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	SuiteParam       []*Pair                `protobuf:"bytes,5,rep,name=suiteParam,proto3" json:"suiteParam,omitempty"`
+	SuiteSpec        *APISpec               `protobuf:"bytes,6,opt,name=suiteSpec,proto3" json:"suiteSpec,omitempty"`
+	SuiteApi         string                 `protobuf:"bytes,7,opt,name=suiteApi,proto3" json:"suiteApi,omitempty"`
+	Request          *Request               `protobuf:"bytes,8,opt,name=request,proto3" json:"request,omitempty"`
+	Response         *Response              `protobuf:"bytes,9,opt,name=response,proto3" json:"response,omitempty"`
+	ID               string                 `protobuf:"bytes,10,opt,name=ID,proto3" json:"ID,omitempty"`
+	HistoryHeader    []*Pair                `protobuf:"bytes,11,rep,name=historyHeader,proto3" json:"historyHeader,omitempty"`
+}
+
+func (x *HistoryTestCase) Reset() {
+	*x = HistoryTestCase{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_server_server_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2738,13 +2785,13 @@ func (x *StoreKind) Reset() {
 	}
 }
 
-func (x *StoreKind) String() string {
+func (x *HistoryTestCase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreKind) ProtoMessage() {}
+func (*HistoryTestCase) ProtoMessage() {}
 
-func (x *StoreKind) ProtoReflect() protoreflect.Message {
+func (x *HistoryTestCase) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_server_server_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2756,70 +2803,98 @@ func (x *StoreKind) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreKind.ProtoReflect.Descriptor instead.
-func (*StoreKind) Descriptor() ([]byte, []int) {
+// Deprecated: Use HistoryTestCase.ProtoReflect.Descriptor instead.
+func (*HistoryTestCase) Descriptor() ([]byte, []int) {
 	return file_pkg_server_server_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *StoreKind) GetName() string {
+func (x *HistoryTestCase) GetCaseName() string {
 	if x != nil {
-		return x.Name
+		return x.CaseName
 	}
 	return ""
 }
 
-func (x *StoreKind) GetUrl() string {
+func (x *HistoryTestCase) GetSuiteName() string {
 	if x != nil {
-		return x.Url
+		return x.SuiteName
 	}
 	return ""
 }
 
-func (x *StoreKind) GetEnabled() bool {
+func (x *HistoryTestCase) GetHistorySuiteName() string {
 	if x != nil {
-		return x.Enabled
+		return x.HistorySuiteName
 	}
-	return false
+	return ""
 }
 
-func (x *StoreKind) GetDependencies() []*StoreKindDependency {
+func (x *HistoryTestCase) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Dependencies
+		return x.CreateTime
 	}
 	return nil
 }
 
-func (x *StoreKind) GetLink() string {
+func (x *HistoryTestCase) GetSuiteParam() []*Pair {
 	if x != nil {
-		return x.Link
+		return x.SuiteParam
+	}
+	return nil
+}
+
+func (x *HistoryTestCase) GetSuiteSpec() *APISpec {
+	if x != nil {
+		return x.SuiteSpec
+	}
+	return nil
+}
+
+func (x *HistoryTestCase) GetSuiteApi() string {
+	if x != nil {
+		return x.SuiteApi
 	}
 	return ""
 }
 
-func (x *StoreKind) GetParams() []*StoreKindParam {
+func (x *HistoryTestCase) GetRequest() *Request {
 	if x != nil {
-		return x.Params
+		return x.Request
 	}
 	return nil
 }
 
-func (x *StoreKind) GetCategories() []string {
+func (x *HistoryTestCase) GetResponse() *Response {
 	if x != nil {
-		return x.Categories
+		return x.Response
 	}
 	return nil
 }
 
-type StoreKindDependency struct {
+func (x *HistoryTestCase) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *HistoryTestCase) GetHistoryHeader() []*Pair {
+	if x != nil {
+		return x.HistoryHeader
+	}
+	return nil
+}
+
+type HistoryTestCases struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data []*HistoryTestCase `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *StoreKindDependency) Reset() {
-	*x = StoreKindDependency{}
+func (x *HistoryTestCases) Reset() {
+	*x = HistoryTestCases{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_server_server_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2827,13 +2902,13 @@ func (x *StoreKindDependency) Reset() {
 	}
 }
 
-func (x *StoreKindDependency) String() string {
+func (x *HistoryTestCases) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreKindDependency) ProtoMessage() {}
+func (*HistoryTestCases) ProtoMessage() {}
 
-func (x *StoreKindDependency) ProtoReflect() protoreflect.Message {
+func (x *HistoryTestCases) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_server_server_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2845,31 +2920,34 @@ func (x *StoreKindDependency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreKindDependency.ProtoReflect.Descriptor instead.
-func (*StoreKindDependency) Descriptor() ([]byte, []int) {
+// Deprecated: Use HistoryTestCases.ProtoReflect.Descriptor instead.
+func (*HistoryTestCases) Descriptor() ([]byte, []int) {
 	return file_pkg_server_server_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *StoreKindDependency) GetName() string {
+func (x *HistoryTestCases) GetData() []*HistoryTestCase {
 	if x != nil {
-		return x.Name
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type StoreKindParam struct {
+type Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key          string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Description  string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	DefaultValue string   `protobuf:"bytes,3,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
-	Enum         []string `protobuf:"bytes,4,rep,name=enum,proto3" json:"enum,omitempty"`
+	Api    string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Method string  `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Header []*Pair `protobuf:"bytes,3,rep,name=header,proto3" json:"header,omitempty"`
+	Query  []*Pair `protobuf:"bytes,4,rep,name=query,proto3" json:"query,omitempty"`
+	Cookie []*Pair `protobuf:"bytes,5,rep,name=cookie,proto3" json:"cookie,omitempty"`
+	Form   []*Pair `protobuf:"bytes,6,rep,name=form,proto3" json:"form,omitempty"`
+	Body   string  `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
 }
 
-func (x *StoreKindParam) Reset() {
-	*x = StoreKindParam{}
+func (x *Request) Reset() {
+	*x = Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_server_server_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2877,13 +2955,13 @@ func (x *StoreKindParam) Reset() {
 	}
 }
 
-func (x *StoreKindParam) String() string {
+func (x *Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreKindParam) ProtoMessage() {}
+func (*Request) ProtoMessage() {}
 
-func (x *StoreKindParam) ProtoReflect() protoreflect.Message {
+func (x *Request) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_server_server_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2895,65 +2973,1697 @@ func (x *StoreKindParam) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreKindParam.ProtoReflect.Descriptor instead.
-func (*StoreKindParam) Descriptor() ([]byte, []int) {
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
 	return file_pkg_server_server_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *StoreKindParam) GetKey() string {
+func (x *Request) GetApi() string {
 	if x != nil {
-		return x.Key
+		return x.Api
 	}
 	return ""
 }
 
-func (x *StoreKindParam) GetDescription() string {
+func (x *Request) GetMethod() string {
 	if x != nil {
-		return x.Description
+		return x.Method
 	}
 	return ""
 }
 
-func (x *StoreKindParam) GetDefaultValue() string {
-	if x != nil {
-		return x.DefaultValue
-	}
-	return ""
+func (x *Request) GetHeader() []*Pair {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Request) GetQuery() []*Pair {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *Request) GetCookie() []*Pair {
+	if x != nil {
+		return x.Cookie
+	}
+	return nil
+}
+
+func (x *Request) GetForm() []*Pair {
+	if x != nil {
+		return x.Form
+	}
+	return nil
+}
+
+func (x *Request) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode        int32                `protobuf:"varint,1,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	Body              string               `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Header            []*Pair              `protobuf:"bytes,3,rep,name=header,proto3" json:"header,omitempty"`
+	BodyFieldsExpect  []*Pair              `protobuf:"bytes,4,rep,name=bodyFieldsExpect,proto3" json:"bodyFieldsExpect,omitempty"`
+	Verify            []string             `protobuf:"bytes,5,rep,name=verify,proto3" json:"verify,omitempty"`
+	ConditionalVerify []*ConditionalVerify `protobuf:"bytes,6,rep,name=ConditionalVerify,proto3" json:"ConditionalVerify,omitempty"`
+	Schema            string               `protobuf:"bytes,7,opt,name=schema,proto3" json:"schema,omitempty"`
+	BodyMatch         *BodyMatch           `protobuf:"bytes,8,opt,name=bodyMatch,proto3" json:"bodyMatch,omitempty"`
+	Snapshot          *Snapshot            `protobuf:"bytes,9,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	MaxDuration       string               `protobuf:"bytes,10,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`
+	MaxBodySize       int64                `protobuf:"varint,11,opt,name=maxBodySize,proto3" json:"maxBodySize,omitempty"`
+	MinBodySize       int64                `protobuf:"varint,12,opt,name=minBodySize,proto3" json:"minBodySize,omitempty"`
+	Query             []*QueryAssertion    `protobuf:"bytes,13,rep,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Response) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *Response) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Response) GetHeader() []*Pair {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Response) GetBodyFieldsExpect() []*Pair {
+	if x != nil {
+		return x.BodyFieldsExpect
+	}
+	return nil
+}
+
+func (x *Response) GetVerify() []string {
+	if x != nil {
+		return x.Verify
+	}
+	return nil
+}
+
+func (x *Response) GetConditionalVerify() []*ConditionalVerify {
+	if x != nil {
+		return x.ConditionalVerify
+	}
+	return nil
+}
+
+func (x *Response) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *Response) GetBodyMatch() *BodyMatch {
+	if x != nil {
+		return x.BodyMatch
+	}
+	return nil
+}
+
+func (x *Response) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *Response) GetMaxDuration() string {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return ""
+}
+
+func (x *Response) GetMaxBodySize() int64 {
+	if x != nil {
+		return x.MaxBodySize
+	}
+	return 0
+}
+
+func (x *Response) GetMinBodySize() int64 {
+	if x != nil {
+		return x.MinBodySize
+	}
+	return 0
+}
+
+func (x *Response) GetQuery() []*QueryAssertion {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+// QueryAssertion asserts the result of a JSONPath or JMESPath query, equal and contains are JSON values
+type QueryAssertion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jsonpath string   `protobuf:"bytes,1,opt,name=jsonpath,proto3" json:"jsonpath,omitempty"`
+	Jmespath string   `protobuf:"bytes,2,opt,name=jmespath,proto3" json:"jmespath,omitempty"`
+	Match    string   `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	Count    *int32   `protobuf:"varint,4,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Equal    string   `protobuf:"bytes,5,opt,name=equal,proto3" json:"equal,omitempty"`
+	Contains string   `protobuf:"bytes,6,opt,name=contains,proto3" json:"contains,omitempty"`
+	Type     string   `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	Regex    string   `protobuf:"bytes,8,opt,name=regex,proto3" json:"regex,omitempty"`
+	Gt       *float64 `protobuf:"fixed64,9,opt,name=gt,proto3,oneof" json:"gt,omitempty"`
+	Gte      *float64 `protobuf:"fixed64,10,opt,name=gte,proto3,oneof" json:"gte,omitempty"`
+	Lt       *float64 `protobuf:"fixed64,11,opt,name=lt,proto3,oneof" json:"lt,omitempty"`
+	Lte      *float64 `protobuf:"fixed64,12,opt,name=lte,proto3,oneof" json:"lte,omitempty"`
+}
+
+func (x *QueryAssertion) Reset() {
+	*x = QueryAssertion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAssertion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAssertion) ProtoMessage() {}
+
+func (x *QueryAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAssertion.ProtoReflect.Descriptor instead.
+func (*QueryAssertion) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *QueryAssertion) GetJsonpath() string {
+	if x != nil {
+		return x.Jsonpath
+	}
+	return ""
+}
+
+func (x *QueryAssertion) GetJmespath() string {
+	if x != nil {
+		return x.Jmespath
+	}
+	return ""
+}
+
+func (x *QueryAssertion) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *QueryAssertion) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *QueryAssertion) GetEqual() string {
+	if x != nil {
+		return x.Equal
+	}
+	return ""
+}
+
+func (x *QueryAssertion) GetContains() string {
+	if x != nil {
+		return x.Contains
+	}
+	return ""
+}
+
+func (x *QueryAssertion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QueryAssertion) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *QueryAssertion) GetGt() float64 {
+	if x != nil && x.Gt != nil {
+		return *x.Gt
+	}
+	return 0
+}
+
+func (x *QueryAssertion) GetGte() float64 {
+	if x != nil && x.Gte != nil {
+		return *x.Gte
+	}
+	return 0
+}
+
+func (x *QueryAssertion) GetLt() float64 {
+	if x != nil && x.Lt != nil {
+		return *x.Lt
+	}
+	return 0
+}
+
+func (x *QueryAssertion) GetLte() float64 {
+	if x != nil && x.Lte != nil {
+		return *x.Lte
+	}
+	return 0
+}
+
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Header []string `protobuf:"bytes,2,rep,name=header,proto3" json:"header,omitempty"`
+	Redact []string `protobuf:"bytes,3,rep,name=redact,proto3" json:"redact,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Snapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Snapshot) GetHeader() []string {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Snapshot) GetRedact() []string {
+	if x != nil {
+		return x.Redact
+	}
+	return nil
+}
+
+type BodyMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Body      string   `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	Ignore    []string `protobuf:"bytes,2,rep,name=ignore,proto3" json:"ignore,omitempty"`
+	Unordered []string `protobuf:"bytes,3,rep,name=unordered,proto3" json:"unordered,omitempty"`
+	Tolerance float64  `protobuf:"fixed64,4,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	Subset    bool     `protobuf:"varint,5,opt,name=subset,proto3" json:"subset,omitempty"`
+}
+
+func (x *BodyMatch) Reset() {
+	*x = BodyMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BodyMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodyMatch) ProtoMessage() {}
+
+func (x *BodyMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodyMatch.ProtoReflect.Descriptor instead.
+func (*BodyMatch) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BodyMatch) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *BodyMatch) GetIgnore() []string {
+	if x != nil {
+		return x.Ignore
+	}
+	return nil
+}
+
+func (x *BodyMatch) GetUnordered() []string {
+	if x != nil {
+		return x.Unordered
+	}
+	return nil
+}
+
+func (x *BodyMatch) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *BodyMatch) GetSubset() bool {
+	if x != nil {
+		return x.Subset
+	}
+	return false
+}
+
+type ConditionalVerify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Condition []string `protobuf:"bytes,1,rep,name=condition,proto3" json:"condition,omitempty"`
+	Verify    []string `protobuf:"bytes,2,rep,name=verify,proto3" json:"verify,omitempty"`
+}
+
+func (x *ConditionalVerify) Reset() {
+	*x = ConditionalVerify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConditionalVerify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionalVerify) ProtoMessage() {}
+
+func (x *ConditionalVerify) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionalVerify.ProtoReflect.Descriptor instead.
+func (*ConditionalVerify) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ConditionalVerify) GetCondition() []string {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *ConditionalVerify) GetVerify() []string {
+	if x != nil {
+		return x.Verify
+	}
+	return nil
+}
+
+type TestCaseResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32   `protobuf:"varint,1,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	Body       string  `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Header     []*Pair `protobuf:"bytes,3,rep,name=header,proto3" json:"header,omitempty"`
+	Error      string  `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Id         string  `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Output     string  `protobuf:"bytes,6,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *TestCaseResult) Reset() {
+	*x = TestCaseResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestCaseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCaseResult) ProtoMessage() {}
+
+func (x *TestCaseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCaseResult.ProtoReflect.Descriptor instead.
+func (*TestCaseResult) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TestCaseResult) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *TestCaseResult) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *TestCaseResult) GetHeader() []*Pair {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TestCaseResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TestCaseResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TestCaseResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type Pair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value       string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Pair) Reset() {
+	*x = Pair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pair) ProtoMessage() {}
+
+func (x *Pair) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pair.ProtoReflect.Descriptor instead.
+func (*Pair) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Pair) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Pair) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Pair) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type Pairs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Pair `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Pairs) Reset() {
+	*x = Pairs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pairs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pairs) ProtoMessage() {}
+
+func (x *Pairs) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pairs.ProtoReflect.Descriptor instead.
+func (*Pairs) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Pairs) GetData() []*Pair {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UserDefinedFunction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Render      string   `protobuf:"bytes,2,opt,name=render,proto3" json:"render,omitempty"`
+	Params      []string `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *UserDefinedFunction) Reset() {
+	*x = UserDefinedFunction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDefinedFunction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDefinedFunction) ProtoMessage() {}
+
+func (x *UserDefinedFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDefinedFunction.ProtoReflect.Descriptor instead.
+func (*UserDefinedFunction) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UserDefinedFunction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserDefinedFunction) GetRender() string {
+	if x != nil {
+		return x.Render
+	}
+	return ""
+}
+
+func (x *UserDefinedFunction) GetParams() []string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *UserDefinedFunction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UserDefinedFunctions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*UserDefinedFunction `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UserDefinedFunctions) Reset() {
+	*x = UserDefinedFunctions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDefinedFunctions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDefinedFunctions) ProtoMessage() {}
+
+func (x *UserDefinedFunctions) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDefinedFunctions.ProtoReflect.Descriptor instead.
+func (*UserDefinedFunctions) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UserDefinedFunctions) GetData() []*UserDefinedFunction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SimpleQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *SimpleQuery) Reset() {
+	*x = SimpleQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimpleQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimpleQuery) ProtoMessage() {}
+
+func (x *SimpleQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimpleQuery.ProtoReflect.Descriptor instead.
+func (*SimpleQuery) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SimpleQuery) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SimpleQuery) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type StoreSyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pull bool   `protobuf:"varint,2,opt,name=pull,proto3" json:"pull,omitempty"`
+	Push bool   `protobuf:"varint,3,opt,name=push,proto3" json:"push,omitempty"`
+}
+
+func (x *StoreSyncRequest) Reset() {
+	*x = StoreSyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreSyncRequest) ProtoMessage() {}
+
+func (x *StoreSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreSyncRequest.ProtoReflect.Descriptor instead.
+func (*StoreSyncRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *StoreSyncRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoreSyncRequest) GetPull() bool {
+	if x != nil {
+		return x.Pull
+	}
+	return false
+}
+
+func (x *StoreSyncRequest) GetPush() bool {
+	if x != nil {
+		return x.Push
+	}
+	return false
+}
+
+type Stores struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Store `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Stores) Reset() {
+	*x = Stores{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stores) ProtoMessage() {}
+
+func (x *Stores) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stores.ProtoReflect.Descriptor instead.
+func (*Stores) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Stores) GetData() []*Store {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Store struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner       string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Description string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Url         string     `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Username    string     `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Password    string     `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	Properties  []*Pair    `protobuf:"bytes,7,rep,name=properties,proto3" json:"properties,omitempty"`
+	Kind        *StoreKind `protobuf:"bytes,8,opt,name=kind,proto3" json:"kind,omitempty"`
+	Ready       bool       `protobuf:"varint,9,opt,name=ready,proto3" json:"ready,omitempty"`
+	ReadOnly    bool       `protobuf:"varint,10,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	Disabled    bool       `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *Store) Reset() {
+	*x = Store{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Store) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Store) ProtoMessage() {}
+
+func (x *Store) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Store.ProtoReflect.Descriptor instead.
+func (*Store) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Store) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Store) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Store) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Store) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Store) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Store) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Store) GetProperties() []*Pair {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *Store) GetKind() *StoreKind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Store) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Store) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *Store) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type StoreKinds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*StoreKind `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *StoreKinds) Reset() {
+	*x = StoreKinds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreKinds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreKinds) ProtoMessage() {}
+
+func (x *StoreKinds) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreKinds.ProtoReflect.Descriptor instead.
+func (*StoreKinds) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *StoreKinds) GetData() []*StoreKind {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StoreKind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url          string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Enabled      bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Dependencies []*StoreKindDependency `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Link         string                 `protobuf:"bytes,5,opt,name=link,proto3" json:"link,omitempty"`
+	Params       []*StoreKindParam      `protobuf:"bytes,6,rep,name=params,proto3" json:"params,omitempty"`
+	Categories   []string               `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+}
+
+func (x *StoreKind) Reset() {
+	*x = StoreKind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreKind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreKind) ProtoMessage() {}
+
+func (x *StoreKind) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreKind.ProtoReflect.Descriptor instead.
+func (*StoreKind) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *StoreKind) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoreKind) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *StoreKind) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *StoreKind) GetDependencies() []*StoreKindDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *StoreKind) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *StoreKind) GetParams() []*StoreKindParam {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *StoreKind) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type StoreKindDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *StoreKindDependency) Reset() {
+	*x = StoreKindDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreKindDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreKindDependency) ProtoMessage() {}
+
+func (x *StoreKindDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreKindDependency.ProtoReflect.Descriptor instead.
+func (*StoreKindDependency) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *StoreKindDependency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StoreKindParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key          string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Description  string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DefaultValue string   `protobuf:"bytes,3,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
+	Enum         []string `protobuf:"bytes,4,rep,name=enum,proto3" json:"enum,omitempty"`
+}
+
+func (x *StoreKindParam) Reset() {
+	*x = StoreKindParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreKindParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreKindParam) ProtoMessage() {}
+
+func (x *StoreKindParam) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreKindParam.ProtoReflect.Descriptor instead.
+func (*StoreKindParam) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *StoreKindParam) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StoreKindParam) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StoreKindParam) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *StoreKindParam) GetEnum() []string {
+	if x != nil {
+		return x.Enum
+	}
+	return nil
+}
+
+type CommonResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CommonResult) Reset() {
+	*x = CommonResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommonResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommonResult) ProtoMessage() {}
+
+func (x *CommonResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommonResult.ProtoReflect.Descriptor instead.
+func (*CommonResult) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CommonResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CommonResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SimpleList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Pair `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SimpleList) Reset() {
+	*x = SimpleList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimpleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimpleList) ProtoMessage() {}
+
+func (x *SimpleList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimpleList.ProtoReflect.Descriptor instead.
+func (*SimpleList) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SimpleList) GetData() []*Pair {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SimpleName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SimpleName) Reset() {
+	*x = SimpleName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimpleName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimpleName) ProtoMessage() {}
+
+func (x *SimpleName) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimpleName.ProtoReflect.Descriptor instead.
+func (*SimpleName) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SimpleName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CodeGenerateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestSuite string `protobuf:"bytes,1,opt,name=TestSuite,proto3" json:"TestSuite,omitempty"`
+	TestCase  string `protobuf:"bytes,2,opt,name=TestCase,proto3" json:"TestCase,omitempty"`
+	Generator string `protobuf:"bytes,3,opt,name=Generator,proto3" json:"Generator,omitempty"`
+	ID        string `protobuf:"bytes,4,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *CodeGenerateRequest) Reset() {
+	*x = CodeGenerateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodeGenerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeGenerateRequest) ProtoMessage() {}
+
+func (x *CodeGenerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeGenerateRequest.ProtoReflect.Descriptor instead.
+func (*CodeGenerateRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CodeGenerateRequest) GetTestSuite() string {
+	if x != nil {
+		return x.TestSuite
+	}
+	return ""
+}
+
+func (x *CodeGenerateRequest) GetTestCase() string {
+	if x != nil {
+		return x.TestCase
+	}
+	return ""
+}
+
+func (x *CodeGenerateRequest) GetGenerator() string {
+	if x != nil {
+		return x.Generator
+	}
+	return ""
+}
+
+func (x *CodeGenerateRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type Secrets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Secret `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Secrets) Reset() {
+	*x = Secrets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secrets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secrets) ProtoMessage() {}
+
+func (x *Secrets) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secrets.ProtoReflect.Descriptor instead.
+func (*Secrets) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *StoreKindParam) GetEnum() []string {
+func (x *Secrets) GetData() []*Secret {
 	if x != nil {
-		return x.Enum
+		return x.Data
 	}
 	return nil
 }
 
-type CommonResult struct {
+type Secret struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Value       string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
 }
 
-func (x *CommonResult) Reset() {
-	*x = CommonResult{}
+func (x *Secret) Reset() {
+	*x = Secret{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[44]
+		mi := &file_pkg_server_server_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommonResult) String() string {
+func (x *Secret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommonResult) ProtoMessage() {}
+func (*Secret) ProtoMessage() {}
 
-func (x *CommonResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[44]
+func (x *Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2964,50 +4674,57 @@ func (x *CommonResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommonResult.ProtoReflect.Descriptor instead.
-func (*CommonResult) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CommonResult) GetSuccess() bool {
+func (x *Secret) GetName() string {
 	if x != nil {
-		return x.Success
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *CommonResult) GetMessage() string {
+func (x *Secret) GetValue() string {
 	if x != nil {
-		return x.Message
+		return x.Value
 	}
 	return ""
 }
 
-type SimpleList struct {
+func (x *Secret) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type RoleBindings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*Pair `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Data []*RoleBinding `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *SimpleList) Reset() {
-	*x = SimpleList{}
+func (x *RoleBindings) Reset() {
+	*x = RoleBindings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[45]
+		mi := &file_pkg_server_server_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SimpleList) String() string {
+func (x *RoleBindings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimpleList) ProtoMessage() {}
+func (*RoleBindings) ProtoMessage() {}
 
-func (x *SimpleList) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[45]
+func (x *RoleBindings) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3018,43 +4735,48 @@ func (x *SimpleList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimpleList.ProtoReflect.Descriptor instead.
-func (*SimpleList) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use RoleBindings.ProtoReflect.Descriptor instead.
+func (*RoleBindings) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *SimpleList) GetData() []*Pair {
+func (x *RoleBindings) GetData() []*RoleBinding {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type SimpleName struct {
+type RoleBinding struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role   string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Users  []string `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	Groups []string `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+	Store  string   `protobuf:"bytes,5,opt,name=store,proto3" json:"store,omitempty"`
+	Suite  string   `protobuf:"bytes,6,opt,name=suite,proto3" json:"suite,omitempty"`
 }
 
-func (x *SimpleName) Reset() {
-	*x = SimpleName{}
+func (x *RoleBinding) Reset() {
+	*x = RoleBinding{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[46]
+		mi := &file_pkg_server_server_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SimpleName) String() string {
+func (x *RoleBinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimpleName) ProtoMessage() {}
+func (*RoleBinding) ProtoMessage() {}
 
-func (x *SimpleName) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[46]
+func (x *RoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3065,46 +4787,88 @@ func (x *SimpleName) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimpleName.ProtoReflect.Descriptor instead.
-func (*SimpleName) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use RoleBinding.ProtoReflect.Descriptor instead.
+func (*RoleBinding) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *SimpleName) GetName() string {
+func (x *RoleBinding) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type CodeGenerateRequest struct {
+func (x *RoleBinding) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *RoleBinding) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *RoleBinding) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetSuite() string {
+	if x != nil {
+		return x.Suite
+	}
+	return ""
+}
+
+type AuditEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TestSuite string `protobuf:"bytes,1,opt,name=TestSuite,proto3" json:"TestSuite,omitempty"`
-	TestCase  string `protobuf:"bytes,2,opt,name=TestCase,proto3" json:"TestCase,omitempty"`
-	Generator string `protobuf:"bytes,3,opt,name=Generator,proto3" json:"Generator,omitempty"`
-	ID        string `protobuf:"bytes,4,opt,name=ID,proto3" json:"ID,omitempty"`
-}
-
-func (x *CodeGenerateRequest) Reset() {
-	*x = CodeGenerateRequest{}
+	Time     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	User     string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Method   string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Kind     string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Store    string                 `protobuf:"bytes,5,opt,name=store,proto3" json:"store,omitempty"`
+	Suite    string                 `protobuf:"bytes,6,opt,name=suite,proto3" json:"suite,omitempty"`
+	Testcase string                 `protobuf:"bytes,7,opt,name=testcase,proto3" json:"testcase,omitempty"`
+	Name     string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	Summary  string                 `protobuf:"bytes,9,opt,name=summary,proto3" json:"summary,omitempty"`
+	Success  bool                   `protobuf:"varint,10,opt,name=success,proto3" json:"success,omitempty"`
+	Error    string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[47]
+		mi := &file_pkg_server_server_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CodeGenerateRequest) String() string {
+func (x *AuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CodeGenerateRequest) ProtoMessage() {}
+func (*AuditEntry) ProtoMessage() {}
 
-func (x *CodeGenerateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[47]
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3115,113 +4879,120 @@ func (x *CodeGenerateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CodeGenerateRequest.ProtoReflect.Descriptor instead.
-func (*CodeGenerateRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CodeGenerateRequest) GetTestSuite() string {
+func (x *AuditEntry) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TestSuite
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-func (x *CodeGenerateRequest) GetTestCase() string {
+func (x *AuditEntry) GetUser() string {
 	if x != nil {
-		return x.TestCase
+		return x.User
 	}
 	return ""
 }
 
-func (x *CodeGenerateRequest) GetGenerator() string {
+func (x *AuditEntry) GetMethod() string {
 	if x != nil {
-		return x.Generator
+		return x.Method
 	}
 	return ""
 }
 
-func (x *CodeGenerateRequest) GetID() string {
+func (x *AuditEntry) GetKind() string {
 	if x != nil {
-		return x.ID
+		return x.Kind
 	}
 	return ""
 }
 
-type Secrets struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Data []*Secret `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+func (x *AuditEntry) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
 }
 
-func (x *Secrets) Reset() {
-	*x = Secrets{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[48]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AuditEntry) GetSuite() string {
+	if x != nil {
+		return x.Suite
 	}
+	return ""
 }
 
-func (x *Secrets) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditEntry) GetTestcase() string {
+	if x != nil {
+		return x.Testcase
+	}
+	return ""
 }
 
-func (*Secrets) ProtoMessage() {}
+func (x *AuditEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-func (x *Secrets) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[48]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AuditEntry) GetSummary() string {
+	if x != nil {
+		return x.Summary
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Secrets.ProtoReflect.Descriptor instead.
-func (*Secrets) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{48}
+func (x *AuditEntry) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
 }
 
-func (x *Secrets) GetData() []*Secret {
+func (x *AuditEntry) GetError() string {
 	if x != nil {
-		return x.Data
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type Secret struct {
+type AuditQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Value       string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	User   string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Method string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Kind   string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Store  string                 `protobuf:"bytes,4,opt,name=store,proto3" json:"store,omitempty"`
+	Suite  string                 `protobuf:"bytes,5,opt,name=suite,proto3" json:"suite,omitempty"`
+	Since  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	Until  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3" json:"until,omitempty"`
+	Limit  int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *Secret) Reset() {
-	*x = Secret{}
+func (x *AuditQuery) Reset() {
+	*x = AuditQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[49]
+		mi := &file_pkg_server_server_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Secret) String() string {
+func (x *AuditQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Secret) ProtoMessage() {}
+func (*AuditQuery) ProtoMessage() {}
 
-func (x *Secret) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[49]
+func (x *AuditQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3232,30 +5003,112 @@ func (x *Secret) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
-func (*Secret) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use AuditQuery.ProtoReflect.Descriptor instead.
+func (*AuditQuery) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *Secret) GetName() string {
+func (x *AuditQuery) GetUser() string {
 	if x != nil {
-		return x.Name
+		return x.User
+	}
+	return ""
+}
+
+func (x *AuditQuery) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditQuery) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AuditQuery) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
+}
+
+func (x *AuditQuery) GetSuite() string {
+	if x != nil {
+		return x.Suite
 	}
 	return ""
 }
 
-func (x *Secret) GetValue() string {
-	if x != nil {
-		return x.Value
+func (x *AuditQuery) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *AuditQuery) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *AuditQuery) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AuditEntries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*AuditEntry `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *AuditEntries) Reset() {
+	*x = AuditEntries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_server_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntries) ProtoMessage() {}
+
+func (x *AuditEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_server_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Secret) GetDescription() string {
+// Deprecated: Use AuditEntries.ProtoReflect.Descriptor instead.
+func (*AuditEntries) Descriptor() ([]byte, []int) {
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AuditEntries) GetData() []*AuditEntry {
 	if x != nil {
-		return x.Description
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
 type ExtensionStatus struct {
@@ -3272,7 +5125,7 @@ type ExtensionStatus struct {
 func (x *ExtensionStatus) Reset() {
 	*x = ExtensionStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[50]
+		mi := &file_pkg_server_server_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3285,7 +5138,7 @@ func (x *ExtensionStatus) String() string {
 func (*ExtensionStatus) ProtoMessage() {}
 
 func (x *ExtensionStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[50]
+	mi := &file_pkg_server_server_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3298,7 +5151,7 @@ func (x *ExtensionStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtensionStatus.ProtoReflect.Descriptor instead.
 func (*ExtensionStatus) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{50}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ExtensionStatus) GetReady() bool {
@@ -3340,7 +5193,7 @@ type PProfRequest struct {
 func (x *PProfRequest) Reset() {
 	*x = PProfRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[51]
+		mi := &file_pkg_server_server_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3353,7 +5206,7 @@ func (x *PProfRequest) String() string {
 func (*PProfRequest) ProtoMessage() {}
 
 func (x *PProfRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[51]
+	mi := &file_pkg_server_server_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3366,7 +5219,7 @@ func (x *PProfRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PProfRequest.ProtoReflect.Descriptor instead.
 func (*PProfRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{51}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *PProfRequest) GetName() string {
@@ -3387,7 +5240,7 @@ type PProfData struct {
 func (x *PProfData) Reset() {
 	*x = PProfData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[52]
+		mi := &file_pkg_server_server_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3400,7 +5253,7 @@ func (x *PProfData) String() string {
 func (*PProfData) ProtoMessage() {}
 
 func (x *PProfData) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[52]
+	mi := &file_pkg_server_server_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3413,7 +5266,7 @@ func (x *PProfData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PProfData.ProtoReflect.Descriptor instead.
 func (*PProfData) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{52}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *PProfData) GetData() []byte {
@@ -3436,7 +5289,7 @@ type FileData struct {
 func (x *FileData) Reset() {
 	*x = FileData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[53]
+		mi := &file_pkg_server_server_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3449,7 +5302,7 @@ func (x *FileData) String() string {
 func (*FileData) ProtoMessage() {}
 
 func (x *FileData) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[53]
+	mi := &file_pkg_server_server_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3462,7 +5315,7 @@ func (x *FileData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileData.ProtoReflect.Descriptor instead.
 func (*FileData) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{53}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *FileData) GetData() []byte {
@@ -3495,7 +5348,7 @@ type Empty struct {
 func (x *Empty) Reset() {
 	*x = Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[54]
+		mi := &file_pkg_server_server_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3508,7 +5361,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[54]
+	mi := &file_pkg_server_server_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3521,7 +5374,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{54}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{77}
 }
 
 type MockConfig struct {
@@ -3541,7 +5394,7 @@ type MockConfig struct {
 func (x *MockConfig) Reset() {
 	*x = MockConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[55]
+		mi := &file_pkg_server_server_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3554,7 +5407,7 @@ func (x *MockConfig) String() string {
 func (*MockConfig) ProtoMessage() {}
 
 func (x *MockConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[55]
+	mi := &file_pkg_server_server_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3567,7 +5420,7 @@ func (x *MockConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MockConfig.ProtoReflect.Descriptor instead.
 func (*MockConfig) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{55}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *MockConfig) GetPrefix() string {
@@ -3632,7 +5485,7 @@ type Version struct {
 func (x *Version) Reset() {
 	*x = Version{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[56]
+		mi := &file_pkg_server_server_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3645,7 +5498,7 @@ func (x *Version) String() string {
 func (*Version) ProtoMessage() {}
 
 func (x *Version) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[56]
+	mi := &file_pkg_server_server_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3658,7 +5511,7 @@ func (x *Version) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Version.ProtoReflect.Descriptor instead.
 func (*Version) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{56}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *Version) GetVersion() string {
@@ -3695,7 +5548,7 @@ type ProxyConfig struct {
 func (x *ProxyConfig) Reset() {
 	*x = ProxyConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[57]
+		mi := &file_pkg_server_server_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3708,7 +5561,7 @@ func (x *ProxyConfig) String() string {
 func (*ProxyConfig) ProtoMessage() {}
 
 func (x *ProxyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[57]
+	mi := &file_pkg_server_server_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3721,7 +5574,7 @@ func (x *ProxyConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyConfig.ProtoReflect.Descriptor instead.
 func (*ProxyConfig) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{57}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *ProxyConfig) GetHttp() string {
@@ -3760,7 +5613,7 @@ type DataQuery struct {
 func (x *DataQuery) Reset() {
 	*x = DataQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[58]
+		mi := &file_pkg_server_server_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3773,7 +5626,7 @@ func (x *DataQuery) String() string {
 func (*DataQuery) ProtoMessage() {}
 
 func (x *DataQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[58]
+	mi := &file_pkg_server_server_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3786,7 +5639,7 @@ func (x *DataQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataQuery.ProtoReflect.Descriptor instead.
 func (*DataQuery) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{58}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *DataQuery) GetType() string {
@@ -3837,7 +5690,7 @@ type DataQueryResult struct {
 func (x *DataQueryResult) Reset() {
 	*x = DataQueryResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[59]
+		mi := &file_pkg_server_server_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3850,7 +5703,7 @@ func (x *DataQueryResult) String() string {
 func (*DataQueryResult) ProtoMessage() {}
 
 func (x *DataQueryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[59]
+	mi := &file_pkg_server_server_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3863,7 +5716,7 @@ func (x *DataQueryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataQueryResult.ProtoReflect.Descriptor instead.
 func (*DataQueryResult) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{59}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *DataQueryResult) GetData() []*Pair {
@@ -3902,7 +5755,7 @@ type DataMeta struct {
 func (x *DataMeta) Reset() {
 	*x = DataMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[60]
+		mi := &file_pkg_server_server_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3915,7 +5768,7 @@ func (x *DataMeta) String() string {
 func (*DataMeta) ProtoMessage() {}
 
 func (x *DataMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[60]
+	mi := &file_pkg_server_server_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3928,7 +5781,7 @@ func (x *DataMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataMeta.ProtoReflect.Descriptor instead.
 func (*DataMeta) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{60}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *DataMeta) GetDatabases() []string {
@@ -3981,7 +5834,7 @@ type AIRequest struct {
 func (x *AIRequest) Reset() {
 	*x = AIRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[61]
+		mi := &file_pkg_server_server_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3994,7 +5847,7 @@ func (x *AIRequest) String() string {
 func (*AIRequest) ProtoMessage() {}
 
 func (x *AIRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[61]
+	mi := &file_pkg_server_server_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4007,7 +5860,7 @@ func (x *AIRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AIRequest.ProtoReflect.Descriptor instead.
 func (*AIRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{61}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *AIRequest) GetPluginName() string {
@@ -4052,7 +5905,7 @@ type AIResponse struct {
 func (x *AIResponse) Reset() {
 	*x = AIResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[62]
+		mi := &file_pkg_server_server_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4065,7 +5918,7 @@ func (x *AIResponse) String() string {
 func (*AIResponse) ProtoMessage() {}
 
 func (x *AIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[62]
+	mi := &file_pkg_server_server_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4078,7 +5931,7 @@ func (x *AIResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AIResponse.ProtoReflect.Descriptor instead.
 func (*AIResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{62}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *AIResponse) GetContent() string {
@@ -4120,7 +5973,7 @@ type AICapabilitiesRequest struct {
 func (x *AICapabilitiesRequest) Reset() {
 	*x = AICapabilitiesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[63]
+		mi := &file_pkg_server_server_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4133,7 +5986,7 @@ func (x *AICapabilitiesRequest) String() string {
 func (*AICapabilitiesRequest) ProtoMessage() {}
 
 func (x *AICapabilitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[63]
+	mi := &file_pkg_server_server_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4146,7 +5999,7 @@ func (x *AICapabilitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AICapabilitiesRequest.ProtoReflect.Descriptor instead.
 func (*AICapabilitiesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{63}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *AICapabilitiesRequest) GetPluginName() string {
@@ -4172,7 +6025,7 @@ type AICapabilitiesResponse struct {
 func (x *AICapabilitiesResponse) Reset() {
 	*x = AICapabilitiesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_server_proto_msgTypes[64]
+		mi := &file_pkg_server_server_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4185,7 +6038,7 @@ func (x *AICapabilitiesResponse) String() string {
 func (*AICapabilitiesResponse) ProtoMessage() {}
 
 func (x *AICapabilitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_server_proto_msgTypes[64]
+	mi := &file_pkg_server_server_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4198,7 +6051,7 @@ func (x *AICapabilitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AICapabilitiesResponse.ProtoReflect.Descriptor instead.
 func (*AICapabilitiesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_server_server_proto_rawDescGZIP(), []int{64}
+	return file_pkg_server_server_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *AICapabilitiesResponse) GetModels() []string {
@@ -4252,89 +6105,156 @@ var file_pkg_server_server_proto_rawDesc = []byte{
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
 	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a,
-	0x04, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
-	0x63, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a,
-	0x08, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7e, 0x0a, 0x06, 0x53,
-	0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x69,
-	0x74, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x1a, 0x46, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x05, 0x49,
-	0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x93, 0x01, 0x0a,
-	0x0d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x33,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69,
-	0x74, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x1a, 0x4d, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x3f, 0x0a, 0x0c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x97, 0x01, 0x0a, 0x13, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43,
-	0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x12, 0x2a, 0x0a,
-	0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x72, 0x0a,
-	0x10, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63,
-	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63,
-	0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x22, 0x4b, 0x0a, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa5,
-	0x01, 0x0a, 0x09, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61,
-	0x70, 0x69, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52,
-	0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x23, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x50,
-	0x49, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x29, 0x0a, 0x05, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x22, 0x62, 0x0a, 0x11, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75,
-	0x69, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x43, 0x61, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x73,
-	0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x05, 0x73,
-	0x75, 0x69, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74,
-	0x43, 0x61, 0x73, 0x65, 0x52, 0x04, 0x63, 0x61, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x07, 0x41, 0x50,
-	0x49, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x03, 0x72,
-	0x70, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x52, 0x50, 0x43, 0x52, 0x03, 0x72, 0x70, 0x63, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x65,
-	0x63, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x52, 0x06, 0x73, 0x65, 0x63, 0x75,
-	0x72, 0x65, 0x22, 0x7a, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x22, 0x5e, 0x0a, 0x04, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x2c, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7e,
+	0x0a, 0x06, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x46, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f,
+	0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22,
+	0x93, 0x01, 0x0a, 0x0d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x4d, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3f, 0x0a, 0x0c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x97, 0x01, 0x0a, 0x13, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x75, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
+	0x22, 0x72, 0x0a, 0x10, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65,
+	0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0x4b, 0x0a, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x9b, 0x03, 0x0a, 0x09, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x23, 0x0a, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x41, 0x50, 0x49, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x29,
+	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x39, 0x0a, 0x09, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x69, 0x74,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x73, 0x73,
+	0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2e, 0x0a, 0x07, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x22,
+	0x4e, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x72, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x65,
+	0x78, 0x70, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x22,
+	0xb7, 0x01, 0x0a, 0x09, 0x53, 0x75, 0x69, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x21, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4a, 0x57, 0x54, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x03, 0x6a, 0x77, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x68, 0x6d, 0x61, 0x63, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x4d, 0x41, 0x43,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x68, 0x6d, 0x61, 0x63, 0x12, 0x21, 0x0a, 0x03, 0x61, 0x77,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x41, 0x57, 0x53, 0x41, 0x75, 0x74, 0x68, 0x52, 0x03, 0x61, 0x77, 0x73, 0x12, 0x2a, 0x0a,
+	0x06, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x41, 0x75, 0x74,
+	0x68, 0x52, 0x06, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x32, 0x22, 0x75, 0x0a, 0x07, 0x4a, 0x57, 0x54,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e,
+	0x22, 0x58, 0x0a, 0x08, 0x48, 0x4d, 0x41, 0x43, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x9b, 0x01, 0x0a, 0x07, 0x41,
+	0x57, 0x53, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x0a, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x32, 0x41, 0x75, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x11, 0x54, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x43, 0x61, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x04, 0x63, 0x61, 0x73, 0x65, 0x22, 0x92, 0x01,
+	0x0a, 0x07, 0x41, 0x50, 0x49, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x1d, 0x0a, 0x03, 0x72, 0x70, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x50, 0x43, 0x52, 0x03, 0x72, 0x70, 0x63, 0x12, 0x26,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x22, 0x7a, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08,
 	0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
 	0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02,
@@ -4356,398 +6276,574 @@ var file_pkg_server_server_proto_rawDesc = []byte{
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70,
 	0x69, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x68, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69,
-	0x74, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x69, 0x74,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53,
-	0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0xb7, 0x01, 0x0a, 0x11, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x44, 0x75, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
-	0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
-	0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x43, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x08, 0x54, 0x65,
-	0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
-	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x2b, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x2e,
-	0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x2c, 0x0a,
-	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3b, 0x0a,
+	0x0e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x46, 0x0a, 0x12, 0x53, 0x75,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61,
+	0x73, 0x65, 0x22, 0x65, 0x0a, 0x15, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x18,
+	0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x6d,
+	0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x42, 0x0a,
+	0x11, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+	0x66, 0x66, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x22, 0x68, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x61, 0x73, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x75, 0x69,
+	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x03,
+	0x65, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x45, 0x6e, 0x76, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xa9, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x7c, 0x0a, 0x0a, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x0e, 0x74, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x11, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x3e, 0x0a, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x0e, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x0a, 0x48, 0x65, 0x6c, 0x6c,
+	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x1e, 0x0a, 0x08, 0x59, 0x61, 0x6d, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x55, 0x0a, 0x05, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x57, 0x0a,
+	0x11, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x75, 0x69,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x31, 0x0a, 0x09, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xad, 0x01, 0x0a, 0x08, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75,
+	0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0xc9, 0x03, 0x0a, 0x0f, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x69,
+	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75,
+	0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2c, 0x0a, 0x0a, 0x73, 0x75, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x0a, 0x73, 0x75, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x2d, 0x0a,
+	0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x50, 0x49, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x75, 0x69, 0x74, 0x65, 0x41, 0x70, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x75, 0x69, 0x74, 0x65, 0x41, 0x70, 0x69, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x32, 0x0a, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x3f, 0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd9, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x61, 0x70, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x24, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x22, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52,
-	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x36, 0x0a, 0x08, 0x45,
-	0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xa9, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x73,
-	0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x20, 0x0a, 0x04,
+	0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x22, 0x8a, 0x04, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69,
-	0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
-	0x7c, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3e, 0x0a,
-	0x0e, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x74,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xec, 0x01,
-	0x0a, 0x11, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x52, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x0a,
-	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x1e, 0x0a, 0x08, 0x59, 0x61,
-	0x6d, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x55, 0x0a, 0x05, 0x53, 0x75,
-	0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x22, 0x57, 0x0a, 0x11, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74,
-	0x68, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74,
-	0x43, 0x61, 0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x31, 0x0a, 0x09, 0x54, 0x65,
-	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xad, 0x01,
-	0x0a, 0x08, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0xc9, 0x03,
-	0x0a, 0x0f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x68,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75,
-	0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x73, 0x75, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0a, 0x73, 0x75, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x12, 0x2d, 0x0a, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x50,
-	0x49, 0x53, 0x70, 0x65, 0x63, 0x52, 0x09, 0x73, 0x75, 0x69, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x69, 0x74, 0x65, 0x41, 0x70, 0x69, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x69, 0x74, 0x65, 0x41, 0x70, 0x69, 0x12, 0x29, 0x0a, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0d, 0x68, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x3f, 0x0a, 0x10, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x2b, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74,
-	0x43, 0x61, 0x73, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd9, 0x01, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x06,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50,
-	0x61, 0x69, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f,
-	0x6f, 0x6b, 0x69, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
-	0x12, 0x20, 0x0a, 0x04, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x66, 0x6f,
-	0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x97, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x10, 0x62, 0x6f, 0x64,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x10, 0x62, 0x6f, 0x64, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x47, 0x0a, 0x11, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x52, 0x11, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2f, 0x0a, 0x09,
+	0x62, 0x6f, 0x64, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x09, 0x62, 0x6f, 0x64, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x0a,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d,
+	0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22,
+	0xd5, 0x02, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x6a, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6a, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x71, 0x75, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x71, 0x75, 0x61,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x13, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x02, 0x67, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03,
+	0x67, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x03, 0x67, 0x74, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x03, 0x52, 0x02, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x67, 0x74,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x67, 0x74, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6c, 0x74, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x74, 0x65, 0x22, 0x4e, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x09, 0x42, 0x6f, 0x64, 0x79,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x6e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
+	0x75, 0x62, 0x73, 0x65, 0x74, 0x22, 0x49, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x22, 0xa8, 0x01, 0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
 	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
 	0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a,
-	0x10, 0x62, 0x6f, 0x64, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x78, 0x70, 0x65, 0x63,
-	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x10, 0x62, 0x6f, 0x64, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12,
-	0x47, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x11, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x22, 0x49, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x22, 0xa8, 0x01, 0x0a, 0x0e,
-	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
-	0x64, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72,
-	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x50, 0x0a, 0x04, 0x50, 0x61, 0x69, 0x72, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x05, 0x50, 0x61, 0x69, 0x72,
-	0x73, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x0b, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x2b, 0x0a, 0x06, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc0, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x50, 0x0a, 0x04, 0x50,
+	0x61, 0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
 	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61,
-	0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0a, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xf0, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a,
+	0x05, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7b, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x35,
+	0x0a, 0x0b, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3f, 0x0a,
-	0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
-	0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69,
-	0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x4b, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x44,
-	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7c, 0x0a,
-	0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x42, 0x0a, 0x0c, 0x43,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x2e, 0x0a, 0x0a, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x20, 0x0a, 0x0a, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x7d, 0x0a, 0x13, 0x43, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x65, 0x73, 0x74,
-	0x53, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x65, 0x73,
-	0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
-	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
-	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
-	0x22, 0x2d, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x54, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x77, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x22,
-	0x0a, 0x0c, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x1f, 0x0a, 0x09, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x5d, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xd4, 0x01, 0x0a, 0x0a,
-	0x4d, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f,
-	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x52, 0x4c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x52, 0x4c,
-	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x22, 0x4f, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x74, 0x74, 0x70, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x74, 0x74, 0x70, 0x73, 0x12, 0x0e, 0x0a, 0x02,
-	0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6e, 0x6f, 0x22, 0x71, 0x0a, 0x09,
-	0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71,
-	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
-	0x7e, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69,
-	0x72, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x65, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22,
-	0xac, 0x01, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x72,
-	0x0a, 0x09, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x22, 0x6a, 0x0a, 0x0a, 0x41, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x38,
-	0x0a, 0x15, 0x41, 0x49, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x16, 0x41, 0x49, 0x43,
-	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x32, 0xd1, 0x27, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x43,
-	0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54,
-	0x65, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x16, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x10, 0x22, 0x0b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6e,
-	0x3a, 0x01, 0x2a, 0x12, 0x5f, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75,
-	0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73,
-	0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x12,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6e, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x3a, 0x01, 0x2a,
-	0x28, 0x01, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65,
-	0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73,
-	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x4e, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x79,
+	0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x75, 0x6c,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x75, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x70, 0x75, 0x73, 0x68, 0x22, 0x2b, 0x0a, 0x06, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xc0, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69,
+	0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf0, 0x01, 0x0a, 0x09, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e,
+	0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x2e, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x29, 0x0a,
+	0x13, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7c, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x4b, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x42, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2e, 0x0a, 0x0a, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x20, 0x0a, 0x0a, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7d, 0x0a, 0x13,
+	0x43, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x07, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x54, 0x0a, 0x06, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x37, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x52, 0x6f,
+	0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x0a,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61,
+	0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xf2, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a,
+	0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x36, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x77, 0x0a,
+	0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1f, 0x0a, 0x09, 0x50, 0x50,
+	0x72, 0x6f, 0x66, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5d, 0x0a, 0x08, 0x46,
+	0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0xd4, 0x01, 0x0a, 0x0a, 0x4d, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b,
+	0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x22, 0x4f, 0x0a, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x0b, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x74,
+	0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x68, 0x74, 0x74, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68,
+	0x74, 0x74, 0x70, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x6e, 0x6f, 0x22, 0x71, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7e, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0xac, 0x01, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x72, 0x0a, 0x09, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x6a, 0x0a, 0x0a, 0x41, 0x49,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x15, 0x41, 0x49, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0xb8, 0x01, 0x0a, 0x16, 0x41, 0x49, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xeb, 0x31, 0x0a, 0x06,
+	0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x10, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6e, 0x12, 0x5f, 0x0a, 0x0c, 0x52,
+	0x75, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x49, 0x64,
 	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x13, 0x22, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74,
-	0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x62, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54,
-	0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22,
-	0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f,
-	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x5b, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x22, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6e, 0x2f,
+	0x73, 0x75, 0x69, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x28, 0x01, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
+	0x12, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73,
+	0x12, 0x5b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75,
+	0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x12,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x62, 0x0a,
+	0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65,
+	0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75,
+	0x69, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01,
+	0x2a, 0x12, 0x66, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a,
+	0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x5b, 0x0a, 0x0c, 0x47, 0x65, 0x74,
 	0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76,
 	0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e,
 	0x74, 0x69, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65,
@@ -4757,9 +6853,9 @@ var file_pkg_server_server_proto_rawDesc = []byte{
 	0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76,
 	0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x1a, 0x12, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a,
-	0x01, 0x2a, 0x12, 0x5f, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x1a, 0x15, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x12, 0x5f, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
 	0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54,
 	0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
 	0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52,
@@ -4770,9 +6866,9 @@ var file_pkg_server_server_proto_rawDesc = []byte{
 	0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x44, 0x75, 0x70, 0x6c,
 	0x69, 0x63, 0x61, 0x74, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48,
 	0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2f, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65,
-	0x73, 0x2f, 0x7b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a,
+	0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75,
+	0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x69, 0x74,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
 	0x12, 0x75, 0x0a, 0x0f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75,
 	0x69, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73,
 	0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x1a,
@@ -4786,343 +6882,418 @@ var file_pkg_server_server_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
 	0x59, 0x61, 0x6d, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
 	0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x79, 0x61, 0x6d, 0x6c, 0x12, 0x5d, 0x0a, 0x0c,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x79, 0x61, 0x6d, 0x6c, 0x12, 0x72, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x16,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x12, 0x77, 0x0a, 0x0b, 0x52,
-	0x75, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72,
+	0x73, 0x75, 0x69, 0x74, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x87, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x66, 0x66, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x69, 0x66, 0x66, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x75,
+	0x69, 0x74, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x66, 0x72, 0x6f, 0x6d, 0x7d, 0x2f, 0x64, 0x69, 0x66, 0x66, 0x12, 0x8d, 0x01, 0x0a, 0x15, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x75, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x39, 0x22, 0x34, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65,
+	0x73, 0x2f, 0x7b, 0x73, 0x75, 0x69, 0x74, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x5d, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
+	0x75, 0x69, 0x74, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x12, 0x77, 0x0a, 0x0b, 0x52, 0x75, 0x6e,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x69, 0x74, 0x65, 0x7d, 0x2f, 0x63, 0x61,
+	0x73, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x7d, 0x2f, 0x72,
+	0x75, 0x6e, 0x12, 0x7f, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x22, 0x30,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b,
+	0x73, 0x75, 0x69, 0x74, 0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x65,
+	0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x7d, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x3a, 0x01, 0x2a, 0x12, 0x56, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e, 0x12,
+	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65,
+	0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x75, 0x6e, 0x3a, 0x01, 0x2a, 0x28, 0x01, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65,
-	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x36, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x30, 0x22, 0x2b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75,
-	0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x69, 0x74, 0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73,
-	0x65, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x7d, 0x2f, 0x72, 0x75,
-	0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x56, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6e,
-	0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54,
-	0x65, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x75, 0x6e, 0x3a, 0x01, 0x2a, 0x28, 0x01, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12,
-	0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f,
-	0x7b, 0x73, 0x75, 0x69, 0x74, 0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x74,
-	0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x7d, 0x12, 0x6c, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68,
-	0x53, 0x75, 0x69, 0x74, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48,
-	0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x25, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65,
-	0x73, 0x2f, 0x7b, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x61,
-	0x73, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x75,
-	0x69, 0x74, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c,
-	0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x1a,
-	0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f,
-	0x7b, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73, 0x65,
-	0x73, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a,
-	0x12, 0x6f, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
-	0x73, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74,
-	0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x2a, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x69, 0x74, 0x65, 0x7d,
-	0x2f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65,
-	0x7d, 0x12, 0x90, 0x01, 0x0a, 0x11, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x22, 0x41,
+	0x74, 0x69, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x61,
-	0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x3a, 0x01, 0x2a, 0x12, 0x8a, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54,
+	0x73, 0x75, 0x69, 0x74, 0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x65,
+	0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x7d, 0x12, 0x6c, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x75, 0x69, 0x74, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65,
+	0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73,
+	0x2f, 0x7b, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73,
+	0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
 	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x53, 0x75, 0x69,
 	0x74, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x22, 0x3e,
+	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x1a, 0x2c,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x61,
-	0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x01,
-	0x2a, 0x12, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x41, 0x50, 0x49, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54,
-	0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
-	0x73, 0x65, 0x73, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x50,
-	0x49, 0x73, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x22, 0x1d, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x17, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x1c,
-	0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
-	0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73,
-	0x74, 0x43, 0x61, 0x73, 0x65, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2f, 0x7b, 0x49, 0x44, 0x7d,
-	0x12, 0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65,
+	0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73, 0x65, 0x73,
+	0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12,
+	0x6f, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x2a, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x69, 0x74, 0x65, 0x7d, 0x2f,
+	0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x73, 0x74, 0x63, 0x61, 0x73, 0x65, 0x7d,
+	0x12, 0x90, 0x01, 0x0a, 0x11, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x22, 0x41, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x61, 0x73,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x3a, 0x01, 0x2a, 0x12, 0x8a, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x3a, 0x01, 0x2a,
+	0x22, 0x3e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73,
+	0x2f, 0x7b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x41, 0x50, 0x49, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a,
+	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x73, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x50, 0x49,
+	0x73, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53,
+	0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x12,
+	0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x1a, 0x17,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x12, 0x6a, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65,
 	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
 	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x1a,
-	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
-	0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x12, 0x6a,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
-	0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x2a, 0x1c, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73,
-	0x74, 0x43, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x12, 0x83, 0x01, 0x0a, 0x18, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
-	0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x2a, 0x32, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69,
-	0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
-	0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
-	0x12, 0x74, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x41,
-	0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x1a, 0x18, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74,
-	0x43, 0x61, 0x73, 0x65, 0x73, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x27, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73,
-	0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x56, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
-	0x64, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0d, 0x2e, 0x73, 0x65,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x2a, 0x1c, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x12, 0x83, 0x01, 0x0a, 0x18, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x1a,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x2a, 0x32, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x75, 0x69, 0x74,
+	0x65, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63,
+	0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12,
+	0x74, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x41, 0x6c,
+	0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x73, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x27, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x75,
+	0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x56, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x6d, 0x0a,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a, 0x13,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x64,
+	0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01,
+	0x2a, 0x22, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x12, 0x0d, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x1e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x6d,
-	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b,
+	0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x1a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x73, 0x12, 0x6c, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12, 0x1b,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e, 0x65,
 	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a,
-	0x13, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f,
-	0x64, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x22,
-	0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2f,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x4e, 0x0a, 0x0d, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x12, 0x0d, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22,
-	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x73, 0x12, 0x6c, 0x0a, 0x10, 0x43,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x12,
-	0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x63,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x4e, 0x0a, 0x0e, 0x50, 0x6f, 0x70,
-	0x75, 0x6c, 0x61, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x70, 0x75, 0x6c,
-	0x61, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x46, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x73, 0x65,
+	0x74, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x4e, 0x0a, 0x0e, 0x50, 0x6f, 0x70, 0x75,
+	0x6c, 0x61, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61,
+	0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a,
+	0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x22, 0x19,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x63, 0x0a, 0x0f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5e,
+	0x0a, 0x14, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x28, 0x01, 0x30, 0x01, 0x12, 0x56,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x13, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x22,
-	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5e, 0x0a, 0x14, 0x46, 0x75,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x28, 0x01, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x14, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6c,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x68,
-	0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x62, 0x6f, 0x64, 0x79, 0x7d, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0x1c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x73, 0x2f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0e, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x16, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x4d, 0x0a, 0x0b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x0d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1a, 0x1a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x4a, 0x0a, 0x0b, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
-	0x2a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x5d, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x17, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x73, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x50, 0x0a,
-	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x0e, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x14, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12,
-	0x54, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
-	0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a,
-	0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b,
-	0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x57, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a,
+	0x06, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x10, 0x12, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x12, 0x68, 0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x1a, 0x10, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x2c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x2f, 0x7b, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x62, 0x6f, 0x64, 0x79, 0x7d, 0x12, 0x50, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x0d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x73,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x48,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0x4d, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12,
+	0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x0d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12,
+	0x4a, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x0d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x0d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x5d, 0x0a, 0x0b, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a,
+	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
+	0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x62, 0x0a, 0x09, 0x53, 0x79,
+	0x6e, 0x63, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22,
+	0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x3a, 0x01, 0x2a, 0x12, 0x45,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0x17, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
 	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
 	0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x1a, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x32,
-	0x0a, 0x05, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x44, 0x61, 0x74, 0x61,
-	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x06, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x49, 0x12, 0x11, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x3a, 0x01, 0x2a, 0x12, 0x81, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x61, 0x70,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x41, 0x49, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x41, 0x49, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
-	0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f, 0x63, 0x61, 0x70,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x32, 0x6b, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x6e, 0x65,
-	0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x03, 0x52, 0x75,
-	0x6e, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53,
-	0x75, 0x69, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x43, 0x61, 0x73, 0x65, 0x1a, 0x14, 0x2e, 0x73,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x54, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x57, 0x0a,
+	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x0e, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x14, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x1a, 0x16,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x2f,
+	0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x55, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x1d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x62, 0x61, 0x63, 0x2f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x60, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x72, 0x62, 0x61, 0x63, 0x2f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x67, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x1a, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x62, 0x61, 0x63, 0x2f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x64, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
+	0x2a, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x62, 0x61, 0x63, 0x2f, 0x62,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x50,
+	0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0f, 0x12, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x12, 0x32, 0x0a, 0x05, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x06, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x49, 0x12, 0x11,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x49, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x81, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x49, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x49, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x41, 0x49, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x69, 0x2f, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x32, 0x6b, 0x0a, 0x0f, 0x52, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x03,
+	0x52, 0x75, 0x6e, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x69, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x43, 0x61, 0x73, 0x65, 0x1a, 0x14,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f,
+	0x72, 0x75, 0x6e, 0x3a, 0x01, 0x2a, 0x32, 0xfd, 0x03, 0x0a, 0x0b, 0x55, 0x49, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e,
+	0x75, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x6d,
+	0x65, 0x6e, 0x75, 0x73, 0x12, 0x62, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f,
+	0x66, 0x4a, 0x53, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x29, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x73, 0x12, 0x64, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x4f, 0x66, 0x43, 0x53, 0x53, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x14, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x75,
-	0x6e, 0x3a, 0x01, 0x2a, 0x32, 0xfd, 0x03, 0x0a, 0x0b, 0x55, 0x49, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x73,
-	0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x65, 0x6e,
-	0x75, 0x73, 0x12, 0x62, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x4a,
-	0x53, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c,
+	0x6c, 0x74, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x73, 0x73, 0x12, 0x6a,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c,
 	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x29, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x74,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x74,
 	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x73, 0x12, 0x64, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x61, 0x67,
-	0x65, 0x4f, 0x66, 0x43, 0x53, 0x53, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x67, 0x65,
-	0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x73, 0x73, 0x12, 0x6a, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12,
-	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x6a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50,
-	0x61, 0x67, 0x65, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a,
-	0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x2f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x32, 0xd2, 0x02, 0x0a, 0x0e, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x68,
-	0x65, 0x6d, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12,
-	0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x12,
-	0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x12, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a,
-	0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x57, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
-	0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x32, 0xed, 0x01, 0x0a, 0x04, 0x4d, 0x6f,
-	0x63, 0x6b, 0x12, 0x4b, 0x0a, 0x06, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x6d, 0x6f, 0x63, 0x6b, 0x2f, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x01, 0x2a, 0x12,
-	0x4b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0d, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x6d, 0x6f, 0x63, 0x6b, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4b, 0x0a, 0x08,
-	0x4c, 0x6f, 0x67, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x18, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
-	0x6f, 0x63, 0x6b, 0x2f, 0x6c, 0x6f, 0x67, 0x30, 0x01, 0x32, 0x60, 0x0a, 0x0a, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1d, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61,
-	0x74, 0x61, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x01, 0x2a, 0x42, 0x2e, 0x5a, 0x2c, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x73,
-	0x75, 0x72, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x6a, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12,
+	0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x32, 0xd2, 0x02, 0x0a, 0x0e, 0x54, 0x68, 0x65, 0x6d, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x54, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x10, 0x12, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x68, 0x65, 0x6d, 0x65,
+	0x73, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x12, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
+	0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
+	0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x57, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x32, 0xed, 0x01, 0x0a, 0x04,
+	0x4d, 0x6f, 0x63, 0x6b, 0x12, 0x4b, 0x0a, 0x06, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x1a, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x63, 0x6b, 0x2f, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x01,
+	0x2a, 0x12, 0x4b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x63, 0x6b, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4b,
+	0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x6d, 0x6f, 0x63, 0x6b, 0x2f, 0x6c, 0x6f, 0x67, 0x30, 0x01, 0x32, 0x60, 0x0a, 0x0a, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x05, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x64, 0x61, 0x74, 0x61, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x01, 0x2a, 0x42, 0x2e, 0x5a,
+	0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x73, 0x75, 0x72, 0x65, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5137,270 +7308,338 @@ func file_pkg_server_server_proto_rawDescGZIP() []byte {
 	return file_pkg_server_server_proto_rawDescData
 }
 
-var file_pkg_server_server_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_pkg_server_server_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
 var file_pkg_server_server_proto_goTypes = []interface{}{
-	(*ServerProxyRequest)(nil),     // 0: server.ServerProxyRequest
-	(*ServerProxyResponse)(nil),    // 1: server.ServerProxyResponse
-	(*Menu)(nil),                   // 2: server.Menu
-	(*MenuList)(nil),               // 3: server.MenuList
-	(*Suites)(nil),                 // 4: server.Suites
-	(*Items)(nil),                  // 5: server.Items
-	(*HistorySuites)(nil),          // 6: server.HistorySuites
-	(*HistoryItems)(nil),           // 7: server.HistoryItems
-	(*HistoryCaseIdentity)(nil),    // 8: server.HistoryCaseIdentity
-	(*TestCaseIdentity)(nil),       // 9: server.TestCaseIdentity
-	(*TestSuiteSource)(nil),        // 10: server.TestSuiteSource
-	(*TestSuite)(nil),              // 11: server.TestSuite
-	(*TestSuiteWithCase)(nil),      // 12: server.TestSuiteWithCase
-	(*APISpec)(nil),                // 13: server.APISpec
-	(*Secure)(nil),                 // 14: server.Secure
-	(*RPC)(nil),                    // 15: server.RPC
-	(*TestSuiteIdentity)(nil),      // 16: server.TestSuiteIdentity
-	(*TestSuiteDuplicate)(nil),     // 17: server.TestSuiteDuplicate
-	(*TestCaseDuplicate)(nil),      // 18: server.TestCaseDuplicate
-	(*TestTask)(nil),               // 19: server.TestTask
-	(*BatchTestTask)(nil),          // 20: server.BatchTestTask
-	(*TestResult)(nil),             // 21: server.TestResult
-	(*HistoryTestResult)(nil),      // 22: server.HistoryTestResult
-	(*HelloReply)(nil),             // 23: server.HelloReply
-	(*YamlData)(nil),               // 24: server.YamlData
-	(*Suite)(nil),                  // 25: server.Suite
-	(*TestCaseWithSuite)(nil),      // 26: server.TestCaseWithSuite
-	(*TestCases)(nil),              // 27: server.TestCases
-	(*TestCase)(nil),               // 28: server.TestCase
-	(*HistoryTestCase)(nil),        // 29: server.HistoryTestCase
-	(*HistoryTestCases)(nil),       // 30: server.HistoryTestCases
-	(*Request)(nil),                // 31: server.Request
-	(*Response)(nil),               // 32: server.Response
-	(*ConditionalVerify)(nil),      // 33: server.ConditionalVerify
-	(*TestCaseResult)(nil),         // 34: server.TestCaseResult
-	(*Pair)(nil),                   // 35: server.Pair
-	(*Pairs)(nil),                  // 36: server.Pairs
-	(*SimpleQuery)(nil),            // 37: server.SimpleQuery
-	(*Stores)(nil),                 // 38: server.Stores
-	(*Store)(nil),                  // 39: server.Store
-	(*StoreKinds)(nil),             // 40: server.StoreKinds
-	(*StoreKind)(nil),              // 41: server.StoreKind
-	(*StoreKindDependency)(nil),    // 42: server.StoreKindDependency
-	(*StoreKindParam)(nil),         // 43: server.StoreKindParam
-	(*CommonResult)(nil),           // 44: server.CommonResult
-	(*SimpleList)(nil),             // 45: server.SimpleList
-	(*SimpleName)(nil),             // 46: server.SimpleName
-	(*CodeGenerateRequest)(nil),    // 47: server.CodeGenerateRequest
-	(*Secrets)(nil),                // 48: server.Secrets
-	(*Secret)(nil),                 // 49: server.Secret
-	(*ExtensionStatus)(nil),        // 50: server.ExtensionStatus
-	(*PProfRequest)(nil),           // 51: server.PProfRequest
-	(*PProfData)(nil),              // 52: server.PProfData
-	(*FileData)(nil),               // 53: server.FileData
-	(*Empty)(nil),                  // 54: server.Empty
-	(*MockConfig)(nil),             // 55: server.MockConfig
-	(*Version)(nil),                // 56: server.Version
-	(*ProxyConfig)(nil),            // 57: server.ProxyConfig
-	(*DataQuery)(nil),              // 58: server.DataQuery
-	(*DataQueryResult)(nil),        // 59: server.DataQueryResult
-	(*DataMeta)(nil),               // 60: server.DataMeta
-	(*AIRequest)(nil),              // 61: server.AIRequest
-	(*AIResponse)(nil),             // 62: server.AIResponse
-	(*AICapabilitiesRequest)(nil),  // 63: server.AICapabilitiesRequest
-	(*AICapabilitiesResponse)(nil), // 64: server.AICapabilitiesResponse
-	nil,                            // 65: server.Suites.DataEntry
-	nil,                            // 66: server.HistorySuites.DataEntry
-	nil,                            // 67: server.TestTask.EnvEntry
-	(*timestamppb.Timestamp)(nil),  // 68: google.protobuf.Timestamp
+	(*Menu)(nil),                     // 0: server.Menu
+	(*MenuList)(nil),                 // 1: server.MenuList
+	(*Suites)(nil),                   // 2: server.Suites
+	(*Items)(nil),                    // 3: server.Items
+	(*HistorySuites)(nil),            // 4: server.HistorySuites
+	(*HistoryItems)(nil),             // 5: server.HistoryItems
+	(*HistoryCaseIdentity)(nil),      // 6: server.HistoryCaseIdentity
+	(*TestCaseIdentity)(nil),         // 7: server.TestCaseIdentity
+	(*TestSuiteSource)(nil),          // 8: server.TestSuiteSource
+	(*TestSuite)(nil),                // 9: server.TestSuite
+	(*ExprFunction)(nil),             // 10: server.ExprFunction
+	(*SuiteAuth)(nil),                // 11: server.SuiteAuth
+	(*JWTAuth)(nil),                  // 12: server.JWTAuth
+	(*HMACAuth)(nil),                 // 13: server.HMACAuth
+	(*AWSAuth)(nil),                  // 14: server.AWSAuth
+	(*OAuth2Auth)(nil),               // 15: server.OAuth2Auth
+	(*TestSuiteWithCase)(nil),        // 16: server.TestSuiteWithCase
+	(*APISpec)(nil),                  // 17: server.APISpec
+	(*Secure)(nil),                   // 18: server.Secure
+	(*RPC)(nil),                      // 19: server.RPC
+	(*TestSuiteIdentity)(nil),        // 20: server.TestSuiteIdentity
+	(*SuiteRevision)(nil),            // 21: server.SuiteRevision
+	(*SuiteRevisions)(nil),           // 22: server.SuiteRevisions
+	(*SuiteRevisionQuery)(nil),       // 23: server.SuiteRevisionQuery
+	(*SuiteRevisionIdentity)(nil),    // 24: server.SuiteRevisionIdentity
+	(*SnapshotAcceptance)(nil),       // 25: server.SnapshotAcceptance
+	(*SuiteRevisionDiffRequest)(nil), // 26: server.SuiteRevisionDiffRequest
+	(*FieldChange)(nil),              // 27: server.FieldChange
+	(*SuiteRevisionDiff)(nil),        // 28: server.SuiteRevisionDiff
+	(*TestSuiteDuplicate)(nil),       // 29: server.TestSuiteDuplicate
+	(*TestCaseDuplicate)(nil),        // 30: server.TestCaseDuplicate
+	(*TestTask)(nil),                 // 31: server.TestTask
+	(*BatchTestTask)(nil),            // 32: server.BatchTestTask
+	(*TestResult)(nil),               // 33: server.TestResult
+	(*HistoryTestResult)(nil),        // 34: server.HistoryTestResult
+	(*HelloReply)(nil),               // 35: server.HelloReply
+	(*YamlData)(nil),                 // 36: server.YamlData
+	(*Suite)(nil),                    // 37: server.Suite
+	(*TestCaseWithSuite)(nil),        // 38: server.TestCaseWithSuite
+	(*TestCases)(nil),                // 39: server.TestCases
+	(*TestCase)(nil),                 // 40: server.TestCase
+	(*HistoryTestCase)(nil),          // 41: server.HistoryTestCase
+	(*HistoryTestCases)(nil),         // 42: server.HistoryTestCases
+	(*Request)(nil),                  // 43: server.Request
+	(*Response)(nil),                 // 44: server.Response
+	(*QueryAssertion)(nil),           // 45: server.QueryAssertion
+	(*Snapshot)(nil),                 // 46: server.Snapshot
+	(*BodyMatch)(nil),                // 47: server.BodyMatch
+	(*ConditionalVerify)(nil),        // 48: server.ConditionalVerify
+	(*TestCaseResult)(nil),           // 49: server.TestCaseResult
+	(*Pair)(nil),                     // 50: server.Pair
+	(*Pairs)(nil),                    // 51: server.Pairs
+	(*UserDefinedFunction)(nil),      // 52: server.UserDefinedFunction
+	(*UserDefinedFunctions)(nil),     // 53: server.UserDefinedFunctions
+	(*SimpleQuery)(nil),              // 54: server.SimpleQuery
+	(*StoreSyncRequest)(nil),         // 55: server.StoreSyncRequest
+	(*Stores)(nil),                   // 56: server.Stores
+	(*Store)(nil),                    // 57: server.Store
+	(*StoreKinds)(nil),               // 58: server.StoreKinds
+	(*StoreKind)(nil),                // 59: server.StoreKind
+	(*StoreKindDependency)(nil),      // 60: server.StoreKindDependency
+	(*StoreKindParam)(nil),           // 61: server.StoreKindParam
+	(*CommonResult)(nil),             // 62: server.CommonResult
+	(*SimpleList)(nil),               // 63: server.SimpleList
+	(*SimpleName)(nil),               // 64: server.SimpleName
+	(*CodeGenerateRequest)(nil),      // 65: server.CodeGenerateRequest
+	(*Secrets)(nil),                  // 66: server.Secrets
+	(*Secret)(nil),                   // 67: server.Secret
+	(*RoleBindings)(nil),             // 68: server.RoleBindings
+	(*RoleBinding)(nil),              // 69: server.RoleBinding
+	(*AuditEntry)(nil),               // 70: server.AuditEntry
+	(*AuditQuery)(nil),               // 71: server.AuditQuery
+	(*AuditEntries)(nil),             // 72: server.AuditEntries
+	(*ExtensionStatus)(nil),          // 73: server.ExtensionStatus
+	(*PProfRequest)(nil),             // 74: server.PProfRequest
+	(*PProfData)(nil),                // 75: server.PProfData
+	(*FileData)(nil),                 // 76: server.FileData
+	(*Empty)(nil),                    // 77: server.Empty
+	(*MockConfig)(nil),               // 78: server.MockConfig
+	(*Version)(nil),                  // 79: server.Version
+	(*ProxyConfig)(nil),              // 80: server.ProxyConfig
+	(*DataQuery)(nil),                // 81: server.DataQuery
+	(*DataQueryResult)(nil),          // 82: server.DataQueryResult
+	(*DataMeta)(nil),                 // 83: server.DataMeta
+	(*AIRequest)(nil),                // 84: server.AIRequest
+	(*AIResponse)(nil),               // 85: server.AIResponse
+	(*AICapabilitiesRequest)(nil),    // 86: server.AICapabilitiesRequest
+	(*AICapabilitiesResponse)(nil),   // 87: server.AICapabilitiesResponse
+	nil,                              // 88: server.Suites.DataEntry
+	nil,                              // 89: server.HistorySuites.DataEntry
+	nil,                              // 90: server.TestTask.EnvEntry
+	(*timestamppb.Timestamp)(nil),    // 91: google.protobuf.Timestamp
 }
 var file_pkg_server_server_proto_depIdxs = []int32{
-	2,   // 0: server.MenuList.data:type_name -> server.Menu
-	65,  // 1: server.Suites.data:type_name -> server.Suites.DataEntry
-	66,  // 2: server.HistorySuites.data:type_name -> server.HistorySuites.DataEntry
-	8,   // 3: server.HistoryItems.data:type_name -> server.HistoryCaseIdentity
-	35,  // 4: server.TestCaseIdentity.parameters:type_name -> server.Pair
-	35,  // 5: server.TestSuite.param:type_name -> server.Pair
-	13,  // 6: server.TestSuite.spec:type_name -> server.APISpec
-	57,  // 7: server.TestSuite.proxy:type_name -> server.ProxyConfig
-	11,  // 8: server.TestSuiteWithCase.suite:type_name -> server.TestSuite
-	28,  // 9: server.TestSuiteWithCase.case:type_name -> server.TestCase
-	15,  // 10: server.APISpec.rpc:type_name -> server.RPC
-	14,  // 11: server.APISpec.secure:type_name -> server.Secure
-	67,  // 12: server.TestTask.env:type_name -> server.TestTask.EnvEntry
-	35,  // 13: server.TestTask.parameters:type_name -> server.Pair
-	35,  // 14: server.BatchTestTask.parameters:type_name -> server.Pair
-	34,  // 15: server.TestResult.testCaseResult:type_name -> server.TestCaseResult
-	34,  // 16: server.HistoryTestResult.testCaseResult:type_name -> server.TestCaseResult
-	29,  // 17: server.HistoryTestResult.data:type_name -> server.HistoryTestCase
-	68,  // 18: server.HistoryTestResult.createTime:type_name -> google.protobuf.Timestamp
-	28,  // 19: server.Suite.items:type_name -> server.TestCase
-	28,  // 20: server.TestCaseWithSuite.data:type_name -> server.TestCase
-	28,  // 21: server.TestCases.data:type_name -> server.TestCase
-	31,  // 22: server.TestCase.request:type_name -> server.Request
-	32,  // 23: server.TestCase.response:type_name -> server.Response
-	68,  // 24: server.HistoryTestCase.createTime:type_name -> google.protobuf.Timestamp
-	35,  // 25: server.HistoryTestCase.suiteParam:type_name -> server.Pair
-	13,  // 26: server.HistoryTestCase.suiteSpec:type_name -> server.APISpec
-	31,  // 27: server.HistoryTestCase.request:type_name -> server.Request
-	32,  // 28: server.HistoryTestCase.response:type_name -> server.Response
-	35,  // 29: server.HistoryTestCase.historyHeader:type_name -> server.Pair
-	29,  // 30: server.HistoryTestCases.data:type_name -> server.HistoryTestCase
-	35,  // 31: server.Request.header:type_name -> server.Pair
-	35,  // 32: server.Request.query:type_name -> server.Pair
-	35,  // 33: server.Request.cookie:type_name -> server.Pair
-	35,  // 34: server.Request.form:type_name -> server.Pair
-	35,  // 35: server.Response.header:type_name -> server.Pair
-	35,  // 36: server.Response.bodyFieldsExpect:type_name -> server.Pair
-	33,  // 37: server.Response.ConditionalVerify:type_name -> server.ConditionalVerify
-	35,  // 38: server.TestCaseResult.header:type_name -> server.Pair
-	35,  // 39: server.Pairs.data:type_name -> server.Pair
-	39,  // 40: server.Stores.data:type_name -> server.Store
-	35,  // 41: server.Store.properties:type_name -> server.Pair
-	41,  // 42: server.Store.kind:type_name -> server.StoreKind
-	41,  // 43: server.StoreKinds.data:type_name -> server.StoreKind
-	42,  // 44: server.StoreKind.dependencies:type_name -> server.StoreKindDependency
-	43,  // 45: server.StoreKind.params:type_name -> server.StoreKindParam
-	35,  // 46: server.SimpleList.data:type_name -> server.Pair
-	49,  // 47: server.Secrets.data:type_name -> server.Secret
-	35,  // 48: server.DataQueryResult.data:type_name -> server.Pair
-	36,  // 49: server.DataQueryResult.items:type_name -> server.Pairs
-	60,  // 50: server.DataQueryResult.meta:type_name -> server.DataMeta
-	35,  // 51: server.DataMeta.labels:type_name -> server.Pair
-	5,   // 52: server.Suites.DataEntry.value:type_name -> server.Items
-	7,   // 53: server.HistorySuites.DataEntry.value:type_name -> server.HistoryItems
-	19,  // 54: server.Runner.Run:input_type -> server.TestTask
-	16,  // 55: server.Runner.RunTestSuite:input_type -> server.TestSuiteIdentity
-	54,  // 56: server.Runner.GetSuites:input_type -> server.Empty
-	16,  // 57: server.Runner.CreateTestSuite:input_type -> server.TestSuiteIdentity
-	10,  // 58: server.Runner.ImportTestSuite:input_type -> server.TestSuiteSource
-	16,  // 59: server.Runner.GetTestSuite:input_type -> server.TestSuiteIdentity
-	11,  // 60: server.Runner.UpdateTestSuite:input_type -> server.TestSuite
-	16,  // 61: server.Runner.DeleteTestSuite:input_type -> server.TestSuiteIdentity
-	17,  // 62: server.Runner.DuplicateTestSuite:input_type -> server.TestSuiteDuplicate
-	17,  // 63: server.Runner.RenameTestSuite:input_type -> server.TestSuiteDuplicate
-	16,  // 64: server.Runner.GetTestSuiteYaml:input_type -> server.TestSuiteIdentity
-	16,  // 65: server.Runner.ListTestCase:input_type -> server.TestSuiteIdentity
-	9,   // 66: server.Runner.RunTestCase:input_type -> server.TestCaseIdentity
-	20,  // 67: server.Runner.BatchRun:input_type -> server.BatchTestTask
-	9,   // 68: server.Runner.GetTestCase:input_type -> server.TestCaseIdentity
-	26,  // 69: server.Runner.CreateTestCase:input_type -> server.TestCaseWithSuite
-	26,  // 70: server.Runner.UpdateTestCase:input_type -> server.TestCaseWithSuite
-	9,   // 71: server.Runner.DeleteTestCase:input_type -> server.TestCaseIdentity
-	18,  // 72: server.Runner.DuplicateTestCase:input_type -> server.TestCaseDuplicate
-	18,  // 73: server.Runner.RenameTestCase:input_type -> server.TestCaseDuplicate
-	16,  // 74: server.Runner.GetSuggestedAPIs:input_type -> server.TestSuiteIdentity
-	54,  // 75: server.Runner.GetHistorySuites:input_type -> server.Empty
-	29,  // 76: server.Runner.GetHistoryTestCaseWithResult:input_type -> server.HistoryTestCase
-	29,  // 77: server.Runner.GetHistoryTestCase:input_type -> server.HistoryTestCase
-	29,  // 78: server.Runner.DeleteHistoryTestCase:input_type -> server.HistoryTestCase
-	29,  // 79: server.Runner.DeleteAllHistoryTestCase:input_type -> server.HistoryTestCase
-	28,  // 80: server.Runner.GetTestCaseAllHistory:input_type -> server.TestCase
-	54,  // 81: server.Runner.ListCodeGenerator:input_type -> server.Empty
-	47,  // 82: server.Runner.GenerateCode:input_type -> server.CodeGenerateRequest
-	47,  // 83: server.Runner.HistoryGenerateCode:input_type -> server.CodeGenerateRequest
-	54,  // 84: server.Runner.ListConverter:input_type -> server.Empty
-	47,  // 85: server.Runner.ConvertTestSuite:input_type -> server.CodeGenerateRequest
-	54,  // 86: server.Runner.PopularHeaders:input_type -> server.Empty
-	37,  // 87: server.Runner.FunctionsQuery:input_type -> server.SimpleQuery
-	37,  // 88: server.Runner.FunctionsQueryStream:input_type -> server.SimpleQuery
-	37,  // 89: server.Runner.GetSchema:input_type -> server.SimpleQuery
-	54,  // 90: server.Runner.GetVersion:input_type -> server.Empty
-	54,  // 91: server.Runner.Sample:input_type -> server.Empty
-	28,  // 92: server.Runner.DownloadResponseFile:input_type -> server.TestCase
-	54,  // 93: server.Runner.GetStoreKinds:input_type -> server.Empty
-	37,  // 94: server.Runner.GetStores:input_type -> server.SimpleQuery
-	39,  // 95: server.Runner.CreateStore:input_type -> server.Store
-	39,  // 96: server.Runner.UpdateStore:input_type -> server.Store
-	39,  // 97: server.Runner.DeleteStore:input_type -> server.Store
-	37,  // 98: server.Runner.VerifyStore:input_type -> server.SimpleQuery
-	54,  // 99: server.Runner.GetSecrets:input_type -> server.Empty
-	49,  // 100: server.Runner.CreateSecret:input_type -> server.Secret
-	49,  // 101: server.Runner.DeleteSecret:input_type -> server.Secret
-	49,  // 102: server.Runner.UpdateSecret:input_type -> server.Secret
-	51,  // 103: server.Runner.PProf:input_type -> server.PProfRequest
-	61,  // 104: server.Runner.CallAI:input_type -> server.AIRequest
-	63,  // 105: server.Runner.GetAICapabilities:input_type -> server.AICapabilitiesRequest
-	12,  // 106: server.RunnerExtension.Run:input_type -> server.TestSuiteWithCase
-	54,  // 107: server.UIExtension.GetMenus:input_type -> server.Empty
-	46,  // 108: server.UIExtension.GetPageOfJS:input_type -> server.SimpleName
-	46,  // 109: server.UIExtension.GetPageOfCSS:input_type -> server.SimpleName
-	46,  // 110: server.UIExtension.GetPageOfStatic:input_type -> server.SimpleName
-	46,  // 111: server.UIExtension.GetPageOfServer:input_type -> server.SimpleName
-	54,  // 112: server.ThemeExtension.GetThemes:input_type -> server.Empty
-	46,  // 113: server.ThemeExtension.GetTheme:input_type -> server.SimpleName
-	54,  // 114: server.ThemeExtension.GetBindings:input_type -> server.Empty
-	46,  // 115: server.ThemeExtension.GetBinding:input_type -> server.SimpleName
-	55,  // 116: server.Mock.Reload:input_type -> server.MockConfig
-	54,  // 117: server.Mock.GetConfig:input_type -> server.Empty
-	54,  // 118: server.Mock.LogWatch:input_type -> server.Empty
-	58,  // 119: server.DataServer.Query:input_type -> server.DataQuery
-	21,  // 120: server.Runner.Run:output_type -> server.TestResult
-	21,  // 121: server.Runner.RunTestSuite:output_type -> server.TestResult
-	4,   // 122: server.Runner.GetSuites:output_type -> server.Suites
-	23,  // 123: server.Runner.CreateTestSuite:output_type -> server.HelloReply
-	44,  // 124: server.Runner.ImportTestSuite:output_type -> server.CommonResult
-	11,  // 125: server.Runner.GetTestSuite:output_type -> server.TestSuite
-	23,  // 126: server.Runner.UpdateTestSuite:output_type -> server.HelloReply
-	23,  // 127: server.Runner.DeleteTestSuite:output_type -> server.HelloReply
-	23,  // 128: server.Runner.DuplicateTestSuite:output_type -> server.HelloReply
-	23,  // 129: server.Runner.RenameTestSuite:output_type -> server.HelloReply
-	24,  // 130: server.Runner.GetTestSuiteYaml:output_type -> server.YamlData
-	25,  // 131: server.Runner.ListTestCase:output_type -> server.Suite
-	34,  // 132: server.Runner.RunTestCase:output_type -> server.TestCaseResult
-	21,  // 133: server.Runner.BatchRun:output_type -> server.TestResult
-	28,  // 134: server.Runner.GetTestCase:output_type -> server.TestCase
-	23,  // 135: server.Runner.CreateTestCase:output_type -> server.HelloReply
-	23,  // 136: server.Runner.UpdateTestCase:output_type -> server.HelloReply
-	23,  // 137: server.Runner.DeleteTestCase:output_type -> server.HelloReply
-	23,  // 138: server.Runner.DuplicateTestCase:output_type -> server.HelloReply
-	23,  // 139: server.Runner.RenameTestCase:output_type -> server.HelloReply
-	27,  // 140: server.Runner.GetSuggestedAPIs:output_type -> server.TestCases
-	6,   // 141: server.Runner.GetHistorySuites:output_type -> server.HistorySuites
-	22,  // 142: server.Runner.GetHistoryTestCaseWithResult:output_type -> server.HistoryTestResult
-	29,  // 143: server.Runner.GetHistoryTestCase:output_type -> server.HistoryTestCase
-	23,  // 144: server.Runner.DeleteHistoryTestCase:output_type -> server.HelloReply
-	23,  // 145: server.Runner.DeleteAllHistoryTestCase:output_type -> server.HelloReply
-	30,  // 146: server.Runner.GetTestCaseAllHistory:output_type -> server.HistoryTestCases
-	45,  // 147: server.Runner.ListCodeGenerator:output_type -> server.SimpleList
-	44,  // 148: server.Runner.GenerateCode:output_type -> server.CommonResult
-	44,  // 149: server.Runner.HistoryGenerateCode:output_type -> server.CommonResult
-	45,  // 150: server.Runner.ListConverter:output_type -> server.SimpleList
-	44,  // 151: server.Runner.ConvertTestSuite:output_type -> server.CommonResult
-	36,  // 152: server.Runner.PopularHeaders:output_type -> server.Pairs
-	36,  // 153: server.Runner.FunctionsQuery:output_type -> server.Pairs
-	36,  // 154: server.Runner.FunctionsQueryStream:output_type -> server.Pairs
-	44,  // 155: server.Runner.GetSchema:output_type -> server.CommonResult
-	56,  // 156: server.Runner.GetVersion:output_type -> server.Version
-	23,  // 157: server.Runner.Sample:output_type -> server.HelloReply
-	53,  // 158: server.Runner.DownloadResponseFile:output_type -> server.FileData
-	40,  // 159: server.Runner.GetStoreKinds:output_type -> server.StoreKinds
-	38,  // 160: server.Runner.GetStores:output_type -> server.Stores
-	39,  // 161: server.Runner.CreateStore:output_type -> server.Store
-	39,  // 162: server.Runner.UpdateStore:output_type -> server.Store
-	39,  // 163: server.Runner.DeleteStore:output_type -> server.Store
-	50,  // 164: server.Runner.VerifyStore:output_type -> server.ExtensionStatus
-	48,  // 165: server.Runner.GetSecrets:output_type -> server.Secrets
-	44,  // 166: server.Runner.CreateSecret:output_type -> server.CommonResult
-	44,  // 167: server.Runner.DeleteSecret:output_type -> server.CommonResult
-	44,  // 168: server.Runner.UpdateSecret:output_type -> server.CommonResult
-	52,  // 169: server.Runner.PProf:output_type -> server.PProfData
-	62,  // 170: server.Runner.CallAI:output_type -> server.AIResponse
-	64,  // 171: server.Runner.GetAICapabilities:output_type -> server.AICapabilitiesResponse
-	44,  // 172: server.RunnerExtension.Run:output_type -> server.CommonResult
-	3,   // 173: server.UIExtension.GetMenus:output_type -> server.MenuList
-	44,  // 174: server.UIExtension.GetPageOfJS:output_type -> server.CommonResult
-	44,  // 175: server.UIExtension.GetPageOfCSS:output_type -> server.CommonResult
-	44,  // 176: server.UIExtension.GetPageOfStatic:output_type -> server.CommonResult
-	44,  // 177: server.UIExtension.GetPageOfServer:output_type -> server.CommonResult
-	45,  // 178: server.ThemeExtension.GetThemes:output_type -> server.SimpleList
-	44,  // 179: server.ThemeExtension.GetTheme:output_type -> server.CommonResult
-	45,  // 180: server.ThemeExtension.GetBindings:output_type -> server.SimpleList
-	44,  // 181: server.ThemeExtension.GetBinding:output_type -> server.CommonResult
-	54,  // 182: server.Mock.Reload:output_type -> server.Empty
-	55,  // 183: server.Mock.GetConfig:output_type -> server.MockConfig
-	44,  // 184: server.Mock.LogWatch:output_type -> server.CommonResult
-	59,  // 185: server.DataServer.Query:output_type -> server.DataQueryResult
-	120, // [120:186] is the sub-list for method output_type
-	54,  // [54:120] is the sub-list for method input_type
-	54,  // [54:54] is the sub-list for extension type_name
-	54,  // [54:54] is the sub-list for extension extendee
-	0,   // [0:54] is the sub-list for field type_name
+	0,   // 0: server.MenuList.data:type_name -> server.Menu
+	88,  // 1: server.Suites.data:type_name -> server.Suites.DataEntry
+	89,  // 2: server.HistorySuites.data:type_name -> server.HistorySuites.DataEntry
+	6,   // 3: server.HistoryItems.data:type_name -> server.HistoryCaseIdentity
+	50,  // 4: server.TestCaseIdentity.parameters:type_name -> server.Pair
+	50,  // 5: server.TestSuite.param:type_name -> server.Pair
+	17,  // 6: server.TestSuite.spec:type_name -> server.APISpec
+	80,  // 7: server.TestSuite.proxy:type_name -> server.ProxyConfig
+	52,  // 8: server.TestSuite.functions:type_name -> server.UserDefinedFunction
+	11,  // 9: server.TestSuite.auth:type_name -> server.SuiteAuth
+	10,  // 10: server.TestSuite.assertions:type_name -> server.ExprFunction
+	10,  // 11: server.TestSuite.helpers:type_name -> server.ExprFunction
+	12,  // 12: server.SuiteAuth.jwt:type_name -> server.JWTAuth
+	13,  // 13: server.SuiteAuth.hmac:type_name -> server.HMACAuth
+	14,  // 14: server.SuiteAuth.aws:type_name -> server.AWSAuth
+	15,  // 15: server.SuiteAuth.oauth2:type_name -> server.OAuth2Auth
+	9,   // 16: server.TestSuiteWithCase.suite:type_name -> server.TestSuite
+	40,  // 17: server.TestSuiteWithCase.case:type_name -> server.TestCase
+	19,  // 18: server.APISpec.rpc:type_name -> server.RPC
+	18,  // 19: server.APISpec.secure:type_name -> server.Secure
+	91,  // 20: server.SuiteRevision.createTime:type_name -> google.protobuf.Timestamp
+	21,  // 21: server.SuiteRevisions.data:type_name -> server.SuiteRevision
+	50,  // 22: server.SnapshotAcceptance.header:type_name -> server.Pair
+	27,  // 23: server.SuiteRevisionDiff.changes:type_name -> server.FieldChange
+	90,  // 24: server.TestTask.env:type_name -> server.TestTask.EnvEntry
+	50,  // 25: server.TestTask.parameters:type_name -> server.Pair
+	50,  // 26: server.BatchTestTask.parameters:type_name -> server.Pair
+	49,  // 27: server.TestResult.testCaseResult:type_name -> server.TestCaseResult
+	49,  // 28: server.HistoryTestResult.testCaseResult:type_name -> server.TestCaseResult
+	41,  // 29: server.HistoryTestResult.data:type_name -> server.HistoryTestCase
+	91,  // 30: server.HistoryTestResult.createTime:type_name -> google.protobuf.Timestamp
+	40,  // 31: server.Suite.items:type_name -> server.TestCase
+	40,  // 32: server.TestCaseWithSuite.data:type_name -> server.TestCase
+	40,  // 33: server.TestCases.data:type_name -> server.TestCase
+	43,  // 34: server.TestCase.request:type_name -> server.Request
+	44,  // 35: server.TestCase.response:type_name -> server.Response
+	91,  // 36: server.HistoryTestCase.createTime:type_name -> google.protobuf.Timestamp
+	50,  // 37: server.HistoryTestCase.suiteParam:type_name -> server.Pair
+	17,  // 38: server.HistoryTestCase.suiteSpec:type_name -> server.APISpec
+	43,  // 39: server.HistoryTestCase.request:type_name -> server.Request
+	44,  // 40: server.HistoryTestCase.response:type_name -> server.Response
+	50,  // 41: server.HistoryTestCase.historyHeader:type_name -> server.Pair
+	41,  // 42: server.HistoryTestCases.data:type_name -> server.HistoryTestCase
+	50,  // 43: server.Request.header:type_name -> server.Pair
+	50,  // 44: server.Request.query:type_name -> server.Pair
+	50,  // 45: server.Request.cookie:type_name -> server.Pair
+	50,  // 46: server.Request.form:type_name -> server.Pair
+	50,  // 47: server.Response.header:type_name -> server.Pair
+	50,  // 48: server.Response.bodyFieldsExpect:type_name -> server.Pair
+	48,  // 49: server.Response.ConditionalVerify:type_name -> server.ConditionalVerify
+	47,  // 50: server.Response.bodyMatch:type_name -> server.BodyMatch
+	46,  // 51: server.Response.snapshot:type_name -> server.Snapshot
+	45,  // 52: server.Response.query:type_name -> server.QueryAssertion
+	50,  // 53: server.TestCaseResult.header:type_name -> server.Pair
+	50,  // 54: server.Pairs.data:type_name -> server.Pair
+	52,  // 55: server.UserDefinedFunctions.data:type_name -> server.UserDefinedFunction
+	57,  // 56: server.Stores.data:type_name -> server.Store
+	50,  // 57: server.Store.properties:type_name -> server.Pair
+	59,  // 58: server.Store.kind:type_name -> server.StoreKind
+	59,  // 59: server.StoreKinds.data:type_name -> server.StoreKind
+	60,  // 60: server.StoreKind.dependencies:type_name -> server.StoreKindDependency
+	61,  // 61: server.StoreKind.params:type_name -> server.StoreKindParam
+	50,  // 62: server.SimpleList.data:type_name -> server.Pair
+	67,  // 63: server.Secrets.data:type_name -> server.Secret
+	69,  // 64: server.RoleBindings.data:type_name -> server.RoleBinding
+	91,  // 65: server.AuditEntry.time:type_name -> google.protobuf.Timestamp
+	91,  // 66: server.AuditQuery.since:type_name -> google.protobuf.Timestamp
+	91,  // 67: server.AuditQuery.until:type_name -> google.protobuf.Timestamp
+	70,  // 68: server.AuditEntries.data:type_name -> server.AuditEntry
+	50,  // 69: server.DataQueryResult.data:type_name -> server.Pair
+	51,  // 70: server.DataQueryResult.items:type_name -> server.Pairs
+	83,  // 71: server.DataQueryResult.meta:type_name -> server.DataMeta
+	50,  // 72: server.DataMeta.labels:type_name -> server.Pair
+	3,   // 73: server.Suites.DataEntry.value:type_name -> server.Items
+	5,   // 74: server.HistorySuites.DataEntry.value:type_name -> server.HistoryItems
+	31,  // 75: server.Runner.Run:input_type -> server.TestTask
+	20,  // 76: server.Runner.RunTestSuite:input_type -> server.TestSuiteIdentity
+	77,  // 77: server.Runner.GetSuites:input_type -> server.Empty
+	20,  // 78: server.Runner.CreateTestSuite:input_type -> server.TestSuiteIdentity
+	8,   // 79: server.Runner.ImportTestSuite:input_type -> server.TestSuiteSource
+	8,   // 80: server.Runner.GenerateTestSuite:input_type -> server.TestSuiteSource
+	20,  // 81: server.Runner.GetTestSuite:input_type -> server.TestSuiteIdentity
+	9,   // 82: server.Runner.UpdateTestSuite:input_type -> server.TestSuite
+	20,  // 83: server.Runner.DeleteTestSuite:input_type -> server.TestSuiteIdentity
+	29,  // 84: server.Runner.DuplicateTestSuite:input_type -> server.TestSuiteDuplicate
+	29,  // 85: server.Runner.RenameTestSuite:input_type -> server.TestSuiteDuplicate
+	20,  // 86: server.Runner.GetTestSuiteYaml:input_type -> server.TestSuiteIdentity
+	23,  // 87: server.Runner.ListSuiteRevisions:input_type -> server.SuiteRevisionQuery
+	26,  // 88: server.Runner.DiffSuiteRevisions:input_type -> server.SuiteRevisionDiffRequest
+	24,  // 89: server.Runner.RollbackSuiteRevision:input_type -> server.SuiteRevisionIdentity
+	20,  // 90: server.Runner.ListTestCase:input_type -> server.TestSuiteIdentity
+	7,   // 91: server.Runner.RunTestCase:input_type -> server.TestCaseIdentity
+	25,  // 92: server.Runner.AcceptSnapshot:input_type -> server.SnapshotAcceptance
+	32,  // 93: server.Runner.BatchRun:input_type -> server.BatchTestTask
+	7,   // 94: server.Runner.GetTestCase:input_type -> server.TestCaseIdentity
+	38,  // 95: server.Runner.CreateTestCase:input_type -> server.TestCaseWithSuite
+	38,  // 96: server.Runner.UpdateTestCase:input_type -> server.TestCaseWithSuite
+	7,   // 97: server.Runner.DeleteTestCase:input_type -> server.TestCaseIdentity
+	30,  // 98: server.Runner.DuplicateTestCase:input_type -> server.TestCaseDuplicate
+	30,  // 99: server.Runner.RenameTestCase:input_type -> server.TestCaseDuplicate
+	20,  // 100: server.Runner.GetSuggestedAPIs:input_type -> server.TestSuiteIdentity
+	77,  // 101: server.Runner.GetHistorySuites:input_type -> server.Empty
+	41,  // 102: server.Runner.GetHistoryTestCaseWithResult:input_type -> server.HistoryTestCase
+	41,  // 103: server.Runner.GetHistoryTestCase:input_type -> server.HistoryTestCase
+	41,  // 104: server.Runner.DeleteHistoryTestCase:input_type -> server.HistoryTestCase
+	41,  // 105: server.Runner.DeleteAllHistoryTestCase:input_type -> server.HistoryTestCase
+	40,  // 106: server.Runner.GetTestCaseAllHistory:input_type -> server.TestCase
+	77,  // 107: server.Runner.ListCodeGenerator:input_type -> server.Empty
+	65,  // 108: server.Runner.GenerateCode:input_type -> server.CodeGenerateRequest
+	65,  // 109: server.Runner.HistoryGenerateCode:input_type -> server.CodeGenerateRequest
+	77,  // 110: server.Runner.ListConverter:input_type -> server.Empty
+	65,  // 111: server.Runner.ConvertTestSuite:input_type -> server.CodeGenerateRequest
+	77,  // 112: server.Runner.PopularHeaders:input_type -> server.Empty
+	54,  // 113: server.Runner.FunctionsQuery:input_type -> server.SimpleQuery
+	53,  // 114: server.Runner.UpdateFunctions:input_type -> server.UserDefinedFunctions
+	54,  // 115: server.Runner.FunctionsQueryStream:input_type -> server.SimpleQuery
+	54,  // 116: server.Runner.GetSchema:input_type -> server.SimpleQuery
+	77,  // 117: server.Runner.GetVersion:input_type -> server.Empty
+	77,  // 118: server.Runner.Sample:input_type -> server.Empty
+	40,  // 119: server.Runner.DownloadResponseFile:input_type -> server.TestCase
+	77,  // 120: server.Runner.GetStoreKinds:input_type -> server.Empty
+	54,  // 121: server.Runner.GetStores:input_type -> server.SimpleQuery
+	57,  // 122: server.Runner.CreateStore:input_type -> server.Store
+	57,  // 123: server.Runner.UpdateStore:input_type -> server.Store
+	57,  // 124: server.Runner.DeleteStore:input_type -> server.Store
+	54,  // 125: server.Runner.VerifyStore:input_type -> server.SimpleQuery
+	55,  // 126: server.Runner.SyncStore:input_type -> server.StoreSyncRequest
+	77,  // 127: server.Runner.GetSecrets:input_type -> server.Empty
+	67,  // 128: server.Runner.CreateSecret:input_type -> server.Secret
+	67,  // 129: server.Runner.DeleteSecret:input_type -> server.Secret
+	67,  // 130: server.Runner.UpdateSecret:input_type -> server.Secret
+	77,  // 131: server.Runner.GetRoleBindings:input_type -> server.Empty
+	69,  // 132: server.Runner.CreateRoleBinding:input_type -> server.RoleBinding
+	69,  // 133: server.Runner.UpdateRoleBinding:input_type -> server.RoleBinding
+	69,  // 134: server.Runner.DeleteRoleBinding:input_type -> server.RoleBinding
+	71,  // 135: server.Runner.QueryAuditLog:input_type -> server.AuditQuery
+	74,  // 136: server.Runner.PProf:input_type -> server.PProfRequest
+	84,  // 137: server.Runner.CallAI:input_type -> server.AIRequest
+	86,  // 138: server.Runner.GetAICapabilities:input_type -> server.AICapabilitiesRequest
+	16,  // 139: server.RunnerExtension.Run:input_type -> server.TestSuiteWithCase
+	77,  // 140: server.UIExtension.GetMenus:input_type -> server.Empty
+	64,  // 141: server.UIExtension.GetPageOfJS:input_type -> server.SimpleName
+	64,  // 142: server.UIExtension.GetPageOfCSS:input_type -> server.SimpleName
+	64,  // 143: server.UIExtension.GetPageOfStatic:input_type -> server.SimpleName
+	64,  // 144: server.UIExtension.GetPageOfServer:input_type -> server.SimpleName
+	77,  // 145: server.ThemeExtension.GetThemes:input_type -> server.Empty
+	64,  // 146: server.ThemeExtension.GetTheme:input_type -> server.SimpleName
+	77,  // 147: server.ThemeExtension.GetBindings:input_type -> server.Empty
+	64,  // 148: server.ThemeExtension.GetBinding:input_type -> server.SimpleName
+	78,  // 149: server.Mock.Reload:input_type -> server.MockConfig
+	77,  // 150: server.Mock.GetConfig:input_type -> server.Empty
+	77,  // 151: server.Mock.LogWatch:input_type -> server.Empty
+	81,  // 152: server.DataServer.Query:input_type -> server.DataQuery
+	33,  // 153: server.Runner.Run:output_type -> server.TestResult
+	33,  // 154: server.Runner.RunTestSuite:output_type -> server.TestResult
+	2,   // 155: server.Runner.GetSuites:output_type -> server.Suites
+	35,  // 156: server.Runner.CreateTestSuite:output_type -> server.HelloReply
+	62,  // 157: server.Runner.ImportTestSuite:output_type -> server.CommonResult
+	62,  // 158: server.Runner.GenerateTestSuite:output_type -> server.CommonResult
+	9,   // 159: server.Runner.GetTestSuite:output_type -> server.TestSuite
+	35,  // 160: server.Runner.UpdateTestSuite:output_type -> server.HelloReply
+	35,  // 161: server.Runner.DeleteTestSuite:output_type -> server.HelloReply
+	35,  // 162: server.Runner.DuplicateTestSuite:output_type -> server.HelloReply
+	35,  // 163: server.Runner.RenameTestSuite:output_type -> server.HelloReply
+	36,  // 164: server.Runner.GetTestSuiteYaml:output_type -> server.YamlData
+	22,  // 165: server.Runner.ListSuiteRevisions:output_type -> server.SuiteRevisions
+	28,  // 166: server.Runner.DiffSuiteRevisions:output_type -> server.SuiteRevisionDiff
+	62,  // 167: server.Runner.RollbackSuiteRevision:output_type -> server.CommonResult
+	37,  // 168: server.Runner.ListTestCase:output_type -> server.Suite
+	49,  // 169: server.Runner.RunTestCase:output_type -> server.TestCaseResult
+	62,  // 170: server.Runner.AcceptSnapshot:output_type -> server.CommonResult
+	33,  // 171: server.Runner.BatchRun:output_type -> server.TestResult
+	40,  // 172: server.Runner.GetTestCase:output_type -> server.TestCase
+	35,  // 173: server.Runner.CreateTestCase:output_type -> server.HelloReply
+	35,  // 174: server.Runner.UpdateTestCase:output_type -> server.HelloReply
+	35,  // 175: server.Runner.DeleteTestCase:output_type -> server.HelloReply
+	35,  // 176: server.Runner.DuplicateTestCase:output_type -> server.HelloReply
+	35,  // 177: server.Runner.RenameTestCase:output_type -> server.HelloReply
+	39,  // 178: server.Runner.GetSuggestedAPIs:output_type -> server.TestCases
+	4,   // 179: server.Runner.GetHistorySuites:output_type -> server.HistorySuites
+	34,  // 180: server.Runner.GetHistoryTestCaseWithResult:output_type -> server.HistoryTestResult
+	41,  // 181: server.Runner.GetHistoryTestCase:output_type -> server.HistoryTestCase
+	35,  // 182: server.Runner.DeleteHistoryTestCase:output_type -> server.HelloReply
+	35,  // 183: server.Runner.DeleteAllHistoryTestCase:output_type -> server.HelloReply
+	42,  // 184: server.Runner.GetTestCaseAllHistory:output_type -> server.HistoryTestCases
+	63,  // 185: server.Runner.ListCodeGenerator:output_type -> server.SimpleList
+	62,  // 186: server.Runner.GenerateCode:output_type -> server.CommonResult
+	62,  // 187: server.Runner.HistoryGenerateCode:output_type -> server.CommonResult
+	63,  // 188: server.Runner.ListConverter:output_type -> server.SimpleList
+	62,  // 189: server.Runner.ConvertTestSuite:output_type -> server.CommonResult
+	51,  // 190: server.Runner.PopularHeaders:output_type -> server.Pairs
+	51,  // 191: server.Runner.FunctionsQuery:output_type -> server.Pairs
+	62,  // 192: server.Runner.UpdateFunctions:output_type -> server.CommonResult
+	51,  // 193: server.Runner.FunctionsQueryStream:output_type -> server.Pairs
+	62,  // 194: server.Runner.GetSchema:output_type -> server.CommonResult
+	79,  // 195: server.Runner.GetVersion:output_type -> server.Version
+	35,  // 196: server.Runner.Sample:output_type -> server.HelloReply
+	76,  // 197: server.Runner.DownloadResponseFile:output_type -> server.FileData
+	58,  // 198: server.Runner.GetStoreKinds:output_type -> server.StoreKinds
+	56,  // 199: server.Runner.GetStores:output_type -> server.Stores
+	57,  // 200: server.Runner.CreateStore:output_type -> server.Store
+	57,  // 201: server.Runner.UpdateStore:output_type -> server.Store
+	57,  // 202: server.Runner.DeleteStore:output_type -> server.Store
+	73,  // 203: server.Runner.VerifyStore:output_type -> server.ExtensionStatus
+	62,  // 204: server.Runner.SyncStore:output_type -> server.CommonResult
+	66,  // 205: server.Runner.GetSecrets:output_type -> server.Secrets
+	62,  // 206: server.Runner.CreateSecret:output_type -> server.CommonResult
+	62,  // 207: server.Runner.DeleteSecret:output_type -> server.CommonResult
+	62,  // 208: server.Runner.UpdateSecret:output_type -> server.CommonResult
+	68,  // 209: server.Runner.GetRoleBindings:output_type -> server.RoleBindings
+	62,  // 210: server.Runner.CreateRoleBinding:output_type -> server.CommonResult
+	62,  // 211: server.Runner.UpdateRoleBinding:output_type -> server.CommonResult
+	62,  // 212: server.Runner.DeleteRoleBinding:output_type -> server.CommonResult
+	72,  // 213: server.Runner.QueryAuditLog:output_type -> server.AuditEntries
+	75,  // 214: server.Runner.PProf:output_type -> server.PProfData
+	85,  // 215: server.Runner.CallAI:output_type -> server.AIResponse
+	87,  // 216: server.Runner.GetAICapabilities:output_type -> server.AICapabilitiesResponse
+	62,  // 217: server.RunnerExtension.Run:output_type -> server.CommonResult
+	1,   // 218: server.UIExtension.GetMenus:output_type -> server.MenuList
+	62,  // 219: server.UIExtension.GetPageOfJS:output_type -> server.CommonResult
+	62,  // 220: server.UIExtension.GetPageOfCSS:output_type -> server.CommonResult
+	62,  // 221: server.UIExtension.GetPageOfStatic:output_type -> server.CommonResult
+	62,  // 222: server.UIExtension.GetPageOfServer:output_type -> server.CommonResult
+	63,  // 223: server.ThemeExtension.GetThemes:output_type -> server.SimpleList
+	62,  // 224: server.ThemeExtension.GetTheme:output_type -> server.CommonResult
+	63,  // 225: server.ThemeExtension.GetBindings:output_type -> server.SimpleList
+	62,  // 226: server.ThemeExtension.GetBinding:output_type -> server.CommonResult
+	77,  // 227: server.Mock.Reload:output_type -> server.Empty
+	78,  // 228: server.Mock.GetConfig:output_type -> server.MockConfig
+	62,  // 229: server.Mock.LogWatch:output_type -> server.CommonResult
+	82,  // 230: server.DataServer.Query:output_type -> server.DataQueryResult
+	153, // [153:231] is the sub-list for method output_type
+	75,  // [75:153] is the sub-list for method input_type
+	75,  // [75:75] is the sub-list for extension type_name
+	75,  // [75:75] is the sub-list for extension extendee
+	0,   // [0:75] is the sub-list for field type_name
 }
 
 func init() { file_pkg_server_server_proto_init() }
@@ -5410,7 +7649,151 @@ func file_pkg_server_server_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_pkg_server_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerProxyRequest); i {
+			switch v := v.(*Menu); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Suites); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Items); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistorySuites); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryItems); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryCaseIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestCaseIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestSuiteSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestSuite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExprFunction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiteAuth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JWTAuth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5421,8 +7804,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerProxyResponse); i {
+		file_pkg_server_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HMACAuth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5433,8 +7816,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Menu); i {
+		file_pkg_server_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AWSAuth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5445,8 +7828,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MenuList); i {
+		file_pkg_server_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OAuth2Auth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5457,8 +7840,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Suites); i {
+		file_pkg_server_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestSuiteWithCase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5469,8 +7852,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Items); i {
+		file_pkg_server_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APISpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5481,8 +7864,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistorySuites); i {
+		file_pkg_server_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5493,8 +7876,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoryItems); i {
+		file_pkg_server_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RPC); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5505,8 +7888,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoryCaseIdentity); i {
+		file_pkg_server_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestSuiteIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5517,8 +7900,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestCaseIdentity); i {
+		file_pkg_server_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiteRevision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5529,8 +7912,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestSuiteSource); i {
+		file_pkg_server_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiteRevisions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5541,8 +7924,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestSuite); i {
+		file_pkg_server_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiteRevisionQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5553,8 +7936,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestSuiteWithCase); i {
+		file_pkg_server_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiteRevisionIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5565,8 +7948,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APISpec); i {
+		file_pkg_server_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotAcceptance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5577,8 +7960,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Secure); i {
+		file_pkg_server_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiteRevisionDiffRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5589,8 +7972,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RPC); i {
+		file_pkg_server_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5601,8 +7984,8 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestSuiteIdentity); i {
+		file_pkg_server_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuiteRevisionDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5613,7 +7996,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestSuiteDuplicate); i {
 			case 0:
 				return &v.state
@@ -5625,7 +8008,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestCaseDuplicate); i {
 			case 0:
 				return &v.state
@@ -5637,7 +8020,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestTask); i {
 			case 0:
 				return &v.state
@@ -5649,7 +8032,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchTestTask); i {
 			case 0:
 				return &v.state
@@ -5661,7 +8044,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestResult); i {
 			case 0:
 				return &v.state
@@ -5673,7 +8056,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HistoryTestResult); i {
 			case 0:
 				return &v.state
@@ -5685,7 +8068,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HelloReply); i {
 			case 0:
 				return &v.state
@@ -5697,7 +8080,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*YamlData); i {
 			case 0:
 				return &v.state
@@ -5709,7 +8092,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Suite); i {
 			case 0:
 				return &v.state
@@ -5721,7 +8104,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestCaseWithSuite); i {
 			case 0:
 				return &v.state
@@ -5733,7 +8116,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestCases); i {
 			case 0:
 				return &v.state
@@ -5745,7 +8128,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestCase); i {
 			case 0:
 				return &v.state
@@ -5757,7 +8140,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HistoryTestCase); i {
 			case 0:
 				return &v.state
@@ -5769,7 +8152,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HistoryTestCases); i {
 			case 0:
 				return &v.state
@@ -5781,7 +8164,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Request); i {
 			case 0:
 				return &v.state
@@ -5793,7 +8176,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Response); i {
 			case 0:
 				return &v.state
@@ -5805,7 +8188,43 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAssertion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BodyMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConditionalVerify); i {
 			case 0:
 				return &v.state
@@ -5817,7 +8236,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestCaseResult); i {
 			case 0:
 				return &v.state
@@ -5829,7 +8248,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Pair); i {
 			case 0:
 				return &v.state
@@ -5841,7 +8260,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Pairs); i {
 			case 0:
 				return &v.state
@@ -5853,7 +8272,31 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDefinedFunction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDefinedFunctions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SimpleQuery); i {
 			case 0:
 				return &v.state
@@ -5865,7 +8308,19 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreSyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Stores); i {
 			case 0:
 				return &v.state
@@ -5877,7 +8332,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Store); i {
 			case 0:
 				return &v.state
@@ -5889,7 +8344,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StoreKinds); i {
 			case 0:
 				return &v.state
@@ -5901,7 +8356,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StoreKind); i {
 			case 0:
 				return &v.state
@@ -5913,7 +8368,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StoreKindDependency); i {
 			case 0:
 				return &v.state
@@ -5925,7 +8380,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StoreKindParam); i {
 			case 0:
 				return &v.state
@@ -5937,7 +8392,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommonResult); i {
 			case 0:
 				return &v.state
@@ -5949,7 +8404,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SimpleList); i {
 			case 0:
 				return &v.state
@@ -5961,7 +8416,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SimpleName); i {
 			case 0:
 				return &v.state
@@ -5973,7 +8428,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CodeGenerateRequest); i {
 			case 0:
 				return &v.state
@@ -5985,7 +8440,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Secrets); i {
 			case 0:
 				return &v.state
@@ -5997,7 +8452,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Secret); i {
 			case 0:
 				return &v.state
@@ -6009,7 +8464,67 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBindings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_server_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExtensionStatus); i {
 			case 0:
 				return &v.state
@@ -6021,7 +8536,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PProfRequest); i {
 			case 0:
 				return &v.state
@@ -6033,7 +8548,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PProfData); i {
 			case 0:
 				return &v.state
@@ -6045,7 +8560,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileData); i {
 			case 0:
 				return &v.state
@@ -6057,7 +8572,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -6069,7 +8584,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MockConfig); i {
 			case 0:
 				return &v.state
@@ -6081,7 +8596,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Version); i {
 			case 0:
 				return &v.state
@@ -6093,7 +8608,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProxyConfig); i {
 			case 0:
 				return &v.state
@@ -6105,7 +8620,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataQuery); i {
 			case 0:
 				return &v.state
@@ -6117,7 +8632,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataQueryResult); i {
 			case 0:
 				return &v.state
@@ -6129,7 +8644,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DataMeta); i {
 			case 0:
 				return &v.state
@@ -6141,7 +8656,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AIRequest); i {
 			case 0:
 				return &v.state
@@ -6153,7 +8668,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AIResponse); i {
 			case 0:
 				return &v.state
@@ -6165,7 +8680,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AICapabilitiesRequest); i {
 			case 0:
 				return &v.state
@@ -6177,7 +8692,7 @@ func file_pkg_server_server_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_server_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_server_server_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AICapabilitiesResponse); i {
 			case 0:
 				return &v.state
@@ -6190,13 +8705,14 @@ func file_pkg_server_server_proto_init() {
 			}
 		}
 	}
+	file_pkg_server_server_proto_msgTypes[45].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_server_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   68,
+			NumMessages:   91,
 			NumExtensions: 0,
 			NumServices:   6,
 		},
@@ -29,16 +29,23 @@ type RunnerClient interface {
 	GetSuites(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Suites, error)
 	CreateTestSuite(ctx context.Context, in *TestSuiteIdentity, opts ...grpc.CallOption) (*HelloReply, error)
 	ImportTestSuite(ctx context.Context, in *TestSuiteSource, opts ...grpc.CallOption) (*CommonResult, error)
+	GenerateTestSuite(ctx context.Context, in *TestSuiteSource, opts ...grpc.CallOption) (*CommonResult, error)
 	GetTestSuite(ctx context.Context, in *TestSuiteIdentity, opts ...grpc.CallOption) (*TestSuite, error)
 	UpdateTestSuite(ctx context.Context, in *TestSuite, opts ...grpc.CallOption) (*HelloReply, error)
 	DeleteTestSuite(ctx context.Context, in *TestSuiteIdentity, opts ...grpc.CallOption) (*HelloReply, error)
 	DuplicateTestSuite(ctx context.Context, in *TestSuiteDuplicate, opts ...grpc.CallOption) (*HelloReply, error)
 	RenameTestSuite(ctx context.Context, in *TestSuiteDuplicate, opts ...grpc.CallOption) (*HelloReply, error)
 	GetTestSuiteYaml(ctx context.Context, in *TestSuiteIdentity, opts ...grpc.CallOption) (*YamlData, error)
+	// suite revisions related
+	ListSuiteRevisions(ctx context.Context, in *SuiteRevisionQuery, opts ...grpc.CallOption) (*SuiteRevisions, error)
+	DiffSuiteRevisions(ctx context.Context, in *SuiteRevisionDiffRequest, opts ...grpc.CallOption) (*SuiteRevisionDiff, error)
+	RollbackSuiteRevision(ctx context.Context, in *SuiteRevisionIdentity, opts ...grpc.CallOption) (*CommonResult, error)
 	// test cases related
 	ListTestCase(ctx context.Context, in *TestSuiteIdentity, opts ...grpc.CallOption) (*Suite, error)
 	// run target test case of a specific test suite
 	RunTestCase(ctx context.Context, in *TestCaseIdentity, opts ...grpc.CallOption) (*TestCaseResult, error)
+	// accept the response as the snapshot of a test case
+	AcceptSnapshot(ctx context.Context, in *SnapshotAcceptance, opts ...grpc.CallOption) (*CommonResult, error)
 	BatchRun(ctx context.Context, opts ...grpc.CallOption) (Runner_BatchRunClient, error)
 	GetTestCase(ctx context.Context, in *TestCaseIdentity, opts ...grpc.CallOption) (*TestCase, error)
 	CreateTestCase(ctx context.Context, in *TestCaseWithSuite, opts ...grpc.CallOption) (*HelloReply, error)
@@ -64,6 +71,7 @@ type RunnerClient interface {
 	// common services
 	PopularHeaders(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Pairs, error)
 	FunctionsQuery(ctx context.Context, in *SimpleQuery, opts ...grpc.CallOption) (*Pairs, error)
+	UpdateFunctions(ctx context.Context, in *UserDefinedFunctions, opts ...grpc.CallOption) (*CommonResult, error)
 	FunctionsQueryStream(ctx context.Context, opts ...grpc.CallOption) (Runner_FunctionsQueryStreamClient, error)
 	GetSchema(ctx context.Context, in *SimpleQuery, opts ...grpc.CallOption) (*CommonResult, error)
 	GetVersion(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Version, error)
@@ -76,11 +84,19 @@ type RunnerClient interface {
 	UpdateStore(ctx context.Context, in *Store, opts ...grpc.CallOption) (*Store, error)
 	DeleteStore(ctx context.Context, in *Store, opts ...grpc.CallOption) (*Store, error)
 	VerifyStore(ctx context.Context, in *SimpleQuery, opts ...grpc.CallOption) (*ExtensionStatus, error)
+	SyncStore(ctx context.Context, in *StoreSyncRequest, opts ...grpc.CallOption) (*CommonResult, error)
 	// secret related interfaces
 	GetSecrets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Secrets, error)
 	CreateSecret(ctx context.Context, in *Secret, opts ...grpc.CallOption) (*CommonResult, error)
 	DeleteSecret(ctx context.Context, in *Secret, opts ...grpc.CallOption) (*CommonResult, error)
 	UpdateSecret(ctx context.Context, in *Secret, opts ...grpc.CallOption) (*CommonResult, error)
+	// role based access control related interfaces
+	GetRoleBindings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RoleBindings, error)
+	CreateRoleBinding(ctx context.Context, in *RoleBinding, opts ...grpc.CallOption) (*CommonResult, error)
+	UpdateRoleBinding(ctx context.Context, in *RoleBinding, opts ...grpc.CallOption) (*CommonResult, error)
+	DeleteRoleBinding(ctx context.Context, in *RoleBinding, opts ...grpc.CallOption) (*CommonResult, error)
+	// audit log related interfaces
+	QueryAuditLog(ctx context.Context, in *AuditQuery, opts ...grpc.CallOption) (*AuditEntries, error)
 	// extension
 	PProf(ctx context.Context, in *PProfRequest, opts ...grpc.CallOption) (*PProfData, error)
 	// AI plugin methods
@@ -163,6 +179,15 @@ func (c *runnerClient) ImportTestSuite(ctx context.Context, in *TestSuiteSource,
 	return out, nil
 }
 
+func (c *runnerClient) GenerateTestSuite(ctx context.Context, in *TestSuiteSource, opts ...grpc.CallOption) (*CommonResult, error) {
+	out := new(CommonResult)
+	err := c.cc.Invoke(ctx, "/server.Runner/GenerateTestSuite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runnerClient) GetTestSuite(ctx context.Context, in *TestSuiteIdentity, opts ...grpc.CallOption) (*TestSuite, error) {
 	out := new(TestSuite)
 	err := c.cc.Invoke(ctx, "/server.Runner/GetTestSuite", in, out, opts...)
@@ -217,6 +242,33 @@ func (c *runnerClient) GetTestSuiteYaml(ctx context.Context, in *TestSuiteIdenti
 	return out, nil
 }
 
+func (c *runnerClient) ListSuiteRevisions(ctx context.Context, in *SuiteRevisionQuery, opts ...grpc.CallOption) (*SuiteRevisions, error) {
+	out := new(SuiteRevisions)
+	err := c.cc.Invoke(ctx, "/server.Runner/ListSuiteRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) DiffSuiteRevisions(ctx context.Context, in *SuiteRevisionDiffRequest, opts ...grpc.CallOption) (*SuiteRevisionDiff, error) {
+	out := new(SuiteRevisionDiff)
+	err := c.cc.Invoke(ctx, "/server.Runner/DiffSuiteRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) RollbackSuiteRevision(ctx context.Context, in *SuiteRevisionIdentity, opts ...grpc.CallOption) (*CommonResult, error) {
+	out := new(CommonResult)
+	err := c.cc.Invoke(ctx, "/server.Runner/RollbackSuiteRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runnerClient) ListTestCase(ctx context.Context, in *TestSuiteIdentity, opts ...grpc.CallOption) (*Suite, error) {
 	out := new(Suite)
 	err := c.cc.Invoke(ctx, "/server.Runner/ListTestCase", in, out, opts...)
@@ -235,6 +287,15 @@ func (c *runnerClient) RunTestCase(ctx context.Context, in *TestCaseIdentity, op
 	return out, nil
 }
 
+func (c *runnerClient) AcceptSnapshot(ctx context.Context, in *SnapshotAcceptance, opts ...grpc.CallOption) (*CommonResult, error) {
+	out := new(CommonResult)
+	err := c.cc.Invoke(ctx, "/server.Runner/AcceptSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runnerClient) BatchRun(ctx context.Context, opts ...grpc.CallOption) (Runner_BatchRunClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Runner_ServiceDesc.Streams[1], "/server.Runner/BatchRun", opts...)
 	if err != nil {
@@ -446,6 +507,15 @@ func (c *runnerClient) FunctionsQuery(ctx context.Context, in *SimpleQuery, opts
 	return out, nil
 }
 
+func (c *runnerClient) UpdateFunctions(ctx context.Context, in *UserDefinedFunctions, opts ...grpc.CallOption) (*CommonResult, error) {
+	out := new(CommonResult)
+	err := c.cc.Invoke(ctx, "/server.Runner/UpdateFunctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runnerClient) FunctionsQueryStream(ctx context.Context, opts ...grpc.CallOption) (Runner_FunctionsQueryStreamClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Runner_ServiceDesc.Streams[2], "/server.Runner/FunctionsQueryStream", opts...)
 	if err != nil {
@@ -567,6 +637,15 @@ func (c *runnerClient) VerifyStore(ctx context.Context, in *SimpleQuery, opts ..
 	return out, nil
 }
 
+func (c *runnerClient) SyncStore(ctx context.Context, in *StoreSyncRequest, opts ...grpc.CallOption) (*CommonResult, error) {
+	out := new(CommonResult)
+	err := c.cc.Invoke(ctx, "/server.Runner/SyncStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runnerClient) GetSecrets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Secrets, error) {
 	out := new(Secrets)
 	err := c.cc.Invoke(ctx, "/server.Runner/GetSecrets", in, out, opts...)
@@ -603,6 +682,51 @@ func (c *runnerClient) UpdateSecret(ctx context.Context, in *Secret, opts ...grp
 	return out, nil
 }
 
+func (c *runnerClient) GetRoleBindings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RoleBindings, error) {
+	out := new(RoleBindings)
+	err := c.cc.Invoke(ctx, "/server.Runner/GetRoleBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) CreateRoleBinding(ctx context.Context, in *RoleBinding, opts ...grpc.CallOption) (*CommonResult, error) {
+	out := new(CommonResult)
+	err := c.cc.Invoke(ctx, "/server.Runner/CreateRoleBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) UpdateRoleBinding(ctx context.Context, in *RoleBinding, opts ...grpc.CallOption) (*CommonResult, error) {
+	out := new(CommonResult)
+	err := c.cc.Invoke(ctx, "/server.Runner/UpdateRoleBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) DeleteRoleBinding(ctx context.Context, in *RoleBinding, opts ...grpc.CallOption) (*CommonResult, error) {
+	out := new(CommonResult)
+	err := c.cc.Invoke(ctx, "/server.Runner/DeleteRoleBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) QueryAuditLog(ctx context.Context, in *AuditQuery, opts ...grpc.CallOption) (*AuditEntries, error) {
+	out := new(AuditEntries)
+	err := c.cc.Invoke(ctx, "/server.Runner/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runnerClient) PProf(ctx context.Context, in *PProfRequest, opts ...grpc.CallOption) (*PProfData, error) {
 	out := new(PProfData)
 	err := c.cc.Invoke(ctx, "/server.Runner/PProf", in, out, opts...)
@@ -641,16 +765,23 @@ type RunnerServer interface {
 	GetSuites(context.Context, *Empty) (*Suites, error)
 	CreateTestSuite(context.Context, *TestSuiteIdentity) (*HelloReply, error)
 	ImportTestSuite(context.Context, *TestSuiteSource) (*CommonResult, error)
+	GenerateTestSuite(context.Context, *TestSuiteSource) (*CommonResult, error)
 	GetTestSuite(context.Context, *TestSuiteIdentity) (*TestSuite, error)
 	UpdateTestSuite(context.Context, *TestSuite) (*HelloReply, error)
 	DeleteTestSuite(context.Context, *TestSuiteIdentity) (*HelloReply, error)
 	DuplicateTestSuite(context.Context, *TestSuiteDuplicate) (*HelloReply, error)
 	RenameTestSuite(context.Context, *TestSuiteDuplicate) (*HelloReply, error)
 	GetTestSuiteYaml(context.Context, *TestSuiteIdentity) (*YamlData, error)
+	// suite revisions related
+	ListSuiteRevisions(context.Context, *SuiteRevisionQuery) (*SuiteRevisions, error)
+	DiffSuiteRevisions(context.Context, *SuiteRevisionDiffRequest) (*SuiteRevisionDiff, error)
+	RollbackSuiteRevision(context.Context, *SuiteRevisionIdentity) (*CommonResult, error)
 	// test cases related
 	ListTestCase(context.Context, *TestSuiteIdentity) (*Suite, error)
 	// run target test case of a specific test suite
 	RunTestCase(context.Context, *TestCaseIdentity) (*TestCaseResult, error)
+	// accept the response as the snapshot of a test case
+	AcceptSnapshot(context.Context, *SnapshotAcceptance) (*CommonResult, error)
 	BatchRun(Runner_BatchRunServer) error
 	GetTestCase(context.Context, *TestCaseIdentity) (*TestCase, error)
 	CreateTestCase(context.Context, *TestCaseWithSuite) (*HelloReply, error)
@@ -676,6 +807,7 @@ type RunnerServer interface {
 	// common services
 	PopularHeaders(context.Context, *Empty) (*Pairs, error)
 	FunctionsQuery(context.Context, *SimpleQuery) (*Pairs, error)
+	UpdateFunctions(context.Context, *UserDefinedFunctions) (*CommonResult, error)
 	FunctionsQueryStream(Runner_FunctionsQueryStreamServer) error
 	GetSchema(context.Context, *SimpleQuery) (*CommonResult, error)
 	GetVersion(context.Context, *Empty) (*Version, error)
@@ -688,11 +820,19 @@ type RunnerServer interface {
 	UpdateStore(context.Context, *Store) (*Store, error)
 	DeleteStore(context.Context, *Store) (*Store, error)
 	VerifyStore(context.Context, *SimpleQuery) (*ExtensionStatus, error)
+	SyncStore(context.Context, *StoreSyncRequest) (*CommonResult, error)
 	// secret related interfaces
 	GetSecrets(context.Context, *Empty) (*Secrets, error)
 	CreateSecret(context.Context, *Secret) (*CommonResult, error)
 	DeleteSecret(context.Context, *Secret) (*CommonResult, error)
 	UpdateSecret(context.Context, *Secret) (*CommonResult, error)
+	// role based access control related interfaces
+	GetRoleBindings(context.Context, *Empty) (*RoleBindings, error)
+	CreateRoleBinding(context.Context, *RoleBinding) (*CommonResult, error)
+	UpdateRoleBinding(context.Context, *RoleBinding) (*CommonResult, error)
+	DeleteRoleBinding(context.Context, *RoleBinding) (*CommonResult, error)
+	// audit log related interfaces
+	QueryAuditLog(context.Context, *AuditQuery) (*AuditEntries, error)
 	// extension
 	PProf(context.Context, *PProfRequest) (*PProfData, error)
 	// AI plugin methods
@@ -720,6 +860,9 @@ func (UnimplementedRunnerServer) CreateTestSuite(context.Context, *TestSuiteIden
 func (UnimplementedRunnerServer) ImportTestSuite(context.Context, *TestSuiteSource) (*CommonResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportTestSuite not implemented")
 }
+func (UnimplementedRunnerServer) GenerateTestSuite(context.Context, *TestSuiteSource) (*CommonResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateTestSuite not implemented")
+}
 func (UnimplementedRunnerServer) GetTestSuite(context.Context, *TestSuiteIdentity) (*TestSuite, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTestSuite not implemented")
 }
@@ -738,12 +881,24 @@ func (UnimplementedRunnerServer) RenameTestSuite(context.Context, *TestSuiteDupl
 func (UnimplementedRunnerServer) GetTestSuiteYaml(context.Context, *TestSuiteIdentity) (*YamlData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTestSuiteYaml not implemented")
 }
+func (UnimplementedRunnerServer) ListSuiteRevisions(context.Context, *SuiteRevisionQuery) (*SuiteRevisions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuiteRevisions not implemented")
+}
+func (UnimplementedRunnerServer) DiffSuiteRevisions(context.Context, *SuiteRevisionDiffRequest) (*SuiteRevisionDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffSuiteRevisions not implemented")
+}
+func (UnimplementedRunnerServer) RollbackSuiteRevision(context.Context, *SuiteRevisionIdentity) (*CommonResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackSuiteRevision not implemented")
+}
 func (UnimplementedRunnerServer) ListTestCase(context.Context, *TestSuiteIdentity) (*Suite, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTestCase not implemented")
 }
 func (UnimplementedRunnerServer) RunTestCase(context.Context, *TestCaseIdentity) (*TestCaseResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunTestCase not implemented")
 }
+func (UnimplementedRunnerServer) AcceptSnapshot(context.Context, *SnapshotAcceptance) (*CommonResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSnapshot not implemented")
+}
 func (UnimplementedRunnerServer) BatchRun(Runner_BatchRunServer) error {
 	return status.Errorf(codes.Unimplemented, "method BatchRun not implemented")
 }
@@ -807,6 +962,9 @@ func (UnimplementedRunnerServer) PopularHeaders(context.Context, *Empty) (*Pairs
 func (UnimplementedRunnerServer) FunctionsQuery(context.Context, *SimpleQuery) (*Pairs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FunctionsQuery not implemented")
 }
+func (UnimplementedRunnerServer) UpdateFunctions(context.Context, *UserDefinedFunctions) (*CommonResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFunctions not implemented")
+}
 func (UnimplementedRunnerServer) FunctionsQueryStream(Runner_FunctionsQueryStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method FunctionsQueryStream not implemented")
 }
@@ -840,6 +998,9 @@ func (UnimplementedRunnerServer) DeleteStore(context.Context, *Store) (*Store, e
 func (UnimplementedRunnerServer) VerifyStore(context.Context, *SimpleQuery) (*ExtensionStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyStore not implemented")
 }
+func (UnimplementedRunnerServer) SyncStore(context.Context, *StoreSyncRequest) (*CommonResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncStore not implemented")
+}
 func (UnimplementedRunnerServer) GetSecrets(context.Context, *Empty) (*Secrets, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSecrets not implemented")
 }
@@ -852,6 +1013,21 @@ func (UnimplementedRunnerServer) DeleteSecret(context.Context, *Secret) (*Common
 func (UnimplementedRunnerServer) UpdateSecret(context.Context, *Secret) (*CommonResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecret not implemented")
 }
+func (UnimplementedRunnerServer) GetRoleBindings(context.Context, *Empty) (*RoleBindings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleBindings not implemented")
+}
+func (UnimplementedRunnerServer) CreateRoleBinding(context.Context, *RoleBinding) (*CommonResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleBinding not implemented")
+}
+func (UnimplementedRunnerServer) UpdateRoleBinding(context.Context, *RoleBinding) (*CommonResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoleBinding not implemented")
+}
+func (UnimplementedRunnerServer) DeleteRoleBinding(context.Context, *RoleBinding) (*CommonResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleBinding not implemented")
+}
+func (UnimplementedRunnerServer) QueryAuditLog(context.Context, *AuditQuery) (*AuditEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
 func (UnimplementedRunnerServer) PProf(context.Context, *PProfRequest) (*PProfData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PProf not implemented")
 }
@@ -972,6 +1148,24 @@ func _Runner_ImportTestSuite_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runner_GenerateTestSuite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestSuiteSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GenerateTestSuite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/GenerateTestSuite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GenerateTestSuite(ctx, req.(*TestSuiteSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Runner_GetTestSuite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TestSuiteIdentity)
 	if err := dec(in); err != nil {
@@ -1080,6 +1274,60 @@ func _Runner_GetTestSuiteYaml_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runner_ListSuiteRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuiteRevisionQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).ListSuiteRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/ListSuiteRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).ListSuiteRevisions(ctx, req.(*SuiteRevisionQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_DiffSuiteRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuiteRevisionDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).DiffSuiteRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/DiffSuiteRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).DiffSuiteRevisions(ctx, req.(*SuiteRevisionDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_RollbackSuiteRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuiteRevisionIdentity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).RollbackSuiteRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/RollbackSuiteRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).RollbackSuiteRevision(ctx, req.(*SuiteRevisionIdentity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Runner_ListTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TestSuiteIdentity)
 	if err := dec(in); err != nil {
@@ -1116,6 +1364,24 @@ func _Runner_RunTestCase_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runner_AcceptSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotAcceptance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).AcceptSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/AcceptSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).AcceptSnapshot(ctx, req.(*SnapshotAcceptance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Runner_BatchRun_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(RunnerServer).BatchRun(&runnerBatchRunServer{stream})
 }
@@ -1502,6 +1768,24 @@ func _Runner_FunctionsQuery_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runner_UpdateFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDefinedFunctions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).UpdateFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/UpdateFunctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).UpdateFunctions(ctx, req.(*UserDefinedFunctions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Runner_FunctionsQueryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(RunnerServer).FunctionsQueryStream(&runnerFunctionsQueryStreamServer{stream})
 }
@@ -1708,6 +1992,24 @@ func _Runner_VerifyStore_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runner_SyncStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).SyncStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/SyncStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).SyncStore(ctx, req.(*StoreSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Runner_GetSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -1780,6 +2082,96 @@ func _Runner_UpdateSecret_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runner_GetRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/GetRoleBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetRoleBindings(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_CreateRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleBinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).CreateRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/CreateRoleBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).CreateRoleBinding(ctx, req.(*RoleBinding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_UpdateRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleBinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).UpdateRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/UpdateRoleBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).UpdateRoleBinding(ctx, req.(*RoleBinding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_DeleteRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleBinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).DeleteRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/DeleteRoleBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).DeleteRoleBinding(ctx, req.(*RoleBinding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Runner/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).QueryAuditLog(ctx, req.(*AuditQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Runner_PProf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PProfRequest)
 	if err := dec(in); err != nil {
@@ -1857,6 +2249,10 @@ var Runner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportTestSuite",
 			Handler:    _Runner_ImportTestSuite_Handler,
 		},
+		{
+			MethodName: "GenerateTestSuite",
+			Handler:    _Runner_GenerateTestSuite_Handler,
+		},
 		{
 			MethodName: "GetTestSuite",
 			Handler:    _Runner_GetTestSuite_Handler,
@@ -1881,6 +2277,18 @@ var Runner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTestSuiteYaml",
 			Handler:    _Runner_GetTestSuiteYaml_Handler,
 		},
+		{
+			MethodName: "ListSuiteRevisions",
+			Handler:    _Runner_ListSuiteRevisions_Handler,
+		},
+		{
+			MethodName: "DiffSuiteRevisions",
+			Handler:    _Runner_DiffSuiteRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackSuiteRevision",
+			Handler:    _Runner_RollbackSuiteRevision_Handler,
+		},
 		{
 			MethodName: "ListTestCase",
 			Handler:    _Runner_ListTestCase_Handler,
@@ -1889,6 +2297,10 @@ var Runner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunTestCase",
 			Handler:    _Runner_RunTestCase_Handler,
 		},
+		{
+			MethodName: "AcceptSnapshot",
+			Handler:    _Runner_AcceptSnapshot_Handler,
+		},
 		{
 			MethodName: "GetTestCase",
 			Handler:    _Runner_GetTestCase_Handler,
@@ -1969,6 +2381,10 @@ var Runner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FunctionsQuery",
 			Handler:    _Runner_FunctionsQuery_Handler,
 		},
+		{
+			MethodName: "UpdateFunctions",
+			Handler:    _Runner_UpdateFunctions_Handler,
+		},
 		{
 			MethodName: "GetSchema",
 			Handler:    _Runner_GetSchema_Handler,
@@ -2009,6 +2425,10 @@ var Runner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyStore",
 			Handler:    _Runner_VerifyStore_Handler,
 		},
+		{
+			MethodName: "SyncStore",
+			Handler:    _Runner_SyncStore_Handler,
+		},
 		{
 			MethodName: "GetSecrets",
 			Handler:    _Runner_GetSecrets_Handler,
@@ -2025,6 +2445,26 @@ var Runner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSecret",
 			Handler:    _Runner_UpdateSecret_Handler,
 		},
+		{
+			MethodName: "GetRoleBindings",
+			Handler:    _Runner_GetRoleBindings_Handler,
+		},
+		{
+			MethodName: "CreateRoleBinding",
+			Handler:    _Runner_CreateRoleBinding_Handler,
+		},
+		{
+			MethodName: "UpdateRoleBinding",
+			Handler:    _Runner_UpdateRoleBinding_Handler,
+		},
+		{
+			MethodName: "DeleteRoleBinding",
+			Handler:    _Runner_DeleteRoleBinding_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _Runner_QueryAuditLog_Handler,
+		},
 		{
 			MethodName: "PProf",
 			Handler:    _Runner_PProf_Handler,
@@ -0,0 +1,78 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/linuxsuren/api-testing/pkg/runner"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+)
+
+// AcceptSnapshot saves the response as the snapshot of a test case, the snapshot is next to the suite file
+func (s *server) AcceptSnapshot(ctx context.Context, in *SnapshotAcceptance) (reply *CommonResult, err error) {
+	loader := s.getLoader(ctx)
+	defer loader.Close()
+
+	var suite *testing.TestSuite
+	var suitePath string
+	if suite, suitePath, err = loader.GetSuite(in.Suite); err != nil {
+		return
+	}
+	if suite == nil {
+		err = fmt.Errorf("not found suite: %s", in.Suite)
+		return
+	}
+	if suitePath == "" {
+		err = fmt.Errorf("the store of suite %s does not support snapshots", in.Suite)
+		return
+	}
+
+	testCase := suite.FindTestCase(in.Testcase)
+	if testCase == nil {
+		err = fmt.Errorf("not found test case: %s", in.Testcase)
+		return
+	}
+
+	path := runner.SnapshotPath(filepath.Dir(suitePath), suite.Name, testCase)
+	snapshot := runner.NewResponseSnapshot(testCase.Expect.Snapshot, pairToMap(in.Header), []byte(in.Body))
+	save := func() error {
+		return runner.SaveSnapshot(path, snapshot)
+	}
+	// the stores like git record the snapshot as a change
+	if committer, ok := loader.(testing.ChangeCommitter); ok {
+		err = committer.CommitChange(testing.RevisionActionAcceptSnapshot, suite.Name, testCase.Name, save)
+	} else {
+		err = save()
+	}
+	if err == nil {
+		reply = &CommonResult{
+			Success: true,
+			Message: fmt.Sprintf("the snapshot is saved to %s", path),
+		}
+	}
+	return
+}
+
+// withSnapshotDir sets the directory of the suite file as the parent dir, the snapshots are resolved from it
+func withSnapshotDir(ctx context.Context, loader testing.Writer, suite string) context.Context {
+	if target, suitePath, err := loader.GetSuite(suite); err == nil && target != nil && suitePath != "" {
+		ctx = context.WithValue(ctx, runner.NewContextKeyBuilder().ParentDir(), filepath.Dir(suitePath))
+	}
+	return ctx
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package server
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcceptSnapshot(t *testing.T) {
+	ctx := context.Background()
+	server, clean := getRemoteServerInTempDir()
+	defer clean()
+
+	_, err := server.CreateTestSuite(ctx, &TestSuiteIdentity{Name: "users", Api: "http://localhost"})
+	assert.NoError(t, err)
+	_, err = server.CreateTestCase(ctx, &TestCaseWithSuite{
+		SuiteName: "users",
+		Data: &TestCase{
+			Name:    "get",
+			Request: &Request{Api: "/users/1"},
+			Response: &Response{
+				Snapshot: &Snapshot{Header: []string{"Content-Type"}, Redact: []string{"id"}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	reply, err := server.AcceptSnapshot(ctx, &SnapshotAcceptance{
+		Suite:    "users",
+		Testcase: "get",
+		Body:     `{"id": 1, "name": "rick"}`,
+		Header: []*Pair{
+			{Key: "Content-Type", Value: "application/json"},
+			{Key: "Date", Value: "today"},
+		},
+	})
+	if assert.NoError(t, err) && assert.True(t, reply.Success) {
+		path := reply.Message[len("the snapshot is saved to "):]
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, `{
+  "header": {
+    "Content-Type": "application/json"
+  },
+  "body": {
+    "id": "$any",
+    "name": "rick"
+  }
+}
+`, string(data))
+	}
+
+	_, err = server.AcceptSnapshot(ctx, &SnapshotAcceptance{Suite: "fake", Testcase: "get"})
+	assert.ErrorContains(t, err, "not found suite")
+
+	_, err = server.AcceptSnapshot(ctx, &SnapshotAcceptance{Suite: "users", Testcase: "fake"})
+	assert.ErrorContains(t, err, "not found test case")
+}
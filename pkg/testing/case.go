@@ -23,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/linuxsuren/api-testing/pkg/render"
 	"github.com/linuxsuren/api-testing/pkg/util"
 	"gopkg.in/yaml.v3"
 )
@@ -35,6 +36,24 @@ type TestSuite struct {
 	Param map[string]string `yaml:"param,omitempty" json:"param,omitempty"`
 	Items []TestCase        `yaml:"items,omitempty" json:"items,omitempty"`
 	Proxy *Proxy            `yaml:"proxy,omitempty" json:"proxy,omitempty"`
+	// Functions are the user-defined template functions which are only available in this suite
+	Functions []render.UserDefinedTemplate `yaml:"functions,omitempty" json:"functions,omitempty"`
+	// Auth signs the HTTP requests of this suite before they are sent
+	Auth *Auth `yaml:"auth,omitempty" json:"auth,omitempty"`
+	// Before and After are the jobs which run once around all the test cases of this suite
+	Before *Job `yaml:"before,omitempty" json:"before,omitempty"`
+	After  *Job `yaml:"after,omitempty" json:"after,omitempty"`
+	// Assertions are the named verify expressions which return a bool, all the test cases could call them
+	Assertions []ExprFunction `yaml:"assertions,omitempty" json:"assertions,omitempty"`
+	// Helpers are the expr functions which could be called by the verify expressions and the jobs
+	Helpers []ExprFunction `yaml:"helpers,omitempty" json:"helpers,omitempty"`
+}
+
+// ExprFunction is a parameterized expr expression which is called like a function, such as: isPage(data, 10)
+type ExprFunction struct {
+	Name   string   `yaml:"name" json:"name"`
+	Params []string `yaml:"params,omitempty" json:"params,omitempty"`
+	Expr   string   `yaml:"expr" json:"expr"`
 }
 
 type APISpec struct {
@@ -43,6 +62,24 @@ type APISpec struct {
 	RPC    *RPCDesc `yaml:"rpc,omitempty" json:"rpc,omitempty"`
 	Secure *Secure  `yaml:"secure,omitempty" json:"secure,omitempty"`
 	Metric *Metric  `yaml:"metric,omitempty" json:"metric,omitempty"`
+	// Contract is the mode of validating the responses against the Swagger or OpenAPI document, it's warn by default
+	Contract string `yaml:"contract,omitempty" json:"contract,omitempty" jsonschema:"enum=warn,enum=strict,enum=none"`
+}
+
+// The modes of the contract validation
+const (
+	// ContractWarn reports the violations as warnings, it is the default mode
+	ContractWarn = "warn"
+	// ContractStrict reports the violations as test failures
+	ContractStrict = "strict"
+	// ContractNone disables the contract validation explicitly
+	ContractNone = "none"
+)
+
+// IsContractEnabled checks if the responses need to be validated against the API document
+func (s APISpec) IsContractEnabled() bool {
+	return (s.Kind == "swagger" || s.Kind == "openapi") && s.URL != "" &&
+		s.Contract != ContractNone
 }
 
 type HistoryTestSuite struct {
@@ -99,6 +136,65 @@ type Proxy struct {
 	No    string `yaml:"no,omitempty" json:"no,omitempty"`       // Comma-separated list of hosts to exclude from proxying
 }
 
+// The modes of the request authentication
+const (
+	AuthModeJWT    = "jwt"
+	AuthModeHMAC   = "hmac"
+	AuthModeAWS    = "aws-sigv4"
+	AuthModeOAuth2 = "oauth2"
+)
+
+// Auth is the authentication of the requests, only the setting of the mode takes effect
+type Auth struct {
+	Mode   string      `yaml:"mode" json:"mode" jsonschema:"enum=jwt,enum=hmac,enum=aws-sigv4,enum=oauth2"`
+	JWT    *JWTAuth    `yaml:"jwt,omitempty" json:"jwt,omitempty"`
+	HMAC   *HMACAuth   `yaml:"hmac,omitempty" json:"hmac,omitempty"`
+	AWS    *AWSAuth    `yaml:"aws,omitempty" json:"aws,omitempty"`
+	OAuth2 *OAuth2Auth `yaml:"oauth2,omitempty" json:"oauth2,omitempty"`
+}
+
+// JWTAuth creates a JWT for each request, and sets it as a bearer token
+type JWTAuth struct {
+	// Algorithm could be HS256, HS384, HS512, RS256, RS384, RS512, ES256, ES384 or ES512
+	Algorithm string `yaml:"algorithm,omitempty" json:"algorithm,omitempty"`
+	// Secret is the HMAC secret, or the PEM encoded private key of the RS and ES algorithms
+	Secret string                 `yaml:"secret" json:"secret"`
+	Claims map[string]interface{} `yaml:"claims,omitempty" json:"claims,omitempty"`
+	// ExpiresIn sets the claims iat and exp, such as 5m
+	ExpiresIn string `yaml:"expiresIn,omitempty" json:"expiresIn,omitempty"`
+}
+
+// HMACAuth signs the method, path and body of the requests, the signature is a hex string
+type HMACAuth struct {
+	// Algorithm could be sha1, sha256 or sha512, the default is sha256
+	Algorithm string `yaml:"algorithm,omitempty" json:"algorithm,omitempty"`
+	Secret    string `yaml:"secret" json:"secret"`
+	// Header is the name of the signature header, the default is X-Signature
+	Header string `yaml:"header,omitempty" json:"header,omitempty"`
+}
+
+// AWSAuth signs the requests with AWS Signature Version 4
+type AWSAuth struct {
+	AccessKey    string `yaml:"accessKey" json:"accessKey"`
+	SecretKey    string `yaml:"secretKey" json:"secretKey"`
+	SessionToken string `yaml:"sessionToken,omitempty" json:"sessionToken,omitempty"`
+	Region       string `yaml:"region" json:"region"`
+	Service      string `yaml:"service" json:"service"`
+}
+
+// OAuth2Auth fetches the access token with the client credentials or password grant,
+// the token is cached per suite and refreshed on expiry
+type OAuth2Auth struct {
+	// Grant could be client_credentials or password, the default is client_credentials
+	Grant        string   `yaml:"grant,omitempty" json:"grant,omitempty"`
+	TokenURL     string   `yaml:"tokenURL" json:"tokenURL"`
+	ClientID     string   `yaml:"clientID" json:"clientID"`
+	ClientSecret string   `yaml:"clientSecret,omitempty" json:"clientSecret,omitempty"`
+	Username     string   `yaml:"username,omitempty" json:"username,omitempty"`
+	Password     string   `yaml:"password,omitempty" json:"password,omitempty"`
+	Scopes       []string `yaml:"scopes,omitempty" json:"scopes,omitempty"`
+}
+
 // TestCase represents a test case
 type TestCase struct {
 	ID      string   `yaml:"id,omitempty" json:"id,omitempty"`
@@ -110,6 +206,16 @@ type TestCase struct {
 	Expect  Response `yaml:"expect,omitempty" json:"expect,omitempty"`
 }
 
+// FindTestCase returns the test case with the specific name, nil if it does not exist
+func (s *TestSuite) FindTestCase(name string) *TestCase {
+	for i := range s.Items {
+		if s.Items[i].Name == name {
+			return &s.Items[i]
+		}
+	}
+	return nil
+}
+
 // InScope returns true if the test case is in scope with the given items.
 // Returns true if the items is empty.
 func (c *TestCase) InScope(items []string) bool {
@@ -231,6 +337,65 @@ type Response struct {
 	Verify            []string               `yaml:"verify,omitempty" json:"verify,omitempty"`
 	ConditionalVerify []ConditionalVerify    `yaml:"conditionalVerify,omitempty" json:"conditionalVerify,omitempty"`
 	Schema            string                 `yaml:"schema,omitempty" json:"schema,omitempty"`
+	// BodyMatch compares the JSON body structurally, it tolerates the volatile fields
+	BodyMatch *BodyMatch `yaml:"bodyMatch,omitempty" json:"bodyMatch,omitempty"`
+	// Snapshot compares the response with the one which was saved in the snapshot file
+	Snapshot *Snapshot `yaml:"snapshot,omitempty" json:"snapshot,omitempty"`
+	// MaxDuration is the max duration of the request, such as: 500ms
+	MaxDuration string `yaml:"maxDuration,omitempty" json:"maxDuration,omitempty"`
+	// MaxBodySize and MinBodySize are the limits of the response body size in bytes
+	MaxBodySize int64 `yaml:"maxBodySize,omitempty" json:"maxBodySize,omitempty"`
+	MinBodySize int64 `yaml:"minBodySize,omitempty" json:"minBodySize,omitempty"`
+	// Query are the assertions of the JSONPath or JMESPath query results
+	Query []QueryAssertion `yaml:"query,omitempty" json:"query,omitempty"`
+}
+
+// QueryAssertion asserts the result of a JSONPath or JMESPath query against the body,
+// only one of the query languages is allowed in an assertion
+type QueryAssertion struct {
+	// JSONPath is the query such as: $.items[*].id
+	JSONPath string `yaml:"jsonpath,omitempty" json:"jsonpath,omitempty"`
+	// JMESPath is the query such as: items[?price > `10`].id
+	JMESPath string `yaml:"jmespath,omitempty" json:"jmespath,omitempty"`
+	// Match is one of all, any and none, the operators are applied to the items of the result if it is set
+	Match string `yaml:"match,omitempty" json:"match,omitempty"`
+	// Count is the length of the result
+	Count *int `yaml:"count,omitempty" json:"count,omitempty"`
+	// Equal is the expected value
+	Equal interface{} `yaml:"equal,omitempty" json:"equal,omitempty"`
+	// Contains is an item of the array, a sub string, or a key of the object
+	Contains interface{} `yaml:"contains,omitempty" json:"contains,omitempty"`
+	// Type is one of string, number, boolean, object, array and null
+	Type               string   `yaml:"type,omitempty" json:"type,omitempty"`
+	Regex              string   `yaml:"regex,omitempty" json:"regex,omitempty"`
+	GreaterThan        *float64 `yaml:"gt,omitempty" json:"gt,omitempty"`
+	GreaterThanOrEqual *float64 `yaml:"gte,omitempty" json:"gte,omitempty"`
+	LessThan           *float64 `yaml:"lt,omitempty" json:"lt,omitempty"`
+	LessThanOrEqual    *float64 `yaml:"lte,omitempty" json:"lte,omitempty"`
+}
+
+// Snapshot is the setting of the snapshot assertion. The snapshot is saved on the first run
+type Snapshot struct {
+	// Name is the file name of the snapshot, the test case name is used by default
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	// Header are the names of the response headers which are saved into the snapshot
+	Header []string `yaml:"header,omitempty" json:"header,omitempty"`
+	// Redact are the volatile paths of the body, they are saved as $any
+	Redact []string `yaml:"redact,omitempty" json:"redact,omitempty"`
+}
+
+// BodyMatch is the structural comparison of the JSON body. The expected string values could be
+// the placeholders: $any, $regex:<pattern> and $type:<string|number|boolean|object|array|null>
+type BodyMatch struct {
+	Body string `yaml:"body" json:"body"`
+	// Ignore are the paths which are not compared, such as: items.*.createdAt
+	Ignore []string `yaml:"ignore,omitempty" json:"ignore,omitempty"`
+	// Unordered are the paths of the arrays which are compared without the order, $ is the root
+	Unordered []string `yaml:"unordered,omitempty" json:"unordered,omitempty"`
+	// Tolerance is the max difference of the numbers
+	Tolerance float64 `yaml:"tolerance,omitempty" json:"tolerance,omitempty"`
+	// Subset allows the extra fields and array items in the response
+	Subset bool `yaml:"subset,omitempty" json:"subset,omitempty"`
 }
 
 func (r Response) GetBody() string {
@@ -0,0 +1,357 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	gherkin "github.com/cucumber/gherkin-go/v19"
+	messages "github.com/cucumber/messages-go/v16"
+	"github.com/linuxsuren/api-testing/pkg/util"
+)
+
+// FeatureFileExt is the extension of the Gherkin feature files
+const FeatureFileExt = ".feature"
+
+// IsFeatureFile checks if the file is a Gherkin feature file
+func IsFeatureFile(path string) bool {
+	return strings.HasSuffix(path, FeatureFileExt)
+}
+
+// ParseFeature converts a Gherkin feature into a test suite. Each request of the scenarios is a test case,
+// the Background steps are shared by all the scenarios, and the Scenario Outlines are expanded by the examples.
+func ParseFeature(data []byte, uri string) (suite *TestSuite, err error) {
+	newID := (&messages.Incrementing{}).NewId
+
+	var doc *messages.GherkinDocument
+	if doc, err = gherkin.ParseGherkinDocument(bytes.NewReader(data), newID); err != nil {
+		return
+	}
+	if doc.Feature == nil {
+		err = fmt.Errorf("no feature found in %s", uri)
+		return
+	}
+
+	suite = &TestSuite{
+		Name: strings.TrimSpace(doc.Feature.Name),
+	}
+	names := map[string]int{}
+	for _, pickle := range gherkin.Pickles(*doc, uri, newID) {
+		scenario := &featureScenario{suite: suite, names: names, name: pickle.Name}
+		for _, step := range pickle.Steps {
+			if err = scenario.run(step); err != nil {
+				err = fmt.Errorf("scenario %q: %w", pickle.Name, err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// featureScenario holds the state of a scenario while its steps are converting
+type featureScenario struct {
+	suite   *TestSuite
+	names   map[string]int
+	name    string
+	base    string
+	headers map[string]string
+	current *TestCase
+}
+
+type featureStep struct {
+	pattern *regexp.Regexp
+	// assertion means the step takes effect on the last request
+	assertion bool
+	handle    func(s *featureScenario, args []string, arg *messages.PickleStepArgument) error
+}
+
+var featureSteps = []featureStep{{
+	pattern: regexp.MustCompile(`^the API base (?:is )?"([^"]*)"$`),
+	handle: func(s *featureScenario, args []string, _ *messages.PickleStepArgument) (err error) {
+		s.base = args[0]
+		if s.suite.API == "" {
+			s.suite.API = args[0]
+		}
+		return
+	},
+}, {
+	pattern: regexp.MustCompile(`^the param "([^"]*)" is "([^"]*)"$`),
+	handle: func(s *featureScenario, args []string, _ *messages.PickleStepArgument) (err error) {
+		if s.suite.Param == nil {
+			s.suite.Param = map[string]string{}
+		}
+		s.suite.Param[args[0]] = args[1]
+		return
+	},
+}, {
+	pattern: regexp.MustCompile(`^the (?:request )?header "([^"]*)" is "([^"]*)"$`),
+	handle: func(s *featureScenario, args []string, _ *messages.PickleStepArgument) (err error) {
+		if s.headers == nil {
+			s.headers = map[string]string{}
+		}
+		s.headers[args[0]] = args[1]
+		return
+	},
+}, {
+	pattern: regexp.MustCompile(`^I ([A-Z]+) "([^"]*)"(?: with (body|form|query)(?: (.+))?)?$`),
+	handle:  (*featureScenario).request,
+}, {
+	pattern:   regexp.MustCompile(`^the status(?: code)? is (\d+)$`),
+	assertion: true,
+	handle: func(s *featureScenario, args []string, _ *messages.PickleStepArgument) (err error) {
+		s.current.Expect.StatusCode, err = strconv.Atoi(args[0])
+		return
+	},
+}, {
+	pattern:   regexp.MustCompile(`^the response header "([^"]*)" is "([^"]*)"$`),
+	assertion: true,
+	handle: func(s *featureScenario, args []string, _ *messages.PickleStepArgument) (err error) {
+		if s.current.Expect.Header == nil {
+			s.current.Expect.Header = map[string]string{}
+		}
+		s.current.Expect.Header[args[0]] = args[1]
+		return
+	},
+}, {
+	pattern:   regexp.MustCompile(`^field "([^"]*)" (exists|does not exist)$`),
+	assertion: true,
+	handle: func(s *featureScenario, args []string, _ *messages.PickleStepArgument) (err error) {
+		var segments []interface{}
+		if segments, err = parseFieldPath(args[0]); err == nil {
+			operator := "!="
+			if args[1] != "exists" {
+				operator = "=="
+			}
+			s.current.Expect.Verify = append(s.current.Expect.Verify, fieldGetExpr(segments)+" "+operator+" nil")
+		}
+		return
+	},
+}, {
+	pattern:   regexp.MustCompile(`^field "([^"]*)" is (.+)$`),
+	assertion: true,
+	handle: func(s *featureScenario, args []string, _ *messages.PickleStepArgument) (err error) {
+		var segments []interface{}
+		if segments, err = parseFieldPath(args[0]); err != nil {
+			return
+		}
+
+		var expected interface{}
+		if json.Unmarshal([]byte(args[1]), &expected) != nil {
+			expected = args[1]
+		}
+		if s.current.Expect.BodyFieldsExpect == nil {
+			s.current.Expect.BodyFieldsExpect = map[string]interface{}{}
+		}
+		s.current.Expect.BodyFieldsExpect[fieldGJSONPath(segments)] = expected
+		return
+	},
+}, {
+	pattern:   regexp.MustCompile(`^the body is(?: "(.*)")?$`),
+	assertion: true,
+	handle: func(s *featureScenario, args []string, arg *messages.PickleStepArgument) (err error) {
+		s.current.Expect.Body = stepText(args[0], arg)
+		return
+	},
+}, {
+	pattern:   regexp.MustCompile(`^the body matches the schema$`),
+	assertion: true,
+	handle: func(s *featureScenario, _ []string, arg *messages.PickleStepArgument) (err error) {
+		s.current.Expect.Schema = stepText("", arg)
+		return
+	},
+}, {
+	pattern:   regexp.MustCompile(`^verify that (.+)$`),
+	assertion: true,
+	handle: func(s *featureScenario, args []string, _ *messages.PickleStepArgument) (err error) {
+		s.current.Expect.Verify = append(s.current.Expect.Verify, args[0])
+		return
+	},
+}}
+
+// run converts a step, all the steps are matched by the text only, no matter the keywords are
+func (s *featureScenario) run(step *messages.PickleStep) (err error) {
+	text := strings.TrimSpace(step.Text)
+	for _, item := range featureSteps {
+		args := item.pattern.FindStringSubmatch(text)
+		if args == nil {
+			continue
+		}
+
+		if item.assertion && s.current == nil {
+			err = fmt.Errorf("no request before the step: %q", text)
+			return
+		}
+		err = item.handle(s, args[1:], step.Argument)
+		return
+	}
+	err = fmt.Errorf("undefined step: %q", text)
+	return
+}
+
+func (s *featureScenario) request(args []string, arg *messages.PickleStepArgument) (err error) {
+	method, api, kind, inline := args[0], args[1], args[2], args[3]
+	switch method {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodHead, http.MethodOptions:
+	default:
+		err = fmt.Errorf("not supported HTTP method: %q", method)
+		return
+	}
+	if s.base != s.suite.API && strings.HasPrefix(api, "/") {
+		api = s.base + api
+	}
+
+	testCase := TestCase{
+		Name: s.caseName(),
+		Request: Request{
+			API:    api,
+			Method: method,
+		},
+	}
+	for key, val := range s.headers {
+		if testCase.Request.Header == nil {
+			testCase.Request.Header = map[string]string{}
+		}
+		testCase.Request.Header[key] = val
+	}
+
+	switch kind {
+	case "body":
+		body := stepText(inline, arg)
+		testCase.Request.Body = NewRequestBody(body)
+		if _, ok := testCase.Request.Header[util.ContentType]; !ok && json.Valid([]byte(body)) {
+			testCase.Request.Header = setHeader(testCase.Request.Header, util.ContentType, util.JSON)
+		}
+	case "form", "query":
+		if arg == nil || arg.DataTable == nil {
+			err = fmt.Errorf("a data table is required by the %s of %s %s", kind, method, api)
+			return
+		}
+		values := map[string]string{}
+		for _, row := range arg.DataTable.Rows {
+			if len(row.Cells) != 2 {
+				err = errors.New("the rows of the data table should be the pairs of key and value")
+				return
+			}
+			values[row.Cells[0].Value] = row.Cells[1].Value
+		}
+
+		if kind == "form" {
+			testCase.Request.Form = values
+			testCase.Request.Header = setHeader(testCase.Request.Header, util.ContentType, util.Form)
+		} else {
+			testCase.Request.Query = SortedKeysStringMap{}
+			for key, val := range values {
+				testCase.Request.Query[key] = val
+			}
+		}
+	}
+
+	s.suite.Items = append(s.suite.Items, testCase)
+	s.current = &s.suite.Items[len(s.suite.Items)-1]
+	return
+}
+
+// caseName returns the scenario name, a sequence number is appended if it was taken
+func (s *featureScenario) caseName() (name string) {
+	name = s.name
+	if count := s.names[name]; count > 0 {
+		name = fmt.Sprintf("%s #%d", name, count+1)
+	}
+	s.names[s.name]++
+	return
+}
+
+func setHeader(header map[string]string, key, value string) map[string]string {
+	if header == nil {
+		header = map[string]string{}
+	}
+	if _, ok := header[key]; !ok {
+		header[key] = value
+	}
+	return header
+}
+
+// stepText returns the inline text, or the doc string of a step
+func stepText(inline string, arg *messages.PickleStepArgument) string {
+	if inline == "" && arg != nil && arg.DocString != nil {
+		return arg.DocString.Content
+	}
+	return inline
+}
+
+var fieldSegment = regexp.MustCompile(`^(?:\.([^.\[\]]+)|\[(\d+)\]|\['([^']*)'\]|\["([^"]*)"\])`)
+
+// parseFieldPath parses a simple JSONPath, such as: $.items[0].name, the leading $ is optional.
+// The segments are the keys (string) and the indexes (int)
+func parseFieldPath(path string) (segments []interface{}, err error) {
+	rest := strings.TrimPrefix(path, "$")
+	if rest != "" && !strings.HasPrefix(rest, ".") && !strings.HasPrefix(rest, "[") {
+		rest = "." + rest
+	}
+
+	for rest != "" {
+		match := fieldSegment.FindStringSubmatch(rest)
+		if match == nil {
+			err = fmt.Errorf("invalid field path: %q", path)
+			return
+		}
+		rest = rest[len(match[0]):]
+
+		switch {
+		case match[2] != "":
+			index, _ := strconv.Atoi(match[2])
+			segments = append(segments, index)
+		default:
+			segments = append(segments, match[1]+match[3]+match[4])
+		}
+	}
+	if len(segments) == 0 {
+		err = fmt.Errorf("invalid field path: %q", path)
+	}
+	return
+}
+
+// fieldGJSONPath returns the path which is compatible with bodyFieldsExpect
+func fieldGJSONPath(segments []interface{}) string {
+	escaper := strings.NewReplacer(".", `\.`, "*", `\*`, "?", `\?`)
+	items := make([]string, len(segments))
+	for i, segment := range segments {
+		items[i] = escaper.Replace(fmt.Sprint(segment))
+	}
+	return strings.Join(items, ".")
+}
+
+// fieldGetExpr returns the nil-safe expression which gets the field of the response data
+func fieldGetExpr(segments []interface{}) string {
+	result := "data"
+	for _, segment := range segments {
+		if key, ok := segment.(string); ok {
+			result = fmt.Sprintf("get(%s, %s)", result, strconv.Quote(key))
+		} else {
+			result = fmt.Sprintf("get(%s, %d)", result, segment)
+		}
+	}
+	return result
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/runner"
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFeature(t *testing.T) {
+	data, err := os.ReadFile("testdata/users.feature")
+	assert.NoError(t, err)
+
+	suite, err := atest.ParseFeature(data, "users.feature")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "users", suite.Name)
+	assert.Equal(t, "http://localhost:8080/api", suite.API)
+	assert.Equal(t, map[string]string{"token": "secret"}, suite.Param)
+	if !assert.Equal(t, 5, len(suite.Items)) {
+		return
+	}
+
+	create := suite.Items[0]
+	assert.Equal(t, "create a user", create.Name)
+	assert.Equal(t, atest.Request{
+		API:    "/users",
+		Method: "POST",
+		Header: map[string]string{
+			"Authorization":  "Bearer {{.param.token}}",
+			util.ContentType: util.JSON,
+		},
+		Body: atest.NewRequestBody(`{"name": "rick"}`),
+	}, create.Request)
+	assert.Equal(t, atest.Response{
+		StatusCode: 201,
+		Header:     map[string]string{"Content-Type": "application/json"},
+		BodyFieldsExpect: map[string]interface{}{
+			"name":    "rick",
+			"roles.0": "admin",
+		},
+		Verify: []string{
+			`get(data, "id") != nil`,
+			`get(data, "password") == nil`,
+		},
+	}, create.Expect)
+
+	query := suite.Items[1]
+	assert.Equal(t, "query the users", query.Name)
+	assert.Equal(t, atest.SortedKeysStringMap{"page": "1", "size": "10"}, query.Request.Query)
+	assert.Equal(t, 200, query.Expect.StatusCode)
+	assert.Equal(t, []string{"len(data) > 0"}, query.Expect.Verify)
+
+	health := suite.Items[2]
+	assert.Equal(t, "query the users #2", health.Name)
+	assert.Equal(t, "http://localhost:9090/health", health.Request.API)
+	assert.Equal(t, "ok", health.Expect.Body)
+
+	assert.Equal(t, "login", suite.Items[3].Name)
+	assert.Equal(t, map[string]string{"username": "rick"}, suite.Items[3].Request.Form)
+	assert.Equal(t, util.Form, suite.Items[3].Request.Header[util.ContentType])
+	assert.Equal(t, 200, suite.Items[3].Expect.StatusCode)
+	assert.Equal(t, "login #2", suite.Items[4].Name)
+	assert.Equal(t, 401, suite.Items[4].Expect.StatusCode)
+
+	t.Run("the field expressions", func(t *testing.T) {
+		body := map[string]any{
+			"data": map[string]any{"id": 1, "roles": []any{"admin"}},
+		}
+		assert.NoError(t, runner.Verify(create.Expect, body))
+
+		body["data"] = map[string]any{"password": "secret"}
+		assert.Error(t, runner.Verify(create.Expect, body))
+	})
+}
+
+func TestParseFeatureErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		feature string
+		err     string
+	}{{
+		name:    "no feature",
+		feature: "# nothing",
+		err:     "no feature found",
+	}, {
+		name:    "invalid syntax",
+		feature: "Given x\nFeature: a",
+		err:     "Parser errors",
+	}, {
+		name:    "undefined step",
+		feature: "Feature: a\n  Scenario: b\n    Given something",
+		err:     `undefined step: "something"`,
+	}, {
+		name:    "assertion without request",
+		feature: "Feature: a\n  Scenario: b\n    Then the status is 200",
+		err:     "no request before the step",
+	}, {
+		name:    "invalid method",
+		feature: "Feature: a\n  Scenario: b\n    When I FETCH \"/users\"",
+		err:     "not supported HTTP method",
+	}, {
+		name:    "form without data table",
+		feature: "Feature: a\n  Scenario: b\n    When I POST \"/users\" with form",
+		err:     "a data table is required",
+	}, {
+		name:    "invalid field path",
+		feature: "Feature: a\n  Scenario: b\n    When I GET \"/users\"\n    Then field \"$.a[x]\" exists",
+		err:     "invalid field path",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := atest.ParseFeature([]byte(tt.feature), "test.feature")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestFeatureLoader(t *testing.T) {
+	loader := atest.NewFileLoader()
+	assert.NoError(t, loader.Put("testdata/users.feature"))
+	assert.True(t, loader.HasMore())
+
+	data, err := loader.Load()
+	assert.NoError(t, err)
+	suite, err := atest.Parse(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "users", suite.Name)
+	assert.Equal(t, 5, len(suite.Items))
+
+	writer := atest.NewFileWriter("testdata")
+	assert.NoError(t, writer.Put("testdata/users.feature"))
+	suites, err := writer.ListTestSuite()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(suites))
+
+	testCase, err := writer.GetTestCase("users", "login #2")
+	assert.NoError(t, err)
+	assert.Equal(t, "/login", testCase.Request.API)
+
+	err = writer.UpdateSuite(atest.TestSuite{Name: "users"})
+	assert.ErrorContains(t, err, "read-only")
+}
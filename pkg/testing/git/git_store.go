@@ -0,0 +1,348 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package git keeps the test suites in a git repository
+package git
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	fakeruntime "github.com/linuxsuren/go-fake-runtime"
+)
+
+// StoreKindName is the name of the builtin git store kind
+const StoreKindName = "git"
+
+// The properties of a git store
+const (
+	PropertyBranch     = "branch"
+	PropertyTargetPath = "targetPath"
+	PropertyName       = "name"
+	PropertyEmail      = "email"
+	PropertyInsecure   = "insecure"
+)
+
+type gitStoreFactory struct {
+	execer   fakeruntime.Execer
+	cacheDir string
+}
+
+// NewGitStoreFactory creates a factory of the git stores, the repositories are cloned into the cache directory
+func NewGitStoreFactory(execer fakeruntime.Execer, cacheDir string) testing.BuiltinStoreWriterFactory {
+	return &gitStoreFactory{
+		execer:   execer,
+		cacheDir: cacheDir,
+	}
+}
+
+// Kind returns the git store kind
+func (f *gitStoreFactory) Kind() testing.StoreKind {
+	return testing.StoreKind{
+		Name:       StoreKindName,
+		Enabled:    true,
+		Categories: []string{"store"},
+		Params: []testing.StoreKindParam{{
+			Key:         PropertyBranch,
+			Description: "The branch of the suites, the default branch is used if it is empty",
+		}, {
+			Key:          PropertyTargetPath,
+			DefaultValue: ".",
+			Description:  "The directory of the suites in the repository",
+		}, {
+			Key:          PropertyName,
+			DefaultValue: defaultCommitterName,
+			Description:  "The committer name",
+		}, {
+			Key:          PropertyEmail,
+			DefaultValue: defaultCommitterEmail,
+			Description:  "The committer email",
+		}, {
+			Key:          PropertyInsecure,
+			DefaultValue: "false",
+			Enum:         []string{"true", "false"},
+			Description:  "Skip the TLS verification",
+		}},
+	}
+}
+
+// NewInstance clones the repository if it does not exist, and creates a writer for the suites of it
+func (f *gitStoreFactory) NewInstance(store testing.Store) (writer testing.Writer, err error) {
+	if store.URL == "" {
+		err = errors.New("the repository URL is required")
+		return
+	}
+	if err = checkRepositoryURL(store.URL); err != nil {
+		return
+	}
+
+	repo := newRepository(f.execer, filepath.Join(f.cacheDir, url.PathEscape(store.Name)), store)
+	lock := repo.lock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err = repo.prepare(); err != nil {
+		return
+	}
+
+	suiteDir := filepath.Join(repo.dir, util.EmptyThenDefault(store.Properties[PropertyTargetPath], "."))
+	if err = os.MkdirAll(suiteDir, 0755); err != nil {
+		return
+	}
+
+	fileWriter := testing.NewFileWriter(suiteDir)
+	// the revisions come from the git history
+	fileWriter.WithUserConfigDir("")
+	if err = fileWriter.Put(filepath.Join(suiteDir, "*.yaml")); err == nil {
+		writer = &gitLoader{
+			Writer:   fileWriter,
+			repo:     repo,
+			suiteDir: suiteDir,
+		}
+	}
+	return
+}
+
+// gitLoader reads and writes the suite files in the work tree, and commits every change
+type gitLoader struct {
+	testing.Writer
+	repo        *repository
+	suiteDir    string
+	authorName  string
+	authorEmail string
+}
+
+// WithAuthor sets the author of the following commits
+func (l *gitLoader) WithAuthor(name, email string) {
+	l.authorName = name
+	l.authorEmail = email
+}
+
+func (l *gitLoader) CreateSuite(name, api string) error {
+	return l.commit(testing.RevisionActionCreateSuite, name, "", func() error {
+		return l.Writer.CreateSuite(name, api)
+	})
+}
+
+func (l *gitLoader) UpdateSuite(suite testing.TestSuite) error {
+	return l.commit(testing.RevisionActionUpdateSuite, suite.Name, "", func() error {
+		return l.Writer.UpdateSuite(suite)
+	})
+}
+
+func (l *gitLoader) DeleteSuite(name string) error {
+	return l.commit(testing.RevisionActionDeleteSuite, name, "", func() error {
+		return l.Writer.DeleteSuite(name)
+	})
+}
+
+func (l *gitLoader) RenameTestSuite(oldName, newName string) error {
+	return l.commit(testing.RevisionActionRenameSuite, newName, "", func() error {
+		return l.Writer.RenameTestSuite(oldName, newName)
+	})
+}
+
+func (l *gitLoader) CreateTestCase(suite string, testcase testing.TestCase) error {
+	return l.commit(testing.RevisionActionCreateTestCase, suite, testcase.Name, func() error {
+		return l.Writer.CreateTestCase(suite, testcase)
+	})
+}
+
+func (l *gitLoader) UpdateTestCase(suite string, testcase testing.TestCase) error {
+	return l.commit(testing.RevisionActionUpdateTestCase, suite, testcase.Name, func() error {
+		return l.Writer.UpdateTestCase(suite, testcase)
+	})
+}
+
+func (l *gitLoader) DeleteTestCase(suite, testcase string) error {
+	return l.commit(testing.RevisionActionDeleteTestCase, suite, testcase, func() error {
+		return l.Writer.DeleteTestCase(suite, testcase)
+	})
+}
+
+func (l *gitLoader) RenameTestCase(suite, oldName, newName string) error {
+	return l.commit(testing.RevisionActionRenameTestCase, suite, newName, func() error {
+		return l.Writer.RenameTestCase(suite, oldName, newName)
+	})
+}
+
+// CommitChange applies a change of the files in the suite directory, and commits it
+func (l *gitLoader) CommitChange(action, suite, testCase string, change func() error) error {
+	return l.commit(action, suite, testCase, change)
+}
+
+// commit applies the change in the work tree, then commits it with a message like "updateTestCase suite/testcase"
+func (l *gitLoader) commit(action, suite, testCase string, change func() error) (err error) {
+	lock := l.repo.lock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err = l.repo.checkConflicts(); err != nil {
+		return
+	}
+	if err = change(); err != nil {
+		return
+	}
+
+	message := fmt.Sprintf("%s %s", action, suite)
+	if testCase != "" {
+		message = fmt.Sprintf("%s/%s", message, testCase)
+	}
+	err = l.repo.commit(l.suiteDir, message, l.authorName, l.authorEmail)
+	return
+}
+
+// ListSuiteRevisions returns the commits which changed the suite file
+func (l *gitLoader) ListSuiteRevisions(suite string) (revisions []testing.SuiteRevision, err error) {
+	var commits []commitInfo
+	if commits, err = l.repo.log(l.getSuiteFile(suite)); err != nil {
+		return
+	}
+
+	revisions = make([]testing.SuiteRevision, 0, len(commits))
+	for _, commit := range commits {
+		revision := testing.SuiteRevision{
+			ID:         commit.ID,
+			Suite:      suite,
+			CreateTime: commit.Time,
+		}
+		if action, target, ok := strings.Cut(commit.Subject, " "); ok {
+			if target == suite || strings.HasPrefix(target, suite+"/") {
+				revision.Action = action
+				revision.TestCase = strings.TrimPrefix(strings.TrimPrefix(target, suite), "/")
+			}
+		}
+		revisions = append(revisions, revision)
+	}
+	return
+}
+
+// GetSuiteRevision returns the suite definition of a commit
+func (l *gitLoader) GetSuiteRevision(suite, revision string) (testSuite testing.TestSuite, err error) {
+	var commits []commitInfo
+	if commits, err = l.repo.log(l.getSuiteFile(suite)); err != nil {
+		return
+	}
+
+	for _, commit := range commits {
+		if commit.ID != revision && !strings.HasPrefix(commit.ID, revision) {
+			continue
+		}
+
+		var data string
+		if data, err = l.repo.show(commit.ID, commit.File); err == nil {
+			var result *testing.TestSuite
+			if result, err = testing.ParseFromData([]byte(data)); err == nil {
+				testSuite = *result
+			}
+		}
+		return
+	}
+	err = testing.ErrRevisionNotFound
+	return
+}
+
+// getSuiteFile returns the file path of a suite, the default one is used if the suite does not exist
+func (l *gitLoader) getSuiteFile(suite string) (suiteFile string) {
+	suiteFile = filepath.Join(l.suiteDir, suite+".yaml")
+	if testSuite, absPath, err := l.Writer.GetSuite(suite); err == nil && testSuite != nil {
+		suiteFile = absPath
+	}
+	return
+}
+
+// Verify reports the unresolved conflicts, the version is the current commit
+func (l *gitLoader) Verify() (readOnly bool, version string, err error) {
+	lock := l.repo.lock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	if head, headErr := l.repo.git("rev-parse", "--short", "HEAD"); headErr == nil {
+		version = strings.TrimSpace(head)
+	}
+	err = l.repo.checkConflicts()
+	return
+}
+
+// Pull merges the changes of the remote branch, the conflicts are kept in the work tree
+func (l *gitLoader) Pull() (err error) {
+	lock := l.repo.lock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err = l.repo.checkConflicts(); err != nil {
+		return
+	}
+	if err = l.repo.pull(); err != nil {
+		if conflictErr := l.repo.checkConflicts(); conflictErr != nil {
+			err = conflictErr
+		}
+	}
+	return
+}
+
+// Push pushes the local commits to the remote branch
+func (l *gitLoader) Push() (err error) {
+	lock := l.repo.lock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err = l.repo.checkConflicts(); err == nil {
+		err = l.repo.push()
+	}
+	return
+}
+
+type commitInfo struct {
+	ID      string
+	Time    time.Time
+	Subject string
+	File    string
+}
+
+// parseLog parses the output of git log with the format "%x1e%H%x1f%at%x1f%s" and the option --name-only
+func parseLog(output string) (commits []commitInfo) {
+	for _, record := range strings.Split(output, "\x1e") {
+		lines := strings.Split(strings.TrimSpace(record), "\n")
+		fields := strings.Split(lines[0], "\x1f")
+		if len(fields) != 3 {
+			continue
+		}
+
+		commit := commitInfo{
+			ID:      fields[0],
+			Subject: fields[2],
+		}
+		if timestamp, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+			commit.Time = time.Unix(timestamp, 0)
+		}
+		for _, line := range lines[1:] {
+			if line = strings.TrimSpace(line); line != "" {
+				commit.File = line
+			}
+		}
+		commits = append(commits, commit)
+	}
+	return
+}
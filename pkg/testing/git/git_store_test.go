@@ -0,0 +1,226 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package git
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	atest "github.com/linuxsuren/api-testing/pkg/testing"
+	fakeruntime "github.com/linuxsuren/go-fake-runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitStore(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	remote := filepath.Join(dir, "remote.git")
+	seed := filepath.Join(dir, "seed")
+	runGit(t, dir, "init", "--bare", "--initial-branch=master", remote)
+	runGit(t, dir, "clone", remote, seed)
+	assert.NoError(t, os.MkdirAll(filepath.Join(seed, "suites"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(seed, "suites", "orders.yaml"), []byte("name: orders\napi: http://localhost\n"), 0644))
+	runGit(t, seed, "add", ".")
+	runGit(t, seed, "commit", "-m", "init")
+	runGit(t, seed, "push", "origin", "master")
+
+	factory := NewGitStoreFactory(fakeruntime.NewDefaultExecer(), filepath.Join(dir, "cache"))
+	assert.Equal(t, StoreKindName, factory.Kind().Name)
+
+	store := atest.Store{
+		Name: "git",
+		URL:  "file://" + remote,
+		Kind: atest.StoreKind{Name: StoreKindName},
+		Properties: map[string]string{
+			PropertyTargetPath: "suites",
+			PropertyBranch:     "master",
+		},
+	}
+	writer, err := factory.NewInstance(store)
+	assert.NoError(t, err)
+	clone := filepath.Join(dir, "cache", "git")
+
+	suites, err := writer.ListTestSuite()
+	assert.NoError(t, err)
+	if assert.Len(t, suites, 1) {
+		assert.Equal(t, "orders", suites[0].Name)
+	}
+
+	t.Run("commit with the author", func(t *testing.T) {
+		writer.(atest.AuthorSetter).WithAuthor("alice", "alice@example.com")
+		assert.NoError(t, writer.CreateTestCase("orders", atest.TestCase{
+			Name:    "list",
+			Request: atest.Request{API: "/orders"},
+		}))
+		assert.Equal(t, "alice <alice@example.com>|atest|createTestCase orders/list",
+			runGit(t, clone, "log", "-1", "--format=%an <%ae>|%cn|%s"))
+
+		assert.NoError(t, writer.UpdateSuite(atest.TestSuite{Name: "orders", API: "http://localhost:8080"}))
+		assert.Equal(t, "updateSuite orders", runGit(t, clone, "log", "-1", "--format=%s"))
+	})
+
+	t.Run("revisions", func(t *testing.T) {
+		revisions, err := writer.ListSuiteRevisions("orders")
+		assert.NoError(t, err)
+		if assert.Len(t, revisions, 3) {
+			assert.Equal(t, atest.RevisionActionUpdateSuite, revisions[0].Action)
+			assert.Equal(t, atest.RevisionActionCreateTestCase, revisions[1].Action)
+			assert.Equal(t, "list", revisions[1].TestCase)
+			assert.Empty(t, revisions[2].Action)
+
+			var suite atest.TestSuite
+			suite, err = writer.GetSuiteRevision("orders", revisions[1].ID[:8])
+			assert.NoError(t, err)
+			assert.Equal(t, "http://localhost", suite.API)
+			assert.Len(t, suite.Items, 1)
+		}
+
+		_, err = writer.GetSuiteRevision("orders", "fake")
+		assert.ErrorIs(t, err, atest.ErrRevisionNotFound)
+	})
+
+	t.Run("push and pull", func(t *testing.T) {
+		syncer := writer.(atest.Syncer)
+		assert.NoError(t, syncer.Push())
+		assert.Equal(t, "updateSuite orders", runGit(t, remote, "log", "-1", "--format=%s"))
+
+		runGit(t, seed, "pull", "origin", "master")
+		assert.NoError(t, os.WriteFile(filepath.Join(seed, "suites", "users.yaml"), []byte("name: users\n"), 0644))
+		runGit(t, seed, "add", ".")
+		runGit(t, seed, "commit", "-m", "add users")
+		runGit(t, seed, "push", "origin", "master")
+
+		assert.NoError(t, syncer.Pull())
+		writer, err = factory.NewInstance(store)
+		assert.NoError(t, err)
+		suite, err := writer.GetTestSuite("users", false)
+		assert.NoError(t, err)
+		assert.Equal(t, "users", suite.Name)
+	})
+
+	t.Run("commit a change", func(t *testing.T) {
+		snapshot := filepath.Join(clone, "suites", "__snapshots__", "orders", "list.json")
+		err := writer.(atest.ChangeCommitter).CommitChange(atest.RevisionActionAcceptSnapshot, "orders", "list", func() error {
+			assert.NoError(t, os.MkdirAll(filepath.Dir(snapshot), 0755))
+			return os.WriteFile(snapshot, []byte("{}"), 0644)
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "acceptSnapshot orders/list", runGit(t, clone, "log", "-1", "--format=%s"))
+		assert.Empty(t, runGit(t, clone, "status", "--porcelain"))
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		assert.NoError(t, os.WriteFile(filepath.Join(seed, "suites", "users.yaml"), []byte("name: users\napi: http://remote\n"), 0644))
+		runGit(t, seed, "commit", "-am", "change users")
+		runGit(t, seed, "push", "origin", "master")
+
+		assert.NoError(t, writer.UpdateSuite(atest.TestSuite{Name: "users", API: "http://local"}))
+		err := writer.(atest.Syncer).Pull()
+		assert.ErrorContains(t, err, "conflicts need to be resolved")
+
+		_, version, err := writer.Verify()
+		assert.ErrorContains(t, err, "users.yaml")
+		assert.NotEmpty(t, version)
+
+		assert.Error(t, writer.CreateTestCase("orders", atest.TestCase{Name: "conflict"}))
+	})
+
+	t.Run("without URL", func(t *testing.T) {
+		_, err := factory.NewInstance(atest.Store{Name: "empty"})
+		assert.Error(t, err)
+	})
+}
+
+func TestCheckRepositoryURL(t *testing.T) {
+	for _, repoURL := range []string{
+		"https://github.com/linuxsuren/api-testing.git",
+		"http://localhost/repo.git",
+		"ssh://git@github.com/linuxsuren/api-testing.git",
+		"file:///tmp/repo.git",
+		"git@github.com:linuxsuren/api-testing.git",
+		"/tmp/repo.git",
+		"../repo",
+	} {
+		assert.NoError(t, checkRepositoryURL(repoURL), repoURL)
+	}
+
+	for _, repoURL := range []string{
+		"--upload-pack=touch /tmp/pwned",
+		"-u touch /tmp/pwned",
+		"ext::sh -c touch% /tmp/pwned",
+		"fd::17",
+		"ftp://localhost/repo.git",
+		"-oProxyCommand=touch:repo",
+	} {
+		assert.Error(t, checkRepositoryURL(repoURL), repoURL)
+	}
+
+	factory := NewGitStoreFactory(fakeruntime.NewDefaultExecer(), t.TempDir())
+	_, err := factory.NewInstance(atest.Store{Name: "evil", URL: "--upload-pack=touch /tmp/pwned"})
+	assert.ErrorContains(t, err, "invalid repository URL")
+}
+
+func TestRepositoryCredential(t *testing.T) {
+	execer := &recordExecer{Execer: &fakeruntime.FakeExecer{ExpectOS: "linux"}}
+	repo := newRepository(execer, "/tmp/repo", atest.Store{
+		URL:        "https://foo.com/bar.git",
+		Username:   "admin",
+		Password:   "secret",
+		Properties: map[string]string{PropertyInsecure: "true"},
+	})
+
+	_, err := repo.git("pull")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"-C", "/tmp/repo", "pull"}, execer.args)
+	assert.Contains(t, execer.env, "GIT_CONFIG_COUNT=4")
+	assert.Contains(t, execer.env, "GIT_CONFIG_KEY_2=http.extraHeader")
+	assert.Contains(t, execer.env, "GIT_CONFIG_VALUE_2=Authorization: Basic YWRtaW46c2VjcmV0")
+	assert.Contains(t, execer.env, "GIT_CONFIG_VALUE_3=false")
+
+	dir := filepath.Join(t.TempDir(), "repo")
+	repo = newRepository(execer, dir, atest.Store{URL: "https://foo.com/bar.git"})
+	assert.NoError(t, repo.prepare())
+	assert.Equal(t, []string{"-C", filepath.Dir(dir), "clone", "--", "https://foo.com/bar.git", dir}, execer.args)
+}
+
+type recordExecer struct {
+	fakeruntime.Execer
+	args []string
+	env  []string
+}
+
+func (e *recordExecer) RunCommandWithEnv(name string, argv, envv []string, stdout, stderr io.Writer) error {
+	e.args = argv
+	e.env = envv
+	return nil
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	args = append([]string{"-c", "user.name=test", "-c", "user.email=test@localhost"}, args...)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(output))
+	return strings.TrimSpace(string(output))
+}
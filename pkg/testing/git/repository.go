@@ -0,0 +1,235 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package git
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/linuxsuren/api-testing/pkg/logging"
+	"github.com/linuxsuren/api-testing/pkg/testing"
+	"github.com/linuxsuren/api-testing/pkg/util"
+	fakeruntime "github.com/linuxsuren/go-fake-runtime"
+)
+
+var (
+	gitLogger = logging.DefaultLogger(logging.LogLevelInfo).WithName("git")
+
+	// repositoryLocks has a lock for each local repository
+	repositoryLocks sync.Map
+)
+
+const (
+	defaultCommitterName  = "atest"
+	defaultCommitterEmail = "atest@localhost"
+)
+
+// repository runs the git commands against a local clone
+type repository struct {
+	execer         fakeruntime.Execer
+	dir            string
+	url            string
+	branch         string
+	username       string
+	password       string
+	insecure       bool
+	committerName  string
+	committerEmail string
+}
+
+// repositorySchemes are the supported schemes of the repository URLs, the local paths and scp-like addresses are supported as well
+var repositorySchemes = []string{"file", "http", "https", "ssh"}
+
+// scpLikeURL matches the addresses like git@github.com:linuxsuren/api-testing.git
+var scpLikeURL = regexp.MustCompile(`^(\w[\w.~-]*@)?\w[\w.-]*:[^:]`)
+
+// checkRepositoryURL rejects the URLs which could be taken as git options or remote helpers, such as --upload-pack=<cmd> or ext::<cmd>
+func checkRepositoryURL(repoURL string) (err error) {
+	switch {
+	case strings.HasPrefix(repoURL, "-") || strings.Contains(repoURL, "::"):
+		err = fmt.Errorf("invalid repository URL %q", repoURL)
+	case strings.Contains(repoURL, "://"):
+		var target *url.URL
+		if target, err = url.Parse(repoURL); err == nil && !slices.Contains(repositorySchemes, target.Scheme) {
+			err = fmt.Errorf("not supported scheme of the repository URL %q, supported: %v", repoURL, repositorySchemes)
+		}
+	case strings.Contains(repoURL, ":") && !filepath.IsAbs(repoURL) && !scpLikeURL.MatchString(repoURL):
+		err = fmt.Errorf("invalid repository URL %q", repoURL)
+	}
+	return
+}
+
+func newRepository(execer fakeruntime.Execer, dir string, store testing.Store) *repository {
+	return &repository{
+		execer:         execer,
+		dir:            dir,
+		url:            store.URL,
+		branch:         store.Properties[PropertyBranch],
+		username:       store.Username,
+		password:       store.Password,
+		insecure:       store.Properties[PropertyInsecure] == "true",
+		committerName:  util.EmptyThenDefault(store.Properties[PropertyName], defaultCommitterName),
+		committerEmail: util.EmptyThenDefault(store.Properties[PropertyEmail], defaultCommitterEmail),
+	}
+}
+
+func (r *repository) lock() *sync.Mutex {
+	lock, _ := repositoryLocks.LoadOrStore(r.dir, &sync.Mutex{})
+	return lock.(*sync.Mutex)
+}
+
+// prepare clones the repository, or points the existing clone to the latest URL
+func (r *repository) prepare() (err error) {
+	if _, err = os.Stat(filepath.Join(r.dir, ".git")); err == nil {
+		var current string
+		if current, err = r.git("remote", "get-url", "origin"); err == nil && strings.TrimSpace(current) != r.url {
+			_, err = r.git("remote", "set-url", "origin", "--", r.url)
+		}
+		return
+	}
+
+	if err = os.MkdirAll(filepath.Dir(r.dir), 0755); err != nil {
+		return
+	}
+
+	args := []string{"clone"}
+	if r.branch != "" {
+		args = append(args, "--branch", r.branch)
+	}
+	// the URL is never taken as an option
+	args = append(args, "--", r.url, r.dir)
+
+	gitLogger.Info("clone repository", "dir", r.dir)
+	_, err = r.run(filepath.Dir(r.dir), args...)
+	return
+}
+
+// commit commits all the changes of a directory, nothing happens without changes
+func (r *repository) commit(dir, message, authorName, authorEmail string) (err error) {
+	var output string
+	if _, err = r.git("add", "--all", "--", dir); err != nil {
+		return
+	}
+	if output, err = r.git("status", "--porcelain", "--", dir); err != nil || strings.TrimSpace(output) == "" {
+		return
+	}
+
+	args := []string{"commit", "--message", message}
+	if authorName != "" {
+		args = append(args, "--author", fmt.Sprintf("%s <%s>", authorName, util.EmptyThenDefault(authorEmail, r.committerEmail)))
+	}
+	_, err = r.git(args...)
+	return
+}
+
+// log returns the commits which changed the file, the latest one comes first
+func (r *repository) log(file string) (commits []commitInfo, err error) {
+	var output string
+	if output, err = r.git("log", "--follow", "--name-only", "--format=%x1e%H%x1f%at%x1f%s", "--", file); err == nil {
+		commits = parseLog(output)
+	} else if _, headErr := r.git("rev-parse", "HEAD"); headErr != nil {
+		// there is no commit yet
+		err = nil
+	}
+	return
+}
+
+// show returns the file content of a commit
+func (r *repository) show(commit, file string) (string, error) {
+	return r.git("show", fmt.Sprintf("%s:%s", commit, file))
+}
+
+func (r *repository) pull() (err error) {
+	var branch string
+	if branch, err = r.currentBranch(); err == nil {
+		_, err = r.git("pull", "--no-rebase", "--no-edit", "origin", branch)
+	}
+	return
+}
+
+func (r *repository) push() (err error) {
+	var branch string
+	if branch, err = r.currentBranch(); err == nil {
+		_, err = r.git("push", "origin", fmt.Sprintf("HEAD:%s", branch))
+	}
+	return
+}
+
+func (r *repository) currentBranch() (branch string, err error) {
+	if r.branch != "" {
+		branch = r.branch
+		return
+	}
+	if branch, err = r.git("rev-parse", "--abbrev-ref", "HEAD"); err == nil {
+		branch = strings.TrimSpace(branch)
+	}
+	return
+}
+
+// checkConflicts returns an error if there are unmerged files in the work tree
+func (r *repository) checkConflicts() (err error) {
+	var output string
+	if output, err = r.git("diff", "--name-only", "--diff-filter=U"); err != nil {
+		return
+	}
+	if files := strings.Fields(output); len(files) > 0 {
+		err = fmt.Errorf("conflicts need to be resolved in %q: %s", r.dir, strings.Join(files, ", "))
+	}
+	return
+}
+
+// git runs a git command in the repository directory
+func (r *repository) git(args ...string) (string, error) {
+	return r.run(r.dir, args...)
+}
+
+func (r *repository) run(dir string, args ...string) (output string, err error) {
+	configs := [][2]string{
+		{"user.name", r.committerName},
+		{"user.email", r.committerEmail},
+	}
+	if r.username != "" || r.password != "" {
+		auth := base64.StdEncoding.EncodeToString([]byte(r.username + ":" + r.password))
+		configs = append(configs, [2]string{"http.extraHeader", "Authorization: Basic " + auth})
+	}
+	if r.insecure {
+		configs = append(configs, [2]string{"http.sslVerify", "false"})
+	}
+
+	// the configs are passed through the environment, the credential is not visible in the process list
+	env := append(os.Environ(), fmt.Sprintf("GIT_CONFIG_COUNT=%d", len(configs)))
+	for i, config := range configs {
+		env = append(env, fmt.Sprintf("GIT_CONFIG_KEY_%d=%s", i, config[0]),
+			fmt.Sprintf("GIT_CONFIG_VALUE_%d=%s", i, config[1]))
+	}
+
+	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	err = r.execer.RunCommandWithEnv("git", append([]string{"-C", dir}, args...), env, stdout, stderr)
+	output = stdout.String()
+	if err != nil {
+		err = fmt.Errorf("failed to run git %s: %v, %s", args[0], err, strings.TrimSpace(output+stderr.String()))
+	}
+	return
+}
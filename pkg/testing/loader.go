@@ -83,5 +83,44 @@ type Writer interface {
 	UpdateSuite(TestSuite) (err error)
 	DeleteSuite(name string) (err error)
 	RenameTestSuite(oldName, newName string) error
+
+	// ListSuiteRevisions returns the revisions of a suite, the latest one comes first
+	ListSuiteRevisions(suite string) (revisions []SuiteRevision, err error)
+	// GetSuiteRevision returns the suite definition of a revision
+	GetSuiteRevision(suite, revision string) (testSuite TestSuite, err error)
 	Close()
 }
+
+// AuthorSetter is implemented by the writers which record the author of the changes
+type AuthorSetter interface {
+	WithAuthor(name, email string)
+}
+
+// StoreNameSetter is implemented by the writers which keep data per store, such as the revisions
+type StoreNameSetter interface {
+	WithStoreName(name string)
+}
+
+// ChangeCommitter is implemented by the writers which record the changes of the files next to the suites, such as the snapshots
+type ChangeCommitter interface {
+	CommitChange(action, suite, testCase string, change func() error) error
+}
+
+// Template is a code generator or converter template which is provided by a store
+type Template struct {
+	Name string
+	// Kind could be generator or converter
+	Kind string
+	Text string
+}
+
+// TemplateProvider is implemented by the loaders which provide the templates, such as the store extensions
+type TemplateProvider interface {
+	GetTemplates() ([]Template, error)
+}
+
+// Syncer is implemented by the writers which are able to sync with a remote
+type Syncer interface {
+	Pull() error
+	Push() error
+}
@@ -29,8 +29,6 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/linuxsuren/api-testing/pkg/util/home"
-
 	"github.com/linuxsuren/api-testing/pkg/logging"
 
 	"github.com/linuxsuren/api-testing/pkg/util"
@@ -41,10 +39,12 @@ var (
 )
 
 type fileLoader struct {
-	paths         []string
-	index         int
-	parent        string
+	paths  []string
+	index  int
+	parent string
+	// userConfigDir keeps the revisions, themes and key bindings, it is empty unless configured
 	userConfigDir string
+	storeName     string
 
 	lock *sync.RWMutex
 }
@@ -52,18 +52,18 @@ type fileLoader struct {
 // NewFileLoader creates the instance of file loader
 func NewFileLoader() Loader {
 	return &fileLoader{
-		index:         -1,
-		lock:          &sync.RWMutex{},
-		userConfigDir: home.GetUserConfigDir(),
+		index:     -1,
+		lock:      &sync.RWMutex{},
+		storeName: LocalStoreName,
 	}
 }
 
 func NewFileWriter(parent string) Writer {
 	return &fileLoader{
-		index:         -1,
-		parent:        parent,
-		lock:          &sync.RWMutex{},
-		userConfigDir: home.GetUserConfigDir(),
+		index:     -1,
+		parent:    parent,
+		lock:      &sync.RWMutex{},
+		storeName: LocalStoreName,
 	}
 }
 
@@ -71,6 +71,11 @@ func (l *fileLoader) WithUserConfigDir(userConfigDir string) {
 	l.userConfigDir = userConfigDir
 }
 
+// WithStoreName sets the store name, the revisions are kept per store
+func (l *fileLoader) WithStoreName(name string) {
+	l.storeName = name
+}
+
 // HasMore returns if there are more test cases
 func (l *fileLoader) HasMore() bool {
 	l.index++
@@ -97,6 +102,14 @@ func loadData(targetFile string) (data []byte, err error) {
 	} else {
 		data, err = os.ReadFile(targetFile)
 	}
+
+	// the feature files are loaded as the YAML test suites
+	if err == nil && IsFeatureFile(targetFile) {
+		var suite *TestSuite
+		if suite, err = ParseFeature(data, targetFile); err == nil {
+			data, err = ToYAML(suite)
+		}
+	}
 	return
 }
 
@@ -240,7 +253,7 @@ func (l *fileLoader) CreateSuite(name, api string) (err error) {
 				Name: name,
 				API:  api,
 			}
-			if err = SaveTestSuiteToFile(suite, newSuiteFile); err == nil {
+			if err = l.saveWithRevision(suite, newSuiteFile, "", RevisionActionCreateSuite); err == nil {
 				l.Put(newSuiteFile)
 			}
 		}
@@ -278,7 +291,7 @@ func (l *fileLoader) UpdateSuite(suite TestSuite) (err error) {
 	var oldSuite *TestSuite
 	if oldSuite, absPath, err = l.GetSuite(suite.Name); err == nil {
 		suite.Items = oldSuite.Items // only update the suite info
-		err = SaveTestSuiteToFile(&suite, absPath)
+		err = l.saveWithRevision(&suite, absPath, "", RevisionActionUpdateSuite)
 	}
 	return
 }
@@ -295,6 +308,10 @@ func (l *fileLoader) DeleteSuite(name string) (err error) {
 		}
 
 		if suite.Name == name {
+			if data, readErr := os.ReadFile(suitePath); readErr == nil {
+				l.recordRevision(name, "", RevisionActionInitial, data)
+				l.recordRevision(name, "", RevisionActionDeleteSuite, data)
+			}
 			err = os.Remove(suitePath)
 			l.paths = append(l.paths[:i], l.paths[i+1:]...)
 			return
@@ -400,13 +417,15 @@ func (l *fileLoader) createOrUpdate(suiteName string, testcase TestCase, update
 			}
 		}
 
+		action := RevisionActionUpdateTestCase
 		if !found {
 			suite.Items = append(suite.Items, testcase)
+			action = RevisionActionCreateTestCase
 		} else if !update {
 			err = fmt.Errorf("test case %s already exists", testcase.Name)
 			return
 		}
-		err = SaveTestSuiteToFile(suite, suiteFilepath)
+		err = l.saveWithRevision(suite, suiteFilepath, testcase.Name, action)
 	}
 	return
 }
@@ -441,7 +460,7 @@ func (l *fileLoader) DeleteTestCase(suiteName, testcase string) (err error) {
 			return
 		}
 
-		err = SaveTestSuiteToFile(suite, suiteFilepath)
+		err = l.saveWithRevision(suite, suiteFilepath, testcase, RevisionActionDeleteTestCase)
 	}
 	return
 }
@@ -511,13 +530,70 @@ func (l *fileLoader) RenameTestSuite(oldName, newName string) (err error) {
 
 	oldSuite.Name = newName
 	newSuiteFile := path.Join(path.Dir(absPath), newName+".yaml")
-	if err = SaveTestSuiteToFile(oldSuite, newSuiteFile); err == nil {
+	if err = l.saveWithRevision(oldSuite, newSuiteFile, "", RevisionActionRenameSuite); err == nil {
 		l.Put(newSuiteFile)
 		err = l.DeleteSuite(oldName)
 	}
 	return
 }
 
+func (l *fileLoader) ListSuiteRevisions(suite string) (revisions []SuiteRevision, err error) {
+	if l.userConfigDir == "" {
+		return
+	}
+	return l.getRevisionStore().List(suite)
+}
+
+func (l *fileLoader) GetSuiteRevision(suite, revision string) (testSuite TestSuite, err error) {
+	if l.userConfigDir == "" {
+		err = fmt.Errorf("%w: %q of suite %q", ErrRevisionNotFound, revision, suite)
+		return
+	}
+
+	var data []byte
+	if data, err = l.getRevisionStore().Get(suite, revision); err == nil {
+		var result *TestSuite
+		if result, err = ParseFromData(data); err == nil {
+			testSuite = *result
+		}
+	}
+	return
+}
+
+// saveWithRevision saves the suite into the file, and records the snapshots before and after the change
+func (l *fileLoader) saveWithRevision(suite *TestSuite, suitePath, testCase, action string) (err error) {
+	if IsFeatureFile(suitePath) {
+		err = fmt.Errorf("the feature file %s is read-only", suitePath)
+		return
+	}
+
+	if data, readErr := os.ReadFile(suitePath); readErr == nil {
+		l.recordRevision(suite.Name, "", RevisionActionInitial, data)
+	}
+
+	if err = SaveTestSuiteToFile(suite, suitePath); err == nil {
+		if data, readErr := os.ReadFile(suitePath); readErr == nil {
+			l.recordRevision(suite.Name, testCase, action, data)
+		}
+	}
+	return
+}
+
+// recordRevision records a revision of the suite, the errors will be logged only.
+// It does nothing without a config directory.
+func (l *fileLoader) recordRevision(suite, testCase, action string, data []byte) {
+	if l.userConfigDir == "" {
+		return
+	}
+	if err := l.getRevisionStore().Record(suite, testCase, action, data); err != nil {
+		loaderLogger.Info("failed to record the revision", "suite", suite, "error", err)
+	}
+}
+
+func (l *fileLoader) getRevisionStore() *fileRevisionStore {
+	return newFileRevisionStore(filepath.Join(l.userConfigDir, "revisions", url.PathEscape(l.storeName)))
+}
+
 func (l *fileLoader) Verify() (readOnly bool, version string, err error) {
 	// always be okay
 	return
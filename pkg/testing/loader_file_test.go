@@ -246,3 +246,71 @@ func TestSuite(t *testing.T) {
 const urlFake = "http://fake"
 const urlTest = "http://test"
 const urlTestLogin = "http://test/login"
+
+func TestSuiteRevisions(t *testing.T) {
+	writer := atest.NewFileWriter(t.TempDir())
+	writer.WithUserConfigDir(t.TempDir())
+
+	assert.NoError(t, writer.CreateSuite("test", urlTest))
+	assert.NoError(t, writer.CreateTestCase("test", atest.TestCase{
+		Name:    "login",
+		Request: atest.Request{API: urlTestLogin},
+	}))
+	assert.NoError(t, writer.UpdateSuite(atest.TestSuite{Name: "test", API: urlFake}))
+
+	revisions, err := writer.ListSuiteRevisions("test")
+	assert.NoError(t, err)
+	if assert.Len(t, revisions, 3) {
+		assert.Equal(t, atest.RevisionActionUpdateSuite, revisions[0].Action)
+		assert.Equal(t, atest.RevisionActionCreateTestCase, revisions[1].Action)
+		assert.Equal(t, "login", revisions[1].TestCase)
+		assert.Equal(t, atest.RevisionActionCreateSuite, revisions[2].Action)
+
+		var suite atest.TestSuite
+		suite, err = writer.GetSuiteRevision("test", revisions[1].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, urlTest, suite.API)
+		assert.Len(t, suite.Items, 1)
+	}
+
+	_, err = writer.GetSuiteRevision("test", "fake")
+	assert.ErrorIs(t, err, atest.ErrRevisionNotFound)
+
+	assert.NoError(t, writer.DeleteSuite("test"))
+	revisions, err = writer.ListSuiteRevisions("test")
+	assert.NoError(t, err)
+	assert.Equal(t, atest.RevisionActionDeleteSuite, revisions[0].Action)
+}
+
+func TestSuiteRevisionsPerStore(t *testing.T) {
+	configDir := t.TempDir()
+	newWriter := func(store string) atest.Writer {
+		writer := atest.NewFileWriter(t.TempDir())
+		writer.WithUserConfigDir(configDir)
+		writer.(atest.StoreNameSetter).WithStoreName(store)
+		return writer
+	}
+
+	local, other := newWriter(atest.LocalStoreName), newWriter("other")
+	assert.NoError(t, local.CreateSuite("test", urlTest))
+	assert.NoError(t, other.CreateSuite("test", urlFake))
+	assert.NoError(t, other.UpdateSuite(atest.TestSuite{Name: "test", API: urlTest}))
+
+	revisions, err := local.ListSuiteRevisions("test")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 1)
+	revisions, err = other.ListSuiteRevisions("test")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+
+	t.Run("no config dir", func(t *testing.T) {
+		writer := atest.NewFileWriter(t.TempDir())
+		assert.NoError(t, writer.CreateSuite("test", urlTest))
+
+		revisions, err := writer.ListSuiteRevisions("test")
+		assert.NoError(t, err)
+		assert.Empty(t, revisions)
+		_, err = writer.GetSuiteRevision("test", "fake")
+		assert.ErrorIs(t, err, atest.ErrRevisionNotFound)
+	})
+}
@@ -145,6 +145,14 @@ func (l *nonLoader) RenameTestSuite(oldName, newName string) (err error) {
 	return
 }
 
+func (l *nonLoader) ListSuiteRevisions(suite string) (revisions []SuiteRevision, err error) {
+	return
+}
+
+func (l *nonLoader) GetSuiteRevision(suite, revision string) (testSuite TestSuite, err error) {
+	return
+}
+
 func (l *nonLoader) Verify() (readOnly bool, version string, err error) {
 	// always be okay
 	return
@@ -1,5 +1,5 @@
 /*
-Copyright 2024 API Testing Authors.
+Copyright 2024-2025 API Testing Authors.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -18,22 +18,60 @@ package local
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"sync"
 
+	"github.com/linuxsuren/api-testing/pkg/secret"
 	"github.com/linuxsuren/api-testing/pkg/server"
 	"github.com/linuxsuren/api-testing/pkg/testing/remote"
 	"gopkg.in/yaml.v3"
 )
 
+// EnvSecretPassphrase is the environment variable of the passphrase,
+// the key file will be used if it is empty
+const EnvSecretPassphrase = "ATEST_SECRET_PASSPHRASE"
+
+// KeyRotator is able to rotate the key of the secrets
+type KeyRotator interface {
+	// RotateKey encrypts all the secrets with a new key, a new passphrase is required in the passphrase mode
+	RotateKey(newPassphrase string) error
+	// Reencrypt encrypts all the secrets again with the current key, the plain text ones are included
+	Reencrypt() error
+}
+
 type localSecretService struct {
-	dataDir string
+	dataDir    string
+	passphrase string
+	lock       sync.Mutex
+
+	// the derived key is cached because it's expensive
+	cachedSalt string
+	cachedKey  []byte
 	remote.UnimplementedSecretServiceServer
 }
 
+// secretFileFormat marks the encrypted secret file, the file without it has the plain text values of the previous versions
+const secretFileFormat = "atest/secret/v1"
+
+// secretFile is the content of the secret file, all the values are encrypted
+type secretFile struct {
+	Format  string            `yaml:"format"`
+	KeyID   string            `yaml:"keyID"`
+	Salt    string            `yaml:"salt,omitempty"`
+	Secrets map[string]string `yaml:"secrets"`
+}
+
+// NewLocalSecretService creates a secret service which keeps the encrypted secrets in the data directory.
+// The key comes from the passphrase of environment variable ATEST_SECRET_PASSPHRASE, or the file secret.key.
 func NewLocalSecretService(dataDir string) remote.SecretServiceServer {
 	return &localSecretService{
-		dataDir: dataDir,
+		dataDir:    dataDir,
+		passphrase: os.Getenv(EnvSecretPassphrase),
 	}
 }
 
@@ -51,89 +89,279 @@ func (s *localSecretService) GetSecret(ctx context.Context, in *server.Secret) (
 }
 
 func (s *localSecretService) GetSecrets(ctx context.Context, in *server.Empty) (reply *server.Secrets, err error) {
-	secretFile := s.getDataFilePath()
-	var data []byte
-	if data, err = os.ReadFile(secretFile); err == nil {
-		secretData := make(map[string]string)
-
-		if err = yaml.Unmarshal(data, &secretData); err == nil {
-			reply = &server.Secrets{
-				Data: make([]*server.Secret, 0),
-			}
+	s.lock.Lock()
+	defer s.lock.Unlock()
 
-			for key, value := range secretData {
-				reply.Data = append(reply.Data, &server.Secret{
-					Name:  key,
-					Value: value,
-				})
-			}
+	var secretData map[string]string
+	if secretData, _, err = s.readSecrets(); err == nil {
+		reply = &server.Secrets{
+			Data: make([]*server.Secret, 0),
+		}
+
+		for key, value := range secretData {
+			secret.RegisterMaskValue(value)
+			reply.Data = append(reply.Data, &server.Secret{
+				Name:  key,
+				Value: value,
+			})
 		}
+		sort.Slice(reply.Data, func(i, j int) bool {
+			return reply.Data[i].Name < reply.Data[j].Name
+		})
 	}
 	return
 }
 
 func (s *localSecretService) CreateSecret(ctx context.Context, in *server.Secret) (reply *server.CommonResult, err error) {
-	secretFile := s.getDataFilePath()
+	err = s.modify(func(secretData map[string]string) {
+		secretData[in.Name] = in.Value
+	})
+	return
+}
 
-	if _, fErr := os.Stat(secretFile); fErr != nil {
-		var file *os.File
-		if file, err = os.Create(secretFile); err != nil {
-			return
-		}
-		file.Close()
+func (s *localSecretService) DeleteSecret(ctx context.Context, in *server.Secret) (reply *server.CommonResult, err error) {
+	err = s.modify(func(secretData map[string]string) {
+		delete(secretData, in.Name)
+	})
+	return
+}
+
+func (s *localSecretService) UpdateSecret(ctx context.Context, in *server.Secret) (reply *server.CommonResult, err error) {
+	reply, err = s.CreateSecret(ctx, in)
+	return
+}
+
+func (s *localSecretService) Query(query map[string]string) (result map[string]string, err error) {
+	result = make(map[string]string)
+	return
+}
+
+func (s *localSecretService) GetThemes() (result []string, err error) {
+	return
+}
+
+func (s *localSecretService) GetTheme(string) (result string, err error) {
+	return
+}
+
+// RotateKey decrypts all the secrets with the current key, then encrypts them with a new one.
+// The secrets are written before the new key takes effect, the previous key file is kept as secret.key.old.
+func (s *localSecretService) RotateKey(newPassphrase string) (err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var secretData map[string]string
+	if secretData, _, err = s.readSecrets(); err != nil {
+		return
+	}
+
+	if s.passphrase != "" {
+		err = s.rotatePassphrase(newPassphrase, secretData)
+	} else {
+		err = s.rotateKeyFile(secretData)
 	}
+	return
+}
 
-	var data []byte
-	if data, err = os.ReadFile(secretFile); err == nil {
-		secretData := make(map[string]string)
+// rotatePassphrase switches to the new passphrase once the secrets are encrypted with it
+func (s *localSecretService) rotatePassphrase(newPassphrase string, secretData map[string]string) (err error) {
+	if newPassphrase == "" {
+		err = errors.New("a new passphrase is required")
+		return
+	}
 
-		if err = yaml.Unmarshal(data, &secretData); err == nil {
-			secretData[in.Name] = in.Value
+	var salt, key []byte
+	if salt, err = secret.GenerateSalt(); err != nil {
+		return
+	}
+	if key, err = secret.KeyFromPassphrase(newPassphrase, salt); err != nil {
+		return
+	}
 
-			if data, err = yaml.Marshal(secretData); err == nil {
-				err = os.WriteFile(secretFile, data, 0644)
-			}
-		}
+	var encryptor secret.Encryptor
+	if encryptor, err = secret.NewAESEncryptor(key); err != nil {
+		return
+	}
+	data := &secretFile{Salt: base64.StdEncoding.EncodeToString(salt)}
+	if err = s.saveSecrets(data, secretData, encryptor); err == nil {
+		s.passphrase = newPassphrase
+		s.cachedSalt, s.cachedKey = data.Salt, key
 	}
 	return
 }
 
-func (s *localSecretService) DeleteSecret(ctx context.Context, in *server.Secret) (reply *server.CommonResult, err error) {
-	secretFile := s.getDataFilePath()
+// rotateKeyFile writes the new key into secret.key.new, and renames it to secret.key once the secrets are encrypted with it.
+// An interrupted rotation is completed by the next read, see resumeRotation.
+func (s *localSecretService) rotateKeyFile(secretData map[string]string) (err error) {
+	keyFile := s.getKeyFilePath()
+	var oldKey, newKey []byte
+	if oldKey, err = secret.ReadKeyFile(keyFile); err == nil {
+		err = secret.WriteKeyFile(keyFile+".old", oldKey)
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return
+	}
 
-	var data []byte
-	if data, err = os.ReadFile(secretFile); err == nil {
-		secretData := make(map[string]string)
+	if newKey, err = secret.GenerateKey(); err != nil {
+		return
+	}
+	if err = secret.WriteKeyFile(keyFile+".new", newKey); err != nil {
+		return
+	}
 
-		if err = yaml.Unmarshal(data, &secretData); err == nil {
-			delete(secretData, in.Name)
+	var encryptor secret.Encryptor
+	if encryptor, err = secret.NewAESEncryptor(newKey); err != nil {
+		return
+	}
+	if err = s.saveSecrets(&secretFile{}, secretData, encryptor); err == nil {
+		err = os.Rename(keyFile+".new", keyFile)
+	}
+	return
+}
 
-			if data, err = yaml.Marshal(secretData); err == nil {
-				err = os.WriteFile(secretFile, data, 0644)
-			}
-		}
+// resumeRotation takes the new key if the secrets were encrypted with it, but the key rotation was interrupted before renaming it
+func (s *localSecretService) resumeRotation(keyID string) (encryptor secret.Encryptor, err error) {
+	if s.passphrase != "" {
+		return
+	}
+
+	keyFile := s.getKeyFilePath()
+	var key []byte
+	if key, err = secret.ReadKeyFile(keyFile + ".new"); err != nil || secret.GetKeyID(key) != keyID {
+		err = nil
+		return
+	}
+	if encryptor, err = secret.NewAESEncryptor(key); err == nil {
+		err = os.Rename(keyFile+".new", keyFile)
 	}
 	return
 }
 
-func (s *localSecretService) UpdateSecret(ctx context.Context, in *server.Secret) (reply *server.CommonResult, err error) {
-	reply, err = s.CreateSecret(ctx, in)
+// Reencrypt writes all the secrets with the current key, it migrates the plain text secrets
+func (s *localSecretService) Reencrypt() (err error) {
+	err = s.modify(func(map[string]string) {})
 	return
 }
 
-func (s *localSecretService) Query(query map[string]string) (result map[string]string, err error) {
-	result = make(map[string]string)
+func (s *localSecretService) modify(callback func(secretData map[string]string)) (err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var secretData map[string]string
+	var data *secretFile
+	if secretData, data, err = s.readSecrets(); err == nil {
+		callback(secretData)
+		err = s.writeSecrets(data, secretData)
+	}
 	return
 }
 
-func (s *localSecretService) GetThemes() (result []string, err error) {
+// readSecrets returns the decrypted secrets, a file with plain text values is supported as well
+func (s *localSecretService) readSecrets() (secretData map[string]string, data *secretFile, err error) {
+	data = &secretFile{}
+	var content []byte
+	if content, err = os.ReadFile(s.getDataFilePath()); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			err = nil
+		}
+	} else if yaml.Unmarshal(content, data) != nil || data.Format != secretFileFormat {
+		// the legacy file has the plain text values only
+		data = &secretFile{}
+		err = yaml.Unmarshal(content, &data.Secrets)
+	}
+	if err != nil {
+		return
+	}
+
+	var encryptor secret.Encryptor
+	if encryptor, err = s.getEncryptor(data); err != nil {
+		return
+	}
+	if data.KeyID != "" && data.KeyID != encryptor.KeyID() {
+		var resumed secret.Encryptor
+		if resumed, err = s.resumeRotation(data.KeyID); err != nil {
+			return
+		} else if resumed == nil {
+			err = fmt.Errorf("the secrets were encrypted by key %q, but the current key is %q", data.KeyID, encryptor.KeyID())
+			return
+		}
+		encryptor = resumed
+	}
+
+	secretData = make(map[string]string, len(data.Secrets))
+	for key, value := range data.Secrets {
+		if secretData[key], err = encryptor.Decrypt(value); err != nil {
+			err = fmt.Errorf("failed to decrypt secret %q: %v", key, err)
+			return
+		}
+	}
 	return
 }
 
-func (s *localSecretService) GetTheme(string) (result string, err error) {
+func (s *localSecretService) writeSecrets(data *secretFile, secretData map[string]string) (err error) {
+	var encryptor secret.Encryptor
+	if encryptor, err = s.getEncryptor(data); err == nil {
+		err = s.saveSecrets(data, secretData, encryptor)
+	}
+	return
+}
+
+// saveSecrets encrypts the secrets with the encryptor, then replaces the secret file atomically
+func (s *localSecretService) saveSecrets(data *secretFile, secretData map[string]string, encryptor secret.Encryptor) (err error) {
+	data.Format = secretFileFormat
+	data.KeyID = encryptor.KeyID()
+	data.Secrets = make(map[string]string, len(secretData))
+	for key, value := range secretData {
+		if data.Secrets[key], err = encryptor.Encrypt(value); err != nil {
+			return
+		}
+	}
+
+	var content []byte
+	if content, err = yaml.Marshal(data); err == nil {
+		if err = os.MkdirAll(s.dataDir, 0700); err == nil {
+			err = secret.WriteFileAtomic(s.getDataFilePath(), content, 0600)
+		}
+	}
+	return
+}
+
+// getEncryptor returns the encryptor from the passphrase or the key file,
+// a new salt will be set into the data if it's missing in the passphrase mode
+func (s *localSecretService) getEncryptor(data *secretFile) (encryptor secret.Encryptor, err error) {
+	var key []byte
+	if s.passphrase != "" {
+		if data.Salt == "" {
+			var salt []byte
+			if salt, err = secret.GenerateSalt(); err != nil {
+				return
+			}
+			data.Salt = base64.StdEncoding.EncodeToString(salt)
+		}
+
+		if data.Salt == s.cachedSalt && s.cachedKey != nil {
+			key = s.cachedKey
+		} else {
+			var salt []byte
+			if salt, err = base64.StdEncoding.DecodeString(data.Salt); err != nil {
+				return
+			}
+			if key, err = secret.KeyFromPassphrase(s.passphrase, salt); err != nil {
+				return
+			}
+			s.cachedSalt, s.cachedKey = data.Salt, key
+		}
+	} else if key, err = secret.LoadOrCreateKeyFile(s.getKeyFilePath()); err != nil {
+		return
+	}
+	encryptor, err = secret.NewAESEncryptor(key)
 	return
 }
 
 func (s *localSecretService) getDataFilePath() string {
 	return filepath.Join(s.dataDir, "secret.yaml")
 }
+
+func (s *localSecretService) getKeyFilePath() string {
+	return filepath.Join(s.dataDir, "secret.key")
+}
@@ -19,6 +19,7 @@ package local
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/linuxsuren/api-testing/pkg/server"
@@ -66,3 +67,102 @@ func TestLocalSecretService(t *testing.T) {
 		assert.Len(t, secrets.Data, 0)
 	})
 }
+
+func TestLocalSecretServiceEncryption(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("key file", func(t *testing.T) {
+		dataDir := t.TempDir()
+		t.Setenv(EnvSecretPassphrase, "")
+		service := NewLocalSecretService(dataDir)
+
+		_, err := service.CreateSecret(ctx, &server.Secret{Name: "token", Value: "plain-value"})
+		assert.NoError(t, err)
+
+		data, err := os.ReadFile(filepath.Join(dataDir, "secret.yaml"))
+		assert.NoError(t, err)
+		assert.NotContains(t, string(data), "plain-value")
+		for _, name := range []string{"secret.yaml", "secret.key"} {
+			info, err := os.Stat(filepath.Join(dataDir, name))
+			assert.NoError(t, err)
+			assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+		}
+
+		rotator, ok := service.(KeyRotator)
+		assert.True(t, ok)
+		oldKey, err := os.ReadFile(filepath.Join(dataDir, "secret.key"))
+		assert.NoError(t, err)
+		assert.NoError(t, rotator.RotateKey(""))
+
+		newKey, err := os.ReadFile(filepath.Join(dataDir, "secret.key"))
+		assert.NoError(t, err)
+		assert.NotEqual(t, oldKey, newKey)
+		backupKey, err := os.ReadFile(filepath.Join(dataDir, "secret.key.old"))
+		assert.NoError(t, err)
+		assert.Equal(t, oldKey, backupKey)
+
+		secret, err := service.GetSecret(ctx, &server.Secret{Name: "token"})
+		assert.NoError(t, err)
+		assert.Equal(t, "plain-value", secret.Value)
+
+		// the interrupted rotation is completed when reading the secrets
+		assert.NoError(t, os.WriteFile(filepath.Join(dataDir, "secret.key.new"), newKey, 0600))
+		assert.NoError(t, os.WriteFile(filepath.Join(dataDir, "secret.key"), oldKey, 0600))
+		secret, err = service.GetSecret(ctx, &server.Secret{Name: "token"})
+		assert.NoError(t, err)
+		assert.Equal(t, "plain-value", secret.Value)
+		assert.NoFileExists(t, filepath.Join(dataDir, "secret.key.new"))
+
+		// the secrets cannot be read with a wrong key
+		assert.NoError(t, os.WriteFile(filepath.Join(dataDir, "secret.key"), oldKey, 0600))
+		_, err = service.GetSecrets(ctx, &server.Empty{})
+		assert.Error(t, err)
+	})
+
+	t.Run("passphrase", func(t *testing.T) {
+		dataDir := t.TempDir()
+		t.Setenv(EnvSecretPassphrase, "passphrase")
+		service := NewLocalSecretService(dataDir)
+
+		_, err := service.CreateSecret(ctx, &server.Secret{Name: "token", Value: "plain-value"})
+		assert.NoError(t, err)
+		assert.NoFileExists(t, filepath.Join(dataDir, "secret.key"))
+
+		rotator := service.(KeyRotator)
+		assert.Error(t, rotator.RotateKey(""))
+		assert.NoError(t, rotator.RotateKey("new-passphrase"))
+
+		_, err = NewLocalSecretService(dataDir).GetSecrets(ctx, &server.Empty{})
+		assert.Error(t, err)
+
+		t.Setenv(EnvSecretPassphrase, "new-passphrase")
+		secret, err := NewLocalSecretService(dataDir).GetSecret(ctx, &server.Secret{Name: "token"})
+		assert.NoError(t, err)
+		assert.Equal(t, "plain-value", secret.Value)
+	})
+
+	t.Run("migrate plain text secrets", func(t *testing.T) {
+		dataDir := t.TempDir()
+		t.Setenv(EnvSecretPassphrase, "")
+		secretFile := filepath.Join(dataDir, "secret.yaml")
+		assert.NoError(t, os.WriteFile(secretFile, []byte("token: plain-value\nkeyID: id\nsecrets: value\n"), 0644))
+
+		service := NewLocalSecretService(dataDir)
+		secret, err := service.GetSecret(ctx, &server.Secret{Name: "token"})
+		assert.NoError(t, err)
+		assert.Equal(t, "plain-value", secret.Value)
+		secret, err = service.GetSecret(ctx, &server.Secret{Name: "keyID"})
+		assert.NoError(t, err)
+		assert.Equal(t, "id", secret.Value)
+
+		assert.NoError(t, service.(KeyRotator).Reencrypt())
+		data, err := os.ReadFile(secretFile)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(data), "plain-value")
+		assert.Contains(t, string(data), "format: atest/secret/v1")
+
+		secret, err = service.GetSecret(ctx, &server.Secret{Name: "token"})
+		assert.NoError(t, err)
+		assert.Equal(t, "plain-value", secret.Value)
+	})
+}
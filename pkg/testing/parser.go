@@ -84,6 +84,9 @@ func ParseTestCaseFromData(data []byte) (testCase *TestCase, err error) {
 func ParseTestSuiteFromFile(suitePath string) (testSuite *TestSuite, err error) {
 	var data []byte
 	if data, err = os.ReadFile(suitePath); err == nil {
+		if IsFeatureFile(suitePath) {
+			return ParseFeature(data, suitePath)
+		}
 		testSuite = &TestSuite{}
 		yaml.Unmarshal(data, testSuite)
 	}
@@ -115,6 +118,15 @@ func SaveTestSuiteToFile(suite *TestSuite, suitePath string) (err error) {
 
 // Render injects the template based context
 func (s *TestSuite) Render(dataContext map[string]interface{}) (err error) {
+	if len(s.Functions) > 0 {
+		functions := &render.UserDefinedTemplates{Items: s.Functions}
+		if err = functions.Check(); err != nil {
+			err = fmt.Errorf("invalid functions of suite %q: %w", s.Name, err)
+			return
+		}
+		dataContext[render.ContextKeyFunctions] = s.Functions
+	}
+
 	// render the API
 	var result string
 	if result, err = render.Render("base api", s.API, dataContext); err == nil {
@@ -124,6 +136,50 @@ func (s *TestSuite) Render(dataContext map[string]interface{}) (err error) {
 		s.Param, err = renderMap(dataContext, s.Param, "parameter")
 		dataContext[ContextKeyGlobalParam] = s.Param
 	}
+	if err == nil && s.Auth != nil {
+		err = s.Auth.Render(dataContext)
+	}
+	return
+}
+
+// Render injects the template based context into the secrets and other fields of the auth
+func (a *Auth) Render(ctx interface{}) (err error) {
+	var fields []*string
+	if a.JWT != nil {
+		fields = append(fields, &a.JWT.Secret, &a.JWT.ExpiresIn)
+	}
+	if a.HMAC != nil {
+		fields = append(fields, &a.HMAC.Secret)
+	}
+	if a.AWS != nil {
+		fields = append(fields, &a.AWS.AccessKey, &a.AWS.SecretKey, &a.AWS.SessionToken, &a.AWS.Region)
+	}
+	if a.OAuth2 != nil {
+		fields = append(fields, &a.OAuth2.TokenURL, &a.OAuth2.ClientID, &a.OAuth2.ClientSecret,
+			&a.OAuth2.Username, &a.OAuth2.Password)
+	}
+
+	for _, field := range fields {
+		var result string
+		if result, err = render.Render("auth", *field, ctx); err != nil {
+			err = fmt.Errorf("failed to render the auth: %w", err)
+			return
+		}
+		*field = strings.TrimSpace(result)
+	}
+
+	if a.JWT != nil {
+		for key, val := range a.JWT.Claims {
+			if text, ok := val.(string); ok {
+				var result string
+				if result, err = render.Render("claim", text, ctx); err != nil {
+					err = fmt.Errorf("failed to render the claim %q: %w", key, err)
+					return
+				}
+				a.JWT.Claims[key] = strings.TrimSpace(result)
+			}
+		}
+	}
 	return
 }
 
@@ -87,6 +87,25 @@ func TestDuplicatedNames(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestSuiteFunctions(t *testing.T) {
+	data, err := os.ReadFile("testdata/functions.yaml")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	suite, err := atest.Parse(data)
+	if assert.NoError(t, err) && assert.Equal(t, 1, len(suite.Functions)) {
+		assert.Equal(t, []string{"host"}, suite.Functions[0].Params)
+
+		dataContext := map[string]interface{}{}
+		assert.NoError(t, suite.Render(dataContext))
+		assert.Equal(t, "http://localhost:8080/api", suite.API)
+	}
+
+	suite.Functions[0].Name = "randInt"
+	assert.ErrorContains(t, suite.Render(map[string]interface{}{}), "invalid functions of suite")
+}
+
 func TestRequestRender(t *testing.T) {
 	validMap := map[string]string{
 		"key": "{{.Name}}",
@@ -256,6 +256,20 @@ func ConvertToGRPCHistoryTestCase(historyTestcase testing.HistoryTestCase) (resu
 	return
 }
 
+// ConvertToNormalSuiteRevision converts the GRPC suite revision to normal suite revision
+func ConvertToNormalSuiteRevision(revision *server.SuiteRevision) (result testing.SuiteRevision) {
+	result = testing.SuiteRevision{
+		ID:       revision.Id,
+		Suite:    revision.Suite,
+		TestCase: revision.Testcase,
+		Action:   revision.Action,
+	}
+	if revision.CreateTime != nil {
+		result.CreateTime = revision.CreateTime.AsTime()
+	}
+	return
+}
+
 func mapToPair(data map[string]string) (pairs []*server.Pair) {
 	pairs = make([]*server.Pair, 0)
 	for k, v := range data {
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	context "context"
+
+	"github.com/linuxsuren/api-testing/pkg/audit"
+	"github.com/linuxsuren/api-testing/pkg/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type gRPCAuditSink struct {
+	conn   *grpc.ClientConn
+	client AuditServiceClient
+}
+
+// NewGRPCAuditSinkFrom creates an audit sink which sends the entries to an extension
+func NewGRPCAuditSinkFrom(address string) (sink audit.Sink, err error) {
+	var conn *grpc.ClientConn
+	if conn, err = grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials())); err == nil {
+		sink = &gRPCAuditSink{
+			conn:   conn,
+			client: NewAuditServiceClient(conn),
+		}
+	}
+	return
+}
+
+func (s *gRPCAuditSink) Write(entry audit.Entry) (err error) {
+	_, err = s.client.WriteAuditEntry(context.Background(), server.ToGRPCAuditEntry(entry))
+	return
+}
+
+func (s *gRPCAuditSink) Close() {
+	if s.conn != nil {
+		_ = s.conn.Close()
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	context "context"
+	"net"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/audit"
+	server "github.com/linuxsuren/api-testing/pkg/server"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestGRPCAuditSink(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	auditServer := &fakeAuditServer{}
+	gRPCServer := grpc.NewServer()
+	RegisterAuditServiceServer(gRPCServer, auditServer)
+	go gRPCServer.Serve(lis)
+	defer gRPCServer.Stop()
+
+	sink, err := NewGRPCAuditSinkFrom(lis.Addr().String())
+	assert.NoError(t, err)
+	defer sink.Close()
+
+	err = sink.Write(audit.Entry{Method: "/server.Runner/DeleteTestSuite", User: "admin", Success: true})
+	assert.NoError(t, err)
+	if assert.Len(t, auditServer.entries, 1) {
+		assert.Equal(t, "admin", auditServer.entries[0].User)
+		assert.Equal(t, "/server.Runner/DeleteTestSuite", auditServer.entries[0].Method)
+	}
+}
+
+type fakeAuditServer struct {
+	UnimplementedAuditServiceServer
+	entries []*server.AuditEntry
+}
+
+func (f *fakeAuditServer) WriteAuditEntry(ctx context.Context, in *server.AuditEntry) (*server.Empty, error) {
+	f.entries = append(f.entries, in)
+	return &server.Empty{}, nil
+}
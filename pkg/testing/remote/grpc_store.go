@@ -32,7 +32,9 @@ import (
 	"github.com/linuxsuren/api-testing/pkg/testing"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -296,6 +298,29 @@ func (g *gRPCLoader) RenameTestSuite(oldName, newName string) (err error) {
 	return
 }
 
+func (g *gRPCLoader) ListSuiteRevisions(suite string) (revisions []testing.SuiteRevision, err error) {
+	var reply *server.SuiteRevisions
+	if reply, err = g.client.ListSuiteRevisions(g.ctx, &server.SuiteRevisionQuery{
+		Suite: suite,
+	}); err == nil && reply != nil {
+		for _, item := range reply.Data {
+			revisions = append(revisions, ConvertToNormalSuiteRevision(item))
+		}
+	}
+	return
+}
+
+func (g *gRPCLoader) GetSuiteRevision(suite, revision string) (testSuite testing.TestSuite, err error) {
+	var reply *TestSuite
+	if reply, err = g.client.GetSuiteRevision(g.ctx, &server.SuiteRevisionIdentity{
+		Suite:    suite,
+		Revision: revision,
+	}); err == nil && reply != nil {
+		testSuite = *ConvertToNormalTestSuite(reply)
+	}
+	return
+}
+
 func (g *gRPCLoader) Verify() (readOnly bool, version string, err error) {
 	// avoid to long to wait the response
 	ctx, cancel := context.WithTimeout(g.ctx, time.Second*5)
@@ -381,6 +406,23 @@ func (g *gRPCLoader) GetTheme(name string) (result string, err error) {
 	return
 }
 
+// GetTemplates returns the templates of the extension, it's empty if the extension does not support it
+func (g *gRPCLoader) GetTemplates() (templates []testing.Template, err error) {
+	var simpleList *server.SimpleList
+	if simpleList, err = g.client.GetTemplates(g.ctx, &server.Empty{}); err == nil {
+		for _, item := range simpleList.Data {
+			templates = append(templates, testing.Template{
+				Name: item.Key,
+				Kind: item.Description,
+				Text: item.Value,
+			})
+		}
+	} else if status.Code(err) == codes.Unimplemented {
+		err = nil
+	}
+	return
+}
+
 func (g *gRPCLoader) GetBindings() (result []string, err error) {
 	var simpleList *server.SimpleList
 	if simpleList, err = g.client.GetBindings(g.ctx, &server.Empty{}); err == nil && simpleList.Data != nil {
@@ -17,10 +17,14 @@ limitations under the License.
 package remote
 
 import (
+	"context"
+	"net"
 	"testing"
 
+	server "github.com/linuxsuren/api-testing/pkg/server"
 	atest "github.com/linuxsuren/api-testing/pkg/testing"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 )
 
 func TestNewGRPCLoader(t *testing.T) {
@@ -118,3 +122,44 @@ func TestNewGRPCLoader(t *testing.T) {
 		assert.NotNil(t, NewGRPCloaderFromStore())
 	})
 }
+
+func TestGRPCLoaderTemplates(t *testing.T) {
+	newLoader := func(t *testing.T, loaderServer LoaderServer) atest.TemplateProvider {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+
+		gRPCServer := grpc.NewServer()
+		RegisterLoaderServer(gRPCServer, loaderServer)
+		go gRPCServer.Serve(lis)
+		t.Cleanup(gRPCServer.Stop)
+
+		writer, err := NewGRPCloaderFromStore().NewInstance(atest.Store{
+			Kind: atest.StoreKind{URL: lis.Addr().String()},
+		})
+		assert.NoError(t, err)
+		t.Cleanup(writer.Close)
+		return writer.(atest.TemplateProvider)
+	}
+
+	t.Run("normal", func(t *testing.T) {
+		templates, err := newLoader(t, &fakeTemplateServer{}).GetTemplates()
+		assert.NoError(t, err)
+		assert.Equal(t, []atest.Template{{Name: "http-file", Kind: "generator", Text: "{{ .Request.API }}"}}, templates)
+	})
+
+	t.Run("not supported by the extension", func(t *testing.T) {
+		templates, err := newLoader(t, &UnimplementedLoaderServer{}).GetTemplates()
+		assert.NoError(t, err)
+		assert.Empty(t, templates)
+	})
+}
+
+type fakeTemplateServer struct {
+	UnimplementedLoaderServer
+}
+
+func (f *fakeTemplateServer) GetTemplates(context.Context, *server.Empty) (*server.SimpleList, error) {
+	return &server.SimpleList{
+		Data: []*server.Pair{{Key: "http-file", Value: "{{ .Request.API }}", Description: "generator"}},
+	}, nil
+}
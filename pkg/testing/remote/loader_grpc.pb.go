@@ -29,6 +29,8 @@ type LoaderClient interface {
 	UpdateTestSuite(ctx context.Context, in *TestSuite, opts ...grpc.CallOption) (*TestSuite, error)
 	DeleteTestSuite(ctx context.Context, in *TestSuite, opts ...grpc.CallOption) (*server.Empty, error)
 	RenameTestSuite(ctx context.Context, in *server.TestSuiteDuplicate, opts ...grpc.CallOption) (*server.HelloReply, error)
+	ListSuiteRevisions(ctx context.Context, in *server.SuiteRevisionQuery, opts ...grpc.CallOption) (*server.SuiteRevisions, error)
+	GetSuiteRevision(ctx context.Context, in *server.SuiteRevisionIdentity, opts ...grpc.CallOption) (*TestSuite, error)
 	ListTestCases(ctx context.Context, in *TestSuite, opts ...grpc.CallOption) (*server.TestCases, error)
 	CreateTestCase(ctx context.Context, in *server.TestCase, opts ...grpc.CallOption) (*server.Empty, error)
 	GetTestCase(ctx context.Context, in *server.TestCase, opts ...grpc.CallOption) (*server.TestCase, error)
@@ -50,6 +52,8 @@ type LoaderClient interface {
 	GetTheme(ctx context.Context, in *server.SimpleName, opts ...grpc.CallOption) (*server.CommonResult, error)
 	GetBindings(ctx context.Context, in *server.Empty, opts ...grpc.CallOption) (*server.SimpleList, error)
 	GetBinding(ctx context.Context, in *server.SimpleName, opts ...grpc.CallOption) (*server.CommonResult, error)
+	// GetTemplates returns the code generator and converter templates, each item has the name as key, the template as value and the kind as description
+	GetTemplates(ctx context.Context, in *server.Empty, opts ...grpc.CallOption) (*server.SimpleList, error)
 	GetMenus(ctx context.Context, in *server.Empty, opts ...grpc.CallOption) (*server.MenuList, error)
 	GetPageOfJS(ctx context.Context, in *server.SimpleName, opts ...grpc.CallOption) (*server.CommonResult, error)
 	GetPageOfCSS(ctx context.Context, in *server.SimpleName, opts ...grpc.CallOption) (*server.CommonResult, error)
@@ -119,6 +123,24 @@ func (c *loaderClient) RenameTestSuite(ctx context.Context, in *server.TestSuite
 	return out, nil
 }
 
+func (c *loaderClient) ListSuiteRevisions(ctx context.Context, in *server.SuiteRevisionQuery, opts ...grpc.CallOption) (*server.SuiteRevisions, error) {
+	out := new(server.SuiteRevisions)
+	err := c.cc.Invoke(ctx, "/remote.Loader/ListSuiteRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loaderClient) GetSuiteRevision(ctx context.Context, in *server.SuiteRevisionIdentity, opts ...grpc.CallOption) (*TestSuite, error) {
+	out := new(TestSuite)
+	err := c.cc.Invoke(ctx, "/remote.Loader/GetSuiteRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loaderClient) ListTestCases(ctx context.Context, in *TestSuite, opts ...grpc.CallOption) (*server.TestCases, error) {
 	out := new(server.TestCases)
 	err := c.cc.Invoke(ctx, "/remote.Loader/ListTestCases", in, out, opts...)
@@ -308,6 +330,15 @@ func (c *loaderClient) GetBinding(ctx context.Context, in *server.SimpleName, op
 	return out, nil
 }
 
+func (c *loaderClient) GetTemplates(ctx context.Context, in *server.Empty, opts ...grpc.CallOption) (*server.SimpleList, error) {
+	out := new(server.SimpleList)
+	err := c.cc.Invoke(ctx, "/remote.Loader/GetTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loaderClient) GetMenus(ctx context.Context, in *server.Empty, opts ...grpc.CallOption) (*server.MenuList, error) {
 	out := new(server.MenuList)
 	err := c.cc.Invoke(ctx, "/remote.Loader/GetMenus", in, out, opts...)
@@ -363,6 +394,8 @@ type LoaderServer interface {
 	UpdateTestSuite(context.Context, *TestSuite) (*TestSuite, error)
 	DeleteTestSuite(context.Context, *TestSuite) (*server.Empty, error)
 	RenameTestSuite(context.Context, *server.TestSuiteDuplicate) (*server.HelloReply, error)
+	ListSuiteRevisions(context.Context, *server.SuiteRevisionQuery) (*server.SuiteRevisions, error)
+	GetSuiteRevision(context.Context, *server.SuiteRevisionIdentity) (*TestSuite, error)
 	ListTestCases(context.Context, *TestSuite) (*server.TestCases, error)
 	CreateTestCase(context.Context, *server.TestCase) (*server.Empty, error)
 	GetTestCase(context.Context, *server.TestCase) (*server.TestCase, error)
@@ -384,6 +417,8 @@ type LoaderServer interface {
 	GetTheme(context.Context, *server.SimpleName) (*server.CommonResult, error)
 	GetBindings(context.Context, *server.Empty) (*server.SimpleList, error)
 	GetBinding(context.Context, *server.SimpleName) (*server.CommonResult, error)
+	// GetTemplates returns the code generator and converter templates, each item has the name as key, the template as value and the kind as description
+	GetTemplates(context.Context, *server.Empty) (*server.SimpleList, error)
 	GetMenus(context.Context, *server.Empty) (*server.MenuList, error)
 	GetPageOfJS(context.Context, *server.SimpleName) (*server.CommonResult, error)
 	GetPageOfCSS(context.Context, *server.SimpleName) (*server.CommonResult, error)
@@ -414,6 +449,12 @@ func (UnimplementedLoaderServer) DeleteTestSuite(context.Context, *TestSuite) (*
 func (UnimplementedLoaderServer) RenameTestSuite(context.Context, *server.TestSuiteDuplicate) (*server.HelloReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameTestSuite not implemented")
 }
+func (UnimplementedLoaderServer) ListSuiteRevisions(context.Context, *server.SuiteRevisionQuery) (*server.SuiteRevisions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuiteRevisions not implemented")
+}
+func (UnimplementedLoaderServer) GetSuiteRevision(context.Context, *server.SuiteRevisionIdentity) (*TestSuite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSuiteRevision not implemented")
+}
 func (UnimplementedLoaderServer) ListTestCases(context.Context, *TestSuite) (*server.TestCases, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTestCases not implemented")
 }
@@ -477,6 +518,9 @@ func (UnimplementedLoaderServer) GetBindings(context.Context, *server.Empty) (*s
 func (UnimplementedLoaderServer) GetBinding(context.Context, *server.SimpleName) (*server.CommonResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBinding not implemented")
 }
+func (UnimplementedLoaderServer) GetTemplates(context.Context, *server.Empty) (*server.SimpleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplates not implemented")
+}
 func (UnimplementedLoaderServer) GetMenus(context.Context, *server.Empty) (*server.MenuList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMenus not implemented")
 }
@@ -613,6 +657,42 @@ func _Loader_RenameTestSuite_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Loader_ListSuiteRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(server.SuiteRevisionQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoaderServer).ListSuiteRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/remote.Loader/ListSuiteRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoaderServer).ListSuiteRevisions(ctx, req.(*server.SuiteRevisionQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loader_GetSuiteRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(server.SuiteRevisionIdentity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoaderServer).GetSuiteRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/remote.Loader/GetSuiteRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoaderServer).GetSuiteRevision(ctx, req.(*server.SuiteRevisionIdentity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Loader_ListTestCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TestSuite)
 	if err := dec(in); err != nil {
@@ -991,6 +1071,24 @@ func _Loader_GetBinding_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Loader_GetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(server.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoaderServer).GetTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/remote.Loader/GetTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoaderServer).GetTemplates(ctx, req.(*server.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Loader_GetMenus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(server.Empty)
 	if err := dec(in); err != nil {
@@ -1112,6 +1210,14 @@ var Loader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenameTestSuite",
 			Handler:    _Loader_RenameTestSuite_Handler,
 		},
+		{
+			MethodName: "ListSuiteRevisions",
+			Handler:    _Loader_ListSuiteRevisions_Handler,
+		},
+		{
+			MethodName: "GetSuiteRevision",
+			Handler:    _Loader_GetSuiteRevision_Handler,
+		},
 		{
 			MethodName: "ListTestCases",
 			Handler:    _Loader_ListTestCases_Handler,
@@ -1196,6 +1302,10 @@ var Loader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBinding",
 			Handler:    _Loader_GetBinding_Handler,
 		},
+		{
+			MethodName: "GetTemplates",
+			Handler:    _Loader_GetTemplates_Handler,
+		},
 		{
 			MethodName: "GetMenus",
 			Handler:    _Loader_GetMenus_Handler,
@@ -1451,6 +1561,92 @@ var SecretService_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "pkg/testing/remote/loader.proto",
 }
 
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuditServiceClient interface {
+	WriteAuditEntry(ctx context.Context, in *server.AuditEntry, opts ...grpc.CallOption) (*server.Empty, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) WriteAuditEntry(ctx context.Context, in *server.AuditEntry, opts ...grpc.CallOption) (*server.Empty, error) {
+	out := new(server.Empty)
+	err := c.cc.Invoke(ctx, "/remote.AuditService/WriteAuditEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+// All implementations must embed UnimplementedAuditServiceServer
+// for forward compatibility
+type AuditServiceServer interface {
+	WriteAuditEntry(context.Context, *server.AuditEntry) (*server.Empty, error)
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+// UnimplementedAuditServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAuditServiceServer struct {
+}
+
+func (UnimplementedAuditServiceServer) WriteAuditEntry(context.Context, *server.AuditEntry) (*server.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteAuditEntry not implemented")
+}
+func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {}
+
+// UnsafeAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServiceServer will
+// result in compilation errors.
+type UnsafeAuditServiceServer interface {
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+func RegisterAuditServiceServer(s grpc.ServiceRegistrar, srv AuditServiceServer) {
+	s.RegisterService(&AuditService_ServiceDesc, srv)
+}
+
+func _AuditService_WriteAuditEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(server.AuditEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).WriteAuditEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/remote.AuditService/WriteAuditEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).WriteAuditEntry(ctx, req.(*server.AuditEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "remote.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WriteAuditEntry",
+			Handler:    _AuditService_WriteAuditEntry_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/testing/remote/loader.proto",
+}
+
 // ConfigServiceClient is the client API for ConfigService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -0,0 +1,207 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"gopkg.in/yaml.v3"
+)
+
+// The actions which create the suite revisions
+const (
+	RevisionActionInitial        = "initial"
+	RevisionActionCreateSuite    = "createSuite"
+	RevisionActionUpdateSuite    = "updateSuite"
+	RevisionActionDeleteSuite    = "deleteSuite"
+	RevisionActionRenameSuite    = "renameSuite"
+	RevisionActionCreateTestCase = "createTestCase"
+	RevisionActionUpdateTestCase = "updateTestCase"
+	RevisionActionDeleteTestCase = "deleteTestCase"
+	RevisionActionRenameTestCase = "renameTestCase"
+	RevisionActionAcceptSnapshot = "acceptSnapshot"
+)
+
+// SuiteRevision represents a snapshot of a test suite
+type SuiteRevision struct {
+	ID         string    `yaml:"id" json:"id"`
+	Suite      string    `yaml:"suite" json:"suite"`
+	TestCase   string    `yaml:"testcase,omitempty" json:"testcase,omitempty"`
+	Action     string    `yaml:"action" json:"action"`
+	CreateTime time.Time `yaml:"createTime" json:"createTime"`
+}
+
+// ErrRevisionNotFound indicates the revision does not exist
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// DefaultMaxRevisions is the number of revisions which are kept for each suite
+const DefaultMaxRevisions = 100
+
+// fileRevisionStore keeps the YAML snapshots of the suites in a directory:
+// <dir>/<suite>/index.yaml has the revisions, and <dir>/<suite>/<id>.yaml has the snapshot.
+type fileRevisionStore struct {
+	dir          string
+	maxRevisions int
+	now          func() time.Time
+}
+
+var revisionLock sync.Mutex
+
+func newFileRevisionStore(dir string) *fileRevisionStore {
+	return &fileRevisionStore{
+		dir:          dir,
+		maxRevisions: DefaultMaxRevisions,
+		now:          time.Now,
+	}
+}
+
+// List returns the revisions of a suite, the latest one comes first
+func (s *fileRevisionStore) List(suite string) (revisions []SuiteRevision, err error) {
+	revisionLock.Lock()
+	defer revisionLock.Unlock()
+
+	if revisions, err = s.readIndex(suite); err == nil {
+		slices.Reverse(revisions)
+	}
+	return
+}
+
+// Get returns the snapshot of a revision
+func (s *fileRevisionStore) Get(suite, id string) (data []byte, err error) {
+	revisionLock.Lock()
+	defer revisionLock.Unlock()
+
+	var revisions []SuiteRevision
+	if revisions, err = s.readIndex(suite); err != nil {
+		return
+	}
+	if !slices.ContainsFunc(revisions, func(item SuiteRevision) bool {
+		return item.ID == id
+	}) {
+		err = fmt.Errorf("%w: %q of suite %q", ErrRevisionNotFound, id, suite)
+		return
+	}
+	data, err = os.ReadFile(filepath.Join(s.suiteDir(suite), id+".yaml"))
+	return
+}
+
+// Record saves a snapshot of a suite as a new revision, the oldest ones are removed once over the limitation.
+// It does nothing if the initial flag is true but the suite has revisions already.
+func (s *fileRevisionStore) Record(suite, testCase, action string, data []byte) (err error) {
+	revisionLock.Lock()
+	defer revisionLock.Unlock()
+
+	var revisions []SuiteRevision
+	if revisions, err = s.readIndex(suite); err != nil || (action == RevisionActionInitial && len(revisions) > 0) {
+		return
+	}
+
+	now := s.now()
+	id := now.UnixNano()
+	if count := len(revisions); count > 0 {
+		if lastID, parseErr := strconv.ParseInt(revisions[count-1].ID, 10, 64); parseErr == nil && lastID >= id {
+			id = lastID + 1
+		}
+	}
+
+	revision := SuiteRevision{
+		ID:         strconv.FormatInt(id, 10),
+		Suite:      suite,
+		TestCase:   testCase,
+		Action:     action,
+		CreateTime: now,
+	}
+	suiteDir := s.suiteDir(suite)
+	if err = os.MkdirAll(suiteDir, 0755); err != nil {
+		return
+	}
+	if err = os.WriteFile(filepath.Join(suiteDir, revision.ID+".yaml"), data, 0644); err != nil {
+		return
+	}
+
+	revisions = append(revisions, revision)
+	if s.maxRevisions > 0 && len(revisions) > s.maxRevisions {
+		for _, item := range revisions[:len(revisions)-s.maxRevisions] {
+			_ = os.Remove(filepath.Join(suiteDir, item.ID+".yaml"))
+		}
+		revisions = revisions[len(revisions)-s.maxRevisions:]
+	}
+
+	var index []byte
+	if index, err = yaml.Marshal(revisions); err == nil {
+		err = os.WriteFile(filepath.Join(suiteDir, "index.yaml"), index, 0644)
+	}
+	return
+}
+
+func (s *fileRevisionStore) readIndex(suite string) (revisions []SuiteRevision, err error) {
+	var data []byte
+	if data, err = os.ReadFile(filepath.Join(s.suiteDir(suite), "index.yaml")); err == nil {
+		err = yaml.Unmarshal(data, &revisions)
+	} else if errors.Is(err, os.ErrNotExist) {
+		err = nil
+	}
+	return
+}
+
+func (s *fileRevisionStore) suiteDir(suite string) string {
+	return filepath.Join(s.dir, url.PathEscape(suite))
+}
+
+// DiffTestSuites returns the structured changes between two suites,
+// the test cases are identified by their names, such as "items.login.request.api"
+func DiffTestSuites(oldSuite, newSuite *TestSuite) []util.FieldChange {
+	return util.DiffFields(suiteDiffObject(oldSuite), suiteDiffObject(newSuite))
+}
+
+// DiffTestCases returns the structured changes between two test cases, nil means the test case does not exist
+func DiffTestCases(oldCase, newCase *TestCase) []util.FieldChange {
+	var oldObj, newObj interface{}
+	if oldCase != nil {
+		oldObj = oldCase
+	}
+	if newCase != nil {
+		newObj = newCase
+	}
+	return util.DiffFields(oldObj, newObj)
+}
+
+func suiteDiffObject(suite *TestSuite) (obj map[string]interface{}) {
+	if suite == nil {
+		return
+	}
+
+	info := *suite
+	info.Items = nil
+	items := make(map[string]TestCase, len(suite.Items))
+	for _, item := range suite.Items {
+		items[item.Name] = item
+	}
+	obj = map[string]interface{}{
+		"suite": info,
+		"items": items,
+	}
+	return
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package testing
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileRevisionStore(t *testing.T) {
+	store := newFileRevisionStore(t.TempDir())
+	store.maxRevisions = 3
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	revisions, err := store.List("a/b")
+	assert.NoError(t, err)
+	assert.Empty(t, revisions)
+
+	assert.NoError(t, store.Record("a/b", "", RevisionActionInitial, []byte("v0")))
+	// the initial revision is ignored if there are revisions
+	assert.NoError(t, store.Record("a/b", "", RevisionActionInitial, []byte("ignored")))
+	assert.NoError(t, store.Record("a/b", "login", RevisionActionUpdateTestCase, []byte("v1")))
+	assert.NoError(t, store.Record("a/b", "", RevisionActionUpdateSuite, []byte("v2")))
+
+	revisions, err = store.List("a/b")
+	assert.NoError(t, err)
+	if assert.Len(t, revisions, 3) {
+		assert.Equal(t, RevisionActionUpdateSuite, revisions[0].Action)
+		assert.Equal(t, "login", revisions[1].TestCase)
+		assert.Equal(t, RevisionActionInitial, revisions[2].Action)
+		// the ids keep increasing even with the same time
+		assert.Greater(t, revisions[0].ID, revisions[1].ID)
+	}
+	initialID := revisions[2].ID
+
+	data, err := store.Get("a/b", revisions[1].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", string(data))
+
+	_, err = store.Get("a/b", "fake")
+	assert.True(t, errors.Is(err, ErrRevisionNotFound))
+
+	// the oldest one is removed
+	assert.NoError(t, store.Record("a/b", "", RevisionActionDeleteSuite, []byte("v3")))
+	revisions, err = store.List("a/b")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 3)
+	_, err = store.Get("a/b", initialID)
+	assert.Error(t, err)
+	assert.NoFileExists(t, filepath.Join(store.suiteDir("a/b"), initialID+".yaml"))
+}
+
+func TestDiffTestSuites(t *testing.T) {
+	oldSuite := &TestSuite{
+		Name: "demo",
+		API:  "http://localhost",
+		Items: []TestCase{{
+			Name:    "login",
+			Request: Request{API: "/login", Method: "POST"},
+		}, {
+			Name:    "logout",
+			Request: Request{API: "/logout"},
+		}},
+	}
+	newSuite := &TestSuite{
+		Name: "demo",
+		API:  "http://localhost:8080",
+		Items: []TestCase{{
+			Name:    "login",
+			Request: Request{API: "/v1/login", Method: "POST"},
+		}},
+	}
+
+	assert.Equal(t, []util.FieldChange{{
+		Path: "items.login.request.api", Type: util.ChangeChanged, OldValue: "/login", NewValue: "/v1/login",
+	}, {
+		Path: "items.logout.name", Type: util.ChangeRemoved, OldValue: "logout",
+	}, {
+		Path: "items.logout.request.api", Type: util.ChangeRemoved, OldValue: "/logout",
+	}, {
+		Path: "suite.api", Type: util.ChangeChanged, OldValue: "http://localhost", NewValue: "http://localhost:8080",
+	}}, DiffTestSuites(oldSuite, newSuite))
+
+	assert.Empty(t, DiffTestCases(oldSuite.FindTestCase("login"), oldSuite.FindTestCase("login")))
+	assert.Equal(t, []util.FieldChange{{
+		Path: "name", Type: util.ChangeAdded, NewValue: "logout",
+	}, {
+		Path: "request.api", Type: util.ChangeAdded, NewValue: "/logout",
+	}}, DiffTestCases(newSuite.FindTestCase("logout"), oldSuite.FindTestCase("logout")))
+}
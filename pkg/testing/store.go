@@ -41,6 +41,9 @@ type StoreConfig struct {
 	Extensions []StoreKind `yaml:"items"`
 }
 
+// LocalStoreName is the name of the default store which keeps the suites in local files
+const LocalStoreName = "local"
+
 type Store struct {
 	Name        string
 	Owner       string
@@ -134,6 +137,59 @@ type StoreWriterFactory interface {
 	NewInstance(store Store) (writer Writer, err error)
 }
 
+// BuiltinStoreWriterFactory creates the writers of a store kind which is implemented inside the server,
+// no extension is required for it
+type BuiltinStoreWriterFactory interface {
+	StoreWriterFactory
+	Kind() StoreKind
+}
+
+type storeWriterFactories struct {
+	fallback StoreWriterFactory
+	builtins []BuiltinStoreWriterFactory
+}
+
+// NewStoreWriterFactory creates a factory which creates the writers of the builtin store kinds by themselves,
+// and the others by the fallback factory
+func NewStoreWriterFactory(fallback StoreWriterFactory, builtins ...BuiltinStoreWriterFactory) StoreWriterFactory {
+	return &storeWriterFactories{
+		fallback: fallback,
+		builtins: builtins,
+	}
+}
+
+func (f *storeWriterFactories) NewInstance(store Store) (writer Writer, err error) {
+	for _, builtin := range f.builtins {
+		if builtin.Kind().Name == store.Kind.Name {
+			return builtin.NewInstance(store)
+		}
+	}
+	return f.fallback.NewInstance(store)
+}
+
+// GetBuiltinStoreKinds returns the builtin store kinds of a factory
+func GetBuiltinStoreKinds(factory StoreWriterFactory) (kinds []StoreKind) {
+	switch f := factory.(type) {
+	case *storeWriterFactories:
+		for _, builtin := range f.builtins {
+			kinds = append(kinds, builtin.Kind())
+		}
+	case BuiltinStoreWriterFactory:
+		kinds = append(kinds, f.Kind())
+	}
+	return
+}
+
+// IsBuiltinStoreKind checks if the store kind is implemented by the factory itself
+func IsBuiltinStoreKind(factory StoreWriterFactory, name string) bool {
+	for _, kind := range GetBuiltinStoreKinds(factory) {
+		if kind.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 type storeFactory struct {
 	configDir string
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package testing
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
@@ -39,6 +40,39 @@ func TestStoreConvert(t *testing.T) {
 	})
 }
 
+func TestStoreWriterFactory(t *testing.T) {
+	factory := NewStoreWriterFactory(&fakeStoreWriterFactory{}, &fakeStoreWriterFactory{kind: "builtin"})
+
+	writer, err := factory.NewInstance(Store{Kind: StoreKind{Name: "builtin"}})
+	assert.NoError(t, err)
+	assert.NotNil(t, writer)
+
+	_, err = factory.NewInstance(Store{Kind: StoreKind{Name: "extension"}})
+	assert.Error(t, err)
+
+	assert.True(t, IsBuiltinStoreKind(factory, "builtin"))
+	assert.False(t, IsBuiltinStoreKind(factory, "extension"))
+	assert.Equal(t, []StoreKind{{Name: "builtin"}}, GetBuiltinStoreKinds(factory))
+	assert.Empty(t, GetBuiltinStoreKinds(NewStoreWriterFactory(&fakeStoreWriterFactory{})))
+}
+
+type fakeStoreWriterFactory struct {
+	kind string
+}
+
+func (f *fakeStoreWriterFactory) Kind() StoreKind {
+	return StoreKind{Name: f.kind}
+}
+
+func (f *fakeStoreWriterFactory) NewInstance(store Store) (writer Writer, err error) {
+	if f.kind == "" {
+		err = fmt.Errorf("not support store kind %s", store.Kind.Name)
+	} else {
+		writer = NewNonWriter()
+	}
+	return
+}
+
 func TestStoreFactory(t *testing.T) {
 	factory := NewStoreFactory("testdata")
 	assert.NotNil(t, factory)
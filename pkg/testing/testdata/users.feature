@@ -0,0 +1,37 @@
+Feature: users
+
+  Background:
+    Given the API base "http://localhost:8080/api"
+    And the header "Authorization" is "Bearer {{.param.token}}"
+    And the param "token" is "secret"
+
+  Scenario: create a user
+    When I POST "/users" with body
+      """json
+      {"name": "rick"}
+      """
+    Then the status is 201
+    And field "$.id" exists
+    And field "$.name" is "rick"
+    And field "$.roles[0]" is "admin"
+    And field "$.password" does not exist
+    And the response header "Content-Type" is "application/json"
+
+  Scenario: query the users
+    When I GET "/users" with query
+      | page | 1  |
+      | size | 10 |
+    Then the status code is 200
+    And verify that len(data) > 0
+    When I GET "http://localhost:9090/health"
+    Then the body is "ok"
+
+  Scenario Outline: login
+    When I POST "/login" with form
+      | username | <name> |
+    Then the status is <status>
+
+    Examples:
+      | name | status |
+      | rick | 200    |
+      | fake | 401    |
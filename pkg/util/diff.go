@@ -0,0 +1,109 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package util
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// The types of the field changes
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// FieldChange represents a changed field, the values are in JSON format
+type FieldChange struct {
+	Path     string `json:"path"`
+	Type     string `json:"type"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+}
+
+// DiffFields compares two objects by their JSON representation.
+// The nested objects are flattened into paths like "spec.url", the arrays are compared as a whole,
+// and the empty strings are ignored.
+// The changes are sorted by the path.
+func DiffFields(oldObj, newObj interface{}) (changes []FieldChange) {
+	oldFields := flattenFields(oldObj)
+	newFields := flattenFields(newObj)
+
+	for key, val := range newFields {
+		if oldVal, ok := oldFields[key]; !ok {
+			changes = append(changes, FieldChange{Path: key, Type: ChangeAdded, NewValue: toJSONText(val)})
+		} else if !reflect.DeepEqual(oldVal, val) {
+			changes = append(changes, FieldChange{Path: key, Type: ChangeChanged,
+				OldValue: toJSONText(oldVal), NewValue: toJSONText(val)})
+		}
+	}
+	for key, val := range oldFields {
+		if _, ok := newFields[key]; !ok {
+			changes = append(changes, FieldChange{Path: key, Type: ChangeRemoved, OldValue: toJSONText(val)})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return
+}
+
+func flattenFields(obj interface{}) (fields map[string]interface{}) {
+	fields = make(map[string]interface{})
+	if obj == nil {
+		return
+	}
+
+	var data interface{}
+	if raw, err := json.Marshal(obj); err != nil || json.Unmarshal(raw, &data) != nil {
+		return
+	}
+	flattenInto("", data, fields)
+	return
+}
+
+func flattenInto(prefix string, data interface{}, fields map[string]interface{}) {
+	switch val := data.(type) {
+	case map[string]interface{}:
+		for key, item := range val {
+			if prefix != "" {
+				key = prefix + "." + key
+			}
+			flattenInto(key, item, fields)
+		}
+	case nil:
+	case string:
+		// an empty string is taken as absent, same as the omitempty fields
+		if prefix != "" && val != "" {
+			fields[prefix] = val
+		}
+	default:
+		if prefix != "" {
+			fields[prefix] = val
+		}
+	}
+}
+
+func toJSONText(val interface{}) string {
+	if text, ok := val.(string); ok {
+		return text
+	}
+	data, _ := json.Marshal(val)
+	return string(data)
+}
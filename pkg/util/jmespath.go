@@ -0,0 +1,65 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+// JMESPath returns the result of the JMESPath expression against the decoded JSON data, such as:
+// items[?price > `10`].name | length(@). See also https://jmespath.org/specification.html
+func JMESPath(data interface{}, expression string) (result interface{}, err error) {
+	defer func() {
+		// the library compares the values with ==, it panics with the objects
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to evaluate JMESPath %q: %v", expression, r)
+		}
+	}()
+
+	var path *jmespath.JMESPath
+	if path, err = jmespath.Compile(expression); err != nil {
+		err = fmt.Errorf("invalid JMESPath %q: %v", expression, err)
+		return
+	}
+	if result, err = path.Search(data); err != nil {
+		err = fmt.Errorf("failed to evaluate JMESPath %q: %v", expression, err)
+	}
+	return
+}
+
+// JSONTypeOf returns the JSON type name of the decoded value: string, number, boolean, object, array or null
+func JSONTypeOf(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64, float32, int, int64, int32, json.Number:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
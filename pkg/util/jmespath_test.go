@@ -0,0 +1,110 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJMESPath(t *testing.T) {
+	var data interface{}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"total": 3,
+		"items": [
+			{"id": 1, "name": "rick", "price": 5, "tags": ["a", "b"], "status": "active"},
+			{"id": 2, "name": "linuxsuren", "price": 20, "tags": ["c"], "status": "active"},
+			{"id": 3, "name": "admin", "price": 15, "tags": [], "status": "deleted"}
+		],
+		"owner": {"name": "admin", "the key": "value"}
+	}`), &data))
+
+	tests := []struct {
+		expression string
+		expect     interface{}
+	}{
+		{expression: "@", expect: data},
+		{expression: "total", expect: float64(3)},
+		{expression: "owner.name", expect: "admin"},
+		{expression: `owner."the key"`, expect: "value"},
+		{expression: "items[0].name", expect: "rick"},
+		{expression: "items[-1].id", expect: float64(3)},
+		{expression: "items[5].id", expect: nil},
+		{expression: "fake.name", expect: nil},
+		{expression: "items[*].id", expect: []interface{}{float64(1), float64(2), float64(3)}},
+		{expression: "items[*].tags[0]", expect: []interface{}{"a", "c"}},
+		{expression: "items[].tags[]", expect: []interface{}{"a", "b", "c"}},
+		{expression: "owner.*", expect: []interface{}{"admin", "value"}},
+		{expression: "items[?price > `10`].name", expect: []interface{}{"linuxsuren", "admin"}},
+		{expression: "items[?status == 'active' && price <= `5`].id", expect: []interface{}{float64(1)}},
+		{expression: "items[?!(status == 'active') || id == `2`].id", expect: []interface{}{float64(2), float64(3)}},
+		{expression: "items[?contains(tags, 'c')].name | [0]", expect: "linuxsuren"},
+		{expression: "items[?starts_with(name, 'li')].id", expect: []interface{}{float64(2)}},
+		{expression: "items[?ends_with(name, 'ck')].id", expect: []interface{}{float64(1)}},
+		{expression: "length(items)", expect: float64(3)},
+		{expression: "items[*].price | sum(@)", expect: float64(40)},
+		{expression: "max(items[*].price)", expect: float64(20)},
+		{expression: "min(items[*].price)", expect: float64(5)},
+		{expression: "keys(owner)", expect: []interface{}{"name", "the key"}},
+		{expression: "values(owner)", expect: []interface{}{"admin", "value"}},
+		{expression: "type(items)", expect: "array"},
+		{expression: "items[:2].id", expect: []interface{}{float64(1), float64(2)}},
+		{expression: "items[::-1].id | [0]", expect: float64(3)},
+		{expression: "items[0].[id, name]", expect: []interface{}{float64(1), "rick"}},
+		{expression: "owner.{n: name}", expect: map[string]interface{}{"n": "admin"}},
+		{expression: "sort_by(items, &price)[*].id", expect: []interface{}{float64(1), float64(3), float64(2)}},
+		{expression: "contains(owner.name, `1`)", expect: false},
+		{expression: "contains(owner.name, null)", expect: false},
+		{expression: "items[?tags]", expect: []interface{}{
+			data.(map[string]interface{})["items"].([]interface{})[0],
+			data.(map[string]interface{})["items"].([]interface{})[1],
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := util.JMESPath(data, tt.expression)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+
+	for expression, msg := range map[string]string{
+		"items[":             "invalid JMESPath",
+		"items[?id = 1]":     "invalid JMESPath",
+		"items#":             "Unknown char: '#'",
+		"'unclosed":          "Unclosed delimiter",
+		"fake(items)":        "unknown function: fake",
+		"length(total)":      "Invalid type for: 3",
+		"sum(items)":         "failed to evaluate JMESPath",
+		"contains(items, @)": "failed to evaluate JMESPath",
+	} {
+		_, err := util.JMESPath(data, expression)
+		assert.ErrorContains(t, err, msg, expression)
+	}
+}
+
+func TestJSONTypeOf(t *testing.T) {
+	assert.Equal(t, "null", util.JSONTypeOf(nil))
+	assert.Equal(t, "string", util.JSONTypeOf("a"))
+	assert.Equal(t, "boolean", util.JSONTypeOf(true))
+	assert.Equal(t, "number", util.JSONTypeOf(1.5))
+	assert.Equal(t, "array", util.JSONTypeOf([]interface{}{}))
+	assert.Equal(t, "object", util.JSONTypeOf(map[string]interface{}{}))
+}
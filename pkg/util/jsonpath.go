@@ -0,0 +1,188 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type jsonPathSegment struct {
+	key       string
+	index     int
+	isIndex   bool
+	wildcard  bool
+	recursive bool
+}
+
+// JSONPath returns the value of the decoded JSON data which matches the path, such as: $.items[0].name.
+// The wildcard (*) and the recursive descent (..) are supported, all the matched values are returned
+// as a list if any of them is used. Otherwise, it returns nil if nothing matches
+func JSONPath(data interface{}, path string) (result interface{}, err error) {
+	var segments []jsonPathSegment
+	if segments, err = parseJSONPath(path); err != nil {
+		return
+	}
+
+	multiple := false
+	nodes := []interface{}{data}
+	for _, segment := range segments {
+		multiple = multiple || segment.wildcard || segment.recursive
+		if segment.recursive {
+			var all []interface{}
+			for _, node := range nodes {
+				all = appendDescendants(all, node)
+			}
+			nodes = all
+		}
+
+		var next []interface{}
+		for _, node := range nodes {
+			next = append(next, selectJSONPath(node, segment)...)
+		}
+		nodes = next
+	}
+
+	switch {
+	case multiple:
+		if nodes == nil {
+			nodes = []interface{}{}
+		}
+		result = nodes
+	case len(nodes) == 1:
+		result = nodes[0]
+	}
+	return
+}
+
+func parseJSONPath(path string) (segments []jsonPathSegment, err error) {
+	rest := strings.TrimSpace(path)
+	if strings.HasPrefix(rest, "$") {
+		rest = rest[1:]
+	} else if rest != "" && !strings.HasPrefix(rest, "[") && !strings.HasPrefix(rest, ".") {
+		rest = "." + rest
+	}
+
+	for rest != "" {
+		var segment jsonPathSegment
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			segment.recursive = true
+			rest = rest[2:]
+			if strings.HasPrefix(rest, "[") {
+				break
+			}
+			rest = "." + rest
+			fallthrough
+		case strings.HasPrefix(rest, "."):
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			name := rest[1 : end+1]
+			rest = rest[end+1:]
+			if name == "" {
+				err = fmt.Errorf("invalid JSONPath: %q", path)
+				return
+			}
+			segment.key = name
+			segment.wildcard = name == "*"
+			segments = append(segments, segment)
+			continue
+		case !strings.HasPrefix(rest, "["):
+			err = fmt.Errorf("invalid JSONPath: %q", path)
+			return
+		}
+
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			err = fmt.Errorf("invalid JSONPath: %q", path)
+			return
+		}
+		selector := strings.TrimSpace(rest[1:end])
+		rest = rest[end+1:]
+
+		switch {
+		case selector == "*":
+			segment.wildcard = true
+		case len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0]:
+			segment.key = selector[1 : len(selector)-1]
+		default:
+			if segment.index, err = strconv.Atoi(selector); err != nil {
+				err = fmt.Errorf("not supported selector %q of JSONPath: %q", selector, path)
+				return
+			}
+			segment.isIndex = true
+		}
+		segments = append(segments, segment)
+	}
+	return
+}
+
+func selectJSONPath(node interface{}, segment jsonPathSegment) (result []interface{}) {
+	switch val := node.(type) {
+	case map[string]interface{}:
+		if segment.wildcard {
+			keys := make([]string, 0, len(val))
+			for k := range val {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				result = append(result, val[k])
+			}
+		} else if item, ok := val[segment.key]; ok && !segment.isIndex {
+			result = append(result, item)
+		}
+	case []interface{}:
+		if segment.wildcard {
+			result = append(result, val...)
+		} else if segment.isIndex {
+			index := segment.index
+			if index < 0 {
+				index += len(val)
+			}
+			if index >= 0 && index < len(val) {
+				result = append(result, val[index])
+			}
+		}
+	}
+	return
+}
+
+// appendDescendants appends the node and all its descendants in the pre-order
+func appendDescendants(nodes []interface{}, node interface{}) []interface{} {
+	nodes = append(nodes, node)
+	switch val := node.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			nodes = appendDescendants(nodes, val[k])
+		}
+	case []interface{}:
+		for _, item := range val {
+			nodes = appendDescendants(nodes, item)
+		}
+	}
+	return nodes
+}
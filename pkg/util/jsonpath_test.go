@@ -0,0 +1,90 @@
+/*
+Copyright 2025 API Testing Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/linuxsuren/api-testing/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONPath(t *testing.T) {
+	var data interface{}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"total": 2,
+		"items": [{"id": 1, "name": "rick", "tags": ["a"]}, {"id": 2, "name": "linuxsuren"}],
+		"owner": {"name": "admin", "the key": "value"}
+	}`), &data))
+
+	tests := []struct {
+		path   string
+		expect interface{}
+	}{{
+		path:   "$",
+		expect: data,
+	}, {
+		path:   "$.total",
+		expect: float64(2),
+	}, {
+		path:   "items[1].name",
+		expect: "linuxsuren",
+	}, {
+		path:   "$.items[-1].id",
+		expect: float64(2),
+	}, {
+		path:   "$['owner']['the key']",
+		expect: "value",
+	}, {
+		path:   `$.owner["name"]`,
+		expect: "admin",
+	}, {
+		path:   "$.items[*].id",
+		expect: []interface{}{float64(1), float64(2)},
+	}, {
+		path:   "$.items.*.name",
+		expect: []interface{}{"rick", "linuxsuren"},
+	}, {
+		path:   "$..name",
+		expect: []interface{}{"rick", "linuxsuren", "admin"},
+	}, {
+		path:   "$..tags[0]",
+		expect: []interface{}{"a"},
+	}, {
+		path:   "$.items[*].fake",
+		expect: []interface{}{},
+	}, {
+		path:   "$.fake.name",
+		expect: nil,
+	}, {
+		path:   "$.items[5]",
+		expect: nil,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			result, err := util.JSONPath(data, tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+
+	for _, path := range []string{"$.", "$.items[0", "$.items[?(@.id)]", "$x"} {
+		_, err := util.JSONPath(data, path)
+		assert.Error(t, err, path)
+	}
+}
@@ -23,11 +23,77 @@ import (
 	"time"
 )
 
+// rng is the shared random source of the templates, test data and mutators,
+// the same seed generates the same random data
 var rng = struct {
 	sync.Mutex
 	rand *rand.Rand
-}{
-	rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	seed int64
+}{}
+
+func init() {
+	SetSeed(time.Now().UnixNano())
+}
+
+// SetSeed resets the shared random source with a seed
+func SetSeed(seed int64) {
+	rng.Lock()
+	defer rng.Unlock()
+	rng.seed = seed
+	rng.rand = rand.New(rand.NewSource(seed))
+}
+
+// Seed returns the seed of the shared random source
+func Seed() int64 {
+	rng.Lock()
+	defer rng.Unlock()
+	return rng.seed
+}
+
+// Intn returns a random number in [0,n) from the shared random source
+func Intn(n int) int {
+	rng.Lock()
+	defer rng.Unlock()
+	return rng.rand.Intn(n)
+}
+
+// Int63 returns a random non-negative number from the shared random source
+func Int63() int64 {
+	rng.Lock()
+	defer rng.Unlock()
+	return rng.rand.Int63()
+}
+
+// Float64 returns a random number in [0.0,1.0) from the shared random source
+func Float64() float64 {
+	rng.Lock()
+	defer rng.Unlock()
+	return rng.rand.Float64()
+}
+
+// NormFloat64 returns a normally distributed number from the shared random source
+func NormFloat64() float64 {
+	rng.Lock()
+	defer rng.Unlock()
+	return rng.rand.NormFloat64()
+}
+
+// Perm returns a random permutation of [0,n) from the shared random source
+func Perm(n int) []int {
+	rng.Lock()
+	defer rng.Unlock()
+	return rng.rand.Perm(n)
+}
+
+// RandReader reads the random bytes from the shared random source
+var RandReader = randReader{}
+
+type randReader struct{}
+
+func (randReader) Read(p []byte) (n int, err error) {
+	rng.Lock()
+	defer rng.Unlock()
+	return rng.rand.Read(p)
 }
 
 const (
@@ -17,6 +17,7 @@ limitations under the License.
 package util
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -41,6 +42,22 @@ func TestString(t *testing.T) {
 	}
 }
 
+func TestSeed(t *testing.T) {
+	defer SetSeed(Seed())
+
+	SetSeed(42)
+	if Seed() != 42 {
+		t.Errorf("expected seed 42, got %d", Seed())
+	}
+	first := []any{String(8), Intn(100), Int63(), Float64(), NormFloat64(), Perm(5)}
+
+	SetSeed(42)
+	second := []any{String(8), Intn(100), Int63(), Float64(), NormFloat64(), Perm(5)}
+	if fmt.Sprint(first) != fmt.Sprint(second) {
+		t.Errorf("expected the same random data with the same seed, got %v and %v", first, second)
+	}
+}
+
 func BenchmarkRandomStringGeneration(b *testing.B) {
 	b.ResetTimer()
 	var s string